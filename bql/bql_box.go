@@ -50,6 +50,11 @@ type bqlBox struct {
 	// removeMe is a function to remove this bqlBox from its
 	// topology. A nil check must be done before calling.
 	removeMe func()
+	// schema validates tuples emitted by this box if it isn't nil
+	schema *streamSchema
+	// name is the name of the stream this box computes. It's used
+	// to report tuples violating the schema.
+	name string
 }
 
 func NewBQLBox(stmt *parser.SelectStmt, reg udf.FunctionRegistry) *bqlBox {
@@ -83,6 +88,17 @@ func (b *bqlBox) Process(ctx *core.Context, t *core.Tuple, s core.Writer) error
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
 
+	// validate emitted tuples with the schema. the writer is also used by
+	// the time-based emitter.
+	if b.schema != nil {
+		s = &schemaWriter{
+			schema:   b.schema,
+			w:        s,
+			nodeType: core.NTBox,
+			nodeName: b.name,
+		}
+	}
+
 	// deal with statements that have an emitter limit. in particular,
 	// if we are already over the limit, exit here
 	b.timeEmitterMutex.Lock()
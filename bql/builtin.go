@@ -1,13 +1,12 @@
 package bql
 
 import (
-	"bufio"
-	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -40,17 +39,12 @@ func init() {
 	MustRegisterGlobalSinkCreator("uds", SinkCreatorFunc(createSharedStateSink))
 }
 
-type readerSource struct {
-	filename string
+// recordEmitter decodes records read from a stream and writes them as tuples.
+type recordEmitter struct {
+	format   RecordFormat
 	tsField  data.Path
 	ioParams *IOParams
 
-	// repeat is the number of times that the input data is read. When its value
-	// is less than 0, the source will read the input again and again until it's
-	// stopped. When the value is 0, the source only read the input once. When
-	// the value is k (> 0), the input is read k+1 times including the first run.
-	repeat int64
-
 	// interval is the interval between emissions of two consecutive tuples.
 	// When its value is less than or equal to 0, the source tries to emit
 	// tuples as fast as possible.
@@ -58,63 +52,77 @@ type readerSource struct {
 	stopCh   chan struct{}
 }
 
-func (s *readerSource) GenerateStream(ctx *core.Context, w core.Writer) error {
-	for r := int64(0); s.repeat < 0 || r <= s.repeat; r++ {
-		if err := s.generateStream(ctx, w); err != nil {
-			return err
+// newRecordEmitter creates a recordEmitter from "format", "timestamp_field",
+// and "interval" parameters, and parameters of the format.
+func newRecordEmitter(ioParams *IOParams, params data.Map) (*recordEmitter, error) {
+	v := &struct {
+		Format         string
+		TimestampField string
+		Interval       time.Duration
+	}{
+		TimestampField: "",
+	}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	var tsField data.Path
+	if v.TimestampField != "" {
+		var err error
+		if tsField, err = data.CompilePath(v.TimestampField); err != nil {
+			return nil, fmt.Errorf("'timestamp_field' parameter doesn't have a valid path: %v", err)
 		}
 	}
-	return nil
-}
 
-func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
-	f, err := os.Open(s.filename)
+	format, err := newRecordFormat(v.Format, params)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer func() {
-		if err := f.Close(); err != nil {
-			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-				Warning("Cannot close the file")
-		}
-	}()
+	return &recordEmitter{
+		format:   format,
+		tsField:  tsField,
+		ioParams: ioParams,
+		interval: v.Interval,
+		stopCh:   make(chan struct{}),
+	}, nil
+}
 
-	r := bufio.NewReader(f)
-	next := time.Now()
-	for lineNumber := 0; ; lineNumber++ {
-		line, err := r.ReadBytes('\n')
-		if err != nil && err != io.EOF {
-			return err
-		}
+// emit reads all records from r and writes them to w. It returns
+// core.ErrSourceStopped when stopCh is closed while it's waiting.
+func (e *recordEmitter) emit(ctx *core.Context, w core.Writer, r io.Reader) error {
+	return e.emitDecoded(ctx, w, e.format.NewDecoder(r))
+}
 
-		line = bytes.TrimSpace(line)
-		if len(line) == 0 {
+// emitDecoded is same as emit except that it reads records from dec.
+func (e *recordEmitter) emitDecoded(ctx *core.Context, w core.Writer, dec RecordDecoder) error {
+	next := time.Now()
+	for {
+		m, err := dec.Decode()
+		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			continue
-		}
-
-		m := data.Map{}
-		if err := json.Unmarshal(line, &m); err != nil {
-			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-				WithField("jsonl_line_number", lineNumber).
-				WithField("body", string(line)).Warning("Ignoring the line due to a json parse error")
-			continue
+			if re, ok := err.(*RecordError); ok {
+				ctx.ErrLog(re.Err).WithField("node_name", e.ioParams.Name).
+					WithField("record_index", re.Index).
+					WithField("body", re.Body).Warning("Ignoring the record due to a parse error")
+				continue
+			}
+			return err
 		}
 
 		t := core.NewTuple(m)
-		if s.interval > 0 {
+		if e.interval > 0 {
 			// When the interval parameter is given, a proper application
 			// timestamp should be assigned to each tuple.
 			t.Timestamp = next
 		}
-		if s.tsField != nil {
-			if v, err := t.Data.Get(s.tsField); err == nil {
+		if e.tsField != nil {
+			if v, err := t.Data.Get(e.tsField); err == nil {
 				if ts, err := data.ToTimestamp(v); err != nil {
-					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-						WithField("jsonl_line_number", lineNumber).
-						WithField("timestamp_field", s.tsField).
+					ctx.ErrLog(err).WithField("node_name", e.ioParams.Name).
+						WithField("timestamp_field", e.tsField).
 						WithField("timestamp_field_value", v).
 						Warning("Cannot convert a value in timestamp_field to a timestamp")
 				} else {
@@ -127,19 +135,19 @@ func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
 			return err
 		}
 
-		if s.interval > 0 {
+		if e.interval > 0 {
 			// wait as accurate as possible
 			now := time.Now()
-			next = next.Add(s.interval)
+			next = next.Add(e.interval)
 			if next.Before(now) {
 				// delayed too much and should be rescheduled.
-				next = now.Add(s.interval)
+				next = now.Add(e.interval)
 			}
 
 			select {
-			case <-s.stopCh:
-				// This works as long as createFileSource returns a source
-				// wrapped with core.NewRewindableSource or core.ImplementSourceStop.
+			case <-e.stopCh:
+				// This works as long as the source is wrapped with
+				// core.NewRewindableSource or core.ImplementSourceStop.
 				return core.ErrSourceStopped
 			case <-time.After(next.Sub(now)):
 			}
@@ -148,45 +156,113 @@ func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
 	return nil
 }
 
+type readerSource struct {
+	*recordEmitter
+	filename string
+
+	// compression is the name of the compression method of the file.
+	compression string
+
+	// repeat is the number of times that the input data is read. When its value
+	// is less than 0, the source will read the input again and again until it's
+	// stopped. When the value is 0, the source only read the input once. When
+	// the value is k (> 0), the input is read k+1 times including the first run.
+	repeat int64
+
+	// follow is true when the source keeps reading data appended to the file
+	// like "tail -F". pollInterval is the interval of checking the file
+	// after it reaches the end of the file.
+	follow       bool
+	pollInterval time.Duration
+}
+
+func (s *readerSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	for r := int64(0); s.repeat < 0 || r <= s.repeat; r++ {
+		if err := s.generateStream(ctx, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
+	var (
+		f   io.ReadCloser
+		err error
+	)
+	if s.follow {
+		f, err = openFollowReader(s.filename, s.pollInterval, s.stopCh)
+	} else {
+		f, err = os.Open(s.filename)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				Warning("Cannot close the file")
+		}
+	}()
+
+	r, err := newDecompressingReader(f, s.compression)
+	if err != nil {
+		return err
+	}
+	return s.emit(ctx, w, r)
+}
+
 func (s *readerSource) Stop(ctx *core.Context) error {
 	close(s.stopCh)
 	return nil
 }
 
 func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
-	// TODO: add format parameter
-
 	v := &struct {
-		Path           string `bql:",required"`
-		Rewindable     bool
-		TimestampField string
-		Repeat         int64
-		Interval       time.Duration
+		Path         string `bql:",required"`
+		Compression  string
+		Rewindable   bool
+		Repeat       int64
+		Follow       bool
+		PollInterval time.Duration
 	}{
-		Rewindable:     false,
-		TimestampField: "",
-		Repeat:         0,
+		Rewindable:   false,
+		Repeat:       0,
+		PollInterval: time.Second,
 	}
 	dec := data.NewDecoder(nil)
 	if err := dec.Decode(params, v); err != nil {
 		return nil, err
 	}
 
-	var tsField data.Path
-	if v.TimestampField != "" {
-		var err error
-		if tsField, err = data.CompilePath(v.TimestampField); err != nil {
-			return nil, fmt.Errorf("'timestamp_field' parameter doesn't have a valid path: %v", err)
+	compression, err := compressionOf(v.Compression, v.Path)
+	if err != nil {
+		return nil, err
+	}
+	if v.Follow {
+		if v.Repeat != 0 {
+			return nil, errors.New("'repeat' parameter cannot be used with 'follow' parameter")
+		}
+		if compression != noCompression {
+			return nil, errors.New("'follow' parameter cannot be used with compressed files")
+		}
+		if v.PollInterval <= 0 {
+			return nil, errors.New("'poll_interval' parameter must be positive")
 		}
 	}
 
+	e, err := newRecordEmitter(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &readerSource{
-		filename: v.Path,
-		tsField:  tsField,
-		ioParams: ioParams,
-		repeat:   v.Repeat,
-		interval: v.Interval,
-		stopCh:   make(chan struct{}),
+		recordEmitter: e,
+		filename:      v.Path,
+		compression:   compression,
+		repeat:        v.Repeat,
+		follow:        v.Follow,
+		pollInterval:  v.PollInterval,
 	}
 	if v.Rewindable {
 		return core.NewRewindableSource(s), nil
@@ -201,25 +277,41 @@ func init() {
 type writerSink struct {
 	m           sync.Mutex
 	w           io.Writer
+	enc         RecordEncoder
 	shouldClose bool
 }
 
-func (s *writerSink) Write(ctx *core.Context, t *core.Tuple) error {
-	// TODO: support custom formatting. There're several things that need to
-	// be considered such as concurrent formatting, zero-copy write, and so on.
-	// While encoding tuples outside the lock supports concurrent formatting,
-	// it makes it difficult to support zero-copy write.
+func newWriterSink(w io.Writer, format RecordFormat, shouldClose bool) *writerSink {
+	return &writerSink{
+		w:           w,
+		enc:         format.NewEncoder(w),
+		shouldClose: shouldClose,
+	}
+}
 
-	js := t.Data.String() // Format this outside the lock
+func (s *writerSink) Write(ctx *core.Context, t *core.Tuple) error {
+	// TODO: support concurrent formatting. Tuples are encoded inside the lock
+	// because some encoders such as the one of CSV have states.
 
-	// This lock is required to avoid interleaving JSONs.
+	// This lock is required to avoid interleaving records.
 	s.m.Lock()
 	defer s.m.Unlock()
 	if s.w == nil {
 		return errors.New("the sink is already closed")
 	}
-	_, err := fmt.Fprintln(s.w, js)
-	return err
+	if err := s.enc.Encode(t.Data); err != nil {
+		return err
+	}
+	// Writers buffering data, such as the one compressing a file, are
+	// flushed so that records written so far can be read from the output.
+	if f, ok := s.w.(flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+type flusher interface {
+	Flush() error
 }
 
 func (s *writerSink) Close(ctx *core.Context) error {
@@ -237,21 +329,30 @@ func (s *writerSink) Close(ctx *core.Context) error {
 }
 
 func createStdoutSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
-	return &writerSink{
-		w: os.Stdout,
-	}, nil
+	v := &struct {
+		Format string
+	}{}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	format, err := newRecordFormat(v.Format, params)
+	if err != nil {
+		return nil, err
+	}
+	return newWriterSink(os.Stdout, format, false), nil
 }
 
 func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
 	// TODO: currently this sink isn't secure because it accepts any path.
 	// TODO: support buffering
-	// TODO: provide "format" parameter to support output formats other than "jsonl".
-	//       "jsonl" should be the default value.
-	// TODO: support "compression" parameter with values like "gz".
 
 	v := &struct {
-		Path     string `bql:",required"`
-		Truncate bool
+		Path        string `bql:",required"`
+		Format      string
+		Compression string
+		Truncate    bool
 		// rotate information
 		MaxSize    int
 		MaxAge     int
@@ -265,10 +366,29 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 		return nil, err
 	}
 
+	format, err := newRecordFormat(v.Format, params)
+	if err != nil {
+		return nil, err
+	}
+	compression, err := compressionOf(v.Compression, v.Path)
+	if err != nil {
+		return nil, err
+	}
+	if compression == bzip2Compression {
+		return nil, fmt.Errorf("%v compression is only supported for reading", compression)
+	}
+
 	var w io.Writer
 	if v.MaxSize > 0 {
+		// lumberjack cannot compress the file being written. Instead, it
+		// compresses rotated files and appends ".gz" to their names.
+		if compression == gzipCompression && strings.EqualFold(filepath.Ext(v.Path), ".gz") {
+			return nil, errors.New("'path' parameter must not have '.gz' extension when the file is rotated " +
+				"because only rotated files are compressed")
+		}
 		l := lumberjack.Logger{
 			Filename: v.Path,
+			Compress: compression == gzipCompression,
 		}
 		if v.MaxAge > 0 {
 			l.MaxAge = v.MaxAge
@@ -292,12 +412,12 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 		if err != nil {
 			return nil, err
 		}
-		w = file
+		if w, err = newCompressingWriter(file, compression); err != nil {
+			file.Close()
+			return nil, err
+		}
 	}
-	return &writerSink{
-		w:           w,
-		shouldClose: true,
-	}, nil
+	return newWriterSink(w, format, true), nil
 }
 
 func init() {
@@ -0,0 +1,422 @@
+package bql
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func init() {
+	MustRegisterGlobalRecordFormatCreator("jsonl", RecordFormatCreatorFunc(createJSONLFormat))
+	MustRegisterGlobalRecordFormatCreator("csv", RecordFormatCreatorFunc(createCSVFormat))
+	MustRegisterGlobalRecordFormatCreator("tsv", RecordFormatCreatorFunc(createTSVFormat))
+	MustRegisterGlobalRecordFormatCreator("msgpack", RecordFormatCreatorFunc(createMsgpackFormat))
+	MustRegisterGlobalRecordFormatCreator("raw", RecordFormatCreatorFunc(createRawFormat))
+}
+
+// lineReader reads a stream line by line while counting line numbers.
+type lineReader struct {
+	r    *bufio.Reader
+	next int
+}
+
+func newLineReader(r io.Reader) *lineReader {
+	return &lineReader{
+		r: bufio.NewReader(r),
+	}
+}
+
+// readLine returns the next line without the trailing newline and its line
+// number. It returns io.EOF when there's no more line.
+func (l *lineReader) readLine() ([]byte, int, error) {
+	line, err := l.r.ReadBytes('\n')
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		return nil, 0, err
+	}
+	n := l.next
+	l.next++
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	return bytes.TrimSuffix(line, []byte("\r")), n, nil
+}
+
+// jsonlFormat reads and writes records in JSON Lines. Blank lines are ignored.
+type jsonlFormat struct{}
+
+func createJSONLFormat(params data.Map) (RecordFormat, error) {
+	return jsonlFormat{}, nil
+}
+
+func (jsonlFormat) NewDecoder(r io.Reader) RecordDecoder {
+	return &jsonlDecoder{
+		r: newLineReader(r),
+	}
+}
+
+func (jsonlFormat) NewEncoder(w io.Writer) RecordEncoder {
+	return &jsonlEncoder{
+		w: w,
+	}
+}
+
+type jsonlDecoder struct {
+	r *lineReader
+}
+
+func (d *jsonlDecoder) Decode() (data.Map, error) {
+	for {
+		line, n, err := d.r.readLine()
+		if err != nil {
+			return nil, err
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		m := data.Map{}
+		if err := json.Unmarshal(line, &m); err != nil {
+			return nil, &RecordError{Index: n, Body: string(line), Err: err}
+		}
+		return m, nil
+	}
+}
+
+type jsonlEncoder struct {
+	w io.Writer
+}
+
+func (e *jsonlEncoder) Encode(m data.Map) error {
+	_, err := fmt.Fprintln(e.w, m.String())
+	return err
+}
+
+// csvFormat reads and writes records in CSV or TSV. When header is true, the
+// first record of a stream has names of columns. columns overrides names in
+// the header. When inferTypes is true, types of values are inferred while
+// decoding records. Otherwise, all values are decoded as strings.
+type csvFormat struct {
+	delimiter  rune
+	header     bool
+	columns    []string
+	inferTypes bool
+}
+
+func createCSVFormat(params data.Map) (RecordFormat, error) {
+	return newCSVFormat(params, ",")
+}
+
+func createTSVFormat(params data.Map) (RecordFormat, error) {
+	return newCSVFormat(params, "\t")
+}
+
+func newCSVFormat(params data.Map, delimiter string) (RecordFormat, error) {
+	v := &struct {
+		Header     bool
+		Columns    []string
+		InferTypes bool
+		Delimiter  string
+	}{
+		Header:     true,
+		InferTypes: true,
+		Delimiter:  delimiter,
+	}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	d, size := utf8.DecodeRuneInString(v.Delimiter)
+	if size == 0 || size != len(v.Delimiter) || d == utf8.RuneError ||
+		d == '"' || d == '\r' || d == '\n' {
+		return nil, fmt.Errorf("'delimiter' parameter must be a valid single character: %v", v.Delimiter)
+	}
+	if !v.Header && len(v.Columns) == 0 {
+		return nil, errors.New("'columns' parameter is required when 'header' parameter is false")
+	}
+	return &csvFormat{
+		delimiter:  d,
+		header:     v.Header,
+		columns:    v.Columns,
+		inferTypes: v.InferTypes,
+	}, nil
+}
+
+func (f *csvFormat) NewDecoder(r io.Reader) RecordDecoder {
+	cr := csv.NewReader(r)
+	cr.Comma = f.delimiter
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	return &csvDecoder{
+		f:       f,
+		r:       cr,
+		columns: f.columns,
+	}
+}
+
+func (f *csvFormat) NewEncoder(w io.Writer) RecordEncoder {
+	cw := csv.NewWriter(w)
+	cw.Comma = f.delimiter
+	return &csvEncoder{
+		f:       f,
+		w:       cw,
+		columns: f.columns,
+	}
+}
+
+type csvDecoder struct {
+	f          *csvFormat
+	r          *csv.Reader
+	columns    []string
+	headerRead bool
+	hdr        []string
+	next       int
+}
+
+// headerDecoder is implemented by decoders reading a header at the beginning
+// of a stream. It's used to decode data appended to a stream whose header has
+// already been read by another decoder.
+type headerDecoder interface {
+	// header returns the header read from the stream. It returns nil when
+	// the decoder doesn't read a header or hasn't read it yet.
+	header() []string
+
+	// setHeader makes the decoder use the header instead of reading it from
+	// the stream.
+	setHeader(h []string)
+}
+
+var (
+	_ headerDecoder = &csvDecoder{}
+)
+
+func (d *csvDecoder) header() []string {
+	return d.hdr
+}
+
+func (d *csvDecoder) setHeader(h []string) {
+	if !d.f.header {
+		return
+	}
+	d.headerRead = true
+	d.hdr = h
+	if len(d.columns) == 0 {
+		d.columns = h
+	}
+}
+
+func (d *csvDecoder) Decode() (data.Map, error) {
+	for {
+		rec, err := d.r.Read()
+		n := d.next
+		d.next++
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); ok {
+				return nil, &RecordError{Index: n, Err: err}
+			}
+			return nil, err
+		}
+
+		if d.f.header && !d.headerRead {
+			d.headerRead = true
+			d.hdr = rec
+			if len(d.columns) == 0 {
+				d.columns = rec
+			}
+			continue
+		}
+
+		if len(rec) != len(d.columns) {
+			return nil, &RecordError{Index: n, Body: strings.Join(rec, string(d.f.delimiter)),
+				Err: fmt.Errorf("the number of fields (%v) doesn't match the number of columns (%v)",
+					len(rec), len(d.columns))}
+		}
+		m := make(data.Map, len(rec))
+		for i, s := range rec {
+			if d.f.inferTypes {
+				m[d.columns[i]] = inferCSVValue(s)
+			} else {
+				m[d.columns[i]] = data.String(s)
+			}
+		}
+		return m, nil
+	}
+}
+
+// inferCSVValue converts a field of CSV to a Value. An empty field is
+// converted to Null. Integers, floating point numbers, and true or false are
+// converted to Int, Float, and Bool, respectively. Other fields are converted
+// to String.
+func inferCSVValue(s string) data.Value {
+	if s == "" {
+		return data.Null{}
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return data.Int(i)
+	}
+	// ParseFloat also accepts strings like "NaN" or "Inf", which should be
+	// kept as strings.
+	if c := s[0]; c == '+' || c == '-' || c == '.' || ('0' <= c && c <= '9') {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return data.Float(f)
+		}
+	}
+	switch strings.ToLower(s) {
+	case "true":
+		return data.True
+	case "false":
+		return data.False
+	}
+	return data.String(s)
+}
+
+type csvEncoder struct {
+	f             *csvFormat
+	w             *csv.Writer
+	columns       []string
+	headerWritten bool
+}
+
+func (e *csvEncoder) Encode(m data.Map) error {
+	if len(e.columns) == 0 {
+		// The order of columns is determined by the first record when
+		// it isn't given.
+		for k := range m {
+			e.columns = append(e.columns, k)
+		}
+		sort.Strings(e.columns)
+	}
+
+	if e.f.header && !e.headerWritten {
+		if err := e.w.Write(e.columns); err != nil {
+			return err
+		}
+		e.headerWritten = true
+	}
+
+	rec := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		v, ok := m[c]
+		if !ok {
+			continue
+		}
+		s, err := data.ToString(v)
+		if err != nil {
+			return err
+		}
+		rec[i] = s
+	}
+	if err := e.w.Write(rec); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// msgpackFormat reads and writes records as concatenated msgpack maps.
+type msgpackFormat struct{}
+
+func createMsgpackFormat(params data.Map) (RecordFormat, error) {
+	return msgpackFormat{}, nil
+}
+
+func (msgpackFormat) NewDecoder(r io.Reader) RecordDecoder {
+	return data.NewMsgpackDecoder(r)
+}
+
+func (msgpackFormat) NewEncoder(w io.Writer) RecordEncoder {
+	return &msgpackEncoder{
+		w: w,
+	}
+}
+
+type msgpackEncoder struct {
+	w io.Writer
+}
+
+func (e *msgpackEncoder) Encode(m data.Map) error {
+	b, err := data.MarshalMsgpack(m)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(b)
+	return err
+}
+
+// rawFormat reads each line as a string in field. It writes the value of
+// field converted to a string as a line.
+type rawFormat struct {
+	field string
+}
+
+func createRawFormat(params data.Map) (RecordFormat, error) {
+	v := &struct {
+		Field string
+	}{
+		Field: "line",
+	}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+	if v.Field == "" {
+		return nil, errors.New("'field' parameter must not be empty")
+	}
+	return &rawFormat{
+		field: v.Field,
+	}, nil
+}
+
+func (f *rawFormat) NewDecoder(r io.Reader) RecordDecoder {
+	return &rawDecoder{
+		f: f,
+		r: newLineReader(r),
+	}
+}
+
+func (f *rawFormat) NewEncoder(w io.Writer) RecordEncoder {
+	return &rawEncoder{
+		f: f,
+		w: w,
+	}
+}
+
+type rawDecoder struct {
+	f *rawFormat
+	r *lineReader
+}
+
+func (d *rawDecoder) Decode() (data.Map, error) {
+	line, _, err := d.r.readLine()
+	if err != nil {
+		return nil, err
+	}
+	return data.Map{d.f.field: data.String(line)}, nil
+}
+
+type rawEncoder struct {
+	f *rawFormat
+	w io.Writer
+}
+
+func (e *rawEncoder) Encode(m data.Map) error {
+	v, ok := m[e.f.field]
+	if !ok {
+		return fmt.Errorf("field '%v' is missing", e.f.field)
+	}
+	s, err := data.ToString(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(e.w, s)
+	return err
+}
@@ -23,13 +23,15 @@ func addBQLToTopology(tb *TopologyBuilder, bql string) error {
 	if err != nil {
 		return err
 	}
+	s := tb.NewSession()
 	for _, stmt := range stmts {
-		_, err := tb.AddStmt(stmt)
+		_, err := s.AddStmt(stmt)
 		if err != nil {
+			s.Close()
 			return err
 		}
 	}
-	return nil
+	return s.Close()
 }
 
 type dummyUDS struct {
@@ -0,0 +1,85 @@
+package bql
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	noCompression    = "none"
+	gzipCompression  = "gzip"
+	bzip2Compression = "bzip2"
+)
+
+// compressionOf returns the name of the compression method specified by
+// "compression" parameter. When the parameter is empty or "auto", the method
+// is detected from the extension of the path: ".gz" for gzip and ".bz2" for
+// bzip2.
+func compressionOf(param, path string) (string, error) {
+	switch c := strings.ToLower(param); c {
+	case "", "auto":
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".gz":
+			return gzipCompression, nil
+		case ".bz2":
+			return bzip2Compression, nil
+		}
+		return noCompression, nil
+	case noCompression, gzipCompression, bzip2Compression:
+		return c, nil
+	default:
+		return "", fmt.Errorf("unsupported compression: %v", param)
+	}
+}
+
+// newDecompressingReader returns a reader decompressing data read from r.
+// Concatenated gzip streams, such as ones created by appending to an existing
+// file, are read as one stream.
+func newDecompressingReader(r io.Reader, compression string) (io.Reader, error) {
+	switch compression {
+	case gzipCompression:
+		return gzip.NewReader(r)
+	case bzip2Compression:
+		return bzip2.NewReader(r), nil
+	default:
+		return r, nil
+	}
+}
+
+// newCompressingWriter returns a writer compressing data written to it. Closing
+// the returned writer also closes w. bzip2 isn't supported for writing.
+//
+// The returned writer has a Flush method, which the file sink calls after
+// each record so that other processes can read records from a file still
+// being written and a crash doesn't lose buffered records. Flushing small
+// chunks of data lowers the compression ratio.
+func newCompressingWriter(w io.WriteCloser, compression string) (io.WriteCloser, error) {
+	switch compression {
+	case gzipCompression:
+		return &gzipWriteCloser{
+			Writer: gzip.NewWriter(w),
+			w:      w,
+		}, nil
+	case bzip2Compression:
+		return nil, fmt.Errorf("%v compression is only supported for reading", compression)
+	default:
+		return w, nil
+	}
+}
+
+type gzipWriteCloser struct {
+	*gzip.Writer
+	w io.WriteCloser
+}
+
+func (g *gzipWriteCloser) Close() error {
+	err := g.Writer.Close()
+	if e := g.w.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,211 @@
+package bql
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestCompressionOf(t *testing.T) {
+	cases := []struct {
+		param    string
+		path     string
+		expected string
+	}{
+		{"", "a.jsonl", noCompression},
+		{"", "a.jsonl.gz", gzipCompression},
+		{"auto", "a.jsonl.GZ", gzipCompression},
+		{"", "a.jsonl.bz2", bzip2Compression},
+		{"none", "a.jsonl.gz", noCompression},
+		{"gzip", "a.jsonl", gzipCompression},
+		{"BZIP2", "a.jsonl", bzip2Compression},
+	}
+	for _, c := range cases {
+		c := c
+		Convey(fmt.Sprintf("When detecting compression of %v with '%v'", c.path, c.param), t, func() {
+			res, err := compressionOf(c.param, c.path)
+
+			Convey("Then it should be "+c.expected, func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldEqual, c.expected)
+			})
+		})
+	}
+
+	Convey("When detecting an unsupported compression", t, func() {
+		_, err := compressionOf("zip", "a.zip")
+
+		Convey("Then it should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestCompressedFileSourceAndSink(t *testing.T) {
+	ctx := core.NewContext(nil)
+	Convey("Given a temp directory", t, func() {
+		tdir, err := ioutil.TempDir("", "test_sb_compression")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(tdir)
+		})
+
+		read := func(params data.Map) []data.Map {
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			defer s.Stop(ctx)
+			w := newRecordCollectingWriter()
+			So(s.GenerateStream(ctx, w), ShouldBeNil)
+			return w.ms
+		}
+
+		write := func(params data.Map, from, to int) {
+			si, err := createFileSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			for i := from; i < to; i++ {
+				So(si.Write(ctx, core.NewTuple(data.Map{"a": data.Int(i)})), ShouldBeNil)
+			}
+			So(si.Close(ctx), ShouldBeNil)
+		}
+
+		Convey("When writing tuples to a file having .gz extension", func() {
+			fn := filepath.Join(tdir, "out.jsonl.gz")
+			params := data.Map{"path": data.String(fn)}
+			write(params, 0, 2)
+
+			Convey("Then the file should be compressed by gzip", func() {
+				f, err := os.Open(fn)
+				So(err, ShouldBeNil)
+				defer f.Close()
+				r, err := gzip.NewReader(f)
+				So(err, ShouldBeNil)
+				b, err := ioutil.ReadAll(r)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, "{\"a\":0}\n{\"a\":1}\n")
+			})
+
+			Convey("Then the file source should read it", func() {
+				So(read(params), ShouldResemble, []data.Map{{"a": data.Int(0)}, {"a": data.Int(1)}})
+			})
+
+			Convey("And appending tuples to the file", func() {
+				write(params, 2, 3)
+
+				Convey("Then the file source should read all tuples", func() {
+					So(read(params), ShouldResemble, []data.Map{
+						{"a": data.Int(0)}, {"a": data.Int(1)}, {"a": data.Int(2)}})
+				})
+			})
+		})
+
+		Convey("When writing a tuple to a file having .gz extension without closing the sink", func() {
+			fn := filepath.Join(tdir, "out.jsonl.gz")
+			si, err := createFileSink(ctx, &IOParams{}, data.Map{"path": data.String(fn)})
+			So(err, ShouldBeNil)
+			Reset(func() {
+				si.Close(ctx)
+			})
+			So(si.Write(ctx, core.NewTuple(data.Map{"a": data.Int(0)})), ShouldBeNil)
+
+			Convey("Then the record should be readable from the file", func() {
+				f, err := os.Open(fn)
+				So(err, ShouldBeNil)
+				defer f.Close()
+				r, err := gzip.NewReader(f)
+				So(err, ShouldBeNil)
+				b, _ := ioutil.ReadAll(r) // the stream isn't terminated yet
+				So(string(b), ShouldEqual, "{\"a\":0}\n")
+			})
+		})
+
+		Convey("When writing tuples with the compression parameter", func() {
+			fn := filepath.Join(tdir, "out.csv")
+			params := data.Map{"path": data.String(fn), "format": data.String("csv"),
+				"compression": data.String("gzip")}
+			write(params, 0, 2)
+
+			Convey("Then the file source should read it with the same parameters", func() {
+				So(read(params), ShouldResemble, []data.Map{{"a": data.Int(0)}, {"a": data.Int(1)}})
+			})
+		})
+
+		Convey("When reading a file compressed by bzip2", func() {
+			fn := filepath.Join(tdir, "in.jsonl.bz2")
+			So(ioutil.WriteFile(fn, []byte("\x42\x5a\x68\x39\x31\x41\x59\x26\x53\x59\x22\x9d\xe2\xe9\x00\x00"+
+				"\x06\x59\x80\x00\x10\x10\x00\x30\x10\x20\x00\x00\x0a\x20\x00\x31\x0c\x08\x12\x80\x7a\x89"+
+				"\xc2\x26\x86\x8b\xe2\xee\x48\xa7\x0a\x12\x04\x53\xbc\x5d\x20"), 0644), ShouldBeNil)
+
+			Convey("Then the file source should decompress it", func() {
+				So(read(data.Map{"path": data.String(fn)}), ShouldResemble,
+					[]data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}})
+			})
+		})
+
+		Convey("When creating a file sink with bzip2", func() {
+			fn := filepath.Join(tdir, "out.jsonl.bz2")
+			_, err := createFileSink(ctx, &IOParams{}, data.Map{"path": data.String(fn)})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the file should not be created", func() {
+				_, err := os.Stat(fn)
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When creating a rotating file sink with gzip", func() {
+			fn := filepath.Join(tdir, "out.jsonl")
+			si, err := createFileSink(ctx, &IOParams{}, data.Map{"path": data.String(fn),
+				"max_size": data.Int(10), "compression": data.String("gzip")})
+			So(err, ShouldBeNil)
+			Reset(func() {
+				si.Close(ctx)
+			})
+
+			Convey("Then rotated files should be compressed", func() {
+				l, ok := si.(*writerSink).w.(*lumberjack.Logger)
+				So(ok, ShouldBeTrue)
+				So(l.Compress, ShouldBeTrue)
+			})
+		})
+
+		Convey("When creating a rotating file sink having .gz extension", func() {
+			_, err := createFileSink(ctx, &IOParams{}, data.Map{
+				"path": data.String(filepath.Join(tdir, "out.jsonl.gz")), "max_size": data.Int(10)})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a gzip-compressed data", t, func() {
+		buf := bytes.NewBuffer(nil)
+		w := gzip.NewWriter(buf)
+		_, err := w.Write([]byte("abc"))
+		So(err, ShouldBeNil)
+		So(w.Close(), ShouldBeNil)
+
+		Convey("When reading it without compression", func() {
+			r, err := newDecompressingReader(bytes.NewReader(buf.Bytes()), noCompression)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be read as is", func() {
+				b, err := ioutil.ReadAll(r)
+				So(err, ShouldBeNil)
+				So(b, ShouldResemble, buf.Bytes())
+			})
+		})
+	})
+}
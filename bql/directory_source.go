@@ -0,0 +1,409 @@
+package bql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// directorySource reads files matching a glob pattern. It checks the pattern
+// periodically and reads new files and data appended to files which have
+// already been read. The header of CSV or TSV is only read at the beginning
+// of a file and it's also used for appended data.
+//
+// Data appended to an uncompressed file is read up to the last newline so
+// that a record being written isn't emitted partially. The last line without
+// a newline is read when neither the size nor the modification time of the
+// file changes for poll_interval. Therefore, a writer must not stop writing
+// in the middle of a line for poll_interval or longer.
+//
+// Compressed files and files moved by move_to are expected to be complete
+// when they start matching the pattern, e.g. they're written with a temporary
+// name and then renamed.
+type directorySource struct {
+	*recordEmitter
+	pattern string
+
+	// compression is the value of "compression" parameter. The compression
+	// method is determined for each file.
+	compression string
+
+	// checkpoint is the path to the file having states of files. They are only
+	// kept in memory when it's empty.
+	checkpoint string
+
+	// moveTo is the absolute path of the directory to which files are moved
+	// after they're read. Files aren't moved when it's empty.
+	moveTo string
+
+	pollInterval time.Duration
+
+	// files has the state of each file which has been read.
+	files map[string]*directoryFileState
+}
+
+// directoryFileState is the state of a file read by a directory source.
+type directoryFileState struct {
+	// Offset is the number of bytes already read from the file.
+	Offset int64 `json:"offset"`
+
+	// Size is the size of the file when it was read last time.
+	Size int64 `json:"size"`
+
+	// ModTime is the modification time of the file when it was read last
+	// time.
+	ModTime time.Time `json:"mod_time"`
+
+	// ID is the inode number of the file. It's used to detect another file
+	// created at the same path. It's 0 when it isn't available.
+	ID uint64 `json:"id,omitempty"`
+
+	// Header is the header read from the beginning of the file. It's used to
+	// decode data appended to the file. It's empty when the format doesn't
+	// have a header.
+	Header []string `json:"header,omitempty"`
+}
+
+func (s *directorySource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	for {
+		if err := s.scan(ctx, w); err != nil {
+			return err
+		}
+
+		select {
+		case <-s.stopCh:
+			return core.ErrSourceStopped
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// scan reads all files matching the pattern which have data not read yet.
+func (s *directorySource) scan(ctx *core.Context, w core.Writer) error {
+	files, err := filepath.Glob(s.pattern)
+	if err != nil {
+		return err
+	}
+
+	matched := make(map[string]bool, len(files))
+	for _, f := range files {
+		if s.moveTo != "" && isInDirectory(f, s.moveTo) {
+			// Files which have already been moved must not be read again.
+			continue
+		}
+		matched[f] = true
+		fi, err := os.Stat(f)
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+
+		// prev is nil when the file is new, truncated, or replaced by another
+		// file.
+		var prev *directoryFileState
+		toEnd := s.moveTo != ""
+		if st, ok := s.files[f]; ok && st.ID == fileID(fi) && fi.Size() >= st.Size {
+			prev = st
+			if fi.Size() == st.Size && s.moveTo == "" {
+				if st.Offset == st.Size {
+					continue
+				}
+				if !fi.ModTime().Equal(st.ModTime) {
+					st.ModTime = fi.ModTime()
+					continue
+				}
+				// The last line without a newline has been left as it is
+				// for poll_interval, so it's regarded as complete.
+				toEnd = true
+			}
+		}
+		if err := s.readFile(ctx, w, f, fi, prev, toEnd); err != nil {
+			return err
+		}
+	}
+
+	// Forget files which no longer exist so that the checkpoint doesn't grow.
+	changed := false
+	for f := range s.files {
+		if !matched[f] {
+			delete(s.files, f)
+			changed = true
+		}
+	}
+	if changed {
+		s.saveCheckpoint(ctx)
+	}
+	return nil
+}
+
+// readFile emits records in the file. fi is the information of the file
+// obtained before opening it. prev is the state of the file when it was read
+// last time, and readFile reads the file from its offset. It's nil when the
+// file has to be read from the beginning. When toEnd is false, the file is
+// read up to the last newline. readFile only returns an error when the source
+// has to stop. Other errors are logged.
+func (s *directorySource) readFile(ctx *core.Context, w core.Writer, path string, fi os.FileInfo,
+	prev *directoryFileState, toEnd bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("file", path).Warning("Cannot open the file")
+		return nil
+	}
+	defer f.Close()
+
+	var (
+		off    int64
+		header []string
+		cr     *countingReader
+		hd     headerDecoder
+	)
+	if prev != nil {
+		off, header = prev.Offset, prev.Header
+	}
+	size := fi.Size()
+	err = func() error {
+		compression, err := compressionOf(s.compression, path)
+		if err != nil {
+			return err
+		}
+		end := size
+		if compression == noCompression && !toEnd {
+			// The last line might still be being written.
+			if end, err = lastLineEnd(f, off, size); err != nil {
+				return err
+			}
+		}
+		cr = &countingReader{r: io.NewSectionReader(f, off, end-off)}
+		r, err := newDecompressingReader(cr, compression)
+		if err != nil {
+			return err
+		}
+		dec := s.format.NewDecoder(r)
+		if d, ok := dec.(headerDecoder); ok {
+			hd = d
+			if off > 0 {
+				// The header has already been read.
+				hd.setHeader(header)
+			}
+		}
+		return s.emitDecoded(ctx, w, dec)
+	}()
+	if err == core.ErrSourceStopped {
+		// The file will be read again from the offset.
+		return err
+	}
+
+	if hd != nil && hd.header() != nil {
+		header = hd.header()
+	}
+	st := &directoryFileState{
+		Offset:  size,
+		Size:    size,
+		ModTime: fi.ModTime(),
+		ID:      fileID(fi),
+		Header:  header,
+	}
+	if err != nil {
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("file", path).Warning("Skipping the rest of the file due to an error")
+	} else {
+		st.Offset = off + cr.n
+	}
+	s.files[path] = st
+
+	if s.moveTo != "" {
+		dst := filepath.Join(s.moveTo, filepath.Base(path))
+		if err := os.Rename(path, dst); err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("file", path).Warning("Cannot move the file")
+		} else {
+			delete(s.files, path)
+		}
+	}
+	s.saveCheckpoint(ctx)
+	return nil
+}
+
+// lastLineEnd returns the offset right after the last newline in the range
+// [off, size) of the file. It returns off when the range doesn't have a
+// newline.
+func lastLineEnd(f io.ReaderAt, off, size int64) (int64, error) {
+	buf := make([]byte, 4096)
+	for end := size; end > off; {
+		start := end - int64(len(buf))
+		if start < off {
+			start = off
+		}
+		b := buf[:end-start]
+		if _, err := f.ReadAt(b, start); err != nil {
+			return 0, err
+		}
+		for i := len(b) - 1; i >= 0; i-- {
+			if b[i] == '\n' {
+				return start + int64(i) + 1, nil
+			}
+		}
+		end = start
+	}
+	return off, nil
+}
+
+// isInDirectory returns true if the file is in the directory or one of its
+// subdirectories. dir must be an absolute path.
+func isInDirectory(path, dir string) bool {
+	p, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(dir, p)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+type directoryCheckpoint struct {
+	Files map[string]*directoryFileState `json:"files"`
+}
+
+func loadDirectoryCheckpoint(path string) (map[string]*directoryFileState, error) {
+	files := map[string]*directoryFileState{}
+	if path == "" {
+		return files, nil
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return files, nil
+		}
+		return nil, err
+	}
+
+	c := &directoryCheckpoint{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("the checkpoint file has an invalid content: %v", err)
+	}
+	for f, st := range c.Files {
+		if st != nil {
+			files[f] = st
+		}
+	}
+	return files, nil
+}
+
+// saveCheckpoint writes states of files to the checkpoint file. It writes them to a
+// temporary file first and then renames it so that the checkpoint file isn't
+// broken even if the process is killed while writing it.
+func (s *directorySource) saveCheckpoint(ctx *core.Context) {
+	if s.checkpoint == "" {
+		return
+	}
+	err := func() error {
+		b, err := json.Marshal(&directoryCheckpoint{Files: s.files})
+		if err != nil {
+			return err
+		}
+		tmp := s.checkpoint + ".tmp"
+		if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+			return err
+		}
+		return os.Rename(tmp, s.checkpoint)
+	}()
+	if err != nil {
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("checkpoint", s.checkpoint).Error("Cannot save the checkpoint")
+	}
+}
+
+func (s *directorySource) Stop(ctx *core.Context) error {
+	close(s.stopCh)
+	return nil
+}
+
+// countingReader counts the number of bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func createDirectorySource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	v := &struct {
+		Pattern      string `bql:",required"`
+		Compression  string
+		Checkpoint   string
+		MoveTo       string
+		PollInterval time.Duration
+	}{
+		PollInterval: time.Second,
+	}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	if _, err := filepath.Match(v.Pattern, ""); err != nil {
+		return nil, fmt.Errorf("'pattern' parameter has an invalid pattern: %v", err)
+	}
+	if _, err := compressionOf(v.Compression, ""); err != nil {
+		return nil, err
+	}
+	if v.PollInterval <= 0 {
+		return nil, errors.New("'poll_interval' parameter must be positive")
+	}
+	if v.MoveTo != "" {
+		fi, err := os.Stat(v.MoveTo)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			return nil, fmt.Errorf("'move_to' parameter must be a directory: %v", v.MoveTo)
+		}
+		if v.MoveTo, err = filepath.Abs(v.MoveTo); err != nil {
+			return nil, err
+		}
+		dir, err := filepath.Abs(filepath.Dir(v.Pattern))
+		if err != nil {
+			return nil, err
+		}
+		if v.MoveTo == dir {
+			return nil, errors.New("'move_to' parameter must be a different directory from the one of 'pattern' parameter")
+		}
+	}
+
+	files, err := loadDirectoryCheckpoint(v.Checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	e, err := newRecordEmitter(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	return core.ImplementSourceStop(&directorySource{
+		recordEmitter: e,
+		pattern:       v.Pattern,
+		compression:   v.Compression,
+		checkpoint:    v.Checkpoint,
+		moveTo:        v.MoveTo,
+		pollInterval:  v.PollInterval,
+		files:         files,
+	}), nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("directory", SourceCreatorFunc(createDirectorySource))
+}
@@ -0,0 +1,253 @@
+package bql
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestDirectorySource(t *testing.T) {
+	ctx := core.NewContext(nil)
+	Convey("Given a directory having files", t, func() {
+		tdir, err := ioutil.TempDir("", "test_sb_directory_source")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(tdir)
+		})
+		in := filepath.Join(tdir, "in")
+		done := filepath.Join(tdir, "done")
+		So(os.Mkdir(in, 0755), ShouldBeNil)
+		So(os.Mkdir(done, 0755), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(in, "1.jsonl"), []byte("{\"a\":1}\n{\"a\":2}\n"), 0644), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(in, "2.jsonl"), []byte("{\"a\":3}\n"), 0644), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(in, "3.txt"), []byte("{\"a\":0}\n"), 0644), ShouldBeNil)
+
+		ckpt := filepath.Join(tdir, "checkpoint.json")
+		params := data.Map{
+			"pattern":       data.String(filepath.Join(in, "*.jsonl")),
+			"checkpoint":    data.String(ckpt),
+			"poll_interval": data.Float(0.01),
+		}
+		start := func() (core.Source, *recordCollectingWriter, chan error) {
+			s, err := createDirectorySource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			w := newRecordCollectingWriter()
+			ch := make(chan error, 1)
+			go func() {
+				ch <- s.GenerateStream(ctx, w)
+			}()
+			return s, w, ch
+		}
+
+		Convey("When reading the directory", func() {
+			s, w, ch := start()
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			Convey("Then it should emit records in matching files", func() {
+				So(w.wait(3), ShouldResemble, []data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}, {"a": data.Int(3)}})
+			})
+
+			Convey("Then it should pick up a new file", func() {
+				w.wait(3)
+				So(ioutil.WriteFile(filepath.Join(in, "4.jsonl"), []byte("{\"a\":4}\n"), 0644), ShouldBeNil)
+				So(w.wait(4)[3], ShouldResemble, data.Map{"a": data.Int(4)})
+			})
+
+			Convey("Then it should save offsets to the checkpoint file", func() {
+				w.wait(3)
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+
+				b, err := ioutil.ReadFile(ckpt)
+				So(err, ShouldBeNil)
+				c := &directoryCheckpoint{}
+				So(json.Unmarshal(b, c), ShouldBeNil)
+				So(c.Files, ShouldHaveLength, 2)
+				So(c.Files[filepath.Join(in, "1.jsonl")].Offset, ShouldEqual, 16)
+				So(c.Files[filepath.Join(in, "2.jsonl")].Offset, ShouldEqual, 8)
+
+				Convey("And restarting the source after appending a record", func() {
+					f, err := os.OpenFile(filepath.Join(in, "1.jsonl"), os.O_WRONLY|os.O_APPEND, 0644)
+					So(err, ShouldBeNil)
+					_, err = f.WriteString("{\"a\":5}\n")
+					So(err, ShouldBeNil)
+					So(f.Close(), ShouldBeNil)
+
+					s, w, _ := start()
+					Reset(func() {
+						s.Stop(ctx)
+					})
+
+					Convey("Then it should only emit the appended record", func() {
+						So(w.wait(1), ShouldResemble, []data.Map{{"a": data.Int(5)}})
+						So(s.Stop(ctx), ShouldBeNil)
+						So(w.wait(1), ShouldHaveLength, 1)
+					})
+				})
+
+				Convey("And restarting the source after replacing a file", func() {
+					tmp := filepath.Join(tdir, "1.jsonl.tmp")
+					So(ioutil.WriteFile(tmp, []byte("{\"a\":6}\n{\"a\":7}\n{\"a\":8}\n"), 0644), ShouldBeNil)
+					So(os.Rename(tmp, filepath.Join(in, "1.jsonl")), ShouldBeNil)
+
+					s, w, _ := start()
+					Reset(func() {
+						s.Stop(ctx)
+					})
+
+					Convey("Then it should emit all records in the new file", func() {
+						So(w.wait(3), ShouldResemble, []data.Map{{"a": data.Int(6)}, {"a": data.Int(7)}, {"a": data.Int(8)}})
+					})
+				})
+			})
+
+		})
+
+		Convey("When reading the directory with a long poll interval", func() {
+			// The interval has to be long enough not to regard a line being
+			// written as complete.
+			params["poll_interval"] = data.Float(0.5)
+			s, w, _ := start()
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			Convey("Then it should not emit a record which doesn't end with a newline", func() {
+				w.wait(3)
+				f, err := os.OpenFile(filepath.Join(in, "2.jsonl"), os.O_WRONLY|os.O_APPEND, 0644)
+				So(err, ShouldBeNil)
+				Reset(func() {
+					f.Close()
+				})
+				_, err = f.WriteString("{\"a\":")
+				So(err, ShouldBeNil)
+				So(ioutil.WriteFile(filepath.Join(in, "4.jsonl"), []byte("{\"a\":4}\n"), 0644), ShouldBeNil)
+				So(w.wait(4)[3], ShouldResemble, data.Map{"a": data.Int(4)})
+
+				Convey("And it should emit the record when it's completed", func() {
+					_, err = f.WriteString("5}\n")
+					So(err, ShouldBeNil)
+					So(w.wait(5)[4], ShouldResemble, data.Map{"a": data.Int(5)})
+				})
+			})
+		})
+
+		Convey("When reading a file whose last line doesn't end with a newline", func() {
+			So(ioutil.WriteFile(filepath.Join(in, "4.jsonl"), []byte("{\"a\":4}\n{\"a\":5}"), 0644), ShouldBeNil)
+			s, w, _ := start()
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			Convey("Then it should emit the last line after the file stops changing", func() {
+				So(w.wait(5)[3:], ShouldResemble, []data.Map{{"a": data.Int(4)}, {"a": data.Int(5)}})
+			})
+		})
+
+		Convey("When reading CSV files", func() {
+			So(ioutil.WriteFile(filepath.Join(in, "t.csv"), []byte("id,temp\n1,10\n"), 0644), ShouldBeNil)
+			params["pattern"] = data.String(filepath.Join(in, "*.csv"))
+			params["format"] = data.String("csv")
+			s, w, ch := start()
+			Reset(func() {
+				s.Stop(ctx)
+			})
+			So(w.wait(1), ShouldResemble, []data.Map{{"id": data.Int(1), "temp": data.Int(10)}})
+
+			appendRows := func(rows string) {
+				f, err := os.OpenFile(filepath.Join(in, "t.csv"), os.O_WRONLY|os.O_APPEND, 0644)
+				So(err, ShouldBeNil)
+				defer f.Close()
+				_, err = f.WriteString(rows)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then it should decode appended rows with the header", func() {
+				appendRows("2,20\n3,30\n")
+				So(w.wait(3)[1:], ShouldResemble, []data.Map{
+					{"id": data.Int(2), "temp": data.Int(20)},
+					{"id": data.Int(3), "temp": data.Int(30)},
+				})
+			})
+
+			Convey("Then it should decode appended rows after restarting the source", func() {
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+				appendRows("4,40\n")
+
+				s, w, _ := start()
+				Reset(func() {
+					s.Stop(ctx)
+				})
+				So(w.wait(1), ShouldResemble, []data.Map{{"id": data.Int(4), "temp": data.Int(40)}})
+			})
+		})
+
+		Convey("When reading the directory with move_to", func() {
+			params["move_to"] = data.String(done)
+			s, w, _ := start()
+			Reset(func() {
+				s.Stop(ctx)
+			})
+			w.wait(3)
+
+			Convey("Then read files should be moved", func() {
+				So(s.Stop(ctx), ShouldBeNil)
+				files, err := filepath.Glob(filepath.Join(done, "*"))
+				So(err, ShouldBeNil)
+				So(files, ShouldResemble, []string{filepath.Join(done, "1.jsonl"), filepath.Join(done, "2.jsonl")})
+				_, err = os.Stat(filepath.Join(in, "3.txt"))
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When reading the directory with move_to matching the pattern", func() {
+			params["pattern"] = data.String(filepath.Join(tdir, "*", "*.jsonl"))
+			params["move_to"] = data.String(done)
+			s, w, _ := start()
+			Reset(func() {
+				s.Stop(ctx)
+			})
+			w.wait(3)
+
+			Convey("Then it should not read moved files again", func() {
+				So(ioutil.WriteFile(filepath.Join(in, "4.jsonl"), []byte("{\"a\":4}\n"), 0644), ShouldBeNil)
+				ms := w.wait(4)
+				So(ms, ShouldHaveLength, 4)
+				So(ms[3], ShouldResemble, data.Map{"a": data.Int(4)})
+			})
+		})
+	})
+
+	Convey("Given invalid parameters", t, func() {
+		wd, err := os.Getwd()
+		So(err, ShouldBeNil)
+		cases := []data.Map{
+			{"pattern": data.String("[")},
+			{"pattern": data.String("*"), "compression": data.String("zip")},
+			{"pattern": data.String("*"), "poll_interval": data.Int(-1)},
+			{"pattern": data.String("./*"), "move_to": data.String(".")},
+			{"pattern": data.String("*"), "move_to": data.String(wd)},
+			{"pattern": data.String("*"), "move_to": data.String("no_such_dir")},
+		}
+		for _, c := range cases {
+			c := c
+
+			Convey("When creating a directory source with "+c.String(), func() {
+				_, err := createDirectorySource(ctx, &IOParams{}, c)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
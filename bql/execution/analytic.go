@@ -0,0 +1,197 @@
+package execution
+
+import (
+	"fmt"
+	"sort"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// analyticEvaluator computes the results of an analytic function
+// application for all input rows of an evaluation. The rows are split
+// into partitions by the PARTITION BY expressions and every partition
+// is sorted by the ORDER BY expressions before the function is called.
+type analyticEvaluator struct {
+	ref       string
+	name      string
+	function  udf.AnalyticFunction
+	args      []Evaluator
+	partition []Evaluator
+	ordering  []sortEvaluator
+}
+
+// analyticPartition holds the indexes of the rows belonging to the
+// partition with the given key.
+type analyticPartition struct {
+	key  data.Array
+	rows []int
+}
+
+func prepareAnalytics(analytics []analyticFuncAppAST, reg udf.FunctionRegistry) ([]*analyticEvaluator, error) {
+	prepare := func(exprs []FlatExpression) ([]Evaluator, error) {
+		evals := make([]Evaluator, len(exprs))
+		for i, expr := range exprs {
+			eval, err := ExpressionToEvaluator(expr, reg)
+			if err != nil {
+				return nil, err
+			}
+			evals[i] = eval
+		}
+		return evals, nil
+	}
+
+	output := make([]*analyticEvaluator, len(analytics))
+	for i, a := range analytics {
+		f, err := reg.Lookup(string(a.Function), len(a.Expressions))
+		if err != nil {
+			return nil, err
+		}
+		function, ok := f.(udf.AnalyticFunction)
+		if !ok {
+			return nil, fmt.Errorf("function '%s' is not an analytic function", a.Function)
+		}
+		args, err := prepare(a.Expressions)
+		if err != nil {
+			return nil, err
+		}
+		partition, err := prepare(a.Partition)
+		if err != nil {
+			return nil, err
+		}
+		ordering := make([]sortEvaluator, len(a.Ordering))
+		for j, o := range a.Ordering {
+			eval, err := ExpressionToEvaluator(o.expr, reg)
+			if err != nil {
+				return nil, err
+			}
+			ordering[j] = sortEvaluator{eval, o.ascending}
+		}
+		output[i] = &analyticEvaluator{
+			ref:       a.Ref,
+			name:      string(a.Function),
+			function:  function,
+			args:      args,
+			partition: partition,
+			ordering:  ordering,
+		}
+	}
+	return output, nil
+}
+
+// compute calls the analytic function for every partition of the given
+// rows and stores the result for each row in the row itself.
+func (a *analyticEvaluator) compute(ctx *core.Context, rows []data.Map) error {
+	partitions, err := a.partitionRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range partitions {
+		// sort the rows of the partition, rows that are equal with
+		// regard to all ORDER BY expressions keep their order
+		sortData := make([]sortArray, len(a.ordering))
+		for i, o := range a.ordering {
+			values := make(data.Array, len(p.rows))
+			for j, idx := range p.rows {
+				v, err := o.eval.Eval(rows[idx])
+				if err != nil {
+					return fmt.Errorf("could not get data for sorting: %s", err.Error())
+				}
+				values[j] = v
+			}
+			sortData[i] = sortArray{values, o.ascending}
+		}
+		indexes := make([]int, len(p.rows))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		sort.Stable(&indexSlice{indexes, sortData})
+
+		ap := &udf.AnalyticPartition{
+			Args:  make([]data.Array, len(indexes)),
+			Peers: make([]int, len(indexes)),
+		}
+		for i, idx := range indexes {
+			args := make(data.Array, len(a.args))
+			for j, eval := range a.args {
+				v, err := eval.Eval(rows[p.rows[idx]])
+				if err != nil {
+					return err
+				}
+				args[j] = v
+			}
+			ap.Args[i] = args
+			if i > 0 && isPeer(sortData, indexes[i-1], idx) {
+				ap.Peers[i] = ap.Peers[i-1]
+			} else {
+				ap.Peers[i] = i
+			}
+		}
+
+		results, err := a.function.CallAnalytic(ctx, ap)
+		if err != nil {
+			return err
+		}
+		if len(results) != len(indexes) {
+			return fmt.Errorf("analytic function '%s' returned %d values for %d rows",
+				a.name, len(results), len(indexes))
+		}
+		for i, idx := range indexes {
+			rows[p.rows[idx]][a.ref] = results[i]
+		}
+	}
+	return nil
+}
+
+// partitionRows splits the given rows into partitions, which are returned
+// in the order of their first rows.
+func (a *analyticEvaluator) partitionRows(rows []data.Map) ([]*analyticPartition, error) {
+	if len(a.partition) == 0 {
+		p := &analyticPartition{rows: make([]int, len(rows))}
+		for i := range rows {
+			p.rows[i] = i
+		}
+		return []*analyticPartition{p}, nil
+	}
+
+	partitions := []*analyticPartition{}
+	byHash := map[data.HashValue][]*analyticPartition{}
+	for i, row := range rows {
+		key := make(data.Array, len(a.partition))
+		for j, eval := range a.partition {
+			v, err := eval.Eval(row)
+			if err != nil {
+				return nil, err
+			}
+			key[j] = v
+		}
+		h := data.Hash(key)
+		var p *analyticPartition
+		for _, c := range byHash[h] {
+			if data.Equal(c.key, key) {
+				p = c
+				break
+			}
+		}
+		if p == nil {
+			p = &analyticPartition{key: key}
+			byHash[h] = append(byHash[h], p)
+			partitions = append(partitions, p)
+		}
+		p.rows = append(p.rows, i)
+	}
+	return partitions, nil
+}
+
+// isPeer returns true if the values at the given indexes are equal in
+// all sort arrays.
+func isPeer(sortData []sortArray, i, j int) bool {
+	for _, s := range sortData {
+		if !data.Equal(s.values[i], s.values[j]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,146 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestAnalyticFunctions(t *testing.T) {
+	Convey("Given a SELECT statement computing the rate of change per id", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM id, int,
+			int - lag(int) OVER (PARTITION BY id ORDER BY ts()) AS delta
+			FROM src [RANGE 4 TUPLES] ORDER BY id, int`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			inputs := []struct {
+				id  string
+				val int64
+			}{
+				{"a", 10},
+				{"b", 100},
+				{"a", 15},
+				{"b", 130},
+				{"a", 17}, // the first "a" leaves the window
+			}
+			outs := [][]data.Map{}
+			for i, in := range inputs {
+				tup := getEventTimeTuple(i, in.val)
+				tup.Data["id"] = data.String(in.id)
+				out, err := plan.Process(tup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the differences to the previous tuples should be emitted", func() {
+				row := func(id string, val int64, delta data.Value) data.Map {
+					return data.Map{"id": data.String(id), "int": data.Int(val), "delta": delta}
+				}
+				So(outs[2], ShouldResemble, []data.Map{
+					row("a", 10, data.Null{}),
+					row("a", 15, data.Int(5)),
+					row("b", 100, data.Null{}),
+				})
+				So(outs[4], ShouldResemble, []data.Map{
+					row("a", 15, data.Null{}),
+					row("a", 17, data.Int(2)),
+					row("b", 100, data.Null{}),
+					row("b", 130, data.Int(30)),
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with several analytic functions", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM id,
+			row_number() OVER (ORDER BY int DESC) AS rn,
+			rank() OVER (ORDER BY int DESC) AS r,
+			first_value(id) OVER (ORDER BY int DESC) AS f,
+			last_value(id) OVER (ORDER BY int DESC) AS l,
+			lead(id) OVER (ORDER BY int DESC) AS next,
+			lag(id, 2, "none") OVER (ORDER BY int DESC) AS prev2
+			FROM src [RANGE 5 TUPLES] ORDER BY rn`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for i, id := range []string{"a", "b", "c", "d", "e"} {
+				tup := getEventTimeTuple(i, []int64{3, 5, 3, 1, 5}[i])
+				tup.Data["id"] = data.String(id)
+				out, err = plan.Process(tup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the functions should be computed over the ordered window", func() {
+				row := func(id string, rn, r int64, f, l string, next, prev2 data.Value) data.Map {
+					return data.Map{"id": data.String(id), "rn": data.Int(rn), "r": data.Int(r),
+						"f": data.String(f), "l": data.String(l), "next": next, "prev2": prev2}
+				}
+				str := func(s string) data.Value {
+					return data.String(s)
+				}
+				So(out, ShouldResemble, []data.Map{
+					row("b", 1, 1, "b", "e", str("e"), str("none")),
+					row("e", 2, 1, "b", "e", str("a"), str("none")),
+					row("a", 3, 3, "b", "c", str("c"), str("b")),
+					row("c", 4, 3, "b", "c", str("d"), str("e")),
+					row("d", 5, 5, "b", "d", data.Null{}, str("a")),
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with an analytic function on a single tuple window", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM int, row_number() OVER () AS rn
+			FROM src [RANGE 1 TUPLES]`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for _, tup := range getTuples(2) {
+				out, err := plan.Process(tup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the row number of tuple %v should be 1", tup.Data["int"]), func() {
+					So(out, ShouldResemble, []data.Map{{"int": tup.Data["int"], "rn": data.Int(1)}})
+				})
+			}
+		})
+	})
+
+	Convey("Given invalid uses of analytic functions", t, func() {
+		testCases := []struct {
+			stmt string
+			err  string
+		}{
+			{`SELECT RSTREAM lag(int) FROM src [RANGE 2 TUPLES]`,
+				"analytic function 'lag' must be used with an OVER clause"},
+			{`SELECT RSTREAM abs(int) OVER () FROM src [RANGE 2 TUPLES]`,
+				"function 'abs' is not an analytic function"},
+			{`SELECT RSTREAM int FROM src [RANGE 2 TUPLES] WHERE lag(int) OVER () > 1`,
+				"analytic function 'lag' can only be used in the SELECT list"},
+			{`SELECT RSTREAM count(*), row_number() OVER () FROM src [RANGE 2 TUPLES]`,
+				"analytic functions cannot be used together with GROUP BY"},
+			{`SELECT RSTREAM lag(count(int)) OVER () FROM src [RANGE 2 TUPLES]`,
+				"aggregates not allowed in analytic function 'lag'"},
+			{`SELECT RSTREAM int AS a FROM src [RANGE 2 TUPLES] ORDER BY row_number() OVER ()`,
+				"analytic function 'row_number' can only be used in the SELECT list"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When creating a plan for %s", tc.stmt), func() {
+				_, err := createPhysicalPlan("CREATE STREAM box AS " + tc.stmt)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldStartWith, tc.err)
+				})
+			})
+		}
+	})
+}
@@ -64,6 +64,26 @@ func prepareFilter(filter FlatExpression, reg udf.FunctionRegistry) (Evaluator,
 	return nil, nil
 }
 
+// evalCondition evaluates a filter or join condition on the given
+// row. A NULL result is treated like false. If cond is nil, the
+// condition is always fulfilled.
+func evalCondition(cond Evaluator, row data.Map) (bool, error) {
+	if cond == nil {
+		return true, nil
+	}
+	result, err := cond.Eval(row)
+	if err != nil {
+		return false, err
+	}
+	// a NULL value is definitely not "true", so since we
+	// have only a binary decision, we should drop rows
+	// where the condition evaluates to NULL
+	if result.Type() == data.TypeNull {
+		return false, nil
+	}
+	return data.AsBool(result)
+}
+
 func prepareGroupList(groupList []FlatExpression, reg udf.FunctionRegistry) ([]Evaluator, error) {
 	output := make([]Evaluator, len(groupList))
 	for i, expr := range groupList {
@@ -9,6 +9,9 @@ import (
 
 type defaultSelectExecutionPlan struct {
 	streamRelationStreamExecutionPlan
+	// analytics computes the results of analytic functions, which
+	// depend on all input rows, before the projections are evaluated
+	analytics []*analyticEvaluator
 }
 
 // CanBuildDefaultSelectExecutionPlan checks whether the given statement
@@ -31,8 +34,13 @@ func NewDefaultSelectExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (P
 	if err != nil {
 		return nil, err
 	}
+	analytics, err := prepareAnalytics(lp.Analytics, reg)
+	if err != nil {
+		return nil, err
+	}
 	return &defaultSelectExecutionPlan{
 		*underlying,
+		analytics,
 	}, nil
 }
 
@@ -78,8 +86,9 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 	// function to compute the projection values and store
 	// the result in the `output` slice
 	evalItem := func(io *inputRowWithCachedResult) error {
-		// if we have a cached result, use this
-		if io.cache != nil {
+		// if we have a cached result, use this (the results of analytic
+		// functions may change with every run, so there is no cache)
+		if io.cache != nil && len(ep.analytics) == 0 {
 			cachedResults, err := data.AsMap(io.cache)
 			if err != nil {
 				return fmt.Errorf("cached data was not a map: %v", io.cache)
@@ -108,6 +117,21 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 		return nil
 	}
 
+	// compute the results of analytic functions and store them in
+	// the input rows
+	if len(ep.analytics) > 0 {
+		rows := make([]data.Map, 0, ep.filteredInputRows.Len())
+		for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
+			rows = append(rows, *e.Value.(*inputRowWithCachedResult).input)
+		}
+		for _, a := range ep.analytics {
+			if err := a.compute(ep.ctx, rows); err != nil {
+				rollback()
+				return err
+			}
+		}
+	}
+
 	// compute the output for each item in ep.filteredInputRows
 	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
 		item := e.Value.(*inputRowWithCachedResult)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -104,9 +105,39 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 				path = obj.Relation + "." + path
 			}
 		}
-		return newPathAccess(path)
+		pa, err := newPathAccess(path)
+		if err != nil {
+			return nil, err
+		}
+		pa.(*pathAccess).relation = obj.Relation
+		return pa, nil
 	case aggInputRef:
 		return newPathAccess(obj.Ref)
+	case analyticFuncAppAST:
+		// the result was computed in advance by the execution plan
+		return newPathAccess(fmt.Sprintf(`["%s"]`, obj.Ref))
+	case groupingAST:
+		cols := make([]string, len(obj.Cols))
+		for i, c := range obj.Cols {
+			cols[i] = c.Repr()
+		}
+		return &groupingFunc{cols}, nil
+	case filteredAggInput:
+		expr, err := ExpressionToEvaluator(obj.Expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		filter, err := ExpressionToEvaluator(obj.Filter, reg)
+		if err != nil {
+			return nil, err
+		}
+		return &filteredAggInputEvaluator{expr, filter}, nil
+	case distinctAggInputRef:
+		pa, err := newPathAccess(obj.Ref)
+		if err != nil {
+			return nil, err
+		}
+		return &distinctValues{pa}, nil
 	case nullLiteral:
 		return &nullConstant{}, nil
 	case numericLiteral:
@@ -149,6 +180,17 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return newGreaterOrnewEqual(bo), nil
 		case parser.NotEqual:
 			return newNot(newEqual(bo)), nil
+		case parser.Like, parser.NotLike, parser.ILike, parser.NotILike,
+			parser.SimilarTo, parser.NotSimilarTo, parser.RegexpMatch, parser.NotRegexpMatch:
+			return newPatternMatch(obj.Op, bo, obj.Right)
+		case parser.In:
+			return newIn(bo, obj.Right)
+		case parser.NotIn:
+			i, err := newIn(bo, obj.Right)
+			if err != nil {
+				return nil, err
+			}
+			return newNot(i), nil
 		case parser.Concat:
 			return &concat{bo}, nil
 		case parser.Is:
@@ -174,6 +216,21 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		case parser.Modulo:
 			return newModulo(bo), nil
 		}
+	case betweenAST:
+		// recurse
+		evals := make([]Evaluator, 3)
+		for i, e := range []FlatExpression{obj.Expr, obj.Lower, obj.Upper} {
+			eval, err := ExpressionToEvaluator(e, reg)
+			if err != nil {
+				return nil, err
+			}
+			evals[i] = eval
+		}
+		var b Evaluator = &between{evals[0], evals[1], evals[2]}
+		if obj.Op == parser.NotBetween {
+			b = newNot(b)
+		}
+		return b, nil
 	case unaryOpAST:
 		// recurse
 		expr, err := ExpressionToEvaluator(obj.Expr, reg)
@@ -346,6 +403,11 @@ func (s *stringConstant) Eval(input data.Value) (data.Value, error) {
 // JSON path.
 type pathAccess struct {
 	path data.Path
+	// relation is the alias of the relation the path points into,
+	// if any. When the value of that relation is NULL (as for the
+	// missing side of an outer join), the access evaluates to NULL
+	// instead of failing.
+	relation string
 }
 
 func (fa *pathAccess) Eval(input data.Value) (data.Value, error) {
@@ -353,7 +415,13 @@ func (fa *pathAccess) Eval(input data.Value) (data.Value, error) {
 	if err != nil {
 		return nil, err
 	}
-	return aMap.Get(fa.path)
+	v, err := aMap.Get(fa.path)
+	if err != nil && fa.relation != "" {
+		if rel, ok := aMap[fa.relation]; ok && rel.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	return v, err
 }
 
 func newPathAccess(s string) (Evaluator, error) {
@@ -361,7 +429,7 @@ func newPathAccess(s string) (Evaluator, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &pathAccess{path}, nil
+	return &pathAccess{path: path}, nil
 }
 
 type missingPathCheck struct {
@@ -475,6 +543,10 @@ func (t *timestampCast) Eval(input data.Value) (data.Value, error) {
 	if err != nil {
 		return nil, err
 	}
+	if val.Type() == data.TypeNull {
+		// the metadata of the missing side of an outer join
+		return val, nil
+	}
 	if val.Type() != data.TypeTimestamp {
 		return nil, fmt.Errorf("value %v was %T, not Time", val, val)
 	}
@@ -748,6 +820,160 @@ func newNotEqual(bo binOp) Evaluator {
 	return newNot(newEqual(bo))
 }
 
+// inListHashThreshold is the minimum number of elements that a constant
+// array on the right side of IN must have so that a hash set is used
+// instead of comparing the value with every element.
+const inListHashThreshold = 8
+
+// in checks whether a value is equal to one of the elements of an array.
+// As in SQL, the result is NULL if the value is NULL, or if it is not
+// found and the array contains NULL.
+type in struct {
+	binOp
+	// set holds the elements of a large constant array, keyed by their
+	// hash values. It is nil if the elements have to be compared one by
+	// one.
+	set map[data.HashValue][]data.Value
+	// setHasNull is true if the constant array contains NULL
+	setHasNull bool
+}
+
+func newIn(bo binOp, right FlatExpression) (Evaluator, error) {
+	i := &in{binOp: bo}
+	if right.Volatility() != Immutable || len(right.Columns()) > 0 {
+		return i, nil
+	}
+	// the array is constant, so it only has to be evaluated once
+	rightVal, err := bo.right.Eval(nil)
+	if err != nil {
+		return nil, err
+	}
+	arr, err := asInArray(rightVal)
+	if err != nil || len(arr) < inListHashThreshold {
+		return i, err
+	}
+	i.set = make(map[data.HashValue][]data.Value, len(arr))
+	for _, elem := range arr {
+		if elem.Type() == data.TypeNull {
+			i.setHasNull = true
+			continue
+		}
+		h := data.Hash(elem)
+		i.set[h] = append(i.set[h], elem)
+	}
+	return i, nil
+}
+
+func (i *in) Eval(input data.Value) (data.Value, error) {
+	val, err := i.left.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	if i.set != nil {
+		// NULL propagation
+		if val.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+		for _, elem := range i.set[data.Hash(val)] {
+			if data.Equal(val, elem) {
+				return data.Bool(true), nil
+			}
+		}
+		if i.setHasNull {
+			return data.Null{}, nil
+		}
+		return data.Bool(false), nil
+	}
+
+	rightVal, err := i.right.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if val.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	arr, err := asInArray(rightVal)
+	if err != nil {
+		return nil, err
+	}
+	hasNull := false
+	for _, elem := range arr {
+		if elem.Type() == data.TypeNull {
+			hasNull = true
+		} else if data.Equal(val, elem) {
+			return data.Bool(true), nil
+		}
+	}
+	if hasNull {
+		return data.Null{}, nil
+	}
+	return data.Bool(false), nil
+}
+
+func asInArray(v data.Value) (data.Array, error) {
+	if v.Type() == data.TypeNull {
+		return nil, nil
+	}
+	arr, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("right operand of IN must be an array: %v", v)
+	}
+	return arr, nil
+}
+
+// between checks whether a value lies in the closed interval between
+// a lower and an upper bound. The value and the bounds must have the
+// same type, except that integers and floats can be compared.
+type between struct {
+	expr  Evaluator
+	lower Evaluator
+	upper Evaluator
+}
+
+func (b *between) Eval(input data.Value) (data.Value, error) {
+	vals := make([]data.Value, 3)
+	for i, e := range []Evaluator{b.expr, b.lower, b.upper} {
+		v, err := e.Eval(input)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+	val, lower, upper := vals[0], vals[1], vals[2]
+	// NULL propagation
+	if val.Type() == data.TypeNull || lower.Type() == data.TypeNull ||
+		upper.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	for _, bound := range []data.Value{lower, upper} {
+		if !isOrderedComparable(val, bound) {
+			return nil, fmt.Errorf("cannot compare %T and %T", val, bound)
+		}
+	}
+	return data.Bool(!data.Less(val, lower) && !data.Less(upper, val)), nil
+}
+
+// isOrderedComparable returns true if the two values can be compared
+// using the same rules as the < operator.
+func isOrderedComparable(v1, v2 data.Value) bool {
+	isNumber := func(t data.TypeID) bool {
+		return t == data.TypeInt || t == data.TypeFloat
+	}
+	t1, t2 := v1.Type(), v2.Type()
+	if isNumber(t1) && isNumber(t2) {
+		return true
+	}
+	if t1 != t2 {
+		return false
+	}
+	switch t1 {
+	case data.TypeBool, data.TypeString, data.TypeTimestamp:
+		return true
+	}
+	return false
+}
+
 /// A Unary Comparison Operation
 
 type isNull struct {
@@ -916,6 +1142,178 @@ func (nbo *concat) Eval(input data.Value) (v data.Value, err error) {
 	return data.String(leftString + rightString), nil
 }
 
+// patternMatch checks whether a string matches a pattern given as
+// a LIKE, ILIKE, SIMILAR TO or regular expression pattern. All kinds
+// of patterns are translated to regular expressions. If the pattern is
+// a constant, it is compiled only once when the evaluator is created.
+// Otherwise, it is compiled every time Eval is called. Since evaluators
+// can be shared and evaluated concurrently, e.g. by UDFs defined with
+// CREATE FUNCTION, the evaluator isn't modified after it is created.
+type patternMatch struct {
+	binOp
+	op      parser.Operator
+	compile func(pattern string) (*regexp.Regexp, error)
+	// constRe is the compiled constant pattern, or nil if the pattern
+	// isn't a constant.
+	constRe *regexp.Regexp
+}
+
+func newPatternMatch(op parser.Operator, bo binOp, right FlatExpression) (Evaluator, error) {
+	var compile func(string) (*regexp.Regexp, error)
+	negate := false
+	switch op {
+	default:
+		return nil, fmt.Errorf("%v is not a pattern matching operator", op)
+	case parser.Like, parser.NotLike:
+		compile = func(p string) (*regexp.Regexp, error) {
+			return compileLikePattern(p, false)
+		}
+		negate = op == parser.NotLike
+	case parser.ILike, parser.NotILike:
+		compile = func(p string) (*regexp.Regexp, error) {
+			return compileLikePattern(p, true)
+		}
+		negate = op == parser.NotILike
+	case parser.SimilarTo, parser.NotSimilarTo:
+		compile = compileSimilarToPattern
+		negate = op == parser.NotSimilarTo
+	case parser.RegexpMatch, parser.NotRegexpMatch:
+		compile = regexp.Compile
+		negate = op == parser.NotRegexpMatch
+	}
+	m := &patternMatch{
+		binOp:   bo,
+		op:      op,
+		compile: compile,
+	}
+	if lit, ok := right.(stringLiteral); ok {
+		re, err := m.compilePattern(lit.Value)
+		if err != nil {
+			return nil, err
+		}
+		m.constRe = re
+	}
+	if negate {
+		return newNot(m), nil
+	}
+	return m, nil
+}
+
+// compilePattern returns the compiled version of the given pattern.
+func (m *patternMatch) compilePattern(pattern string) (*regexp.Regexp, error) {
+	re, err := m.compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern for %v: %v", m.op, err)
+	}
+	return re, nil
+}
+
+func (m *patternMatch) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := m.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	str, err := data.AsString(leftVal)
+	if err != nil {
+		return nil, fmt.Errorf("left operand of %v must be string: %v", m.op, leftVal)
+	}
+	re := m.constRe
+	if re == nil {
+		pattern, err := data.AsString(rightVal)
+		if err != nil {
+			return nil, fmt.Errorf("right operand of %v must be string: %v", m.op, rightVal)
+		}
+		if re, err = m.compilePattern(pattern); err != nil {
+			return nil, err
+		}
+	}
+	return data.Bool(re.MatchString(str)), nil
+}
+
+// compileLikePattern converts a LIKE pattern to a regular expression
+// that matches the whole string. In the pattern, '%' matches any
+// sequence of characters, '_' matches any single character and '\'
+// escapes the next character.
+func compileLikePattern(pattern string, caseInsensitive bool) (*regexp.Regexp, error) {
+	expr := []string{"(?s)^"}
+	if caseInsensitive {
+		expr[0] = "(?is)^"
+	}
+	escaped := false
+	for _, r := range pattern {
+		if escaped {
+			expr = append(expr, regexp.QuoteMeta(string(r)))
+			escaped = false
+			continue
+		}
+		switch r {
+		case '\\':
+			escaped = true
+		case '%':
+			expr = append(expr, ".*")
+		case '_':
+			expr = append(expr, ".")
+		default:
+			expr = append(expr, regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("pattern must not end with an escape character")
+	}
+	expr = append(expr, "$")
+	return regexp.Compile(strings.Join(expr, ""))
+}
+
+// compileSimilarToPattern converts a SIMILAR TO pattern to a regular
+// expression that matches the whole string. Like in a LIKE pattern,
+// '%' and '_' are wildcards and '\' escapes the next character. In
+// addition, '|', '*', '+', '?', '{m,n}', '(...)' and '[...]' have the
+// same meaning as in a regular expression.
+func compileSimilarToPattern(pattern string) (*regexp.Regexp, error) {
+	expr := []string{"(?s)^(?:"}
+	escaped := false
+	inBrackets := false
+	for _, r := range pattern {
+		if escaped {
+			expr = append(expr, regexp.QuoteMeta(string(r)))
+			escaped = false
+			continue
+		}
+		if inBrackets {
+			// characters in a bracket expression are used as they are
+			if r == ']' {
+				inBrackets = false
+			}
+			expr = append(expr, string(r))
+			continue
+		}
+		switch r {
+		case '\\':
+			escaped = true
+		case '%':
+			expr = append(expr, ".*")
+		case '_':
+			expr = append(expr, ".")
+		case '[':
+			inBrackets = true
+			expr = append(expr, "[")
+		case '|', '*', '+', '?', '{', '}', '(', ')':
+			expr = append(expr, string(r))
+		default:
+			expr = append(expr, regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("pattern must not end with an escape character")
+	}
+	expr = append(expr, ")$")
+	return regexp.Compile(strings.Join(expr, ""))
+}
+
 /// Function Evaluation
 
 type funcApp struct {
@@ -1006,6 +1404,105 @@ func FuncAppSelector(funcEval *funcApp, selector string) (Evaluator, error) {
 	return funcEval, nil
 }
 
+/// Aggregate Function with Distinct Input
+
+// distinctValues evaluates to the list of values computed by another
+// Evaluator with duplicates removed. The order of the remaining values
+// is kept.
+type distinctValues struct {
+	values Evaluator
+}
+
+func (d *distinctValues) Eval(input data.Value) (data.Value, error) {
+	v, err := d.values.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	arr, err := data.AsArray(v)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[data.HashValue][]data.Value, len(arr))
+	output := make(data.Array, 0, len(arr))
+	for _, elem := range arr {
+		h := data.Hash(elem)
+		dup := false
+		for _, other := range seen[h] {
+			if data.Equal(elem, other) {
+				dup = true
+				break
+			}
+		}
+		if dup {
+			continue
+		}
+		seen[h] = append(seen[h], elem)
+		output = append(output, elem)
+	}
+	return output, nil
+}
+
+/// Grouping Sets
+
+// groupingKey is the key of the representative row of a group having
+// a Map from the representations of GROUP BY columns to whether they
+// are rolled up in the grouping set of the group.
+const groupingKey = ":grouping:"
+
+// groupingFunc computes grouping() using the information stored in
+// the representative row of a group by the execution plan.
+type groupingFunc struct {
+	cols []string
+}
+
+func (g *groupingFunc) Eval(input data.Value) (data.Value, error) {
+	aMap, err := data.AsMap(input)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := aMap[groupingKey]
+	if !ok {
+		return nil, fmt.Errorf("%s() can only be used with GROUP BY", groupingFuncName)
+	}
+	rolledUp, err := data.AsMap(v)
+	if err != nil {
+		return nil, err
+	}
+	res := int64(0)
+	for _, c := range g.cols {
+		res <<= 1
+		r, ok := rolledUp[c]
+		if !ok {
+			return nil, fmt.Errorf("column \"%s\" is not in the GROUP BY clause", c)
+		}
+		if b, _ := data.AsBool(r); b {
+			res |= 1
+		}
+	}
+	return data.Int(res), nil
+}
+
+/// Aggregate Input with Filter
+
+// filteredAggInputEvaluator computes the input of an aggregation
+// parameter of a function with a FILTER clause. Execution plans must
+// use accepts to check if a row is part of the input before calling
+// Eval.
+type filteredAggInputEvaluator struct {
+	expr   Evaluator
+	filter Evaluator
+}
+
+func (f *filteredAggInputEvaluator) Eval(input data.Value) (data.Value, error) {
+	return f.expr.Eval(input)
+}
+
+// accepts returns true when the row satisfies the condition of the
+// FILTER clause. A NULL result is treated like false.
+func (f *filteredAggInputEvaluator) accepts(row data.Map) (bool, error) {
+	return evalCondition(f.filter, row)
+}
+
 /// Aggregate Function with Sorted Input
 
 type sortEvaluator struct {
@@ -1264,6 +1761,10 @@ func (w *wildcard) Eval(input data.Value) (data.Value, error) {
 		if !exists {
 			return nil, fmt.Errorf("there is no entry with key '%s'", w.Relation)
 		}
+		if subElement.Type() == data.TypeNull {
+			// the missing side of an outer join has no columns
+			return output, nil
+		}
 		subMap, err := data.AsMap(subElement)
 		if err != nil {
 			return nil, err
@@ -1274,7 +1775,7 @@ func (w *wildcard) Eval(input data.Value) (data.Value, error) {
 	} else {
 		// if we have *, take items from all submaps
 		for alias, subElement := range aMap {
-			if strings.Contains(alias, ":meta:") {
+			if strings.Contains(alias, ":meta:") || subElement.Type() == data.TypeNull {
 				continue
 			}
 			subMap, err := data.AsMap(subElement)
@@ -3,6 +3,7 @@ package execution
 import (
 	"fmt"
 	"math"
+	"sync"
 	"testing"
 	"time"
 
@@ -54,6 +55,68 @@ func TestEvaluators(t *testing.T) {
 	}
 }
 
+func TestPatternMatchEvaluators(t *testing.T) {
+	reg := &testFuncRegistry{ctx: core.NewContext(nil)}
+
+	Convey("Given pattern matching expressions with invalid constant patterns", t, func() {
+		a := parser.RowValue{"", "a"}
+		exprs := []parser.Expression{
+			parser.BinaryOpAST{parser.Like, a, parser.StringLiteral{`abc\`}},
+			parser.BinaryOpAST{parser.NotSimilarTo, a, parser.StringLiteral{"(a"}},
+			parser.BinaryOpAST{parser.RegexpMatch, a, parser.StringLiteral{"[a"}},
+		}
+
+		for _, expr := range exprs {
+			expr := expr
+			Convey(fmt.Sprintf("When creating an evaluator for %v", expr), func() {
+				flatExpr, err := ParserExprToFlatExpr(expr, reg)
+				So(err, ShouldBeNil)
+				_, err = ExpressionToEvaluator(flatExpr, reg)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldStartWith, "invalid pattern")
+				})
+			})
+		}
+	})
+
+	Convey("Given a pattern matching evaluator with a non-constant pattern", t, func() {
+		expr := parser.BinaryOpAST{parser.Like, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}}
+		flatExpr, err := ParserExprToFlatExpr(expr, reg)
+		So(err, ShouldBeNil)
+		eval, err := ExpressionToEvaluator(flatExpr, reg)
+		So(err, ShouldBeNil)
+
+		Convey("When evaluating it with different patterns concurrently", func() {
+			inputs := []data.Map{
+				{"a": data.String("abc"), "b": data.String("a%")},
+				{"a": data.String("abc"), "b": data.String("b%")},
+			}
+			wg := sync.WaitGroup{}
+			failed := make([]bool, len(inputs))
+			for i, in := range inputs {
+				i, in := i, in
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 100; j++ {
+						v, err := eval.Eval(in)
+						if err != nil || v != data.Bool(i == 0) {
+							failed[i] = true
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			Convey("Then each pattern should be matched correctly", func() {
+				So(failed, ShouldResemble, []bool{false, false})
+			})
+		})
+	})
+}
+
 func TestFoldableExecution(t *testing.T) {
 	testCases := []struct {
 		ast      parser.Expression
@@ -132,27 +195,27 @@ func TestFoldableExecution(t *testing.T) {
 		{parser.TypeCastAST{parser.NumericLiteral{7}, parser.Float},
 			true, data.Float(7.0)},
 		{parser.FuncAppAST{parser.FuncName("now"),
-			parser.ExpressionsAST{[]parser.Expression{}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, nil},
 			false, nil},
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil, nil},
 			false, nil},
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}, nil, nil},
 			true, data.Int(8)},
 		{parser.FuncAppSelectorAST{
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.ArrayAST{parser.ExpressionsAST{
 						[]parser.Expression{parser.NumericLiteral{1}}}},
-				}}, nil},
+				}}, nil, nil},
 			parser.Raw{"[0]"}},
 			true, data.Int(1)},
 		{parser.FuncAppSelectorAST{
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.MapAST{[]parser.KeyValuePairAST{{"a", parser.StringLiteral{"value"}}}},
-				}}, nil},
+				}}, nil, nil},
 			parser.Raw{".a"}},
 			true, data.String("value")},
 		{parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}},
@@ -244,7 +307,7 @@ func TestFuncAppConversion(t *testing.T) {
 			ast := parser.FuncAppAST{parser.FuncName("plusone"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil}
+				}}, nil, nil}
 
 			Convey("Then we obtain an evaluatable funcApp", func() {
 				flatExpr, err := ParserExprToFlatExpr(ast, reg)
@@ -261,7 +324,7 @@ func TestFuncAppConversion(t *testing.T) {
 					parser.ExpressionsAST{[]parser.Expression{
 						parser.MapAST{[]parser.KeyValuePairAST{
 							{"a", parser.StringLiteral{"value"}}}},
-					}}, nil},
+					}}, nil, nil},
 				parser.Raw{".a"}}
 
 			Convey("Then we obtain an evaluatable funcApp", func() {
@@ -277,7 +340,7 @@ func TestFuncAppConversion(t *testing.T) {
 			ast := parser.FuncAppAST{parser.FuncName("fun"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil}
+				}}, nil, nil}
 
 			Convey("Then converting to an Evaluator fails", func() {
 				// we cannot even get the flat expression in that case
@@ -291,7 +354,7 @@ func TestFuncAppConversion(t *testing.T) {
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
 				}},
-				[]parser.SortedExpressionAST{{parser.RowValue{"", "a"}, parser.Yes}}}
+				[]parser.SortedExpressionAST{{parser.RowValue{"", "a"}, parser.Yes}}, nil}
 
 			Convey("Then converting to an Evaluator fails", func() {
 				// we cannot even get the flat expression in that case
@@ -308,7 +371,7 @@ func TestFuncAppConversion(t *testing.T) {
 					parser.ExpressionsAST{[]parser.Expression{
 						parser.MapAST{[]parser.KeyValuePairAST{
 							{"a", parser.StringLiteral{"value"}}}},
-					}}, nil},
+					}}, nil, nil},
 				parser.Raw{"[0"}}
 
 			Convey("Then converting to an Evaluator should fail", func() {
@@ -322,7 +385,7 @@ func TestFuncAppConversion(t *testing.T) {
 
 		Convey("When the now() function is used", func() {
 			ast := parser.FuncAppAST{parser.FuncName("now"),
-				parser.ExpressionsAST{[]parser.Expression{}}, nil}
+				parser.ExpressionsAST{[]parser.Expression{}}, nil, nil}
 
 			Convey("Then we obtain an evaluatable timestampCast", func() {
 				flatExpr, err := ParserExprToFlatExpr(ast, reg)
@@ -1480,6 +1543,149 @@ func getTestCases() []struct {
 					"b": data.String("b")}, data.String("ab")},
 			}, nullOps...),
 		},
+		// Pattern Matching
+		{parser.BinaryOpAST{parser.Like, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// not strings => error
+				{data.Map{"a": data.Int(1), "b": data.String("1")}, nil},
+				{data.Map{"a": data.String("1"), "b": data.Int(1)}, nil},
+				// invalid pattern => error
+				{data.Map{"a": data.String("a"), "b": data.String(`a\`)}, nil},
+				// left and right present
+				{data.Map{"a": data.String("sensor error"), "b": data.String("%error")}, data.Bool(true)},
+				{data.Map{"a": data.String("sensor error"), "b": data.String("%ERROR")}, data.Bool(false)},
+				{data.Map{"a": data.String("sensor error"), "b": data.String("error")}, data.Bool(false)},
+				{data.Map{"a": data.String("a.c"), "b": data.String("a_c")}, data.Bool(true)},
+				{data.Map{"a": data.String("abc"), "b": data.String("a.c")}, data.Bool(false)},
+				{data.Map{"a": data.String("日本語"), "b": data.String("_本%")}, data.Bool(true)},
+				{data.Map{"a": data.String("a\nb"), "b": data.String("a%b")}, data.Bool(true)},
+				{data.Map{"a": data.String("100%"), "b": data.String(`100\%`)}, data.Bool(true)},
+				{data.Map{"a": data.String("1000"), "b": data.String(`100\%`)}, data.Bool(false)},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotILike, parser.RowValue{"", "a"}, parser.StringLiteral{"%error%"}},
+			[]evalTest{
+				{data.Map{"a": data.String("Sensor ERROR")}, data.Bool(false)},
+				{data.Map{"a": data.String("sensor ok")}, data.Bool(true)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.SimilarTo, parser.RowValue{"", "a"}, parser.StringLiteral{"(temp|hum)_[0-9]+.%"}},
+			[]evalTest{
+				{data.Map{"a": data.String("temp_12.c")}, data.Bool(true)},
+				{data.Map{"a": data.String("hum_3.")}, data.Bool(true)},
+				{data.Map{"a": data.String("hum_3x")}, data.Bool(false)},
+				{data.Map{"a": data.String("pressure_1.c")}, data.Bool(false)},
+				{data.Map{"a": data.String("xtemp_1.c")}, data.Bool(false)},
+			},
+		},
+		{parser.BinaryOpAST{parser.RegexpMatch, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				{data.Map{"a": data.String("code=E42"), "b": data.String(`E[0-9]+`)}, data.Bool(true)},
+				{data.Map{"a": data.String("code=E42"), "b": data.String(`^E[0-9]+`)}, data.Bool(false)},
+				{data.Map{"a": data.String("code=E42"), "b": data.String(`(`)}, nil},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotRegexpMatch, parser.RowValue{"", "a"}, parser.StringLiteral{"^E[0-9]+$"}},
+			[]evalTest{
+				{data.Map{"a": data.String("E42")}, data.Bool(false)},
+				{data.Map{"a": data.String("W42")}, data.Bool(true)},
+			},
+		},
+		// IN
+		{parser.BinaryOpAST{parser.In, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// right side not an array => error
+				{data.Map{"a": data.Int(1), "b": data.Int(1)}, nil},
+				// left and right present
+				{data.Map{"a": data.Int(1), "b": data.Array{data.Float(1.0), data.Int(2)}}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Array{data.Int(1), data.Int(2)}}, data.Bool(false)},
+				{data.Map{"a": data.Int(3), "b": data.Array{}}, data.Bool(false)},
+				{data.Map{"a": data.String("2"), "b": data.Array{data.Int(1), data.Int(2)}}, data.Bool(false)},
+				{data.Map{"a": data.Array{data.Int(2)}, "b": data.Array{data.Array{data.Int(2)}}}, data.Bool(true)},
+				// NULL in the array
+				{data.Map{"a": data.Int(1), "b": data.Array{data.Null{}, data.Int(1)}}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Array{data.Null{}, data.Int(1)}}, data.Null{}},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotIn, parser.RowValue{"", "a"},
+			parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.StringLiteral{"a"}, parser.RowValue{"", "b"}}}}},
+			[]evalTest{
+				{data.Map{"a": data.Int(1), "b": data.Int(5)}, data.Bool(false)},
+				{data.Map{"a": data.Int(5), "b": data.Int(5)}, data.Bool(false)},
+				{data.Map{"a": data.Int(4), "b": data.Int(5)}, data.Bool(true)},
+				{data.Map{"a": data.Int(4), "b": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Null{}, "b": data.Int(5)}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.In, parser.RowValue{"", "a"},
+			parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.NumericLiteral{2}, parser.NumericLiteral{3},
+				parser.NumericLiteral{4}, parser.NumericLiteral{5}, parser.FloatLiteral{6.5},
+				parser.StringLiteral{"a"}, parser.StringLiteral{"b"}, parser.BoolLiteral{true}}}}},
+			[]evalTest{
+				{data.Int(17), nil},
+				{data.Map{"x": data.Int(17)}, nil},
+				{data.Map{"a": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Float(3.0)}, data.Bool(true)},
+				{data.Map{"a": data.Float(6.5)}, data.Bool(true)},
+				{data.Map{"a": data.String("b")}, data.Bool(true)},
+				{data.Map{"a": data.Bool(true)}, data.Bool(true)},
+				{data.Map{"a": data.Int(6)}, data.Bool(false)},
+				{data.Map{"a": data.String("1")}, data.Bool(false)},
+				{data.Map{"a": data.Bool(false)}, data.Bool(false)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.NotIn, parser.RowValue{"", "a"},
+			parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.NumericLiteral{2}, parser.NumericLiteral{3},
+				parser.NumericLiteral{4}, parser.NumericLiteral{5}, parser.NumericLiteral{6},
+				parser.NumericLiteral{7}, parser.NullLiteral{}}}}},
+			[]evalTest{
+				{data.Map{"a": data.Int(3)}, data.Bool(false)},
+				{data.Map{"a": data.Int(8)}, data.Null{}},
+			},
+		},
+		// BETWEEN
+		{parser.BetweenAST{parser.Between, parser.RowValue{"", "a"},
+			parser.NumericLiteral{1}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// incomparable types => error
+				{data.Map{"a": data.String("2"), "b": data.Int(3)}, nil},
+				{data.Map{"a": data.Int(2), "b": data.String("3")}, nil},
+				{data.Map{"a": data.Array{}, "b": data.Array{}}, nil},
+				// left and right present
+				{data.Map{"a": data.Int(1), "b": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Float(2.5), "b": data.Int(3)}, data.Bool(true)},
+				{data.Map{"a": data.Float(0.5), "b": data.Int(3)}, data.Bool(false)},
+				{data.Map{"a": data.Int(4), "b": data.Float(3.5)}, data.Bool(false)},
+				{data.Map{"a": data.Int(2), "b": data.Int(0)}, data.Bool(false)},
+			}, nullOps...),
+		},
+		{parser.BetweenAST{parser.NotBetween, parser.RowValue{"", "a"},
+			parser.StringLiteral{"b"}, parser.StringLiteral{"d"}},
+			[]evalTest{
+				{data.Map{"a": data.String("c")}, data.Bool(false)},
+				{data.Map{"a": data.String("d")}, data.Bool(false)},
+				{data.Map{"a": data.String("da")}, data.Bool(true)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
 		// IsNull
 		{parser.BinaryOpAST{parser.Is, parser.RowValue{"", "a"}, parser.NullLiteral{}},
 			[]evalTest{
@@ -1751,7 +1957,7 @@ func getTestCases() []struct {
 		},
 		/// Function Application
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil, nil},
 			// NB. This only tests the behavior of funcApp.Eval.
 			// It does *not* test the function registry, mismatch
 			// in parameter counts or any particular function.
@@ -1771,7 +1977,7 @@ func getTestCases() []struct {
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil},
+				}}, nil, nil},
 			parser.Raw{".key"}},
 			[]evalTest{
 				// function return selected value
@@ -1787,7 +1993,7 @@ func getTestCases() []struct {
 			parser.FuncAppAST{parser.FuncName("identity"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil},
+				}}, nil, nil},
 			parser.Raw{"[1]"}},
 			[]evalTest{
 				// function return selected value
@@ -1896,7 +2102,7 @@ func getTestCases() []struct {
 		// Using now() should find the timestamp at the
 		// correct position
 		{parser.FuncAppAST{parser.FuncName("now"),
-			parser.ExpressionsAST{[]parser.Expression{}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -1976,7 +2182,7 @@ func getTestCases() []struct {
 			},
 		},
 		{parser.FuncAppAST{parser.FuncName("maplen"),
-			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{}}}, nil, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -1993,7 +2199,7 @@ func getTestCases() []struct {
 			},
 		},
 		{parser.FuncAppAST{parser.FuncName("maplen"),
-			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{"a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{"a"}}}, nil, nil},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
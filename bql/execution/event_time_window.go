@@ -0,0 +1,116 @@
+package execution
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// eventTimeWindows holds the state of a TUMBLING window. Such a window
+// assigns each tuple to a fixed-size, non-overlapping interval based on
+// the tuple's Timestamp (the event time) rather than its arrival order.
+// An interval is evaluated once, when the watermark passes its end. The
+// watermark is the largest timestamp seen so far minus the allowed
+// lateness, so tuples arriving out of order are still assigned to the
+// correct interval as long as it has not been closed yet.
+type eventTimeWindows struct {
+	// size is the length of each window
+	size time.Duration
+	// lateness is the time the watermark lags behind the largest
+	// timestamp seen so far
+	lateness time.Duration
+	// maxEventTime holds the largest timestamp seen so far, valid if
+	// started is true.
+	maxEventTime time.Time
+	started      bool
+	// closedUntil is the end (in nanoseconds since the epoch) of the
+	// last window that was closed. Tuples whose window ends at or
+	// before that point are late.
+	closedUntil int64
+	// open holds the tuples of all windows that have not been closed
+	// yet, keyed by the start of the respective window (in nanoseconds
+	// since the epoch).
+	open map[int64][]*core.Tuple
+}
+
+func newEventTimeWindows(size, lateness time.Duration) *eventTimeWindows {
+	if size < 1 {
+		size = 1
+	}
+	return &eventTimeWindows{
+		size:     size,
+		lateness: lateness,
+		open:     map[int64][]*core.Tuple{},
+	}
+}
+
+// windowStart returns the start of the window the given time falls
+// into (in nanoseconds since the epoch).
+func (w *eventTimeWindows) windowStart(t time.Time) int64 {
+	ns := t.UnixNano()
+	size := int64(w.size)
+	start := ns - ns%size
+	if ns < 0 && ns%size != 0 {
+		start -= size
+	}
+	return start
+}
+
+// add assigns the tuple to its window and advances the watermark. It
+// returns the contents of all windows that were closed by the new
+// watermark, ordered by their start time. If the tuple belongs to a
+// window that was already closed, an error is returned and the tuple
+// is not added.
+func (w *eventTimeWindows) add(t *core.Tuple) ([][]*core.Tuple, error) {
+	start := w.windowStart(t.Timestamp)
+	if w.started && start+int64(w.size) <= w.closedUntil {
+		return nil, fmt.Errorf("tuple with timestamp %v arrived too late: "+
+			"the watermark is already at %v", data.Timestamp(t.Timestamp),
+			data.Timestamp(w.watermark()))
+	}
+	// the tuple is kept until its window closes, so a copy is required
+	w.open[start] = append(w.open[start], t.ShallowCopy())
+
+	if !w.started || t.Timestamp.After(w.maxEventTime) {
+		w.maxEventTime = t.Timestamp
+	}
+	if !w.started {
+		w.started = true
+		w.closedUntil = w.windowStart(w.watermark())
+	}
+
+	// all windows ending at or before the watermark are closed now
+	closedUntil := w.windowStart(w.watermark())
+	if closedUntil <= w.closedUntil {
+		return nil, nil
+	}
+	w.closedUntil = closedUntil
+
+	starts := []int64{}
+	for s := range w.open {
+		if s+int64(w.size) <= closedUntil {
+			starts = append(starts, s)
+		}
+	}
+	sort.Sort(int64Slice(starts))
+	closed := make([][]*core.Tuple, 0, len(starts))
+	for _, s := range starts {
+		closed = append(closed, w.open[s])
+		delete(w.open, s)
+	}
+	return closed, nil
+}
+
+// watermark returns the time up to which all windows can be closed.
+func (w *eventTimeWindows) watermark() time.Time {
+	return w.maxEventTime.Add(-w.lateness)
+}
+
+type int64Slice []int64
+
+func (s int64Slice) Len() int           { return len(s) }
+func (s int64Slice) Less(i, j int) bool { return s[i] < s[j] }
+func (s int64Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
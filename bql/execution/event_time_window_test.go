@@ -0,0 +1,121 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+func getEventTimeTuple(sec int, val int64) *core.Tuple {
+	t := core.NewTuple(data.Map{"int": data.Int(val)})
+	t.InputName = "src"
+	t.Timestamp = time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC).
+		Add(time.Duration(sec) * time.Second)
+	return t
+}
+
+func TestTumblingWindow(t *testing.T) {
+	Convey("Given a SELECT statement with a TUMBLING window without lateness", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c, sum(int) AS s
+			FROM src [RANGE 10 SECONDS TUMBLING]`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples in order", func() {
+			secs := []int{0, 3, 9, 10, 15, 25, 41}
+			outs := [][]data.Map{}
+			for i, sec := range secs {
+				out, err := plan.Process(getEventTimeTuple(sec, int64(i+1)))
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then each window should be emitted once it is closed", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{{"c": data.Int(3), "s": data.Int(6)}})
+				So(outs[4], ShouldBeEmpty)
+				So(outs[5], ShouldResemble, []data.Map{{"c": data.Int(2), "s": data.Int(9)}})
+				// the window [20,30) is closed, but [30,40) is empty
+				So(outs[6], ShouldResemble, []data.Map{{"c": data.Int(1), "s": data.Int(6)}})
+			})
+		})
+
+		Convey("When feeding it with a tuple for a closed window", func() {
+			_, err := plan.Process(getEventTimeTuple(12, 1))
+			So(err, ShouldBeNil)
+			_, err = plan.Process(getEventTimeTuple(8, 2))
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "arrived too late")
+			})
+		})
+
+		Convey("When feeding it with a tuple from an unknown input", func() {
+			tup := getEventTimeTuple(0, 1)
+			tup.InputName = "hoge"
+			_, err := plan.Process(tup)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a TUMBLING window with ALLOWED LATENESS", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM count(*) AS c, sum(int) AS s
+			FROM src [RANGE 10 SECONDS TUMBLING, ALLOWED LATENESS 5 SECONDS] WHERE int > 0`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples out of order", func() {
+			inputs := []struct {
+				sec int
+				val int64
+			}{
+				{1, 1},
+				{12, 2},
+				{8, 4}, // late, but within the allowed lateness
+				{14, 8},
+				{15, 16}, // closes [0,10) with the watermark at 10
+				{6, 32},  // too late, dropped
+				{11, -1}, // filtered out
+				{35, 64}, // closes [10,20) and [20,30)
+			}
+			outs := [][]data.Map{}
+			errs := []error{}
+			for _, in := range inputs {
+				out, err := plan.Process(getEventTimeTuple(in.sec, in.val))
+				outs = append(outs, out)
+				errs = append(errs, err)
+			}
+
+			for i := range inputs {
+				if i == 5 {
+					Convey(fmt.Sprintf("Then there should be an error in %v", i), func() {
+						So(errs[i], ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then there should be no error in %v", i), func() {
+						So(errs[i], ShouldBeNil)
+					})
+				}
+			}
+
+			Convey("Then late tuples should be assigned to the correct window", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldBeEmpty)
+				So(outs[4], ShouldResemble, []data.Map{{"c": data.Int(2), "s": data.Int(5)}})
+				So(outs[6], ShouldBeEmpty)
+				So(outs[7], ShouldResemble, []data.Map{{"c": data.Int(3), "s": data.Int(26)}})
+			})
+		})
+	})
+}
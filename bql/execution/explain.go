@@ -0,0 +1,343 @@
+package execution
+
+import (
+	"reflect"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// Explain describes how the statement is executed, i.e., which physical
+// plan is chosen, how the windows of the input relations are buffered,
+// which emitter settings are used, and how the expressions of the
+// statement were flattened and converted to Evaluators. The result is
+// meant to be read by humans, so its structure may change between
+// versions.
+func (lp *LogicalPlan) Explain(reg udf.FunctionRegistry) (data.Map, error) {
+	planName, _, err := lp.choosePhysicalPlan(reg)
+	if err != nil {
+		return nil, err
+	}
+	plan := data.Map{
+		"name": data.String(planName),
+	}
+	if planName != "filterPlan" {
+		plan["base"] = data.String("streamRelationStreamExecutionPlan")
+	} else if lp.isOrdered() {
+		plan["wrapper"] = data.String("orderedPlan")
+	}
+
+	res := data.Map{
+		"plan":     plan,
+		"emitter":  lp.explainEmitter(),
+		"distinct": data.Bool(lp.Distinct),
+		"grouping": data.Bool(lp.GroupingStmt),
+	}
+
+	relations := make(data.Array, len(lp.Relations))
+	for i, rel := range lp.Relations {
+		if relations[i], err = explainRelation(&rel, reg); err != nil {
+			return nil, err
+		}
+	}
+	res["relations"] = relations
+
+	explainAll := func(exprs []FlatExpression) (data.Array, error) {
+		arr := make(data.Array, len(exprs))
+		for i, e := range exprs {
+			m, err := explainExpression(e, reg)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = m
+		}
+		return arr, nil
+	}
+
+	if jc := lp.JoinCondition; jc != nil {
+		join := data.Map{
+			"type": data.String(jc.joinType.String()),
+		}
+		if join["left_keys"], err = explainAll(jc.leftKeys); err != nil {
+			return nil, err
+		}
+		if join["right_keys"], err = explainAll(jc.rightKeys); err != nil {
+			return nil, err
+		}
+		if jc.residual != nil {
+			if join["residual"], err = explainExpression(jc.residual, reg); err != nil {
+				return nil, err
+			}
+		}
+		res["join"] = join
+	}
+
+	if len(lp.StateLookups) > 0 {
+		lookups := make(data.Array, len(lp.StateLookups))
+		for i, l := range lp.StateLookups {
+			key, err := explainExpression(l.key, reg)
+			if err != nil {
+				return nil, err
+			}
+			lookups[i] = data.Map{
+				"state":    data.String(l.state),
+				"alias":    data.String(l.alias),
+				"relation": data.String(l.relation),
+				"key":      key,
+			}
+		}
+		res["lookups"] = lookups
+	}
+
+	projections := data.Array{}
+	for _, p := range lp.Projections {
+		m, err := explainExpression(p.expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		if len(p.aggrInputs) > 0 {
+			inputs := data.Map{}
+			for ref, e := range p.aggrInputs {
+				if inputs[ref], err = explainExpression(e, reg); err != nil {
+					return nil, err
+				}
+			}
+			m["aggregate_inputs"] = inputs
+		}
+		if p.alias == ":having:" {
+			// HAVING is evaluated like a projection, but it is
+			// not part of the output
+			res["having"] = m
+			continue
+		}
+		m["alias"] = data.String(p.alias)
+		projections = append(projections, m)
+	}
+	res["projections"] = projections
+
+	if len(lp.Analytics) > 0 {
+		analytics := make(data.Array, len(lp.Analytics))
+		for i, a := range lp.Analytics {
+			m := data.Map{
+				"ref":      data.String(a.Ref),
+				"function": data.String(a.Function),
+			}
+			if m["arguments"], err = explainAll(a.Expressions); err != nil {
+				return nil, err
+			}
+			if m["partition"], err = explainAll(a.Partition); err != nil {
+				return nil, err
+			}
+			if m["ordering"], err = explainOrdering(a.Ordering, reg); err != nil {
+				return nil, err
+			}
+			analytics[i] = m
+		}
+		res["analytics"] = analytics
+	}
+
+	if lp.Filter != nil {
+		if res["filter"], err = explainExpression(lp.Filter, reg); err != nil {
+			return nil, err
+		}
+	}
+	if len(lp.GroupList) > 0 {
+		if res["group_by"], err = explainAll(lp.GroupList); err != nil {
+			return nil, err
+		}
+	}
+	if lp.GroupingSets != nil {
+		// each set is a list of indexes of the GROUP BY expressions
+		sets := make(data.Array, len(lp.GroupingSets))
+		for i, set := range lp.GroupingSets {
+			idxs := make(data.Array, len(set))
+			for j, idx := range set {
+				idxs[j] = data.Int(idx)
+			}
+			sets[i] = idxs
+		}
+		res["grouping_sets"] = sets
+	}
+	if len(lp.Ordering) > 0 {
+		if res["ordering"], err = explainOrdering(lp.Ordering, reg); err != nil {
+			return nil, err
+		}
+	}
+	if lp.Limit >= 0 {
+		res["limit"] = data.Int(lp.Limit)
+	}
+	return res, nil
+}
+
+func (lp *LogicalPlan) explainEmitter() data.Map {
+	m := data.Map{
+		"type": data.String(lp.EmitterType.String()),
+	}
+	if lp.EmitterLimit >= 0 {
+		m["limit"] = data.Int(lp.EmitterLimit)
+	}
+	if lp.EmitterSamplingType != parser.UnspecifiedSamplingType {
+		m["sampling"] = data.Map{
+			"type":  data.String(lp.EmitterSamplingType.String()),
+			"value": data.Float(lp.EmitterSampling),
+		}
+	}
+	return m
+}
+
+// explainRelation describes an input relation and the buffer holding
+// its window. A subquery is explained in the same way as a statement.
+func explainRelation(rel *parser.AliasedStreamWindowAST, reg udf.FunctionRegistry) (data.Map, error) {
+	explainInterval := func(i parser.IntervalAST) data.Map {
+		return data.Map{
+			"value": data.Float(i.Value),
+			"unit":  data.String(i.Unit.String()),
+		}
+	}
+
+	window := explainInterval(rel.IntervalAST)
+	if rel.Slide.Unit != parser.UnspecifiedIntervalUnit {
+		window["slide"] = explainInterval(rel.Slide)
+	}
+	if rel.WindowType != parser.UnspecifiedWindowType {
+		window["type"] = data.String(rel.WindowType.String())
+	}
+	if rel.AllowedLateness.Unit != parser.UnspecifiedIntervalUnit {
+		window["allowed_lateness"] = explainInterval(rel.AllowedLateness)
+	}
+	if rel.Capacity != parser.UnspecifiedCapacity {
+		window["capacity"] = data.Int(rel.Capacity)
+	}
+	if rel.Shedding != parser.UnspecifiedSheddingOption {
+		window["shedding"] = data.String(rel.Shedding.String())
+	}
+
+	m := data.Map{
+		"name":   data.String(rel.Name),
+		"alias":  data.String(rel.Alias),
+		"type":   data.String(rel.Type.String()),
+		"window": window,
+	}
+	if rel.Type == parser.UDSFStream {
+		params := make(data.Array, len(rel.Params))
+		for i, p := range rel.Params {
+			params[i] = data.String(p.String())
+		}
+		m["params"] = params
+	}
+	if rel.Type == parser.SubqueryStream {
+		analyzedPlan, err := Analyze(*rel.Subquery, reg)
+		if err != nil {
+			return nil, err
+		}
+		optimizedPlan, err := analyzedPlan.LogicalOptimize()
+		if err != nil {
+			return nil, err
+		}
+		if m["subquery"], err = optimizedPlan.Explain(reg); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func explainOrdering(ordering []resultOrdering, reg udf.FunctionRegistry) (data.Array, error) {
+	arr := make(data.Array, len(ordering))
+	for i, o := range ordering {
+		m, err := explainExpression(o.expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		m["ascending"] = data.Bool(o.ascending)
+		arr[i] = m
+	}
+	return arr, nil
+}
+
+// explainExpression describes a flattened expression together with the
+// Evaluators that are created for it and its sub-expressions.
+func explainExpression(expr FlatExpression, reg udf.FunctionRegistry) (data.Map, error) {
+	eval, err := ExpressionToEvaluator(expr, reg)
+	if err != nil {
+		return nil, err
+	}
+	m := data.Map{
+		"expression": data.String(expr.Repr()),
+		"volatility": data.String(expr.Volatility().String()),
+		"evaluator":  data.String(evaluatorName(eval)),
+	}
+
+	var children []FlatExpression
+	switch e := expr.(type) {
+	case binaryOpAST:
+		m["operator"] = data.String(e.Op.String())
+		children = []FlatExpression{e.Left, e.Right}
+	case betweenAST:
+		m["operator"] = data.String(e.Op.String())
+		children = []FlatExpression{e.Expr, e.Lower, e.Upper}
+	case unaryOpAST:
+		m["operator"] = data.String(e.Op.String())
+		children = []FlatExpression{e.Expr}
+	case typeCastAST:
+		m["target"] = data.String(e.Target.String())
+		children = []FlatExpression{e.Expr}
+	case funcAppAST:
+		m["function"] = data.String(e.Function)
+		children = e.Expressions
+	case aggregateInputSorter:
+		m["function"] = data.String(e.Function)
+		children = e.Expressions
+	case analyticFuncAppAST:
+		m["function"] = data.String(e.Function)
+	case groupingAST:
+		m["function"] = data.String(groupingFuncName)
+		for _, c := range e.Cols {
+			children = append(children, c)
+		}
+	case filteredAggInput:
+		children = []FlatExpression{e.Expr, e.Filter}
+	case funcAppSelectorAST:
+		m["selector"] = data.String(e.Selector)
+		children = []FlatExpression{e.Expr}
+	case arrayAST:
+		children = e.Expressions
+	case mapAST:
+		for _, kv := range e.Entries {
+			children = append(children, kv.Value)
+		}
+	case caseAST:
+		if e.Reference != nil {
+			children = append(children, e.Reference)
+		}
+		for _, c := range e.Checks {
+			children = append(children, c.When, c.Then)
+		}
+		if e.Default != nil {
+			children = append(children, e.Default)
+		}
+	case missing:
+		children = []FlatExpression{e.Expr}
+	}
+
+	if len(children) > 0 {
+		arr := make(data.Array, len(children))
+		for i, c := range children {
+			if arr[i], err = explainExpression(c, reg); err != nil {
+				return nil, err
+			}
+		}
+		m["children"] = arr
+	}
+	return m, nil
+}
+
+// evaluatorName returns the name of the type implementing the
+// Evaluator, e.g., "plusOp".
+func evaluatorName(eval Evaluator) string {
+	t := reflect.TypeOf(eval)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
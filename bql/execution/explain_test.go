@@ -0,0 +1,176 @@
+package execution
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func explainStmt(s string) (data.Map, error) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	stmt, _, err := parser.New().ParseStmt(s)
+	if err != nil {
+		return nil, err
+	}
+	lp, err := Analyze(stmt.(parser.SelectStmt), reg)
+	if err != nil {
+		return nil, err
+	}
+	return lp.Explain(reg)
+}
+
+func TestExplain(t *testing.T) {
+	Convey("Given SELECT statements", t, func() {
+		testCases := []struct {
+			stmt string
+			plan data.Map
+		}{
+			{"SELECT RSTREAM a FROM s [RANGE 1 TUPLES] WHERE b",
+				data.Map{"name": data.String("filterPlan")}},
+			{"SELECT ISTREAM a FROM s [RANGE 2 TUPLES]",
+				data.Map{"name": data.String("defaultSelectExecutionPlan"),
+					"base": data.String("streamRelationStreamExecutionPlan")}},
+			{"SELECT ISTREAM count(a) FROM s [RANGE 2 TUPLES]",
+				data.Map{"name": data.String("groupbyExecutionPlan"),
+					"base": data.String("streamRelationStreamExecutionPlan")}},
+			{"SELECT RSTREAM a FROM s [RANGE 1 TUPLES] ORDER BY a",
+				data.Map{"name": data.String("filterPlan"),
+					"wrapper": data.String("orderedPlan")}},
+			{"SELECT ISTREAM a FROM s [RANGE 2 TUPLES] ORDER BY a LIMIT 1",
+				data.Map{"name": data.String("defaultSelectExecutionPlan"),
+					"base": data.String("streamRelationStreamExecutionPlan")}},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When explaining %s", tc.stmt), func() {
+				m, err := explainStmt(tc.stmt)
+				So(err, ShouldBeNil)
+
+				Convey("Then the chosen plan should be returned", func() {
+					So(m["plan"], ShouldResemble, tc.plan)
+				})
+			})
+		}
+	})
+
+	Convey("Given a SELECT statement with various clauses", t, func() {
+		s := `SELECT ISTREAM [EVERY 2-ND TUPLE LIMIT 5] x:a + 1 AS b
+			FROM s [RANGE 3 SECONDS, BUFFER SIZE 4, DROP OLDEST IF FULL] AS x
+			WHERE x:c LIMIT 3`
+
+		Convey("When explaining it", func() {
+			m, err := explainStmt(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then the emitter settings should be returned", func() {
+				So(m["emitter"], ShouldResemble, data.Map{
+					"type":  data.String("ISTREAM"),
+					"limit": data.Int(5),
+					"sampling": data.Map{
+						"type":  data.String("EVERY k-TH TUPLE"),
+						"value": data.Float(2),
+					},
+				})
+			})
+
+			Convey("Then the window buffers should be returned", func() {
+				So(m["relations"], ShouldResemble, data.Array{data.Map{
+					"name":  data.String("s"),
+					"alias": data.String("x"),
+					"type":  data.String("ActualStream"),
+					"window": data.Map{
+						"value":    data.Float(3),
+						"unit":     data.String("SECONDS"),
+						"capacity": data.Int(4),
+						"shedding": data.String("DROP OLDEST"),
+					},
+				}})
+			})
+
+			Convey("Then the evaluator tree of the projection should be returned", func() {
+				So(m["projections"], ShouldResemble, data.Array{data.Map{
+					"alias":      data.String("b"),
+					"expression": data.String("(x:a)+(1)"),
+					"volatility": data.String("IMMUTABLE"),
+					"evaluator":  data.String("numBinOp"),
+					"operator":   data.String("+"),
+					"children": data.Array{
+						data.Map{
+							"expression": data.String("x:a"),
+							"volatility": data.String("IMMUTABLE"),
+							"evaluator":  data.String("pathAccess"),
+						},
+						data.Map{
+							"expression": data.String("1"),
+							"volatility": data.String("IMMUTABLE"),
+							"evaluator":  data.String("intConstant"),
+						},
+					},
+				}})
+			})
+
+			Convey("Then the filter and the limit should be returned", func() {
+				So(m["filter"], ShouldResemble, data.Map{
+					"expression": data.String("x:c"),
+					"volatility": data.String("IMMUTABLE"),
+					"evaluator":  data.String("pathAccess"),
+				})
+				So(m["limit"], ShouldEqual, data.Int(3))
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with GROUP BY and HAVING", t, func() {
+		s := `SELECT RSTREAM b, count(a) AS c FROM s [RANGE 2 TUPLES]
+			GROUP BY b HAVING count(a) > 1`
+
+		Convey("When explaining it", func() {
+			m, err := explainStmt(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then the grouping should be described", func() {
+				So(m["grouping"], ShouldEqual, data.Bool(true))
+				So(m["group_by"], ShouldHaveLength, 1)
+				So(m["having"], ShouldNotBeNil)
+			})
+
+			Convey("Then HAVING shouldn't be listed in the projections", func() {
+				So(m["projections"], ShouldHaveLength, 2)
+				proj, err := m.Get(data.MustCompilePath("projections[1]"))
+				So(err, ShouldBeNil)
+				So(proj.(data.Map)["aggregate_inputs"], ShouldHaveLength, 1)
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with ROLLUP", t, func() {
+		s := `SELECT RSTREAM b, c, count(a) AS n, grouping(b, c) AS g
+			FROM s [RANGE 2 TUPLES] GROUP BY ROLLUP(b, c)`
+
+		Convey("When explaining it", func() {
+			m, err := explainStmt(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then the grouping sets should be described", func() {
+				So(m["group_by"], ShouldHaveLength, 2)
+				So(m["grouping_sets"], ShouldResemble, data.Array{
+					data.Array{data.Int(0), data.Int(1)},
+					data.Array{data.Int(0)},
+					data.Array{},
+				})
+			})
+
+			Convey("Then grouping() should be described", func() {
+				f, err := m.Get(data.MustCompilePath("projections[3].function"))
+				So(err, ShouldBeNil)
+				So(f, ShouldEqual, data.String("grouping"))
+			})
+		})
+	})
+}
@@ -0,0 +1,108 @@
+package execution
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// ExpressionUDF is a UDF whose body is a BQL expression, such as the ones
+// defined by CREATE FUNCTION statements. Parameters are referred to as
+// columns without a stream prefix in the body, e.g., `x` in
+// `CREATE FUNCTION c_to_f(x) AS x * 9 / 5 + 32`.
+type ExpressionUDF struct {
+	params []string
+	body   parser.Expression
+	eval   Evaluator
+}
+
+var (
+	_ udf.UDF = &ExpressionUDF{}
+)
+
+// NewExpressionUDF creates a new ExpressionUDF having the given parameters
+// and body. Functions called in the body are looked up from the registry
+// when the ExpressionUDF is created, so redefining or removing them later
+// doesn't affect the ExpressionUDF.
+//
+// The body cannot contain aggregate functions, analytic functions,
+// wildcards, or stream prefixes, and it can only refer to the parameters.
+// Metadata of tuples such as ts() isn't available in the body, either.
+func NewExpressionUDF(params []string, body parser.Expression, reg udf.FunctionRegistry) (*ExpressionUDF, error) {
+	ps := make(map[string]bool, len(params))
+	for _, p := range params {
+		if ps[p] {
+			return nil, fmt.Errorf("parameter '%s' is defined more than once", p)
+		}
+		ps[p] = true
+	}
+
+	rels := body.ReferencedRelations()
+	if len(rels) > 1 || (len(rels) == 1 && !rels[""]) {
+		return nil, errors.New("stream prefixes cannot be used in the body of a function")
+	}
+	flatExpr, err := ParserExprToFlatExpr(body, reg)
+	if err != nil {
+		return nil, err
+	}
+	if flatExpr.ContainsWildcard() {
+		return nil, errors.New("wildcards cannot be used in the body of a function")
+	}
+	for _, c := range flatExpr.Columns() {
+		name := c.Column
+		if i := strings.IndexAny(name, ".["); i >= 0 {
+			name = name[:i]
+		}
+		if !ps[name] {
+			return nil, fmt.Errorf("'%s' is not a parameter of the function", c.Column)
+		}
+	}
+
+	eval, err := ExpressionToEvaluator(flatExpr, reg)
+	if err != nil {
+		return nil, err
+	}
+	return &ExpressionUDF{
+		params: params,
+		body:   body,
+		eval:   eval,
+	}, nil
+}
+
+// Call evaluates the body with the given arguments.
+func (f *ExpressionUDF) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != len(f.params) {
+		return nil, fmt.Errorf("the function takes %d arguments but %d were given",
+			len(f.params), len(args))
+	}
+	input := make(data.Map, len(args))
+	for i, p := range f.params {
+		input[p] = args[i]
+	}
+	return f.eval.Eval(input)
+}
+
+// Accept returns true when the arity is equal to the number of parameters.
+func (f *ExpressionUDF) Accept(arity int) bool {
+	return arity == len(f.params)
+}
+
+// IsAggregationParameter always returns false.
+func (f *ExpressionUDF) IsAggregationParameter(k int) bool {
+	return false
+}
+
+// Params returns names of parameters of the function.
+func (f *ExpressionUDF) Params() []string {
+	return f.params
+}
+
+// Body returns the expression evaluated by the function.
+func (f *ExpressionUDF) Body() parser.Expression {
+	return f.body
+}
@@ -0,0 +1,111 @@
+package execution
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestExpressionUDF(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	p := parser.New()
+
+	newUDF := func(stmt string) (*ExpressionUDF, error) {
+		s, _, err := p.ParseStmt(stmt)
+		So(err, ShouldBeNil)
+		cf := s.(parser.CreateFunctionStmt)
+		params := make([]string, len(cf.Params))
+		for i, p := range cf.Params {
+			params[i] = string(p)
+		}
+		return NewExpressionUDF(params, cf.Body, reg)
+	}
+
+	Convey("Given an ExpressionUDF converting Celsius to Fahrenheit", t, func() {
+		f, err := newUDF("CREATE FUNCTION c_to_f(x) AS x * 9 / 5 + 32")
+		So(err, ShouldBeNil)
+
+		Convey("Then it should only accept one argument", func() {
+			So(f.Accept(1), ShouldBeTrue)
+			So(f.Accept(0), ShouldBeFalse)
+			So(f.Accept(2), ShouldBeFalse)
+			So(f.IsAggregationParameter(0), ShouldBeFalse)
+		})
+
+		Convey("Then it should have the parameter and the body", func() {
+			So(f.Params(), ShouldResemble, []string{"x"})
+			So(f.Body().String(), ShouldEqual, "(x * 9) / 5 + 32")
+		})
+
+		Convey("When calling it with an integer", func() {
+			v, err := f.Call(nil, data.Int(100))
+
+			Convey("Then it should return the converted value", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(212))
+			})
+		})
+
+		Convey("When calling it with a float", func() {
+			v, err := f.Call(nil, data.Float(-40))
+
+			Convey("Then it should return the converted value", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Float(-40))
+			})
+		})
+
+		Convey("When calling it with a wrong number of arguments", func() {
+			_, err := f.Call(nil, data.Int(1), data.Int(2))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given an ExpressionUDF with several parameters", t, func() {
+		f, err := newUDF(`CREATE FUNCTION classify(v, t) AS
+			CASE WHEN v.value > t THEN "high" ELSE str(v.value) END`)
+		So(err, ShouldBeNil)
+
+		Convey("When calling it", func() {
+			high, err := f.Call(nil, data.Map{"value": data.Int(10)}, data.Int(5))
+			So(err, ShouldBeNil)
+			low, err := f.Call(nil, data.Map{"value": data.Int(3)}, data.Int(5))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should evaluate the body with the arguments", func() {
+				So(high, ShouldEqual, data.String("high"))
+				So(low, ShouldEqual, data.String("3"))
+			})
+		})
+	})
+
+	invalidStmts := []string{
+		"CREATE FUNCTION f(x, x) AS x",
+		"CREATE FUNCTION f(x) AS y + 1",
+		"CREATE FUNCTION f(x) AS s:x",
+		"CREATE FUNCTION f(x) AS count(x)",
+		"CREATE FUNCTION f(x) AS no_such_function(x)",
+		"CREATE FUNCTION f(x) AS f(x)",
+	}
+	for _, stmt := range invalidStmts {
+		stmt := stmt
+
+		Convey(fmt.Sprintf("Given an invalid statement '%s'", stmt), t, func() {
+			Convey("When creating an ExpressionUDF", func() {
+				_, err := newUDF(stmt)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+	}
+}
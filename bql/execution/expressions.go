@@ -133,6 +133,17 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			return nil, err
 		}
 		return binaryOpAST{obj.Op, left, right}, nil
+	case parser.BetweenAST:
+		// recurse
+		exprs := make([]FlatExpression, 3)
+		for i, e := range []parser.Expression{obj.Expr, obj.Lower, obj.Upper} {
+			expr, err := ParserExprToFlatExpr(e, reg)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i] = expr
+		}
+		return betweenAST{obj.Op, exprs[0], exprs[1], exprs[2]}, nil
 	case parser.UnaryOpAST:
 		// recurse
 		expr, err := ParserExprToFlatExpr(obj.Expr, reg)
@@ -159,9 +170,15 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		}, nil
 	case parser.FuncAppAST:
 		// exception for now()
-		if string(obj.Function) == "now" && len(obj.Expressions) == 0 && len(obj.Ordering) == 0 {
+		if string(obj.Function) == "now" && len(obj.Expressions) == 0 &&
+			len(obj.Ordering) == 0 && obj.Filter == nil {
 			return stmtMeta{parser.NowMeta}, nil
 		}
+		if string(obj.Function) == groupingFuncName {
+			err := fmt.Errorf("%s() can only be used in the SELECT list "+
+				"or HAVING clause", groupingFuncName)
+			return nil, err
+		}
 		// look up the function
 		function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
 		if err != nil {
@@ -176,6 +193,14 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			err := fmt.Errorf("you cannot use ORDER BY in non-aggregate "+
 				"function '%s'", obj.Function)
 			return nil, err
+		} else if obj.Filter != nil {
+			err := fmt.Errorf("you cannot use FILTER in non-aggregate "+
+				"function '%s'", obj.Function)
+			return nil, err
+		} else if _, ok := function.(udf.AnalyticFunction); ok {
+			err := fmt.Errorf("analytic function '%s' must be used with "+
+				"an OVER clause", obj.Function)
+			return nil, err
 		}
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
@@ -187,6 +212,10 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			exprs[i] = expr
 		}
 		return funcAppAST{obj.Function, exprs}, nil
+	case parser.AnalyticFuncAppAST:
+		err := fmt.Errorf("analytic function '%s' can only be used in "+
+			"the SELECT list", obj.Function)
+		return nil, err
 	case parser.ArrayAST:
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
@@ -247,6 +276,10 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		return caseAST{ref, c.Checks, c.Default}, nil
 	case parser.Wildcard:
 		return wildcardAST{obj.Relation}, nil
+	case parser.DistinctExpressionAST:
+		err := fmt.Errorf("DISTINCT can only be used for parameters of " +
+			"aggregate functions")
+		return nil, err
 	}
 	err := fmt.Errorf("don't know how to convert type %#v", e)
 	return nil, err
@@ -303,6 +336,24 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			returnAgg = rightAgg
 		}
 		return binaryOpAST{obj.Op, left, right}, returnAgg, nil
+	case parser.BetweenAST:
+		// recurse
+		exprs := make([]FlatExpression, 3)
+		returnAgg := map[string]FlatExpression{}
+		for i, e := range []parser.Expression{obj.Expr, obj.Lower, obj.Upper} {
+			expr, agg, err := ParserExprToMaybeAggregate(e, aggIdx+len(returnAgg), reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			for key, val := range agg {
+				returnAgg[key] = val
+			}
+			exprs[i] = expr
+		}
+		if len(returnAgg) == 0 {
+			returnAgg = nil
+		}
+		return betweenAST{obj.Op, exprs[0], exprs[1], exprs[2]}, returnAgg, nil
 	case parser.UnaryOpAST:
 		// recurse
 		expr, agg, err := ParserExprToMaybeAggregate(obj.Expr, aggIdx, reg)
@@ -329,14 +380,25 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 		}, agg, nil
 	case parser.FuncAppAST:
 		// exception for now()
-		if string(obj.Function) == "now" && len(obj.Expressions) == 0 {
+		if string(obj.Function) == "now" && len(obj.Expressions) == 0 && obj.Filter == nil {
 			return stmtMeta{parser.NowMeta}, nil, nil
 		}
+		// grouping() isn't a function in the registry because it
+		// depends on the grouping set of the group being evaluated
+		if string(obj.Function) == groupingFuncName {
+			expr, err := groupingFuncAppToFlatExpr(obj, reg)
+			return expr, nil, err
+		}
 		// look up the function
 		function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
 		if err != nil {
 			return nil, nil, err
 		}
+		if _, ok := function.(udf.AnalyticFunction); ok {
+			err := fmt.Errorf("analytic function '%s' must be used with "+
+				"an OVER clause", obj.Function)
+			return nil, nil, err
+		}
 		// replace the "*" by 1 for the count function
 		for i, ast := range obj.Expressions {
 			if _, ok := ast.(parser.Wildcard); ok {
@@ -353,7 +415,40 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			// we have a setting like
 			//  SELECT udaf(x+1, "state", c ORDER BY d + e, f DESC) ... GROUP BY c
 			// where some parameters are aggregates, others aren't.
+
+			// with a FILTER clause like `udaf(x) FILTER (WHERE y > 0)`,
+			// the input of each aggregation parameter is computed only
+			// for rows satisfying the condition
+			var filter FlatExpression
+			if obj.Filter != nil {
+				expr, err := ParserExprToFlatExpr(obj.Filter, reg)
+				if err != nil {
+					// return a prettier error message
+					if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+						err = fmt.Errorf("aggregate functions cannot be used in FILTER")
+					}
+					return nil, nil, err
+				}
+				filter = expr
+			}
 			for i, ast := range obj.Expressions {
+				// a parameter like `DISTINCT a` is aggregated like `a`,
+				// but duplicate values are removed before the call
+				distinct := false
+				if d, ok := ast.(parser.DistinctExpressionAST); ok {
+					if !function.IsAggregationParameter(i) {
+						err := fmt.Errorf("DISTINCT can only be used for parameters of " +
+							"aggregate functions")
+						return nil, nil, err
+					}
+					if len(obj.Ordering) > 0 {
+						err := fmt.Errorf("DISTINCT cannot be used together with "+
+							"ORDER BY in function '%s'", obj.Function)
+						return nil, nil, err
+					}
+					ast = d.Expr
+					distinct = true
+				}
 				// this expression must be flat, there must not be other aggregates
 				expr, err := ParserExprToFlatExpr(ast, reg)
 				if err != nil {
@@ -365,6 +460,9 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 				}
 				isAggr := function.IsAggregationParameter(i)
 				if isAggr {
+					if filter != nil {
+						expr = filteredAggInput{expr, filter}
+					}
 					// this is an aggregation parameter, we will replace
 					// it by a reference to the aggregated list of values
 					h := sha1.New()
@@ -380,7 +478,11 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 					if expr.Volatility() == Volatile {
 						exprID += fmt.Sprintf("_%d", aggIdx+len(returnAgg))
 					}
-					exprs[i] = aggInputRef{exprID}
+					if distinct {
+						exprs[i] = distinctAggInputRef{aggInputRef{exprID}}
+					} else {
+						exprs[i] = aggInputRef{exprID}
+					}
 					returnAgg[exprID] = expr
 				} else {
 					// this is a non-aggregate parameter, use as is
@@ -406,6 +508,9 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 						}
 						return nil, nil, err
 					}
+					if filter != nil {
+						expr = filteredAggInput{expr, filter}
+					}
 					// we will replace this value by a reference to the
 					// aggregated list of values
 					h := sha1.New()
@@ -442,6 +547,11 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			}
 
 		} else {
+			if obj.Filter != nil {
+				err := fmt.Errorf("you cannot use FILTER in non-aggregate "+
+					"function '%s'", obj.Function)
+				return nil, nil, err
+			}
 			for i, ast := range obj.Expressions {
 				expr, agg, err := ParserExprToMaybeAggregate(ast, aggIdx, reg)
 				if err != nil {
@@ -457,6 +567,9 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			}
 		}
 		return funcAppAST{obj.Function, exprs}, returnAgg, nil
+	case parser.AnalyticFuncAppAST:
+		expr, err := analyticFuncAppToFlatExpr(obj, reg)
+		return expr, nil, err
 	case parser.ArrayAST:
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
@@ -573,6 +686,80 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 	return nil, nil, err
 }
 
+// analyticFuncAppToFlatExpr converts the application of an analytic
+// function. The parameters and the expressions of the OVER clause must
+// not contain aggregate or analytic functions.
+func analyticFuncAppToFlatExpr(obj parser.AnalyticFuncAppAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := function.(udf.AnalyticFunction); !ok {
+		err := fmt.Errorf("function '%s' is not an analytic function "+
+			"and cannot be used with OVER", obj.Function)
+		return nil, err
+	}
+	if len(obj.Ordering) > 0 {
+		err := fmt.Errorf("you cannot use ORDER BY in analytic "+
+			"function '%s', use OVER (ORDER BY ...) instead", obj.Function)
+		return nil, err
+	}
+	if obj.Filter != nil {
+		err := fmt.Errorf("you cannot use FILTER in analytic "+
+			"function '%s'", obj.Function)
+		return nil, err
+	}
+	flatten := func(exprs []parser.Expression) ([]FlatExpression, error) {
+		flatExprs := make([]FlatExpression, len(exprs))
+		for i, ast := range exprs {
+			expr, err := ParserExprToFlatExpr(ast, reg)
+			if err != nil {
+				// return a prettier error message
+				if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+					err = fmt.Errorf("aggregates not allowed in analytic "+
+						"function '%s'", obj.Function)
+				}
+				return nil, err
+			}
+			flatExprs[i] = expr
+		}
+		return flatExprs, nil
+	}
+	exprs, err := flatten(obj.Expressions)
+	if err != nil {
+		return nil, err
+	}
+	partition, err := flatten(obj.Over.Partition)
+	if err != nil {
+		return nil, err
+	}
+	sortExprs := make([]parser.Expression, len(obj.Over.Ordering))
+	for i, sortExpr := range obj.Over.Ordering {
+		sortExprs[i] = sortExpr.Expr
+	}
+	orderExprs, err := flatten(sortExprs)
+	if err != nil {
+		return nil, err
+	}
+	ordering := make([]resultOrdering, len(orderExprs))
+	for i, expr := range orderExprs {
+		ordering[i] = resultOrdering{expr, obj.Over.Ordering[i].Ascending != parser.No}
+	}
+
+	a := analyticFuncAppAST{
+		Function:    obj.Function,
+		Expressions: exprs,
+		Partition:   partition,
+		Ordering:    ordering,
+	}
+	// the same call is computed only once per row, even if it is
+	// used in several places (e.g., for `lag(a) OVER (), lag(a) OVER ()`)
+	h := sha1.New()
+	h.Write([]byte(a.Repr()))
+	a.Ref = ":meta:analytic_" + hex.EncodeToString(h.Sum(nil))[:8]
+	return a, nil
+}
+
 // FlatExpression represents an expression that can be completely
 // evaluated on a single row and results in an unnamed value. In
 // particular, it cannot contain/represent a call to an aggregate
@@ -657,6 +844,39 @@ func (b binaryOpAST) ContainsWildcard() bool {
 	return b.Left.ContainsWildcard() || b.Right.ContainsWildcard()
 }
 
+type betweenAST struct {
+	Op    parser.Operator
+	Expr  FlatExpression
+	Lower FlatExpression
+	Upper FlatExpression
+}
+
+func (b betweenAST) Repr() string {
+	return fmt.Sprintf("(%s)%s(%s)AND(%s)", b.Expr.Repr(), b.Op, b.Lower.Repr(), b.Upper.Repr())
+}
+
+func (b betweenAST) Columns() []rowValue {
+	cols := append(b.Expr.Columns(), b.Lower.Columns()...)
+	return append(cols, b.Upper.Columns()...)
+}
+
+func (b betweenAST) Volatility() VolatilityType {
+	// take the lowest level of all sub-expressions
+	v := b.Expr.Volatility()
+	if l := b.Lower.Volatility(); l < v {
+		v = l
+	}
+	if u := b.Upper.Volatility(); u < v {
+		v = u
+	}
+	return v
+}
+
+func (b betweenAST) ContainsWildcard() bool {
+	return b.Expr.ContainsWildcard() || b.Lower.ContainsWildcard() ||
+		b.Upper.ContainsWildcard()
+}
+
 type unaryOpAST struct {
 	Op   parser.Operator
 	Expr FlatExpression
@@ -964,6 +1184,123 @@ func (w wildcardAST) ContainsWildcard() bool {
 	return true
 }
 
+// analyticFuncAppAST is the application of an analytic function. The
+// results are computed for all input rows of an evaluation at once and
+// stored in every input row using the key Ref, so the expression can
+// be evaluated on a single row afterwards.
+type analyticFuncAppAST struct {
+	Ref         string
+	Function    parser.FuncName
+	Expressions []FlatExpression
+	Partition   []FlatExpression
+	Ordering    []resultOrdering
+}
+
+func (a analyticFuncAppAST) Repr() string {
+	reprs := func(exprs []FlatExpression) string {
+		s := make([]string, len(exprs))
+		for i, expr := range exprs {
+			s[i] = expr.Repr()
+		}
+		return strings.Join(s, ",")
+	}
+	orderReprs := make([]string, len(a.Ordering))
+	for i, o := range a.Ordering {
+		orderReprs[i] = o.expr.Repr()
+		if !o.ascending {
+			orderReprs[i] += " DESC"
+		}
+	}
+	return fmt.Sprintf("%s(%s) OVER (PARTITION BY %s ORDER BY %s)", a.Function,
+		reprs(a.Expressions), reprs(a.Partition), strings.Join(orderReprs, ","))
+}
+
+func (a analyticFuncAppAST) Columns() []rowValue {
+	var allColumns []rowValue
+	for _, e := range a.Expressions {
+		allColumns = append(allColumns, e.Columns()...)
+	}
+	for _, e := range a.Partition {
+		allColumns = append(allColumns, e.Columns()...)
+	}
+	for _, o := range a.Ordering {
+		allColumns = append(allColumns, o.expr.Columns()...)
+	}
+	return allColumns
+}
+
+func (a analyticFuncAppAST) Volatility() VolatilityType {
+	// the result depends on the other rows
+	return Volatile
+}
+
+func (a analyticFuncAppAST) ContainsWildcard() bool {
+	for _, e := range a.Expressions {
+		if e.ContainsWildcard() {
+			return true
+		}
+	}
+	return false
+}
+
+// analyticFuncApps returns the applications of analytic functions
+// contained in the given expression.
+func analyticFuncApps(expr FlatExpression) []analyticFuncAppAST {
+	if obj, ok := expr.(analyticFuncAppAST); ok {
+		return []analyticFuncAppAST{obj}
+	}
+	var apps []analyticFuncAppAST
+	for _, child := range flatExprChildren(expr) {
+		apps = append(apps, analyticFuncApps(child)...)
+	}
+	return apps
+}
+
+// containsGroupingFunc returns true if grouping() is used in expr.
+func containsGroupingFunc(expr FlatExpression) bool {
+	if _, ok := expr.(groupingAST); ok {
+		return true
+	}
+	for _, child := range flatExprChildren(expr) {
+		if containsGroupingFunc(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// flatExprChildren returns the expressions directly contained in expr
+// that are evaluated in the same row context as expr itself.
+func flatExprChildren(expr FlatExpression) []FlatExpression {
+	var children []FlatExpression
+	switch obj := expr.(type) {
+	case binaryOpAST:
+		children = []FlatExpression{obj.Left, obj.Right}
+	case betweenAST:
+		children = []FlatExpression{obj.Expr, obj.Lower, obj.Upper}
+	case unaryOpAST:
+		children = []FlatExpression{obj.Expr}
+	case typeCastAST:
+		children = []FlatExpression{obj.Expr}
+	case funcAppAST:
+		children = obj.Expressions
+	case funcAppSelectorAST:
+		children = []FlatExpression{obj.Expr}
+	case arrayAST:
+		children = obj.Expressions
+	case mapAST:
+		for _, pair := range obj.Entries {
+			children = append(children, pair.Value)
+		}
+	case caseAST:
+		children = []FlatExpression{obj.Reference, obj.Default}
+		for _, pair := range obj.Checks {
+			children = append(children, pair.When, pair.Then)
+		}
+	}
+	return children
+}
+
 type aggInputRef struct {
 	Ref string
 }
@@ -989,6 +1326,102 @@ func (a aggInputRef) ContainsWildcard() bool {
 	return false
 }
 
+// groupingFuncName is the name of the function returning which of
+// the GROUP BY columns are rolled up in the current grouping set.
+const groupingFuncName = "grouping"
+
+// groupingFuncAppToFlatExpr converts an application of grouping().
+// All parameters must be columns. Whether they're in the GROUP BY
+// clause is checked when the statement is analyzed.
+func groupingFuncAppToFlatExpr(obj parser.FuncAppAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	if len(obj.Expressions) == 0 {
+		return nil, fmt.Errorf("%s() needs at least one parameter", groupingFuncName)
+	}
+	if len(obj.Ordering) > 0 || obj.Filter != nil {
+		return nil, fmt.Errorf("you cannot use ORDER BY or FILTER in %s()", groupingFuncName)
+	}
+	cols := make([]rowValue, len(obj.Expressions))
+	for i, ast := range obj.Expressions {
+		expr, err := ParserExprToFlatExpr(ast, reg)
+		if err != nil {
+			return nil, err
+		}
+		col, ok := expr.(rowValue)
+		if !ok {
+			return nil, fmt.Errorf("parameters of %s() must be columns "+
+				"in the GROUP BY clause", groupingFuncName)
+		}
+		cols[i] = col
+	}
+	return groupingAST{cols}, nil
+}
+
+// groupingAST is the application of grouping(). It evaluates to an
+// integer having a bit for each of its parameters, where the bit of
+// the first parameter is the most significant one. A bit is 1 when
+// the column is rolled up, i.e., not in the grouping set of the group.
+type groupingAST struct {
+	Cols []rowValue
+}
+
+func (g groupingAST) Repr() string {
+	reprs := make([]string, len(g.Cols))
+	for i, c := range g.Cols {
+		reprs[i] = c.Repr()
+	}
+	return fmt.Sprintf("%s(%s)", groupingFuncName, strings.Join(reprs, ","))
+}
+
+func (g groupingAST) Columns() []rowValue {
+	return g.Cols
+}
+
+func (g groupingAST) Volatility() VolatilityType {
+	return Immutable
+}
+
+func (g groupingAST) ContainsWildcard() bool {
+	return false
+}
+
+// filteredAggInput is the input of an aggregation parameter of a
+// function with a FILTER clause. Expr is only added to the aggregated
+// list of values for rows where Filter evaluates to true.
+type filteredAggInput struct {
+	Expr   FlatExpression
+	Filter FlatExpression
+}
+
+func (f filteredAggInput) Repr() string {
+	return fmt.Sprintf("%s FILTER (WHERE %s)", f.Expr.Repr(), f.Filter.Repr())
+}
+
+func (f filteredAggInput) Columns() []rowValue {
+	return append(f.Expr.Columns(), f.Filter.Columns()...)
+}
+
+func (f filteredAggInput) Volatility() VolatilityType {
+	if v := f.Filter.Volatility(); v < f.Expr.Volatility() {
+		return v
+	}
+	return f.Expr.Volatility()
+}
+
+func (f filteredAggInput) ContainsWildcard() bool {
+	return f.Expr.ContainsWildcard() || f.Filter.ContainsWildcard()
+}
+
+// distinctAggInputRef is like aggInputRef, but refers to a parameter
+// with the DISTINCT modifier, so duplicates are removed from the list
+// of values before it is passed to the aggregate function.
+type distinctAggInputRef struct {
+	aggInputRef
+}
+
+func (a distinctAggInputRef) Repr() string {
+	return "DISTINCT " + a.Ref
+}
+
 type rowValue struct {
 	Relation string
 	Column   string
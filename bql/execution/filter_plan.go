@@ -11,6 +11,9 @@ import (
 type filterPlan struct {
 	commonExecutionPlan
 	relAlias string
+	// lookups holds the LOOKUP clauses of the statement
+	lookups []*stateLookupEvaluator
+	ctx     *core.Context
 }
 
 // CanBuildFilterPlan checks whether the given statement
@@ -20,6 +23,7 @@ func CanBuildFilterPlan(lp *LogicalPlan, reg udf.FunctionRegistry) bool {
 		return false
 	}
 	return !lp.GroupingStmt &&
+		len(lp.Analytics) == 0 &&
 		lp.EmitterType == parser.Rstream &&
 		lp.Relations[0].Unit == parser.Tuples &&
 		lp.Relations[0].Value == 1
@@ -40,10 +44,15 @@ func NewFilterPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (PhysicalPlan, err
 	if err != nil {
 		return nil, err
 	}
+	lookups, err := prepareStateLookups(lp.StateLookups, reg)
+	if err != nil {
+		return nil, err
+	}
+	relAlias := lp.Relations[0].Alias
 	return &filterPlan{commonExecutionPlan{
 		projections: projs,
 		filter:      filter,
-	}, lp.Relations[0].Alias}, nil
+	}, relAlias, lookups[relAlias], reg.Context()}, nil
 }
 
 func (ep *filterPlan) Process(input *core.Tuple) ([]data.Map, error) {
@@ -57,6 +66,15 @@ func (ep *filterPlan) Process(input *core.Tuple) ([]data.Map, error) {
 	// to each item
 	d[":meta:NOW"] = data.Timestamp(time.Now().In(time.UTC))
 
+	// add the values looked up in states
+	for _, l := range ep.lookups {
+		v, err := l.lookup(ep.ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		d[l.alias] = v
+	}
+
 	// evaluate filter condition and convert to bool
 	if ep.filter != nil {
 		filterResult, err := ep.filter.Eval(d)
@@ -2,13 +2,37 @@ package execution
 
 import (
 	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"time"
 )
 
 type groupbyExecutionPlan struct {
 	streamRelationStreamExecutionPlan
+	// sessions holds the state of a SESSION window. It is nil if
+	// the input relation does not have a SESSION window.
+	sessions *sessionWindows
+	// groupingSets has the grouping sets of GROUPING SETS, ROLLUP,
+	// or CUBE. It has a single set having all GROUP BY columns if
+	// none of them is used.
+	groupingSets []*groupingSet
+	// multipleSets is true if GROUPING SETS, ROLLUP, or CUBE is used.
+	// Then every row is added to one group per grouping set.
+	multipleSets bool
+	// groupPaths has the paths of the GROUP BY columns in input rows.
+	groupPaths []data.Path
+}
+
+// groupingSet is a set of GROUP BY columns used to group rows.
+type groupingSet struct {
+	// rolledUp[i] is true if the i-th GROUP BY column isn't in the set.
+	// The column is NULL in the result rows of the set.
+	rolledUp []bool
+	// grouping is stored in the representative rows of groups to
+	// compute grouping().
+	grouping data.Map
 }
 
 // tmpGroupData is an intermediate data structure to represent
@@ -46,8 +70,54 @@ func NewGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (Physica
 	if err != nil {
 		return nil, err
 	}
+	// SESSION windows are only allowed with a single input relation
+	var sessions *sessionWindows
+	if len(lp.Relations) == 1 && lp.Relations[0].WindowType == parser.SessionWindow {
+		sessions = newSessionWindows(intervalDuration(lp.Relations[0].IntervalAST))
+	}
+
+	// GROUP BY only has columns, so their evaluators are path accesses
+	groupPaths := make([]data.Path, len(underlying.groupList))
+	for i, eval := range underlying.groupList {
+		pa, ok := eval.(*pathAccess)
+		if !ok {
+			return nil, fmt.Errorf("GROUP BY column %s cannot be rolled up",
+				lp.GroupList[i].Repr())
+		}
+		groupPaths[i] = pa.path
+	}
+	sets := lp.GroupingSets
+	if sets == nil {
+		all := make([]int, len(lp.GroupList))
+		for i := range all {
+			all[i] = i
+		}
+		sets = [][]int{all}
+	}
+	groupingSets := make([]*groupingSet, len(sets))
+	for i, set := range sets {
+		gs := &groupingSet{
+			rolledUp: make([]bool, len(lp.GroupList)),
+			grouping: make(data.Map, len(lp.GroupList)),
+		}
+		for j := range gs.rolledUp {
+			gs.rolledUp[j] = true
+		}
+		for _, idx := range set {
+			gs.rolledUp[idx] = false
+		}
+		for j, rolledUp := range gs.rolledUp {
+			gs.grouping[lp.GroupList[j].Repr()] = data.Bool(rolledUp)
+		}
+		groupingSets[i] = gs
+	}
+
 	return &groupbyExecutionPlan{
 		*underlying,
+		sessions,
+		groupingSets,
+		lp.GroupingSets != nil,
+		groupPaths,
 	}, nil
 }
 
@@ -56,9 +126,95 @@ func NewGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (Physica
 // plan. Note that the order of items in the returned slice is undefined
 // and cannot be relied on.
 func (ep *groupbyExecutionPlan) Process(input *core.Tuple) ([]data.Map, error) {
+	if ep.sessions != nil {
+		return ep.processSession(input)
+	}
 	return ep.process(input, ep.performQueryOnBuffer)
 }
 
+// processSession is the counterpart of process for SESSION windows.
+// The rows derived from the input tuple are appended to the session of
+// their group, and every session whose gap has elapsed at the time of
+// the input tuple is closed and evaluated before. The results of all
+// closed sessions are returned.
+func (ep *groupbyExecutionPlan) processSession(input *core.Tuple) ([]data.Map, error) {
+	ep.now = time.Now().In(time.UTC)
+
+	// stream-to-relation:
+	// since there is only one input relation, the buffer only needs
+	// to hold the new tuple to compute the filtered rows
+	for _, buffer := range ep.buffers {
+		buffer.tuples.Init()
+	}
+	ep.filteredInputRows.Init()
+	if err := ep.addTupleToBuffer(input); err != nil {
+		return nil, err
+	}
+	if err := ep.filterInputTuples(); err != nil {
+		return nil, err
+	}
+	newRows := make([]*inputRowWithCachedResult, 0, ep.filteredInputRows.Len())
+	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
+		io := e.Value.(*inputRowWithCachedResult)
+		if _, err := ep.groupValues(io); err != nil {
+			return nil, err
+		}
+		newRows = append(newRows, io)
+	}
+
+	// relation-to-relation and relation-to-stream:
+	// each closed session is evaluated on its own
+	var output []data.Map
+	for _, s := range ep.sessions.expire(input.Timestamp) {
+		ep.filteredInputRows.Init()
+		for _, io := range s.rows {
+			ep.filteredInputRows.PushBack(io)
+		}
+		if err := ep.performQueryOnBuffer(); err != nil {
+			return nil, err
+		}
+		res, err := ep.computeResultTuples()
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+	}
+	ep.filteredInputRows.Init()
+
+	for _, io := range newRows {
+		groupValues, _ := data.AsArray(io.cache)
+		ep.sessions.add(groupValues, io.hash, io, input.Timestamp)
+	}
+	return output, nil
+}
+
+// groupValues computes the values of the GROUP BY expressions for the
+// given row. The result is cached in the row together with its hash.
+func (ep *groupbyExecutionPlan) groupValues(io *inputRowWithCachedResult) (data.Array, error) {
+	// if we have a cached result, use this
+	if io.cache != nil {
+		cachedGroupValues, err := data.AsArray(io.cache)
+		if err != nil {
+			return nil, fmt.Errorf("cached data was not an array: %v", io.cache)
+		}
+		return cachedGroupValues, nil
+	}
+	// otherwise, compute the expressions in the GROUP BY to find
+	// the correct group to append to
+	itemGroupValues := make([]data.Value, len(ep.groupList))
+	for i, eval := range ep.groupList {
+		// ordinary "flat" expression
+		value, err := eval.Eval(*io.input)
+		if err != nil {
+			return nil, err
+		}
+		itemGroupValues[i] = value
+	}
+	io.cache = data.Array(itemGroupValues)
+	io.hash = data.Hash(io.cache)
+	return itemGroupValues, nil
+}
+
 // performQueryOnBuffer computes the projections of a SELECT query on the data
 // stored in `ep.filteredInputRows`. The query results (which is a set of
 // data.Value, not core.Tuple) is stored in ep.curResults. The data
@@ -111,7 +267,7 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	// groupValues in the `groups`map. if there is no such
 	// group, a new one is created and a copy of the given map
 	// is used as a representative of this group's values.
-	findOrCreateGroup := func(groupValues []data.Value, groupHash data.HashValue, nonGroupValues data.Map) (*tmpGroupData, error) {
+	findOrCreateGroup := func(groupValues []data.Value, groupHash data.HashValue, nonGroupValues data.Map, set *groupingSet) (*tmpGroupData, error) {
 		mkGroup := func() *tmpGroupData {
 			newGroup := &tmpGroupData{
 				// the values that make up this group
@@ -123,6 +279,16 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 				//      just the parts common to the whole group
 				nonGroupValues.Copy(),
 			}
+			// columns not in the grouping set are NULL in the results.
+			// setting a column fails only if its relation is NULL (as
+			// for the missing side of an outer join), and then the
+			// column evaluates to NULL anyway.
+			for i, rolledUp := range set.rolledUp {
+				if rolledUp {
+					newGroup.nonAggData.Set(ep.groupPaths[i], data.Null{})
+				}
+			}
+			newGroup.nonAggData[groupingKey] = set.grouping
 			// initialize the map with the aggregate function inputs
 			for _, proj := range ep.projections {
 				for key := range proj.aggrEvals {
@@ -163,44 +329,58 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	// function to compute the grouping expressions and store the
 	// input for aggregate functions in the correct group.
 	evalItem := func(io *inputRowWithCachedResult) error {
-		var itemGroupValues data.Array
-		// if we have a cached result, use this
-		if io.cache != nil {
-			cachedGroupValues, err := data.AsArray(io.cache)
-			if err != nil {
-				return fmt.Errorf("cached data was not an array: %v", io.cache)
-			}
-			itemGroupValues = cachedGroupValues
-		} else {
-			// otherwise, compute the expressions in the GROUP BY to find
-			// the correct group to append to
-			itemGroupValues = make([]data.Value, len(ep.groupList))
-			for i, eval := range ep.groupList {
-				// ordinary "flat" expression
-				value, err := eval.Eval(*io.input)
-				if err != nil {
-					return err
-				}
-				itemGroupValues[i] = value
-			}
-			io.cache = itemGroupValues
-			io.hash = data.Hash(io.cache)
-		}
-
-		itemGroup, err := findOrCreateGroup(itemGroupValues, io.hash, *io.input)
+		itemGroupValues, err := ep.groupValues(io)
 		if err != nil {
 			return err
 		}
 
+		// with multiple grouping sets, the row belongs to one group
+		// per set. the group values have the index of the set in
+		// addition to the values of the columns so that a rolled up
+		// column can be distinguished from a column being NULL.
+		itemGroups := make([]*tmpGroupData, len(ep.groupingSets))
+		for i, set := range ep.groupingSets {
+			groupValues, groupHash := itemGroupValues, io.hash
+			if ep.multipleSets {
+				groupValues = make([]data.Value, len(itemGroupValues)+1)
+				groupValues[0] = data.Int(i)
+				for j, v := range itemGroupValues {
+					if set.rolledUp[j] {
+						v = data.Null{}
+					}
+					groupValues[j+1] = v
+				}
+				groupHash = data.Hash(data.Array(groupValues))
+			}
+			itemGroup, err := findOrCreateGroup(groupValues, groupHash, *io.input, set)
+			if err != nil {
+				return err
+			}
+			itemGroups[i] = itemGroup
+		}
+
 		// now compute all the input data for the aggregate functions,
 		// e.g. for `SELECT count(a) + max(b/2)`, compute `a` and `b/2`
 		for key, agg := range allAggEvaluators {
+			// rows not satisfying the FILTER clause of the aggregate
+			// function are not part of its input
+			if f, ok := agg.(*filteredAggInputEvaluator); ok {
+				accepted, err := f.accepts(*io.input)
+				if err != nil {
+					return err
+				}
+				if !accepted {
+					continue
+				}
+			}
 			value, err := agg.Eval(*io.input)
 			if err != nil {
 				return err
 			}
 			// store this value in the output map
-			itemGroup.aggData[key] = append(itemGroup.aggData[key], value)
+			for _, itemGroup := range itemGroups {
+				itemGroup.aggData[key] = append(itemGroup.aggData[key], value)
+			}
 		}
 		return nil
 	}
@@ -255,16 +435,22 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 		return nil
 	}
 
-	evalNoGroup := func() error {
+	evalNoGroup := func(set *groupingSet) error {
 		// if we have an empty group list *and* a GROUP BY clause,
 		// we have to return an empty result (because there are no
 		// rows with "the same values"). but if the list is empty and
 		// we *don't* have a GROUP BY clause, then we need to compute
-		// all foldables and aggregates with an empty input
-		if len(ep.groupList) > 0 {
-			return nil
-		}
+		// all foldables and aggregates with an empty input. the same
+		// applies to an empty grouping set such as the last one of
+		// ROLLUP, where all GROUP BY columns are NULL.
 		input := data.Map{}
+		for i, rolledUp := range set.rolledUp {
+			if !rolledUp {
+				return nil
+			}
+			input.Set(ep.groupPaths[i], data.Null{})
+		}
+		input[groupingKey] = set.grouping
 		result := data.Map(make(map[string]data.Value, len(ep.projections)))
 		for _, proj := range ep.projections {
 			// collect input for aggregate functions
@@ -275,9 +461,10 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 			}
 			// now evaluate this projection on the flattened data.
 			// note that input has *only* the keys of the empty
-			// arrays, no other columns, but we cannot have other
-			// columns involved in the projection (since we know
-			// that GROUP BY is empty).
+			// arrays and the NULL GROUP BY columns, no other
+			// columns, but we cannot have other columns involved
+			// in the projection (since all GROUP BY columns are
+			// rolled up).
 			value, err := proj.evaluator.Eval(input)
 			if err != nil {
 				return err
@@ -312,9 +499,11 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 		}
 	}
 	if len(groups) == 0 {
-		if err := evalNoGroup(); err != nil {
-			rollback()
-			return err
+		for _, set := range ep.groupingSets {
+			if err := evalNoGroup(set); err != nil {
+				rollback()
+				return err
+			}
 		}
 	}
 
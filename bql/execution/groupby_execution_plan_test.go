@@ -906,6 +906,148 @@ func TestAggregateFunctions(t *testing.T) {
 		})
 	})
 
+	Convey("Given a SELECT clause with count and DISTINCT", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(foo) AS c, count(DISTINCT foo) AS d
+			FROM src [RANGE 3 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+
+					// the values of foo are 1, 1, 2, 2
+					expected := []data.Map{
+						{"c": data.Int(1), "d": data.Int(1)},
+						{"c": data.Int(2), "d": data.Int(1)},
+						{"c": data.Int(3), "d": data.Int(2)},
+						{"c": data.Int(3), "d": data.Int(2)},
+					}
+					So(out[0], ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with array_agg and DISTINCT", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM array_agg(DISTINCT foo * 2) AS result
+			FROM src [RANGE 3 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+
+					if idx == 1 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Array{
+							data.Int(2)}})
+					} else if idx == 3 {
+						So(out[0], ShouldResemble, data.Map{"result": data.Array{
+							data.Int(2), data.Int(4)}})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given invalid uses of DISTINCT", t, func() {
+		testCases := []struct {
+			stmt string
+			err  string
+		}{
+			{`SELECT RSTREAM abs(DISTINCT int) AS a FROM src [RANGE 3 TUPLES]`,
+				"DISTINCT can only be used for parameters of aggregate functions"},
+			{`SELECT RSTREAM DISTINCT int FROM src [RANGE 3 TUPLES] WHERE abs(DISTINCT int) > 1`,
+				"DISTINCT can only be used for parameters of aggregate functions"},
+			{`SELECT RSTREAM array_agg(DISTINCT int ORDER BY foo) AS a FROM src [RANGE 3 TUPLES]`,
+				"DISTINCT cannot be used together with ORDER BY"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When creating a plan for %s", tc.stmt), func() {
+				_, err := createGroupbyPlan("CREATE STREAM box AS "+tc.stmt, t)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldStartWith, tc.err)
+				})
+			})
+		}
+	})
+
+	Convey("Given a SELECT clause with FILTER", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c,
+			count(*) FILTER (WHERE foo = 2) AS f,
+			array_agg(bar ORDER BY int DESC) FILTER (WHERE int % 2 = 1) AS a
+			FROM src [RANGE 3 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+
+					// the values of foo are 1, 1, 2, 2
+					expected := []data.Map{
+						{"c": data.Int(1), "f": data.Int(0), "a": data.Array{data.String("a")}},
+						{"c": data.Int(2), "f": data.Int(0), "a": data.Array{data.String("a")}},
+						{"c": data.Int(3), "f": data.Int(1), "a": data.Array{data.String("c"), data.String("a")}},
+						{"c": data.Int(3), "f": data.Int(2), "a": data.Array{data.String("c")}},
+					}
+					So(out[0], ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+
+	Convey("Given invalid uses of FILTER", t, func() {
+		testCases := []struct {
+			stmt string
+			err  string
+		}{
+			{`SELECT RSTREAM abs(int) FILTER (WHERE int > 1) AS a FROM src [RANGE 3 TUPLES]`,
+				"you cannot use FILTER in non-aggregate function"},
+			{`SELECT RSTREAM DISTINCT int FROM src [RANGE 3 TUPLES] WHERE abs(int) FILTER (WHERE int > 1) > 1`,
+				"you cannot use FILTER in non-aggregate function"},
+			{`SELECT RSTREAM count(int) FILTER (WHERE count(foo) > 1) AS a FROM src [RANGE 3 TUPLES]`,
+				"aggregate functions cannot be used in FILTER"},
+			{`SELECT RSTREAM lag(int) FILTER (WHERE int > 1) OVER () AS a FROM src [RANGE 3 TUPLES]`,
+				"you cannot use FILTER in analytic function"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When creating a plan for %s", tc.stmt), func() {
+				_, err := createGroupbyPlan("CREATE STREAM box AS "+tc.stmt, t)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldStartWith, tc.err)
+				})
+			})
+		}
+	})
+
 	Convey("Given a SELECT clause with array_agg and wildcard", t, func() {
 		tuples := getExtTuples()
 
@@ -1317,3 +1459,161 @@ func BenchmarkComplicatedGroupExecution(b *testing.B) {
 		}
 	}
 }
+
+func TestGroupingSets(t *testing.T) {
+	getExtTuples := func() []*core.Tuple {
+		tuples := getOtherTuples()
+		tuples[0].Data["bar"] = data.String("a")
+		tuples[1].Data["bar"] = data.String("b")
+		tuples[2].Data["bar"] = data.String("a")
+		tuples[3].Data["bar"] = data.String("b")
+		return tuples
+	}
+	row := func(foo, bar data.Value, c, g int) data.Map {
+		return data.Map{"foo": foo, "bar": bar, "c": data.Int(c), "g": data.Int(g)}
+	}
+	null := data.Null{}
+
+	Convey("Given a SELECT clause with ROLLUP", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+			grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES] GROUP BY ROLLUP(foo, bar)`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the results of all grouping sets should appear", func() {
+				So(len(out), ShouldEqual, 7)
+				for _, r := range []data.Map{
+					row(data.Int(1), data.String("a"), 1, 0),
+					row(data.Int(1), data.String("b"), 1, 0),
+					row(data.Int(2), data.String("a"), 1, 0),
+					row(data.Int(2), data.String("b"), 1, 0),
+					row(data.Int(1), null, 2, 1),
+					row(data.Int(2), null, 2, 1),
+					row(null, null, 4, 3),
+				} {
+					So(out, ShouldContain, r)
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with CUBE and HAVING", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+			grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES] GROUP BY CUBE(foo, bar)
+			HAVING grouping(foo) = 1`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the results of the sets without foo should appear", func() {
+				So(len(out), ShouldEqual, 3)
+				for _, r := range []data.Map{
+					row(null, data.String("a"), 2, 2),
+					row(null, data.String("b"), 2, 2),
+					row(null, null, 4, 3),
+				} {
+					So(out, ShouldContain, r)
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with GROUPING SETS", t, func() {
+		tuples := getExtTuples()
+		tuples[1].Data["bar"] = data.Null{}
+
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+			grouping(foo, bar) AS g FROM src [RANGE 2 TUPLES] GROUP BY GROUPING SETS ((foo), (bar))`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples[:2] {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then a NULL column should be distinguished from a rolled up one", func() {
+				So(len(out), ShouldEqual, 3)
+				for _, r := range []data.Map{
+					row(data.Int(1), null, 2, 1),
+					row(null, data.String("a"), 1, 2),
+					row(null, null, 1, 2),
+				} {
+					So(out, ShouldContain, r)
+				}
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with ROLLUP and a filter", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+			grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES] WHERE int > 10
+			GROUP BY ROLLUP(foo, bar)`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When no row satisfies the filter", func() {
+			out, err := plan.Process(tuples[0])
+			So(err, ShouldBeNil)
+
+			Convey("Then only the grand total should appear", func() {
+				So(out, ShouldResemble, []data.Map{row(null, null, 0, 3)})
+			})
+		})
+	})
+
+	Convey("Given invalid uses of grouping sets", t, func() {
+		testCases := []struct {
+			stmt string
+			err  string
+		}{
+			{`SELECT RSTREAM grouping(foo) AS g FROM src [RANGE 3 TUPLES]`,
+				`column "src:foo" must appear in the GROUP BY clause`},
+			{`SELECT RSTREAM grouping(foo) AS g FROM src [RANGE 3 TUPLES] GROUP BY ROLLUP(bar)`,
+				`column "src:foo" must appear in the GROUP BY clause`},
+			{`SELECT RSTREAM count(*) AS c FROM src [RANGE 3 TUPLES] WHERE grouping(foo) = 0 GROUP BY ROLLUP(foo)`,
+				"grouping() can only be used in the SELECT list or HAVING clause"},
+			{`SELECT RSTREAM grouping(foo + 1) AS g FROM src [RANGE 3 TUPLES] GROUP BY ROLLUP(foo)`,
+				"parameters of grouping() must be columns"},
+			{`SELECT RSTREAM grouping() AS g FROM src [RANGE 3 TUPLES] GROUP BY ROLLUP(foo)`,
+				"grouping() needs at least one parameter"},
+			{`SELECT RSTREAM foo, count(*) AS c FROM src [RANGE 3 TUPLES] GROUP BY ROLLUP(foo + 1)`,
+				"grouping by expressions is not supported yet"},
+			{`SELECT RSTREAM foo, count(*) AS c FROM src [SESSION GAP 5 SECONDS] GROUP BY ROLLUP(foo)`,
+				"SESSION windows cannot be used together with GROUPING SETS"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When creating a plan for %s", tc.stmt), func() {
+				_, err := createGroupbyPlan("CREATE STREAM box AS "+tc.stmt, t)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldStartWith, tc.err)
+				})
+			})
+		}
+	})
+}
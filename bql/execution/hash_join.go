@@ -0,0 +1,335 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// hashJoin holds the state of an explicit JOIN between two relations.
+// Instead of computing the cartesian product of both window buffers,
+// every tuple is put into a hash table using the values of its side of
+// the equalities in the ON condition, so that a new tuple only needs to
+// be compared with those tuples of the other relation that have the
+// same key. If the ON condition has no such equalities, all tuples get
+// the same (empty) key.
+//
+// For a LEFT OUTER JOIN, a left tuple that does not match any right
+// tuple is joined with NULL for the right relation. That row is removed
+// when a matching right tuple arrives and added again when all matching
+// right tuples have left the window.
+type hashJoin struct {
+	joinType parser.JoinType
+	left     string
+	right    string
+	// keys holds the evaluators of the hash keys, keyed by the alias
+	// of the relation they are evaluated on
+	keys map[string][]Evaluator
+	// residual evaluates the part of the ON condition that is not
+	// used as a hash key, or is nil if there is no such part.
+	residual Evaluator
+	// tables holds the entries of the tuples in each buffer, keyed
+	// by the alias and by the hash of their key
+	tables map[string]map[data.HashValue][]*joinEntry
+	// unmatched holds left entries that lost their last matching
+	// right tuple and need to be joined with NULL again
+	unmatched []*joinEntry
+	// nullAliases holds the aliases of values looked up for the
+	// right relation, which are NULL when the right relation is
+	nullAliases []string
+}
+
+// joinEntry holds the state of a single tuple in a window buffer.
+type joinEntry struct {
+	tuple *tupleWithDerivedInputRows
+	alias string
+	key   data.Array
+	hash  data.HashValue
+	// valid is false if the key contains a NULL value, because
+	// such a tuple cannot match any other tuple
+	valid bool
+	// matches holds the number of right tuples that match this
+	// (left) tuple
+	matches int
+	// matched holds the left entries that this (right) entry
+	// was matched with
+	matched []*joinEntry
+	// padded holds the row of this (left) tuple joined with NULL
+	// while it is part of the filtered input rows
+	padded *inputRowWithCachedResult
+	// removed is true when the tuple has left the window
+	removed bool
+}
+
+func newHashJoin(jc *joinCondition, left, right string, reg udf.FunctionRegistry) (*hashJoin, error) {
+	prepareKeys := func(exprs []FlatExpression) ([]Evaluator, error) {
+		evals := make([]Evaluator, len(exprs))
+		for i, expr := range exprs {
+			eval, err := ExpressionToEvaluator(expr, reg)
+			if err != nil {
+				return nil, err
+			}
+			evals[i] = eval
+		}
+		return evals, nil
+	}
+	leftKeys, err := prepareKeys(jc.leftKeys)
+	if err != nil {
+		return nil, err
+	}
+	rightKeys, err := prepareKeys(jc.rightKeys)
+	if err != nil {
+		return nil, err
+	}
+	residual, err := prepareFilter(jc.residual, reg)
+	if err != nil {
+		return nil, err
+	}
+	return &hashJoin{
+		joinType: jc.joinType,
+		left:     left,
+		right:    right,
+		keys: map[string][]Evaluator{
+			left:  leftKeys,
+			right: rightKeys,
+		},
+		residual: residual,
+		tables: map[string]map[data.HashValue][]*joinEntry{
+			left:  {},
+			right: {},
+		},
+	}, nil
+}
+
+// insert computes the key of a tuple that was appended to the buffer of
+// the given relation and adds it to the respective hash table.
+func (j *hashJoin) insert(alias string, t *tupleWithDerivedInputRows, now data.Value) (*joinEntry, error) {
+	row := data.Map{
+		":meta:NOW": now,
+	}
+	for key, val := range t.tuple.Data {
+		row[key] = val
+	}
+	setMetadata(row, alias, t.tuple)
+
+	keys := j.keys[alias]
+	key := make(data.Array, len(keys))
+	valid := true
+	for i, eval := range keys {
+		v, err := eval.Eval(row)
+		if err != nil {
+			return nil, err
+		}
+		if v.Type() == data.TypeNull {
+			valid = false
+		}
+		key[i] = v
+	}
+
+	e := &joinEntry{
+		tuple: t,
+		alias: alias,
+		key:   key,
+		hash:  data.Hash(key),
+		valid: valid,
+	}
+	if valid {
+		j.tables[alias][e.hash] = append(j.tables[alias][e.hash], e)
+	}
+	t.join = e
+	return e, nil
+}
+
+// remove deletes the entry of a tuple that has left the window. If the
+// tuple belongs to the right relation, the left tuples that it matched
+// are updated, and those that have no match left are remembered so
+// that they can be joined with NULL again.
+func (j *hashJoin) remove(t *tupleWithDerivedInputRows) {
+	e := t.join
+	if e == nil {
+		return
+	}
+	e.removed = true
+	if e.valid {
+		table := j.tables[e.alias]
+		candidates := table[e.hash]
+		for i, c := range candidates {
+			if c == e {
+				candidates = append(candidates[:i], candidates[i+1:]...)
+				break
+			}
+		}
+		if len(candidates) == 0 {
+			delete(table, e.hash)
+		} else {
+			table[e.hash] = candidates
+		}
+	}
+	if e.alias == j.right {
+		for _, l := range e.matched {
+			if l.removed {
+				continue
+			}
+			l.matches--
+			if l.matches == 0 && j.joinType == parser.LeftOuterJoin {
+				j.unmatched = append(j.unmatched, l)
+			}
+		}
+		e.matched = nil
+	}
+}
+
+// joinedRow combines the data of a left and a right tuple into a single
+// input row. If r is nil, the right relation is NULL.
+func (j *hashJoin) joinedRow(l, r *joinEntry, now data.Value) data.Map {
+	// the data of a tuple also holds the values looked up for it
+	row := data.Map{
+		":meta:NOW": now,
+	}
+	for key, val := range l.tuple.tuple.Data {
+		row[key] = val
+	}
+	setMetadata(row, j.left, l.tuple.tuple)
+	if r != nil {
+		for key, val := range r.tuple.tuple.Data {
+			row[key] = val
+		}
+		setMetadata(row, j.right, r.tuple.tuple)
+	} else {
+		row[j.right] = data.Null{}
+		row[fmt.Sprintf("%s:meta:%s", j.right, parser.TimestampMeta)] = data.Null{}
+		for _, alias := range j.nullAliases {
+			row[alias] = data.Null{}
+		}
+	}
+	return row
+}
+
+// filterJoinedInputTuples is the counterpart of filterInputTuples for
+// an explicit JOIN. The tuple that was appended last is looked up in
+// the hash table of the other relation, and every pair that fulfills
+// the ON condition and the filter condition is added to the filtered
+// input rows.
+func (ep *streamRelationStreamExecutionPlan) filterJoinedInputTuples() error {
+	j := ep.join
+	now := data.Timestamp(ep.now)
+
+	// new rows are collected in a separate buffer first and appended
+	// to the filtered input rows at the end
+	ep.filteredInputRowsBuffer = list.New()
+	// obsolete holds rows joined with NULL whose left tuple has found
+	// a matching right tuple
+	obsolete := map[*inputRowWithCachedResult]bool{}
+
+	// on a self-join, the tuple was appended to both buffers. by
+	// inserting it on the left side first and looking it up on the
+	// right side before inserting it there, the pair of the tuple
+	// with itself is found exactly once.
+	var newLeft *joinEntry
+	for _, alias := range []string{j.left, j.right} {
+		if !ep.lastTupleBuffers[alias] {
+			continue
+		}
+		t := ep.buffers[alias].tuples.Back().Value.(*tupleWithDerivedInputRows)
+		e, err := j.insert(alias, t, now)
+		if err != nil {
+			return err
+		}
+		other := j.right
+		if alias == j.right {
+			other = j.left
+		} else {
+			newLeft = e
+		}
+		if !e.valid {
+			continue
+		}
+
+		for _, c := range j.tables[other][e.hash] {
+			if c == e || !data.Equal(c.key, e.key) {
+				continue
+			}
+			l, r := e, c
+			if alias == j.right {
+				l, r = c, e
+			}
+			row := j.joinedRow(l, r, now)
+			ok, err := evalCondition(j.residual, row)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			l.matches++
+			r.matched = append(r.matched, l)
+			if l.padded != nil {
+				obsolete[l.padded] = true
+				l.padded = nil
+			}
+			if _, err := ep.addJoinedRow(row, l, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	if j.joinType == parser.LeftOuterJoin {
+		pad := j.unmatched
+		j.unmatched = nil
+		if newLeft != nil {
+			pad = append(pad, newLeft)
+		}
+		for _, l := range pad {
+			if l.removed || l.matches > 0 || l.padded != nil {
+				continue
+			}
+			padded, err := ep.addJoinedRow(j.joinedRow(l, nil, now), l, nil)
+			if err != nil {
+				return err
+			}
+			l.padded = padded
+		}
+	}
+
+	if len(obsolete) > 0 {
+		var next *list.Element
+		for e := ep.filteredInputRows.Front(); e != nil; e = next {
+			next = e.Next()
+			if obsolete[e.Value.(*inputRowWithCachedResult)] {
+				ep.filteredInputRows.Remove(e)
+			}
+		}
+	}
+	// (NB. the items appended here will be cleaned up in future
+	// runs by `removeOutdatedTuplesFromBuffer`)
+	ep.filteredInputRows.PushBackList(ep.filteredInputRowsBuffer)
+	return nil
+}
+
+// addJoinedRow evaluates the filter condition on a joined row and, if
+// it is fulfilled, appends the row to the filtered input rows and
+// returns it. Otherwise nil is returned. If r is nil, the row only
+// originates from the left tuple.
+func (ep *streamRelationStreamExecutionPlan) addJoinedRow(row data.Map, l, r *joinEntry) (*inputRowWithCachedResult, error) {
+	ok, err := evalCondition(ep.filter, row)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	item := &inputRowWithCachedResult{
+		input: &row,
+	}
+	// write the address of this item to all tuples it originates
+	// from so that it is removed when one of them leaves the window
+	l.tuple.rows = append(l.tuple.rows, item)
+	if r != nil {
+		r.tuple.rows = append(r.tuple.rows, item)
+	}
+	ep.filteredInputRowsBuffer.PushBack(item)
+	return item, nil
+}
@@ -0,0 +1,211 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"testing"
+)
+
+// getJoinTuples returns tuples that alternate between the given input
+// names. The join key "k" cycles through three values and is NULL for
+// every fifth tuple.
+func getJoinTuples(num int, inputs ...string) []*core.Tuple {
+	tuples := getTuples(num)
+	for i, t := range tuples {
+		t.InputName = inputs[i%len(inputs)]
+		t.Data["v"] = data.Int(i)
+		if i%5 == 4 {
+			t.Data["k"] = data.Null{}
+		} else {
+			t.Data["k"] = data.String(fmt.Sprintf("k%d", i%3))
+		}
+	}
+	return tuples
+}
+
+func TestHashJoin(t *testing.T) {
+	testCases := []struct {
+		title  string
+		join   string
+		cross  string
+		inputs []string
+	}{
+		{"an equality on TUPLES windows",
+			`SELECT RSTREAM l:v AS lv, r:v AS rv FROM src1 [RANGE 4 TUPLES] AS l
+				INNER JOIN src2 [RANGE 3 TUPLES] AS r ON l:k = r:k`,
+			`SELECT RSTREAM l:v AS lv, r:v AS rv FROM src1 [RANGE 4 TUPLES] AS l,
+				src2 [RANGE 3 TUPLES] AS r WHERE l:k = r:k`,
+			[]string{"src1", "src2"}},
+		{"an equality and a residual condition on time-based windows",
+			`SELECT ISTREAM l:v AS lv, r:v AS rv FROM src1 [RANGE 3 SECONDS] AS l
+				JOIN src2 [RANGE 5 SECONDS] AS r ON r:k = l:k AND l:v < r:v + 2 WHERE l:v % 2 = 0`,
+			`SELECT ISTREAM l:v AS lv, r:v AS rv FROM src1 [RANGE 3 SECONDS] AS l,
+				src2 [RANGE 5 SECONDS] AS r WHERE r:k = l:k AND l:v < r:v + 2 AND l:v % 2 = 0`,
+			[]string{"src1", "src2", "src2"}},
+		{"no equality",
+			`SELECT DSTREAM l:v AS lv, r:v AS rv FROM src1 [RANGE 3 TUPLES] AS l
+				INNER JOIN src2 [RANGE 2 TUPLES] AS r ON l:v < r:v`,
+			`SELECT DSTREAM l:v AS lv, r:v AS rv FROM src1 [RANGE 3 TUPLES] AS l,
+				src2 [RANGE 2 TUPLES] AS r WHERE l:v < r:v`,
+			[]string{"src1", "src2"}},
+		{"a self-join",
+			`SELECT RSTREAM l:v AS lv, r:v AS rv FROM src1 [RANGE 4 TUPLES] AS l
+				INNER JOIN src1 [RANGE 2 TUPLES] AS r ON l:k = r:k`,
+			`SELECT RSTREAM l:v AS lv, r:v AS rv FROM src1 [RANGE 4 TUPLES] AS l,
+				src1 [RANGE 2 TUPLES] AS r WHERE l:k = r:k`,
+			[]string{"src1"}},
+		{"an aggregation",
+			`SELECT RSTREAM l:k, count(*) AS c, sum(r:v) AS s FROM src1 [RANGE 4 TUPLES] AS l
+				INNER JOIN src2 [RANGE 4 TUPLES] AS r ON l:k = r:k GROUP BY l:k`,
+			`SELECT RSTREAM l:k, count(*) AS c, sum(r:v) AS s FROM src1 [RANGE 4 TUPLES] AS l,
+				src2 [RANGE 4 TUPLES] AS r WHERE l:k = r:k GROUP BY l:k`,
+			[]string{"src1", "src2"}},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		Convey(fmt.Sprintf("Given a JOIN with %s and the equivalent cartesian product", testCase.title), t, func() {
+			joinPlan, err := createPhysicalPlan("CREATE STREAM box AS " + testCase.join)
+			So(err, ShouldBeNil)
+			crossPlan, err := createPhysicalPlan("CREATE STREAM box AS " + testCase.cross)
+			So(err, ShouldBeNil)
+
+			Convey("When feeding them with tuples", func() {
+				for idx, inTup := range getJoinTuples(20, testCase.inputs...) {
+					joinOut, err := joinPlan.Process(inTup)
+					So(err, ShouldBeNil)
+					crossOut, err := crossPlan.Process(inTup)
+					So(err, ShouldBeNil)
+					sort.Sort(tupleList(joinOut))
+					sort.Sort(tupleList(crossOut))
+
+					Convey(fmt.Sprintf("Then both should emit the same rows for tuple %d", idx), func() {
+						So(joinOut, ShouldResemble, crossOut)
+					})
+				}
+			})
+		})
+	}
+
+	Convey("Given a LEFT OUTER JOIN", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM l:v AS lv, r:v AS rv
+			FROM src1 [RANGE 2 TUPLES] AS l LEFT OUTER JOIN src2 [RANGE 1 TUPLES] AS r
+			ON l:k = r:k`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		inputs := []struct {
+			input string
+			k     string
+			v     int64
+		}{
+			{"src1", "a", 1},
+			{"src2", "a", 2},
+			{"src1", "b", 3},
+			{"src2", "b", 4}, // replaces 2, so 1 is unmatched again
+			{"src1", "c", 5}, // replaces 1
+		}
+		outs := [][]data.Map{}
+		for i, in := range inputs {
+			tup := getEventTimeTuple(i, in.v)
+			tup.InputName = in.input
+			tup.Data["k"] = data.String(in.k)
+			tup.Data["v"] = data.Int(in.v)
+			out, err := plan.Process(tup)
+			So(err, ShouldBeNil)
+			sort.Sort(tupleList(out))
+			outs = append(outs, out)
+		}
+
+		Convey("Then unmatched left tuples should be joined with NULL", func() {
+			null := data.Null{}
+			So(outs[0], ShouldResemble, []data.Map{
+				{"lv": data.Int(1), "rv": null},
+			})
+			So(outs[1], ShouldResemble, []data.Map{
+				{"lv": data.Int(1), "rv": data.Int(2)},
+			})
+			So(outs[2], ShouldResemble, []data.Map{
+				{"lv": data.Int(1), "rv": data.Int(2)},
+				{"lv": data.Int(3), "rv": null},
+			})
+			So(outs[3], ShouldResemble, []data.Map{
+				{"lv": data.Int(1), "rv": null},
+				{"lv": data.Int(3), "rv": data.Int(4)},
+			})
+			So(outs[4], ShouldResemble, []data.Map{
+				{"lv": data.Int(3), "rv": data.Int(4)},
+				{"lv": data.Int(5), "rv": null},
+			})
+		})
+	})
+
+	Convey("Given a LEFT OUTER JOIN with a filter on the right relation", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM l:* FROM src1 [RANGE 2 TUPLES] AS l
+			LEFT JOIN src2 [RANGE 1 TUPLES] AS r ON l:k = r:k WHERE r:v IS NULL`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, tup := range getJoinTuples(6, "src1", "src2") {
+				out, err := plan.Process(tup)
+				So(err, ShouldBeNil)
+				// remove fields that are not important for this test
+				for _, o := range out {
+					delete(o, "int")
+				}
+				sort.Sort(tupleList(out))
+				outs = append(outs, out)
+			}
+
+			Convey("Then only left tuples without a match should be emitted", func() {
+				// the keys are k0, k1, k2, k0, NULL, k2
+				l0 := data.Map{"k": data.String("k0"), "v": data.Int(0)}
+				l2 := data.Map{"k": data.String("k2"), "v": data.Int(2)}
+				l4 := data.Map{"k": data.Null{}, "v": data.Int(4)}
+				expected := [][]data.Map{
+					{l0},
+					{l0},
+					{l0, l2},
+					{l2},
+					{l2, l4},
+					{l4},
+				}
+				for i, exp := range expected {
+					sort.Sort(tupleList(exp))
+					So(outs[i], ShouldResemble, exp)
+				}
+			})
+		})
+	})
+
+	Convey("Given invalid JOIN statements", t, func() {
+		testCases := []struct {
+			stmt string
+			err  string
+		}{
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:x = b:x
+				JOIN c [RANGE 1 TUPLES] ON a:x = c:x`, "only a single JOIN is supported"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:x = c:x`,
+				"cannot reference relation 'c'"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:x = count(b:x)`,
+				"aggregates not allowed in ON clause"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When creating a plan for %s", tc.stmt), func() {
+				_, err := createPhysicalPlan("CREATE STREAM box AS " + tc.stmt)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldStartWith, tc.err)
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package execution
+
+import (
+	"fmt"
+	"sort"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// resultOrdering holds an expression of an ORDER BY clause and its
+// direction. In the ORDER BY clause of a statement, it refers to the
+// output columns rather than to the input relations, as in
+// `SELECT RSTREAM a, count(*) AS c ... ORDER BY c`.
+type resultOrdering struct {
+	expr      FlatExpression
+	ascending bool
+}
+
+// resultOrderer applies the ORDER BY and LIMIT clauses of a statement
+// to the result rows of an evaluation. Unlike the LIMIT emitter option,
+// which caps the total number of emitted tuples, the limit applies to
+// each evaluation separately, so a statement like
+//
+//	SELECT RSTREAM name, max(score) AS score FROM s [RANGE 1 MINUTES]
+//	    GROUP BY name ORDER BY score DESC LIMIT 5
+//
+// emits the five best entries of the current window every time.
+//
+// The rows are sorted and cut before they are compared with the results
+// of the previous evaluation, so ISTREAM emits rows entering the top N
+// and DSTREAM emits rows leaving it.
+type resultOrderer struct {
+	ordering []sortEvaluator
+	// limit is -1 if there is no LIMIT clause
+	limit int64
+}
+
+// newResultOrderer returns nil if the statement has neither an ORDER BY
+// nor a LIMIT clause.
+func newResultOrderer(lp *LogicalPlan, reg udf.FunctionRegistry) (*resultOrderer, error) {
+	if !lp.isOrdered() {
+		return nil, nil
+	}
+	ordering := make([]sortEvaluator, len(lp.Ordering))
+	for i, o := range lp.Ordering {
+		eval, err := ExpressionToEvaluator(o.expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		ordering[i] = sortEvaluator{eval, o.ascending}
+	}
+	return &resultOrderer{
+		ordering: ordering,
+		limit:    lp.Limit,
+	}, nil
+}
+
+// order returns the indexes of the n rows returned by row in the order
+// given by the ORDER BY clause, cut after the limit. Rows that are equal
+// with regard to all expressions keep the order in which they were
+// computed.
+func (o *resultOrderer) order(n int, row func(i int) data.Map) ([]int, error) {
+	indexes := make([]int, n)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	if len(o.ordering) > 0 && n > 1 {
+		sortData := make([]sortArray, len(o.ordering))
+		for i, so := range o.ordering {
+			values := make(data.Array, n)
+			for j := range values {
+				v, err := so.eval.Eval(row(j))
+				if err != nil {
+					return nil, fmt.Errorf("could not get data for sorting: %s", err.Error())
+				}
+				values[j] = v
+			}
+			sortData[i] = sortArray{values, so.ascending}
+		}
+		sort.Stable(&indexSlice{indexes, sortData})
+	}
+	if o.limit >= 0 && int64(len(indexes)) > o.limit {
+		indexes = indexes[:o.limit]
+	}
+	return indexes, nil
+}
+
+// orderResults sorts and cuts the given results. It returns a new slice.
+func (o *resultOrderer) orderResults(results []resultRow) ([]resultRow, error) {
+	indexes, err := o.order(len(results), func(i int) data.Map {
+		return results[i].row
+	})
+	if err != nil {
+		return nil, err
+	}
+	ordered := make([]resultRow, len(indexes))
+	for i, idx := range indexes {
+		ordered[i] = results[idx]
+	}
+	return ordered, nil
+}
+
+// orderedPlan wraps a plan that doesn't keep the results of previous
+// evaluations, i.e., the filterPlan, to apply the ORDER BY and LIMIT
+// clauses to the rows it returns. Plans computing ISTREAM or DSTREAM
+// results apply them by themselves before comparing results.
+type orderedPlan struct {
+	plan PhysicalPlan
+	*resultOrderer
+}
+
+func newOrderedPlan(plan PhysicalPlan, lp *LogicalPlan, reg udf.FunctionRegistry) (PhysicalPlan, error) {
+	o, err := newResultOrderer(lp, reg)
+	if err != nil {
+		return nil, err
+	}
+	return &orderedPlan{
+		plan:          plan,
+		resultOrderer: o,
+	}, nil
+}
+
+func (p *orderedPlan) Process(input *core.Tuple) ([]data.Map, error) {
+	rows, err := p.plan.Process(input)
+	if err != nil || len(rows) == 0 {
+		return rows, err
+	}
+	indexes, err := p.order(len(rows), func(i int) data.Map {
+		return rows[i]
+	})
+	if err != nil {
+		return nil, err
+	}
+	ordered := make([]data.Map, len(indexes))
+	for i, idx := range indexes {
+		ordered[i] = rows[idx]
+	}
+	return ordered, nil
+}
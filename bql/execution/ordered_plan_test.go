@@ -0,0 +1,219 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestOrderedPlan(t *testing.T) {
+	Convey("Given a SELECT statement computing a top-N leaderboard", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM name, sum(score) AS score
+			FROM src [RANGE 5 TUPLES] GROUP BY name ORDER BY score DESC, name LIMIT 2`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			inputs := []struct {
+				name  string
+				score int64
+			}{
+				{"a", 1},
+				{"b", 3},
+				{"c", 2},
+				{"a", 4},
+				{"c", 3},
+				{"b", 1}, // the first "a" leaves the window
+			}
+			outs := [][]data.Map{}
+			for i, in := range inputs {
+				tup := getEventTimeTuple(i, in.score)
+				tup.Data["name"] = data.String(in.name)
+				tup.Data["score"] = data.Int(in.score)
+				out, err := plan.Process(tup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the best entries of every evaluation should be emitted in order", func() {
+				row := func(name string, score int64) data.Map {
+					return data.Map{"name": data.String(name), "score": data.Int(score)}
+				}
+				So(outs, ShouldResemble, [][]data.Map{
+					{row("a", 1)},
+					{row("b", 3), row("a", 1)},
+					{row("b", 3), row("c", 2)},
+					{row("a", 5), row("b", 3)},
+					{row("a", 5), row("c", 5)},
+					{row("c", 5), row("a", 4)},
+				})
+			})
+		})
+	})
+
+	Convey("Given top-N statements emitting changes of the ranking", t, func() {
+		row := func(name string, score int64) data.Map {
+			return data.Map{"name": data.String(name), "score": data.Int(score)}
+		}
+		testCases := []struct {
+			emitter  string
+			expected [][]data.Map
+		}{
+			// the rankings are a1 / b3,a1 / b3,c2 / a5,b3 / a5,c5 / c5,a4
+			{"ISTREAM", [][]data.Map{
+				{row("a", 1)}, {row("b", 3)}, {row("c", 2)},
+				{row("a", 5)}, {row("c", 5)}, {row("a", 4)},
+			}},
+			{"DSTREAM", [][]data.Map{
+				nil, nil, {row("a", 1)},
+				{row("c", 2)}, {row("b", 3)}, {row("a", 5)},
+			}},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When feeding a %v statement with tuples", tc.emitter), func() {
+				s := `CREATE STREAM box AS SELECT ` + tc.emitter + ` name, sum(score) AS score
+					FROM src [RANGE 5 TUPLES] GROUP BY name ORDER BY score DESC, name LIMIT 2`
+				plan, err := createPhysicalPlan(s)
+				So(err, ShouldBeNil)
+
+				inputs := []struct {
+					name  string
+					score int64
+				}{
+					{"a", 1},
+					{"b", 3},
+					{"c", 2},
+					{"a", 4},
+					{"c", 3},
+					{"b", 1}, // the first "a" leaves the window
+				}
+				outs := [][]data.Map{}
+				for i, in := range inputs {
+					tup := getEventTimeTuple(i, in.score)
+					tup.Data["name"] = data.String(in.name)
+					tup.Data["score"] = data.Int(in.score)
+					out, err := plan.Process(tup)
+					So(err, ShouldBeNil)
+					outs = append(outs, out)
+				}
+
+				Convey("Then rows should be compared with the previous top entries", func() {
+					So(outs, ShouldResemble, tc.expected)
+				})
+			})
+		}
+	})
+
+	Convey("Given an ISTREAM statement with LIMIT where a row moves into the top", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [RANGE 3 TUPLES]
+			ORDER BY int DESC LIMIT 1`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for i, v := range []int64{5, 3, 1, 1} {
+				tup := getEventTimeTuple(i, v)
+				out, err := plan.Process(tup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the row should be emitted when it enters the top", func() {
+				So(outs, ShouldResemble, [][]data.Map{
+					{{"int": data.Int(5)}}, nil, nil, {{"int": data.Int(3)}},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with ORDER BY on a computed column", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM int, int % 3 AS m
+			FROM src [RANGE 4 TUPLES] ORDER BY m, int DESC`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, tup := range getTuples(5) {
+				out, err = plan.Process(tup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then all rows in the window should be emitted in order", func() {
+				row := func(i, m int64) data.Map {
+					return data.Map{"int": data.Int(i), "m": data.Int(m)}
+				}
+				So(out, ShouldResemble, []data.Map{
+					row(3, 0), row(4, 1), row(5, 2), row(2, 2),
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with LIMIT only", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [RANGE 3 TUPLES] LIMIT 0`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for _, tup := range getTuples(4) {
+				out, err := plan.Process(tup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then no rows should be emitted for tuple %v", tup.Data["int"]), func() {
+					So(out, ShouldBeEmpty)
+				})
+			}
+		})
+	})
+
+	Convey("Given a filter statement with ORDER BY and LIMIT", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 1 TUPLES]
+			WHERE int % 2 = 0 ORDER BY int LIMIT 1`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, tup := range getTuples(3) {
+				out, err := plan.Process(tup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the filter should be applied as usual", func() {
+				So(len(outs[0]), ShouldEqual, 0)
+				So(outs[1], ShouldResemble, []data.Map{{"int": data.Int(2)}})
+				So(len(outs[2]), ShouldEqual, 0)
+			})
+		})
+	})
+
+	Convey("Given invalid ORDER BY clauses", t, func() {
+		testCases := []struct {
+			stmt string
+			err  string
+		}{
+			{`SELECT RSTREAM s:int FROM src [RANGE 2 TUPLES] AS s ORDER BY s:int`,
+				"ORDER BY can only refer to output columns"},
+			{`SELECT RSTREAM int, count(*) AS c FROM src [RANGE 2 TUPLES] GROUP BY int ORDER BY count(*)`,
+				"aggregates not allowed in ORDER BY clause"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When creating a plan for %s", tc.stmt), func() {
+				_, err := createPhysicalPlan("CREATE STREAM box AS " + tc.stmt)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldStartWith, tc.err)
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package execution
+
+import (
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// sessionWindows holds the state of a SESSION window. There is at most
+// one open session per group (as defined by the GROUP BY clause), which
+// collects the input rows of that group. A session is closed when the
+// largest timestamp seen so far is more than `gap` after the timestamp
+// of the session's last row. Since there is no timer involved, sessions
+// can only be closed when a new tuple arrives.
+type sessionWindows struct {
+	gap time.Duration
+	// now holds the largest timestamp seen so far
+	now time.Time
+	// sessions holds the open sessions, keyed by the hash of their
+	// group values
+	sessions map[data.HashValue][]*session
+	// open holds the same sessions as `sessions` in the order they
+	// were opened so that the order of closing is deterministic
+	open []*session
+}
+
+type session struct {
+	// group holds the values of the GROUP BY expressions
+	group data.Array
+	hash  data.HashValue
+	// last is the largest timestamp of a row in this session
+	last time.Time
+	rows []*inputRowWithCachedResult
+}
+
+func newSessionWindows(gap time.Duration) *sessionWindows {
+	return &sessionWindows{
+		gap:      gap,
+		sessions: map[data.HashValue][]*session{},
+	}
+}
+
+// expire advances the time to the given timestamp (if it is later than
+// the current one) and removes all sessions whose gap has elapsed. The
+// removed sessions are returned in the order they were opened.
+func (w *sessionWindows) expire(t time.Time) []*session {
+	if t.After(w.now) {
+		w.now = t
+	}
+	var closed []*session
+	open := w.open[:0]
+	for _, s := range w.open {
+		if w.now.Sub(s.last) > w.gap {
+			closed = append(closed, s)
+			w.remove(s)
+		} else {
+			open = append(open, s)
+		}
+	}
+	// clear the references at the end of the slice so that closed
+	// sessions can be garbage collected
+	for i := len(open); i < len(w.open); i++ {
+		w.open[i] = nil
+	}
+	w.open = open
+	return closed
+}
+
+// remove deletes the given session from the `sessions` map.
+func (w *sessionWindows) remove(s *session) {
+	candidates := w.sessions[s.hash]
+	for i, c := range candidates {
+		if c == s {
+			candidates = append(candidates[:i], candidates[i+1:]...)
+			break
+		}
+	}
+	if len(candidates) == 0 {
+		delete(w.sessions, s.hash)
+	} else {
+		w.sessions[s.hash] = candidates
+	}
+}
+
+// add appends the row to the open session of the given group, opening
+// a new session if there is none.
+func (w *sessionWindows) add(group data.Array, hash data.HashValue, row *inputRowWithCachedResult, t time.Time) {
+	var s *session
+	for _, c := range w.sessions[hash] {
+		if data.Equal(group, c.group) {
+			s = c
+			break
+		}
+	}
+	if s == nil {
+		s = &session{
+			group: group,
+			hash:  hash,
+			last:  t,
+		}
+		w.sessions[hash] = append(w.sessions[hash], s)
+		w.open = append(w.open, s)
+	}
+	if t.After(s.last) {
+		s.last = t
+	}
+	s.rows = append(s.rows, row)
+}
@@ -0,0 +1,106 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"testing"
+)
+
+func TestSessionWindow(t *testing.T) {
+	Convey("Given a SELECT statement with a SESSION window and GROUP BY", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM dev, count(*) AS c, sum(int) AS s
+			FROM src [SESSION GAP 5 SECONDS] WHERE int > 0 GROUP BY dev`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			inputs := []struct {
+				sec int
+				dev string
+				val int64
+			}{
+				{0, "a", 1},
+				{2, "b", 2},
+				{4, "a", 4},
+				{6, "b", -1},  // filtered out, but advances the time
+				{8, "b", 8},   // closes the session of b
+				{10, "a", 16}, // closes the session of a
+				{14, "a", 32}, // closes the session of b opened at 8
+				{20, "b", 64}, // closes the session of a opened at 10
+				{30, "a", 128},
+			}
+			outs := [][]data.Map{}
+			for _, in := range inputs {
+				tup := getEventTimeTuple(in.sec, in.val)
+				tup.Data["dev"] = data.String(in.dev)
+				out, err := plan.Process(tup)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+				outs = append(outs, out)
+			}
+
+			for _, i := range []int{0, 1, 2, 3} {
+				i := i
+				Convey(fmt.Sprintf("Then nothing should be emitted in %v", i), func() {
+					So(outs[i], ShouldBeEmpty)
+				})
+			}
+
+			Convey("Then sessions should be closed after the gap elapsed", func() {
+				// b was last seen at 2 and is reopened at 8
+				So(outs[4], ShouldResemble, []data.Map{
+					{"dev": data.String("b"), "c": data.Int(1), "s": data.Int(2)},
+				})
+				So(outs[5], ShouldResemble, []data.Map{
+					{"dev": data.String("a"), "c": data.Int(2), "s": data.Int(5)},
+				})
+				So(outs[6], ShouldResemble, []data.Map{
+					{"dev": data.String("b"), "c": data.Int(1), "s": data.Int(8)},
+				})
+				So(outs[7], ShouldResemble, []data.Map{
+					{"dev": data.String("a"), "c": data.Int(2), "s": data.Int(48)},
+				})
+				So(outs[8], ShouldResemble, []data.Map{
+					{"dev": data.String("b"), "c": data.Int(1), "s": data.Int(64)},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a SESSION window without GROUP BY", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [SESSION GAP 2 SECONDS]`
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for i, sec := range []int{0, 1, 2, 5, 6, 9} {
+				out, err := plan.Process(getEventTimeTuple(sec, int64(i)))
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then there should be a single session at a time", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{{"c": data.Int(3)}})
+				So(outs[4], ShouldBeEmpty)
+				So(outs[5], ShouldResemble, []data.Map{{"c": data.Int(2)}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a SESSION window and no aggregation", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [SESSION GAP 2 SECONDS]`
+		_, err := createPhysicalPlan(s)
+
+		Convey("Then the plan cannot be created", func() {
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "SESSION windows can only be used with")
+		})
+	})
+}
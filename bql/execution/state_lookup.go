@@ -0,0 +1,66 @@
+package execution
+
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// stateLookupEvaluator performs a LOOKUP clause. When a tuple arrives on
+// the relation the key refers to, the key is computed and the data stored
+// for it in the state is added to the tuple, so it can be accessed like
+// the data of an input relation using the alias of the lookup.
+type stateLookupEvaluator struct {
+	state string
+	alias string
+	key   Evaluator
+}
+
+// prepareStateLookups computes evaluators for the LOOKUP clauses, keyed
+// by the alias of the relation the key refers to. It returns an error if
+// one of the states doesn't exist or cannot be used for lookups.
+func prepareStateLookups(lookups []stateLookup, reg udf.FunctionRegistry) (map[string][]*stateLookupEvaluator, error) {
+	output := make(map[string][]*stateLookupEvaluator, len(lookups))
+	for _, l := range lookups {
+		if _, err := udf.GetKeyedLookupState(reg.Context(), l.state); err != nil {
+			return nil, err
+		}
+		key, err := ExpressionToEvaluator(l.key, reg)
+		if err != nil {
+			return nil, err
+		}
+		output[l.relation] = append(output[l.relation], &stateLookupEvaluator{
+			state: l.state,
+			alias: l.alias,
+			key:   key,
+		})
+	}
+	return output, nil
+}
+
+// lookup computes the key from the given row and returns the data stored
+// for it in the state. If the key is NULL or there is no data for the key,
+// NULL is returned.
+func (l *stateLookupEvaluator) lookup(ctx *core.Context, row data.Map) (data.Value, error) {
+	key, err := l.key.Eval(row)
+	if err != nil {
+		return nil, err
+	}
+	if key.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	// the state is obtained every time because it might have been
+	// replaced, e.g., by LOAD STATE
+	state, err := udf.GetKeyedLookupState(ctx, l.state)
+	if err != nil {
+		return nil, err
+	}
+	v, err := state.Lookup(ctx, key)
+	if err != nil {
+		if core.IsNotExist(err) {
+			return data.Null{}, nil
+		}
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,195 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"testing"
+)
+
+type testLookupState struct {
+	m     data.Map
+	calls int
+}
+
+func (s *testLookupState) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func (s *testLookupState) Lookup(ctx *core.Context, key data.Value) (data.Map, error) {
+	s.calls++
+	k, err := data.ToString(key)
+	if err != nil {
+		return nil, err
+	}
+	if k == "error" {
+		return nil, fmt.Errorf("lookup failed")
+	}
+	v, ok := s.m[k]
+	if !ok {
+		return nil, core.NotExistError(fmt.Errorf("key '%v' was not found", k))
+	}
+	return data.AsMap(v)
+}
+
+type testNonLookupState struct{}
+
+func (s *testNonLookupState) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func newLookupTestContext() (*core.Context, *testLookupState) {
+	ctx := core.NewContext(nil)
+	state := &testLookupState{
+		m: data.Map{
+			"d1": data.Map{"loc": data.String("kitchen"), "offset": data.Int(10)},
+			"d2": data.Map{"loc": data.String("garage"), "offset": data.Int(20)},
+		},
+	}
+	ctx.SharedStates.Add("devices", "test_lookup", state)
+	ctx.SharedStates.Add("plain", "test", &testNonLookupState{})
+	return ctx, state
+}
+
+func getLookupTuple(input, id string, v int64) *core.Tuple {
+	t := getEventTimeTuple(int(v), v)
+	t.InputName = input
+	t.Data["id"] = data.String(id)
+	return t
+}
+
+func TestStateLookup(t *testing.T) {
+	Convey("Given a SELECT statement with a LOOKUP clause", t, func() {
+		ctx, state := newLookupTestContext()
+		s := `CREATE STREAM box AS SELECT RSTREAM r:int AS v, d:loc AS loc, r:int + d:offset AS x
+			FROM readings [RANGE 1 TUPLES] AS r LOOKUP devices AS d ON r:id`
+		plan, err := createPhysicalPlanWithContext(s, ctx)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with a tuple having a known key", func() {
+			out, err := plan.Process(getLookupTuple("readings", "d2", 1))
+
+			Convey("Then the looked up data should be available", func() {
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{
+					{"v": data.Int(1), "loc": data.String("garage"), "x": data.Int(21)},
+				})
+				So(state.calls, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When feeding it with a tuple having an unknown key", func() {
+			out, err := plan.Process(getLookupTuple("readings", "d3", 1))
+
+			Convey("Then the looked up data should be NULL", func() {
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{
+					{"v": data.Int(1), "loc": data.Null{}, "x": data.Null{}},
+				})
+			})
+		})
+
+		Convey("When the lookup fails", func() {
+			_, err := plan.Process(getLookupTuple("readings", "error", 1))
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "lookup failed")
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a LOOKUP clause without aliases", t, func() {
+		ctx, state := newLookupTestContext()
+		s := `CREATE STREAM box AS SELECT ISTREAM int AS v, devices:loc AS loc
+			FROM readings [RANGE 3 TUPLES] LOOKUP devices ON id WHERE devices:loc = "kitchen"`
+		plan, err := createPhysicalPlanWithContext(s, ctx)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for i, id := range []string{"d1", "d2", "d1", "d3"} {
+				out, err := plan.Process(getLookupTuple("readings", id, int64(i)))
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then the state should be looked up once per tuple", func() {
+				So(state.calls, ShouldEqual, 4)
+			})
+
+			Convey("Then the filter should be applied to the looked up data", func() {
+				So(outs[0], ShouldResemble, []data.Map{{"v": data.Int(0), "loc": data.String("kitchen")}})
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldResemble, []data.Map{{"v": data.Int(2), "loc": data.String("kitchen")}})
+				So(outs[3], ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a LEFT OUTER JOIN and a LOOKUP on the right relation", t, func() {
+		ctx, _ := newLookupTestContext()
+		s := `CREATE STREAM box AS SELECT RSTREAM l:int AS lv, d:loc AS loc
+			FROM src1 [RANGE 1 TUPLES] AS l LEFT OUTER JOIN src2 [RANGE 1 TUPLES] AS r ON l:id = r:id
+			LOOKUP devices AS d ON r:id`
+		plan, err := createPhysicalPlanWithContext(s, ctx)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, in := range []struct {
+				input string
+				id    string
+			}{{"src1", "d1"}, {"src2", "d1"}, {"src1", "d2"}} {
+				out, err := plan.Process(getLookupTuple(in.input, in.id, int64(len(outs))))
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+				outs = append(outs, out)
+			}
+
+			Convey("Then the looked up data should be NULL for a missing right relation", func() {
+				So(outs[0], ShouldResemble, []data.Map{{"lv": data.Int(0), "loc": data.Null{}}})
+				So(outs[1], ShouldResemble, []data.Map{{"lv": data.Int(0), "loc": data.String("kitchen")}})
+				So(outs[2], ShouldResemble, []data.Map{{"lv": data.Int(2), "loc": data.Null{}}})
+			})
+		})
+	})
+
+	Convey("Given invalid LOOKUP clauses", t, func() {
+		testCases := []struct {
+			stmt string
+			err  string
+		}{
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] LOOKUP hoge ON a:x`, "state 'hoge' was not found"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] LOOKUP plain ON a:x`, "'plain' state cannot be used for lookups"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] LOOKUP devices AS a ON a:x`, "cannot use relation 'a' and state 'devices'"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] LOOKUP devices ON a:x LOOKUP devices ON a:y`,
+				"cannot use the alias 'devices' for more than one LOOKUP clause"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] LOOKUP devices ON 1`,
+				"the key of LOOKUP devices must refer to exactly one input relation"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] AS a, b [RANGE 1 TUPLES] LOOKUP devices ON a:x || b:x`,
+				"the key of LOOKUP devices must refer to exactly one input relation"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] LOOKUP devices ON a:x LOOKUP d ON devices:x`,
+				"cannot refer to 'devices' in the key of LOOKUP d"},
+			{`SELECT RSTREAM a:x FROM a [RANGE 1 TUPLES] LOOKUP devices ON c:x`,
+				"cannot refer to relations"},
+			{`SELECT RSTREAM count(a:x) FROM a [RANGE 1 TUPLES] LOOKUP devices ON count(a:x)`,
+				"aggregates not allowed in LOOKUP clause"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When creating a plan for %s", tc.stmt), func() {
+				ctx, _ := newLookupTestContext()
+				_, err := createPhysicalPlanWithContext("CREATE STREAM box AS "+tc.stmt, ctx)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldStartWith, tc.err)
+				})
+			})
+		}
+	})
+}
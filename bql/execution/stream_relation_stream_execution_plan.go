@@ -7,6 +7,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
 	"time"
 )
 
@@ -14,16 +15,71 @@ type inputBuffer struct {
 	tuples     *list.List
 	windowSize float64
 	windowType parser.IntervalUnit
+	// slideSize and slideType describe the SLIDE of the window.
+	// slideType is parser.UnspecifiedIntervalUnit if the window
+	// is evaluated on every tuple.
+	slideSize float64
+	slideType parser.IntervalUnit
+	// slideCount holds the number of tuples that were appended
+	// since the last evaluation (for a TUPLES slide).
+	slideCount int64
+	// slideSlot holds the index of the time slot the last tuple
+	// belonged to (for a time-based slide), valid if slideStarted
+	// is true.
+	slideSlot    int64
+	slideStarted bool
 }
 
 type tupleWithDerivedInputRows struct {
 	tuple *core.Tuple
 	rows  []*inputRowWithCachedResult
+	// join holds the state of this tuple in an explicit JOIN, if any
+	join *joinEntry
 }
 
 func (i *inputBuffer) isTimeBased() bool {
 	return i.windowType == parser.Seconds ||
-		i.windowType == parser.Milliseconds
+		i.windowType == parser.Milliseconds ||
+		i.windowType == parser.Minutes
+}
+
+// completesSlide updates the SLIDE state of this buffer with a tuple
+// that was just appended and returns whether the window needs to be
+// evaluated now. For a TUPLES slide of size n this is the case for
+// every n-th tuple, for a time-based slide this is the case for the
+// first tuple whose timestamp lies in a later slot (of the slide's
+// length) than the previous tuple's. Without SLIDE, every tuple
+// completes a slide.
+func (i *inputBuffer) completesSlide(t *core.Tuple) bool {
+	switch i.slideType {
+	case parser.Tuples:
+		i.slideCount++
+		if i.slideCount < int64(i.slideSize) {
+			return false
+		}
+		i.slideCount = 0
+		return true
+
+	case parser.Seconds, parser.Milliseconds, parser.Minutes:
+		slideNanos := float64(intervalDuration(parser.IntervalAST{
+			parser.FloatLiteral{i.slideSize}, i.slideType}))
+		if slideNanos < 1 {
+			slideNanos = 1
+		}
+		slot := int64(math.Floor(float64(t.Timestamp.UnixNano()) / slideNanos))
+		if !i.slideStarted {
+			// the first tuple only opens the first slot
+			i.slideStarted = true
+			i.slideSlot = slot
+			return false
+		}
+		if slot <= i.slideSlot {
+			return false
+		}
+		i.slideSlot = slot
+		return true
+	}
+	return true
 }
 
 // inputRowWithCachedResult holds an input tuple plus space for
@@ -70,6 +126,11 @@ type partialList struct {
 // - perform a SELECT query on that data,
 // - compute the data that need to be emitted by comparison with
 //   the previous run's results.
+//
+// If a window has a SLIDE specification, the last two steps are
+// only performed when the arriving tuple completes a slide, so the
+// output equals the output of the per-tuple evaluation at those
+// points, with nothing emitted in between.
 type streamRelationStreamExecutionPlan struct {
 	commonExecutionPlan
 	// store name->alias mapping
@@ -82,6 +143,12 @@ type streamRelationStreamExecutionPlan struct {
 	buffers map[string]*inputBuffer
 	// emitter configuration
 	emitterType parser.Emitter
+	// distinct is true if duplicate rows are removed from the
+	// results of every run (SELECT DISTINCT)
+	distinct bool
+	// orderer applies the ORDER BY and LIMIT clauses to the results
+	// of every run. It is nil if the statement has neither of them.
+	orderer *resultOrderer
 	// curResults holds results of a query over the buffer.
 	curResults []resultRow
 	// prevResults holds results of a query over the buffer
@@ -104,6 +171,17 @@ type streamRelationStreamExecutionPlan struct {
 	// the last tuple was appended to. this is valid after
 	// `addTupleToBuffer` has returned.
 	lastTupleBuffers map[string]bool
+	// eventTime holds the state of a TUMBLING window. It is nil
+	// if the window is evaluated in arrival order.
+	eventTime *eventTimeWindows
+	// join holds the state of an explicit JOIN. It is nil if the
+	// input rows are computed as the cartesian product of all buffers.
+	join *hashJoin
+	// lookups holds the LOOKUP clauses keyed by the alias of the
+	// relation whose tuples they are performed on.
+	lookups map[string][]*stateLookupEvaluator
+	// ctx is passed to the states used by the LOOKUP clauses.
+	ctx *core.Context
 }
 
 func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (*streamRelationStreamExecutionPlan, error) {
@@ -133,7 +211,43 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 		rangeUnit := rel.Unit
 		// the alias of the relation is the key of the buffer
 		buffers[rel.Alias] = &inputBuffer{
-			tuples, rangeValue, rangeUnit,
+			tuples:     tuples,
+			windowSize: rangeValue,
+			windowType: rangeUnit,
+			slideSize:  rel.Slide.Value,
+			slideType:  rel.Slide.Unit,
+		}
+	}
+
+	// TUMBLING windows are only allowed with a single input relation
+	var eventTime *eventTimeWindows
+	if len(lp.Relations) == 1 && lp.Relations[0].WindowType == parser.TumblingWindow {
+		rel := lp.Relations[0]
+		eventTime = newEventTimeWindows(intervalDuration(rel.IntervalAST),
+			intervalDuration(rel.AllowedLateness))
+	}
+
+	lookups, err := prepareStateLookups(lp.StateLookups, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	orderer, err := newResultOrderer(lp, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	var join *hashJoin
+	if lp.JoinCondition != nil {
+		join, err = newHashJoin(lp.JoinCondition, lp.Relations[0].Alias,
+			lp.Relations[1].Alias, reg)
+		if err != nil {
+			return nil, err
+		}
+		// the values looked up for the right relation are NULL as
+		// well when it is missing in a LEFT OUTER JOIN
+		for _, l := range lookups[join.right] {
+			join.nullAliases = append(join.nullAliases, l.alias)
 		}
 	}
 
@@ -146,10 +260,16 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 		relations:            lp.Relations,
 		buffers:              buffers,
 		emitterType:          lp.EmitterType,
+		distinct:             lp.Distinct,
+		orderer:              orderer,
 		curResults:           []resultRow{},
 		prevResults:          []resultRow{},
 		prevHashesForIstream: map[data.HashValue][]resultRowCount{},
 		filteredInputRows:    list.New(),
+		eventTime:            eventTime,
+		join:                 join,
+		lookups:              lookups,
+		ctx:                  reg.Context(),
 	}, nil
 }
 
@@ -170,6 +290,71 @@ func (ep *streamRelationStreamExecutionPlan) relationKey(rel *parser.AliasedStre
 // items than allowed by the window specification, so a call to
 // removeOutdatedTuplesFromBuffer is necessary afterwards.
 func (ep *streamRelationStreamExecutionPlan) addTupleToBuffer(t *core.Tuple) error {
+	numAppends, err := ep.countMatchingRelations(t)
+	if err != nil {
+		return err
+	}
+
+	// core.TFSharedData is set by t.ShallowCopy() below.
+
+	// the tuples are only appended after all lookups have succeeded
+	aliases := make([]string, 0, numAppends)
+	editTuples := make([]*core.Tuple, 0, numAppends)
+	for _, rel := range ep.relations {
+		if t.InputName == ep.relationKey(&rel) {
+			// because the tuple is always cached, ShallowCopy is required here.
+			editTuple := t.ShallowCopy()
+			// nest the data in a one-element map using the alias as the key
+			editTuple.Data = data.Map{rel.Alias: editTuple.Data}
+			// add the values looked up for this relation
+			if err := ep.lookupStates(rel.Alias, editTuple); err != nil {
+				return err
+			}
+			aliases = append(aliases, rel.Alias)
+			editTuples = append(editTuples, editTuple)
+		}
+	}
+
+	ep.lastTupleBuffers = make(map[string]bool, numAppends)
+	for i, alias := range aliases {
+		// wrap this in a container struct
+		editTupleCont := tupleWithDerivedInputRows{
+			tuple: editTuples[i],
+		}
+		buffer := ep.buffers[alias]
+		buffer.tuples.PushBack(&editTupleCont)
+		ep.lastTupleBuffers[alias] = true
+	}
+
+	return nil
+}
+
+// lookupStates performs the LOOKUP clauses of the given relation on a
+// tuple whose data is already nested under the relation's alias, and
+// adds the looked up values to the tuple's data using their aliases.
+func (ep *streamRelationStreamExecutionPlan) lookupStates(alias string, t *core.Tuple) error {
+	lookups := ep.lookups[alias]
+	if len(lookups) == 0 {
+		return nil
+	}
+	row := data.Map{
+		alias:       t.Data[alias],
+		":meta:NOW": data.Timestamp(ep.now),
+	}
+	setMetadata(row, alias, t)
+	for _, l := range lookups {
+		v, err := l.lookup(ep.ctx, row)
+		if err != nil {
+			return err
+		}
+		t.Data[l.alias] = v
+	}
+	return nil
+}
+
+// countMatchingRelations returns the number of input relations whose
+// name matches the tuple's input name, or an error if there is none.
+func (ep *streamRelationStreamExecutionPlan) countMatchingRelations(t *core.Tuple) (int, error) {
 	// we need to append this tuple to all buffers where the input name
 	// matches the relation name, so first we count the those buffers
 	// (for `FROM a AS left, a AS right`, this tuple will be
@@ -187,30 +372,10 @@ func (ep *streamRelationStreamExecutionPlan) addTupleToBuffer(t *core.Tuple) err
 		for _, rel := range ep.relations {
 			knownRelNames = append(knownRelNames, rel.Name)
 		}
-		return fmt.Errorf("tuple has input name '%s' set, but we "+
+		return 0, fmt.Errorf("tuple has input name '%s' set, but we "+
 			"can only deal with %v", t.InputName, knownRelNames)
 	}
-
-	// core.TFSharedData is set by t.ShallowCopy() below.
-
-	ep.lastTupleBuffers = make(map[string]bool, numAppends)
-	for _, rel := range ep.relations {
-		if t.InputName == ep.relationKey(&rel) {
-			// because the tuple is always cached, ShallowCopy is required here.
-			editTuple := t.ShallowCopy()
-			// nest the data in a one-element map using the alias as the key
-			editTuple.Data = data.Map{rel.Alias: editTuple.Data}
-			// wrap this in a container struct
-			editTupleCont := tupleWithDerivedInputRows{
-				tuple: editTuple,
-			}
-			buffer := ep.buffers[rel.Alias]
-			buffer.tuples.PushBack(&editTupleCont)
-			ep.lastTupleBuffers[rel.Alias] = true
-		}
-	}
-
-	return nil
+	return numAppends, nil
 }
 
 // removeOutdatedTuplesFromBuffer removes tuples from the buffer that
@@ -237,15 +402,16 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 					for _, inputRow := range tupCont.rows {
 						expiredInputRows[inputRow] = true
 					}
+					if ep.join != nil {
+						ep.join.remove(tupCont)
+					}
 					buffer.tuples.Remove(e)
 				}
 			}
 
 		} else if buffer.isTimeBased() {
-			windowSizeSeconds := float64(buffer.windowSize)
-			if buffer.windowType == parser.Milliseconds {
-				windowSizeSeconds = windowSizeSeconds / 1000
-			}
+			windowSizeSeconds := intervalInSeconds(parser.IntervalAST{
+				parser.FloatLiteral{buffer.windowSize}, buffer.windowType})
 			// we have to remove all items from the list that are
 			// older than the specified window length
 			var next *list.Element
@@ -259,6 +425,9 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 					for _, inputRow := range tupCont.rows {
 						expiredInputRows[inputRow] = true
 					}
+					if ep.join != nil {
+						ep.join.remove(tupCont)
+					}
 					buffer.tuples.Remove(e)
 				}
 			}
@@ -330,11 +499,37 @@ func (ep *streamRelationStreamExecutionPlan) incrAndGetMultiplicity(r *resultRow
 	return 1
 }
 
+// removeDuplicateResults removes all but the first occurrence of each
+// row from this run's results. Since the results of the next run are
+// compared with the deduplicated ones, ISTREAM and DSTREAM treat the
+// results as sets.
+func (ep *streamRelationStreamExecutionPlan) removeDuplicateResults() {
+	counts := make(map[data.HashValue][]resultRowCount, len(ep.curResults))
+	output := ep.curResults[:0]
+	for _, res := range ep.curResults {
+		if ep.incrAndGetMultiplicity(&res, counts) == 1 {
+			output = append(output, res)
+		}
+	}
+	ep.curResults = output
+}
+
 // computeResultTuples compares the results of this run's query with
 // the results of the previous run's query and returns the data to
 // be emitted as per the Emitter specification (Rstream = new,
-// Istream = new-old, Dstream = old-new).
+// Istream = new-old, Dstream = old-new). ORDER BY and LIMIT are
+// applied to this run's results before the comparison.
 func (ep *streamRelationStreamExecutionPlan) computeResultTuples() ([]data.Map, error) {
+	if ep.distinct {
+		ep.removeDuplicateResults()
+	}
+	if ep.orderer != nil {
+		ordered, err := ep.orderer.orderResults(ep.curResults)
+		if err != nil {
+			return nil, err
+		}
+		ep.curResults = ordered
+	}
 	// TODO turn this into an iterator/generator pattern
 	var output []data.Map
 	if ep.emitterType == parser.Rstream {
@@ -409,6 +604,9 @@ func (ep *streamRelationStreamExecutionPlan) computeResultTuples() ([]data.Map,
 // order of items in the returned slice is undefined and cannot be relied on.
 func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
 	ep.now = time.Now().In(time.UTC)
+	if ep.eventTime != nil {
+		return ep.processEventTime(input, performQueryOnBuffer)
+	}
 
 	// stream-to-relation:
 	// updates the internal buffer with correct window data
@@ -425,6 +623,11 @@ func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQ
 	if err := ep.filterInputTuples(); err != nil {
 		return nil, err
 	}
+	if !ep.lastTupleCompletesSlide(input) {
+		// the filtered rows are kept up to date, but the query is
+		// only evaluated at slide boundaries
+		return nil, nil
+	}
 	if err := performQueryOnBuffer(); err != nil {
 		return nil, err
 	}
@@ -434,7 +637,71 @@ func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQ
 	return ep.computeResultTuples()
 }
 
+// processEventTime is the counterpart of process for TUMBLING windows.
+// The input tuple is assigned to its window and the query is performed
+// on the contents of every window that is closed by the advancing
+// watermark, returning the concatenated results. A tuple that belongs
+// to an already closed window is rejected with an error, so that it
+// will be reported as a dropped tuple.
+func (ep *streamRelationStreamExecutionPlan) processEventTime(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if _, err := ep.countMatchingRelations(input); err != nil {
+		return nil, err
+	}
+	windows, err := ep.eventTime.add(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []data.Map
+	for _, tuples := range windows {
+		// stream-to-relation:
+		// the buffer holds exactly the tuples of the closed window
+		for _, buffer := range ep.buffers {
+			buffer.tuples.Init()
+		}
+		ep.filteredInputRows.Init()
+		for _, t := range tuples {
+			if err := ep.addTupleToBuffer(t); err != nil {
+				return nil, err
+			}
+			if err := ep.filterInputTuples(); err != nil {
+				return nil, err
+			}
+		}
+
+		// relation-to-relation and relation-to-stream
+		if err := performQueryOnBuffer(); err != nil {
+			return nil, err
+		}
+		res, err := ep.computeResultTuples()
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, res...)
+	}
+	return output, nil
+}
+
+// lastTupleCompletesSlide updates the SLIDE state of all buffers the
+// last tuple was appended to and returns whether the query needs to be
+// evaluated, i.e., whether the tuple completes a slide in at least one
+// of them.
+func (ep *streamRelationStreamExecutionPlan) lastTupleCompletesSlide(t *core.Tuple) bool {
+	completes := false
+	for key := range ep.lastTupleBuffers {
+		// every buffer must be updated, so do not break early
+		if ep.buffers[key].completesSlide(t) {
+			completes = true
+		}
+	}
+	return completes
+}
+
 func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
+	if ep.join != nil {
+		return ep.filterJoinedInputTuples()
+	}
+
 	// we need to make a cross product of the data in all buffers,
 	// combine it to get an input like
 	//  {"streamA": {data}, "streamB": {data}, "streamC": {data}}
@@ -551,8 +818,11 @@ func (ep *streamRelationStreamExecutionPlan) preprocCartProdInt(dataHolder data.
 		}
 		for e := myBuffer.start; e != myBuffer.end; e = e.Next() {
 			t := e.Value.(*tupleWithDerivedInputRows)
-			// add the data of this tuple to dataHolder and recurse
-			dataHolder[myKey] = t.tuple.Data[myKey]
+			// add the data of this tuple (and the values looked up
+			// for it) to dataHolder and recurse
+			for key, val := range t.tuple.Data {
+				dataHolder[key] = val
+			}
 			origin[myKey] = t
 			setMetadata(dataHolder, myKey, t.tuple)
 			if err := ep.preprocCartProdInt(dataHolder, rest, origin); err != nil {
@@ -569,25 +839,13 @@ func (ep *streamRelationStreamExecutionPlan) preprocCartProdInt(dataHolder data.
 		dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
 
 		// evaluate filter condition
-		if ep.filter != nil {
-			filterResult, err := ep.filter.Eval(dataHolder)
-			if err != nil {
-				return err
-			}
-			// a NULL value is definitely not "true", so since we
-			// have only a binary decision, we should drop tuples
-			// where the filter condition evaluates to NULL
-			filterResultBool := false
-			if filterResult.Type() != data.TypeNull {
-				filterResultBool, err = data.AsBool(filterResult)
-				if err != nil {
-					return err
-				}
-			}
-			// if it evaluated to false, do not further process this tuple
-			if !filterResultBool {
-				return nil
-			}
+		ok, err := evalCondition(ep.filter, dataHolder)
+		if err != nil {
+			return err
+		}
+		// if it evaluated to false, do not further process this tuple
+		if !ok {
+			return nil
 		}
 
 		// if we arrive here, this item of the cartesian product fulfills
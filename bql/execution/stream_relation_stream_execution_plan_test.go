@@ -1,8 +1,14 @@
 package execution
 
 import (
+	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -49,3 +55,156 @@ func TestMultiplicityHandling(t *testing.T) {
 		})
 	})
 }
+
+func createPhysicalPlan(s string) (PhysicalPlan, error) {
+	return createPhysicalPlanWithContext(s, core.NewContext(nil))
+}
+
+func createPhysicalPlanWithContext(s string, ctx *core.Context) (PhysicalPlan, error) {
+	p := parser.New()
+	reg := udf.CopyGlobalUDFRegistry(ctx)
+	_stmt, _, err := p.ParseStmt(s)
+	if err != nil {
+		return nil, err
+	}
+	stmt := _stmt.(parser.CreateStreamAsSelectStmt).Select
+	logicalPlan, err := Analyze(stmt, reg)
+	if err != nil {
+		return nil, err
+	}
+	return logicalPlan.MakePhysicalPlan(reg)
+}
+
+func TestSelectDistinct(t *testing.T) {
+	testCases := []struct {
+		emitter  string
+		expected [][]data.Map
+	}{
+		{"RSTREAM", [][]data.Map{
+			{{"foo": data.Int(1)}},
+			{{"foo": data.Int(1)}},
+			{{"foo": data.Int(1)}, {"foo": data.Int(2)}},
+			{{"foo": data.Int(1)}, {"foo": data.Int(2)}},
+		}},
+		{"ISTREAM", [][]data.Map{
+			{{"foo": data.Int(1)}},
+			nil,
+			{{"foo": data.Int(2)}},
+			nil,
+		}},
+		{"DSTREAM", [][]data.Map{
+			nil,
+			nil,
+			nil,
+			nil,
+		}},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		Convey(fmt.Sprintf("Given a SELECT %s DISTINCT statement", testCase.emitter), t, func() {
+			s := fmt.Sprintf(`CREATE STREAM box AS SELECT %s DISTINCT foo
+				FROM src [RANGE 3 TUPLES]`, testCase.emitter)
+			plan, err := createPhysicalPlan(s)
+			So(err, ShouldBeNil)
+
+			Convey("When feeding it with tuples", func() {
+				// the values of foo are 1, 1, 2, 2
+				for idx, inTup := range getOtherTuples() {
+					out, err := plan.Process(inTup)
+					So(err, ShouldBeNil)
+					sort.Sort(tupleList(out))
+
+					Convey(fmt.Sprintf("Then duplicates should be removed in %v", idx), func() {
+						So(out, ShouldResemble, testCase.expected[idx])
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	testCases := []struct {
+		title      string
+		window     string
+		slide      string
+		rest       string
+		boundaries map[int]bool
+	}{
+		{"a TUPLES slide without grouping", "[RANGE 4 TUPLES", " SLIDE 2 TUPLES", "] WHERE int % 3 != 0",
+			map[int]bool{1: true, 3: true, 5: true, 7: true}},
+		{"a tumbling TUPLES window with grouping", "[RANGE 3 TUPLES", " SLIDE 3 TUPLES", "] GROUP BY k",
+			map[int]bool{2: true, 5: true}},
+		{"a SECONDS slide without grouping", "[RANGE 3 SECONDS", " SLIDE 2 SECONDS", "]",
+			map[int]bool{2: true, 4: true, 6: true}},
+		{"a MILLISECONDS slide with grouping", "[RANGE 2500 MILLISECONDS", " SLIDE 1500 MILLISECONDS", "] GROUP BY k",
+			map[int]bool{2: true, 3: true, 5: true, 6: true}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		projs := "int"
+		if strings.Contains(tc.rest, "GROUP BY") {
+			projs = "k, count(*) AS c, sum(int) AS s"
+		}
+		s := "CREATE STREAM box AS SELECT RSTREAM " + projs + " FROM src " +
+			tc.window + tc.slide + tc.rest
+		refS := "CREATE STREAM box AS SELECT RSTREAM " + projs + " FROM src " +
+			tc.window + tc.rest
+
+		Convey(fmt.Sprintf("Given a SELECT statement with %s", tc.title), t, func() {
+			plan, err := createPhysicalPlan(s)
+			So(err, ShouldBeNil)
+			refPlan, err := createPhysicalPlan(refS)
+			So(err, ShouldBeNil)
+
+			Convey("When feeding it with tuples", func() {
+				for idx, inTup := range getTuples(8) {
+					inTup.Data["k"] = data.Int(idx % 2)
+					out, err := plan.Process(inTup.Copy())
+					So(err, ShouldBeNil)
+					refOut, err := refPlan.Process(inTup.Copy())
+					So(err, ShouldBeNil)
+
+					if tc.boundaries[idx] {
+						Convey(fmt.Sprintf("Then the result should match the per-tuple result in %v", idx), func() {
+							sort.Sort(tupleList(out))
+							sort.Sort(tupleList(refOut))
+							So(out, ShouldResemble, refOut)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then nothing should be emitted in %v", idx), func() {
+							So(out, ShouldBeEmpty)
+						})
+					}
+				}
+			})
+		})
+	}
+
+	Convey("Given a SELECT ISTREAM statement with a TUPLES slide", t, func() {
+		s := "CREATE STREAM box AS SELECT ISTREAM count(*) AS c FROM src [RANGE 4 TUPLES SLIDE 2 TUPLES]"
+		plan, err := createPhysicalPlan(s)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			outs := [][]data.Map{}
+			for _, inTup := range getTuples(6) {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then results should be compared with the previous slide only", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldResemble, []data.Map{{"c": data.Int(2)}})
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{{"c": data.Int(4)}})
+				So(outs[4], ShouldBeEmpty)
+				// the count is still 4, so nothing new is emitted
+				So(outs[5], ShouldBeEmpty)
+			})
+		})
+	})
+}
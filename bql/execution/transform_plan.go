@@ -5,6 +5,7 @@ import (
 	"math"
 	"regexp"
 	"strings"
+	"time"
 
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
@@ -16,6 +17,7 @@ const (
 	MaxRangeTuples   float64 = 1<<20 - 1
 	MaxRangeSec      float64 = 60 * 60 * 24
 	MaxRangeMillisec float64 = 60 * 60 * 24 * 1000
+	MaxRangeMin      float64 = 60 * 24
 )
 
 /*
@@ -43,11 +45,34 @@ type LogicalPlan struct {
 	EmitterLimit        int64
 	EmitterSampling     float64
 	EmitterSamplingType parser.EmitterSamplingType
-	Projections         []aliasedExpression
+	// Distinct is true if duplicate rows are removed from the
+	// results of every evaluation.
+	Distinct    bool
+	Projections []aliasedExpression
+	// Analytics holds the applications of analytic functions in the
+	// projections. Their results are computed for all input rows of
+	// an evaluation at once.
+	Analytics []analyticFuncAppAST
 	parser.WindowedFromAST
-	Filter    FlatExpression
-	GroupList []FlatExpression
+	// JoinCondition holds the ON condition of an explicit JOIN. It
+	// is nil if the relations are not combined using JOIN.
+	JoinCondition *joinCondition
+	// StateLookups holds the states that are looked up for every
+	// input tuple as specified by the LOOKUP clauses.
+	StateLookups []stateLookup
+	Filter       FlatExpression
+	GroupList    []FlatExpression
+	// GroupingSets has the grouping sets of GROUPING SETS, ROLLUP,
+	// or CUBE as indexes of expressions in GroupList. It is nil if
+	// rows are only grouped by all expressions in GroupList.
+	GroupingSets [][]int
 	parser.HavingAST
+	// Ordering holds the ORDER BY clause, which is evaluated on the
+	// result rows of every evaluation.
+	Ordering []resultOrdering
+	// Limit is the maximum number of rows emitted per evaluation,
+	// or -1 if there is no LIMIT clause.
+	Limit int64
 }
 
 // PhysicalPlan is a physical interface that is capable of
@@ -135,6 +160,10 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		if len(aggrs) > 0 {
 			groupingMode = true
 		}
+		// grouping() refers to GROUP BY columns as well
+		if containsGroupingFunc(flatExpr) {
+			groupingMode = true
+		}
 		// compute column name
 		colHeader := fmt.Sprintf("col_%v", i)
 		switch projType := expr.(type) {
@@ -157,6 +186,8 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 				string(projType.FuncAppAST.Function), i)
 		case parser.FuncAppAST:
 			colHeader = string(projType.Function)
+		case parser.AnalyticFuncAppAST:
+			colHeader = string(projType.Function)
 		case parser.Wildcard:
 			// The wildcard projection (without AS) is very special in that
 			// it is the only case where the BQL user does not determine
@@ -177,6 +208,19 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		flatProjExprs[i] = aliasedExpression{colHeader, flatExpr, aggrs}
 	}
 
+	// collect the analytic function applications, every distinct one
+	// only once
+	var analytics []analyticFuncAppAST
+	knownAnalytics := map[string]bool{}
+	for _, expr := range flatProjExprs {
+		for _, a := range analyticFuncApps(expr.expr) {
+			if !knownAnalytics[a.Ref] {
+				knownAnalytics[a.Ref] = true
+				analytics = append(analytics, a)
+			}
+		}
+	}
+
 	if s.Having != nil {
 		// convert the parser Expression to a FlatExpression
 		flatExpr, aggrs, err := ParserExprToMaybeAggregate(s.Having, numAggParams, reg)
@@ -204,6 +248,66 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		filterExpr = filterFlatExpr
 	}
 
+	var joinCond *joinCondition
+	if len(s.Joins) > 0 {
+		// validateReferences makes sure that there is only one JOIN
+		jc, err := flattenJoinCondition(s.Joins[0].On, s.Relations[0].Alias,
+			s.Relations[1].Alias, reg)
+		if err != nil {
+			return nil, err
+		}
+		jc.joinType = s.Joins[0].Type
+		joinCond = jc
+	}
+
+	lookups := make([]stateLookup, len(s.Lookups))
+	for i, lookup := range s.Lookups {
+		flatExpr, err := ParserExprToFlatExpr(lookup.Key, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in LOOKUP clause")
+			}
+			return nil, err
+		}
+		// validateReferences makes sure that there is exactly one
+		var relation string
+		for rel := range lookup.Key.ReferencedRelations() {
+			relation = rel
+		}
+		lookups[i] = stateLookup{string(lookup.State), lookup.Alias, relation, flatExpr}
+	}
+
+	ordering := make([]resultOrdering, len(s.OrderBy))
+	for i, sortExpr := range s.OrderBy {
+		// ORDER BY is evaluated on the result rows, which do not
+		// contain the data of the input relations any more
+		for rel := range sortExpr.Expr.ReferencedRelations() {
+			if rel != "" {
+				err := fmt.Errorf("ORDER BY can only refer to output "+
+					"columns, not to relation '%s'", rel)
+				return nil, err
+			}
+		}
+		flatExpr, err := ParserExprToFlatExpr(sortExpr.Expr, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in ORDER BY clause")
+			}
+			return nil, err
+		}
+		ordering[i] = resultOrdering{flatExpr, sortExpr.Ascending != parser.No}
+	}
+
+	limit := int64(-1)
+	if s.Limited {
+		limit = s.Limit
+		if limit < 0 {
+			return nil, fmt.Errorf("LIMIT must not be negative, not %d", limit)
+		}
+	}
+
 	groupCols := make([]rowValue, len(s.GroupList))
 	flatGroupExprs := make([]FlatExpression, len(s.GroupList))
 	for i, expr := range s.GroupList {
@@ -226,7 +330,7 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		groupCols[i] = col
 		flatGroupExprs[i] = flatExpr
 	}
-	groupingMode = groupingMode || len(flatGroupExprs) > 0
+	groupingMode = groupingMode || len(flatGroupExprs) > 0 || s.GroupingSets != nil
 
 	// check if grouping is done correctly
 	if groupingMode {
@@ -262,6 +366,30 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		}
 	}
 
+	if groupingMode && len(analytics) > 0 {
+		err := fmt.Errorf("analytic functions cannot be used together " +
+			"with GROUP BY or aggregate functions")
+		return nil, err
+	}
+
+	// a SESSION window keeps one session per group, so it can only
+	// be used with aggregation
+	for _, rel := range s.Relations {
+		if rel.WindowType != parser.SessionWindow {
+			continue
+		}
+		if !groupingMode {
+			err := fmt.Errorf("SESSION windows can only be used with " +
+				"GROUP BY or aggregate functions")
+			return nil, err
+		}
+		if s.GroupingSets != nil {
+			err := fmt.Errorf("SESSION windows cannot be used together " +
+				"with GROUPING SETS, ROLLUP, or CUBE")
+			return nil, err
+		}
+	}
+
 	// validate the emitter parameters
 	emitLimit := int64(-1)
 	emitSampling := float64(-1)
@@ -317,14 +445,116 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		emitLimit,
 		emitSampling,
 		emitSamplingType,
+		s.Distinct,
 		flatProjExprs,
+		analytics,
 		s.WindowedFromAST,
+		joinCond,
+		lookups,
 		filterExpr,
 		flatGroupExprs,
+		s.GroupingSets,
 		s.HavingAST,
+		ordering,
+		limit,
 	}, nil
 }
 
+// joinCondition holds the flattened ON condition of a JOIN between
+// two relations. Equalities between an expression that only refers
+// to the left relation and an expression that only refers to the
+// right relation are split off so that they can be used as keys
+// of a hash join.
+type joinCondition struct {
+	joinType  parser.JoinType
+	leftKeys  []FlatExpression
+	rightKeys []FlatExpression
+	// residual holds the remaining part of the condition that
+	// must be evaluated on the joined rows. It is nil if the
+	// condition only consists of equalities.
+	residual FlatExpression
+}
+
+// flattenJoinCondition splits the ON condition into its AND-connected
+// parts and sorts them into hash keys and a residual condition.
+func flattenJoinCondition(on parser.Expression, left, right string, reg udf.FunctionRegistry) (*joinCondition, error) {
+	flatten := func(expr parser.Expression) (FlatExpression, error) {
+		flatExpr, err := ParserExprToFlatExpr(expr, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in ON clause")
+			}
+			return nil, err
+		}
+		return flatExpr, nil
+	}
+	onlyRefersTo := func(expr parser.Expression, rel string) bool {
+		refs := expr.ReferencedRelations()
+		return len(refs) == 1 && refs[rel]
+	}
+
+	jc := &joinCondition{}
+	var residual []parser.Expression
+	var split func(expr parser.Expression) error
+	split = func(expr parser.Expression) error {
+		b, ok := expr.(parser.BinaryOpAST)
+		if ok && b.Op == parser.And {
+			if err := split(b.Left); err != nil {
+				return err
+			}
+			return split(b.Right)
+		}
+		if ok && b.Op == parser.Equal {
+			l, r := b.Left, b.Right
+			if onlyRefersTo(l, right) && onlyRefersTo(r, left) {
+				l, r = r, l
+			}
+			if onlyRefersTo(l, left) && onlyRefersTo(r, right) {
+				lKey, err := flatten(l)
+				if err != nil {
+					return err
+				}
+				rKey, err := flatten(r)
+				if err != nil {
+					return err
+				}
+				jc.leftKeys = append(jc.leftKeys, lKey)
+				jc.rightKeys = append(jc.rightKeys, rKey)
+				return nil
+			}
+		}
+		residual = append(residual, expr)
+		return nil
+	}
+	if err := split(on); err != nil {
+		return nil, err
+	}
+
+	if len(residual) > 0 {
+		cond := residual[0]
+		for _, expr := range residual[1:] {
+			cond = parser.BinaryOpAST{parser.And, cond, expr}
+		}
+		flatCond, err := flatten(cond)
+		if err != nil {
+			return nil, err
+		}
+		jc.residual = flatCond
+	}
+	return jc, nil
+}
+
+// stateLookup describes a LOOKUP clause. The key is computed from
+// every tuple arriving on the given input relation and the value
+// looked up in the state is stored under the alias.
+type stateLookup struct {
+	state    string
+	alias    string
+	relation string
+	key      FlatExpression
+}
+
 // makeRelationAliases will assign an internal alias to every relation
 // does not yet have one (given by the user). It will also detect if
 // there is a conflict between aliases.
@@ -346,11 +576,32 @@ func makeRelationAliases(s *parser.SelectStmt) error {
 		newRels[i] = aliasedRel
 	}
 	s.Relations = newRels
+
+	// the values looked up in a state can be referred to like
+	// relations, so their aliases must be unique as well
+	lookupNames := make(map[string]bool, len(s.Lookups))
+	newLookups := make([]parser.LookupAST, len(s.Lookups))
+	for i, lookup := range s.Lookups {
+		if lookup.Alias == "" {
+			lookup.Alias = string(lookup.State)
+		}
+		if otherRel, exists := relNames[lookup.Alias]; exists {
+			return fmt.Errorf("cannot use relation '%s' and state '%s' with the "+
+				"same alias '%s'", otherRel.Name, lookup.State, lookup.Alias)
+		}
+		if lookupNames[lookup.Alias] {
+			return fmt.Errorf("cannot use the alias '%s' for more than one "+
+				"LOOKUP clause", lookup.Alias)
+		}
+		lookupNames[lookup.Alias] = true
+		newLookups[i] = lookup
+	}
+	s.Lookups = newLookups
 	return nil
 }
 
 // validateReferences checks if the references to input relations
-// in SELECT, WHERE, GROUP BY and HAVING clauses of the given
+// in SELECT, ON, WHERE, GROUP BY and HAVING clauses of the given
 // statement are matching the relations mentioned in the FROM
 // clause.
 func validateReferences(s *parser.SelectStmt) error {
@@ -364,6 +615,11 @@ func validateReferences(s *parser.SelectStmt) error {
 	   the input relations (as in `SELECT a.col, b.col FROM a, b`).
 	*/
 
+	// at the moment, the hash join can only combine two relations
+	if len(s.Joins) > 1 {
+		return fmt.Errorf("only a single JOIN is supported, not %d", len(s.Joins))
+	}
+
 	// collect the referenced relations in SELECT, WHERE, GROUP BY clauses
 	// and store them in the given map
 	refRels := map[string]bool{}
@@ -372,6 +628,22 @@ func validateReferences(s *parser.SelectStmt) error {
 			refRels[rel] = true
 		}
 	}
+	for _, join := range s.Joins {
+		for rel := range join.On.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
+	for _, lookup := range s.Lookups {
+		for rel := range lookup.Key.ReferencedRelations() {
+			for _, other := range s.Lookups {
+				if rel == other.Alias {
+					return fmt.Errorf("cannot refer to '%s' in the key of "+
+						"LOOKUP %s", rel, lookup.Alias)
+				}
+			}
+			refRels[rel] = true
+		}
+	}
 	if s.Filter != nil {
 		for rel := range s.Filter.ReferencedRelations() {
 			refRels[rel] = true
@@ -387,6 +659,11 @@ func validateReferences(s *parser.SelectStmt) error {
 			refRels[rel] = true
 		}
 	}
+	// the looked up values are no input relations, so references
+	// to them are always valid
+	for _, lookup := range s.Lookups {
+		delete(refRels, lookup.Alias)
+	}
 
 	// do the correctness check for SELECT, WHERE, GROUP BY clauses
 	if len(s.Relations) == 0 {
@@ -426,6 +703,12 @@ func validateReferences(s *parser.SelectStmt) error {
 			if s.Having != nil {
 				s.Having = s.Having.RenameReferencedRelation("", inputRel)
 			}
+			newLookups := make([]parser.LookupAST, len(s.Lookups))
+			for i, lookup := range s.Lookups {
+				lookup.Key = lookup.Key.RenameReferencedRelation("", inputRel)
+				newLookups[i] = lookup
+			}
+			s.Lookups = newLookups
 
 		} else if len(refRels) > 1 {
 			// Sample: SELECT a, b.a FROM b // SELECT b.a, x.a FROM b
@@ -468,43 +751,155 @@ func validateReferences(s *parser.SelectStmt) error {
 		// FROM clause -> OK
 	}
 
+	// a lookup is performed when a tuple arrives, so the key must be
+	// computable from the tuple of a single input relation
+	for _, lookup := range s.Lookups {
+		if len(lookup.Key.ReferencedRelations()) != 1 {
+			return fmt.Errorf("the key of LOOKUP %s must refer to exactly "+
+				"one input relation", lookup.Alias)
+		}
+	}
+
 	for _, rel := range s.Relations {
+		// the length of a SESSION window is given by its GAP clause
+		clause := "RANGE"
+		if rel.WindowType == parser.SessionWindow {
+			clause = "GAP"
+		}
 		if rel.Value <= 0 {
-			err := fmt.Errorf("number in RANGE clause must be positive, not %v", rel.Value)
+			err := fmt.Errorf("number in %s clause must be positive, not %v", clause, rel.Value)
 			return err
 		}
 		if rel.Unit == parser.Tuples && math.Trunc(rel.Value) != rel.Value {
 			// actually the parser should not allow fractional numbers,
 			// but we check anyway
-			err := fmt.Errorf("number in RANGE clause must be integral "+
-				"for TUPLES, not %v", rel.Value)
+			err := fmt.Errorf("number in %s clause must be integral "+
+				"for TUPLES, not %v", clause, rel.Value)
 			return err
 		}
 		switch rel.Unit {
 		case parser.Tuples:
 			if rel.Value > MaxRangeTuples {
-				err := fmt.Errorf("RANGE value %d is too large for TUPLES (must be at most %d)",
-					int64(rel.Value), int64(MaxRangeTuples))
+				err := fmt.Errorf("%s value %d is too large for TUPLES (must be at most %d)",
+					clause, int64(rel.Value), int64(MaxRangeTuples))
 				return err
 			}
 		case parser.Seconds:
 			if rel.Value > MaxRangeSec {
-				err := fmt.Errorf("RANGE value %v is too large for SECONDS (must be at most %d)",
-					rel.Value, int64(MaxRangeSec))
+				err := fmt.Errorf("%s value %v is too large for SECONDS (must be at most %d)",
+					clause, rel.Value, int64(MaxRangeSec))
 				return err
 			}
 		case parser.Milliseconds:
 			if rel.Value > MaxRangeMillisec {
-				err := fmt.Errorf("RANGE value %v is too large for MILLISECONDS (must be at most %d)",
-					rel.Value, int64(MaxRangeMillisec))
+				err := fmt.Errorf("%s value %v is too large for MILLISECONDS (must be at most %d)",
+					clause, rel.Value, int64(MaxRangeMillisec))
 				return err
 			}
+		case parser.Minutes:
+			if rel.Value > MaxRangeMin {
+				err := fmt.Errorf("%s value %v is too large for MINUTES (must be at most %d)",
+					clause, rel.Value, int64(MaxRangeMin))
+				return err
+			}
+		}
+		if err := validateSlide(&rel); err != nil {
+			return err
+		}
+		if err := validateWindowType(&rel, len(s.Relations)); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// validateSlide checks that the SLIDE clause of a window (if any) is
+// compatible with its RANGE, i.e., it must be positive, must use the
+// same kind of unit (tuples or time) and must not exceed the RANGE.
+func validateSlide(rel *parser.AliasedStreamWindowAST) error {
+	slide := rel.Slide
+	if slide.Unit == parser.UnspecifiedIntervalUnit {
+		return nil
+	}
+	if slide.Value <= 0 {
+		err := fmt.Errorf("number in SLIDE clause must be positive, not %v", slide.Value)
+		return err
+	}
+	if (slide.Unit == parser.Tuples) != (rel.Unit == parser.Tuples) {
+		err := fmt.Errorf("SLIDE unit %s cannot be used with RANGE unit %s",
+			slide.Unit, rel.Unit)
+		return err
+	}
+	if slide.Unit == parser.Tuples && math.Trunc(slide.Value) != slide.Value {
+		err := fmt.Errorf("number in SLIDE clause must be integral "+
+			"for TUPLES, not %v", slide.Value)
+		return err
+	}
+	if intervalInSeconds(slide) > intervalInSeconds(rel.IntervalAST) {
+		err := fmt.Errorf("SLIDE %v %s must not be larger than RANGE %v %s",
+			slide.Value, slide.Unit, rel.Value, rel.Unit)
+		return err
+	}
+	return nil
+}
+
+// validateWindowType checks that a TUMBLING window has a time-based
+// RANGE, no SLIDE and is the only input relation, that a SESSION window
+// is the only input relation, and that ALLOWED LATENESS is only used
+// with TUMBLING windows.
+func validateWindowType(rel *parser.AliasedStreamWindowAST, numRels int) error {
+	lateness := rel.AllowedLateness
+	if rel.WindowType == parser.SessionWindow {
+		if numRels > 1 {
+			return fmt.Errorf("SESSION windows cannot be used with more than one input relation")
+		}
+		if rel.Unit == parser.Tuples {
+			// the parser should not allow this, but we check anyway
+			return fmt.Errorf("SESSION windows must have a time-based GAP, not TUPLES")
+		}
+	}
+	if rel.WindowType != parser.TumblingWindow {
+		if lateness.Unit != parser.UnspecifiedIntervalUnit {
+			return fmt.Errorf("ALLOWED LATENESS can only be used with TUMBLING windows")
+		}
+		return nil
+	}
+	if rel.Unit == parser.Tuples {
+		return fmt.Errorf("TUMBLING windows must have a time-based RANGE, not TUPLES")
+	}
+	if rel.Slide.Unit != parser.UnspecifiedIntervalUnit {
+		return fmt.Errorf("TUMBLING windows cannot have a SLIDE clause")
+	}
+	if numRels > 1 {
+		return fmt.Errorf("TUMBLING windows cannot be used with more than one input relation")
+	}
+	if lateness.Value < 0 {
+		err := fmt.Errorf("number in ALLOWED LATENESS clause must not be negative, not %v",
+			lateness.Value)
+		return err
+	}
+	return nil
+}
+
+// intervalInSeconds returns the length of a time-based interval in
+// seconds. For a TUPLES interval, the number of tuples is returned.
+func intervalInSeconds(i parser.IntervalAST) float64 {
+	switch i.Unit {
+	case parser.Milliseconds:
+		return i.Value / 1000
+	case parser.Minutes:
+		return i.Value * 60
+	}
+	return i.Value
+}
+
+// intervalDuration returns the length of a time-based interval as
+// a time.Duration.
+func intervalDuration(i parser.IntervalAST) time.Duration {
+	return time.Duration(intervalInSeconds(i) * float64(time.Second))
+}
+
 // LogicalOptimize does nothing at the moment. In the future, logical
 // optimizations (evaluation of foldable terms etc.) can be added here.
 func (lp *LogicalPlan) LogicalOptimize() (*LogicalPlan, error) {
@@ -528,12 +923,38 @@ func (lp *LogicalPlan) MakePhysicalPlan(reg udf.FunctionRegistry) (PhysicalPlan,
 	   > and generates one or more physical plans, using physical operators
 	   > that match the Spark execution engine.
 	*/
+	name, newPlan, err := lp.choosePhysicalPlan(reg)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := newPlan(lp, reg)
+	if err != nil {
+		return nil, err
+	}
+	// other plans apply ORDER BY and LIMIT before computing the
+	// results to be emitted
+	if name == "filterPlan" && lp.isOrdered() {
+		return newOrderedPlan(plan, lp, reg)
+	}
+	return plan, nil
+}
+
+// choosePhysicalPlan returns the name and the constructor of the
+// physical plan that is used for the statement.
+func (lp *LogicalPlan) choosePhysicalPlan(reg udf.FunctionRegistry) (string,
+	func(*LogicalPlan, udf.FunctionRegistry) (PhysicalPlan, error), error) {
 	if CanBuildFilterPlan(lp, reg) {
-		return NewFilterPlan(lp, reg)
+		return "filterPlan", NewFilterPlan, nil
 	} else if CanBuildDefaultSelectExecutionPlan(lp, reg) {
-		return NewDefaultSelectExecutionPlan(lp, reg)
+		return "defaultSelectExecutionPlan", NewDefaultSelectExecutionPlan, nil
 	} else if CanBuildGroupbyExecutionPlan(lp, reg) {
-		return NewGroupbyExecutionPlan(lp, reg)
+		return "groupbyExecutionPlan", NewGroupbyExecutionPlan, nil
 	}
-	return nil, fmt.Errorf("no plan can deal with such a statement")
+	return "", nil, fmt.Errorf("no plan can deal with such a statement")
+}
+
+// isOrdered returns true if the physical plan has to be wrapped by
+// an orderedPlan.
+func (lp *LogicalPlan) isOrdered() bool {
+	return len(lp.Ordering) > 0 || lp.Limit >= 0
 }
@@ -46,15 +46,16 @@ type analyzeTest struct {
 
 func TestRelationChecker(t *testing.T) {
 	r := parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}
+	none := parser.IntervalAST{}
 	singleFrom := parser.WindowedFromAST{
 		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil}, r, 0, parser.Wait}, ""},
-		},
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, ""},
+		}, nil, nil,
 	}
 	singleFromAlias := parser.WindowedFromAST{
 		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil}, r, 0, parser.Wait}, "t"},
-		},
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, "t"},
+		}, nil, nil,
 	}
 	two := parser.NumericLiteral{2}
 	a := parser.RowValue{"", "a"}
@@ -121,7 +122,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{a}},
-					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, ""},
@@ -179,7 +180,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{a}},
-					[]parser.SortedExpressionAST{{tB, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{tB, parser.UnspecifiedKeyword}}, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, "cannot refer to relations"},
@@ -187,7 +188,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{tA}},
-					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}, nil},
 			}},
 			WindowedFromAST: singleFrom,
 		}, "cannot refer to relations"},
@@ -299,79 +300,79 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{a}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}, nil},
 		}, ""},
 		// SELECT 2   FROM t GROUP BY 2        -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{two}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}, nil},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY 2        -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}, nil},
 		}, ""},
 		// SELECT a   FROM t GROUP BY b        -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{a}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}, nil},
 		}, ""},
 		// SELECT a   FROM t GROUP BY b, c     -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{a}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b, c}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b, c}, nil},
 		}, ""},
 		// SELECT 2   FROM t GROUP BY b        -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{two}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}, nil},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY b        -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}, nil},
 		}, "cannot refer to relations"},
 		// SELECT a   FROM t GROUP BY t:b      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{a}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}, nil},
 		}, "cannot refer to relations"},
 		// SELECT 2   FROM t GROUP BY t:b      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{two}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}, nil},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY t:b      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}, nil},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY t:b, t:c -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB, tC}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB, tC}, nil},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY b, t:b   -> NG (same table with multiple aliases)
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b, tB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b, tB}, nil},
 		}, "cannot refer to relations"},
 		// SELECT 2   FROM t GROUP BY x:b      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{two}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{xB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{xB}, nil},
 		}, "cannot refer to relation 'x' when using only 't'"},
 
 		////////// HAVING //////////////
@@ -525,6 +526,7 @@ func TestRelationChecker(t *testing.T) {
 
 func TestRelationAliasing(t *testing.T) {
 	r := parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}
+	none := parser.IntervalAST{}
 	two := parser.NumericLiteral{2}
 	proj := parser.ProjectionsAST{[]parser.Expression{two}}
 
@@ -534,52 +536,52 @@ func TestRelationAliasing(t *testing.T) {
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, ""},
+				}, nil, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b         -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, "b"},
+				}, nil, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b, a      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, ""},
+				}, nil, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b, c AS a -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil}, r, 0, parser.Wait}, "a"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, "a"},
+				}, nil, nil},
 		}, ""},
 		// SELECT 2 FROM a, a           -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, ""},
+				}, nil, nil},
 		}, "cannot use relations"},
 		// SELECT 2 FROM a, b AS a      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil}, r, 0, parser.Wait}, "a"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil, nil}, r, none, parser.UnspecifiedWindowType, none, 0, parser.Wait}, "a"},
+				}, nil, nil},
 		}, "cannot use relations"},
 	}
 
@@ -971,6 +973,51 @@ func TestRangeChecker(t *testing.T) {
 		{"a FROM x [RANGE 86400000 MILLISECONDS]", ""},
 		{"a FROM x [RANGE 86400000.01 MILLISECONDS]",
 			"RANGE value 8.640000001e+07 is too large for MILLISECONDS (must be at most 86400000)"},
+		// SLIDE
+		{"a FROM x [RANGE 5 TUPLES SLIDE 1 TUPLES]", ""},
+		{"a FROM x [RANGE 5 TUPLES SLIDE 5 TUPLES]", ""},
+		{"a FROM x [RANGE 5 TUPLES SLIDE 6 TUPLES]",
+			"SLIDE 6 TUPLES must not be larger than RANGE 5 TUPLES"},
+		{"a FROM x [RANGE 5 TUPLES SLIDE 0 TUPLES]",
+			"number in SLIDE clause must be positive, not 0"},
+		{"a FROM x [RANGE 5 TUPLES SLIDE 1 SECONDS]",
+			"SLIDE unit SECONDS cannot be used with RANGE unit TUPLES"},
+		{"a FROM x [RANGE 5 SECONDS SLIDE 1 TUPLES]",
+			"SLIDE unit TUPLES cannot be used with RANGE unit SECONDS"},
+		{"a FROM x [RANGE 5 SECONDS SLIDE 500 MILLISECONDS]", ""},
+		{"a FROM x [RANGE 5 SECONDS SLIDE 5000 MILLISECONDS]", ""},
+		{"a FROM x [RANGE 5 SECONDS SLIDE 5001 MILLISECONDS]",
+			"SLIDE 5001 MILLISECONDS must not be larger than RANGE 5 SECONDS"},
+		{"a FROM x [RANGE 500 MILLISECONDS SLIDE 0.5 SECONDS]", ""},
+		// MINUTES
+		{"a FROM x [RANGE 1 MINUTES]", ""},
+		{"a FROM x [RANGE 1440 MINUTES]", ""},
+		{"a FROM x [RANGE 1440.01 MINUTES]",
+			"RANGE value 1440.01 is too large for MINUTES (must be at most 1440)"},
+		{"a FROM x [RANGE 1 MINUTES SLIDE 61 SECONDS]",
+			"SLIDE 61 SECONDS must not be larger than RANGE 1 MINUTES"},
+		// TUMBLING
+		{"a FROM x [RANGE 1 MINUTES TUMBLING]", ""},
+		{"a FROM x [RANGE 1 MINUTES TUMBLING, ALLOWED LATENESS 5 SECONDS]", ""},
+		{"a FROM x [RANGE 1 MINUTES TUMBLING, ALLOWED LATENESS 0 SECONDS]", ""},
+		{"a FROM x [RANGE 1 MINUTES TUMBLING, ALLOWED LATENESS -1 SECONDS]",
+			"number in ALLOWED LATENESS clause must not be negative, not -1"},
+		{"a FROM x [RANGE 5 TUPLES TUMBLING]",
+			"TUMBLING windows must have a time-based RANGE, not TUPLES"},
+		{"a FROM x [RANGE 5 SECONDS SLIDE 1 SECONDS TUMBLING]",
+			"TUMBLING windows cannot have a SLIDE clause"},
+		{"a FROM x [RANGE 5 SECONDS, ALLOWED LATENESS 1 SECONDS]",
+			"ALLOWED LATENESS can only be used with TUMBLING windows"},
+		{"x:a FROM x [RANGE 5 SECONDS TUMBLING], y [RANGE 5 SECONDS]",
+			"TUMBLING windows cannot be used with more than one input relation"},
+		// SESSION
+		{"count(a) FROM x [SESSION GAP 5 SECONDS]", ""},
+		{"count(a) FROM x [SESSION GAP 0 SECONDS]",
+			"number in GAP clause must be positive, not 0"},
+		{"count(a) FROM x [SESSION GAP 86401 SECONDS]",
+			"GAP value 86401 is too large for SECONDS (must be at most 86400)"},
+		{"count(x:a) FROM x [SESSION GAP 5 SECONDS], y [RANGE 5 SECONDS]",
+			"SESSION windows cannot be used with more than one input relation"},
 	}
 
 	for _, testCase := range testCases {
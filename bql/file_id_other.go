@@ -0,0 +1,15 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package bql
+
+import (
+	"os"
+)
+
+// fileID always returns 0 because inode numbers aren't available on this
+// platform. Files replaced at the same path are only detected when they
+// become smaller.
+func fileID(fi os.FileInfo) uint64 {
+	return 0
+}
@@ -0,0 +1,108 @@
+package bql
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+)
+
+// followReader reads a file like "tail -F". When it reaches the end of the
+// file, it waits for data to be appended to the file instead of returning
+// io.EOF. It reads the file from the beginning again when the file is
+// truncated, and it reopens the path when the file is rotated, that is, when
+// another file is created at the path.
+type followReader struct {
+	path         string
+	f            *os.File
+	offset       int64
+	pollInterval time.Duration
+	stopCh       <-chan struct{}
+}
+
+func openFollowReader(path string, pollInterval time.Duration, stopCh <-chan struct{}) (*followReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &followReader{
+		path:         path,
+		f:            f,
+		pollInterval: pollInterval,
+		stopCh:       stopCh,
+	}, nil
+}
+
+// Read reads data from the file. It blocks until some data is available. It
+// returns core.ErrSourceStopped when stopCh is closed while it's waiting.
+func (r *followReader) Read(p []byte) (int, error) {
+	for {
+		n, err := r.f.Read(p)
+		r.offset += int64(n)
+		if n > 0 {
+			return n, nil
+		}
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+
+		changed, err := r.checkFile()
+		if err != nil {
+			return 0, err
+		}
+		if changed {
+			continue
+		}
+
+		select {
+		case <-r.stopCh:
+			return 0, core.ErrSourceStopped
+		case <-time.After(r.pollInterval):
+		}
+	}
+}
+
+// checkFile handles truncation and rotation of the file. It returns true when
+// the reader should read the file again without waiting.
+func (r *followReader) checkFile() (bool, error) {
+	fi, err := r.f.Stat()
+	if err != nil {
+		return false, err
+	}
+	if fi.Size() < r.offset {
+		if _, err := r.f.Seek(0, io.SeekStart); err != nil {
+			return false, err
+		}
+		r.offset = 0
+		return true, nil
+	}
+
+	pfi, err := os.Stat(r.path)
+	if err != nil {
+		// The file might be being rotated. The new file will be opened
+		// once it's created.
+		return false, nil
+	}
+	if os.SameFile(fi, pfi) {
+		return false, nil
+	}
+
+	// Data written to the old file after the last read must be read before
+	// switching to the new file.
+	if fi.Size() > r.offset {
+		return true, nil
+	}
+	f, err := os.Open(r.path)
+	if err != nil {
+		return false, nil
+	}
+	r.f.Close()
+	r.f = f
+	r.offset = 0
+	return true, nil
+}
+
+func (r *followReader) Close() error {
+	return r.f.Close()
+}
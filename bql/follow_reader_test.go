@@ -0,0 +1,111 @@
+package bql
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestFileSourceFollow(t *testing.T) {
+	ctx := core.NewContext(nil)
+	Convey("Given a file source following a file", t, func() {
+		tdir, err := ioutil.TempDir("", "test_sb_follow")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(tdir)
+		})
+		fn := filepath.Join(tdir, "in.jsonl")
+		So(ioutil.WriteFile(fn, []byte("{\"a\":1}\n{\"a\":2}\n"), 0644), ShouldBeNil)
+
+		appendLine := func(path, line string) {
+			f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+			So(err, ShouldBeNil)
+			defer f.Close()
+			_, err = fmt.Fprintln(f, line)
+			So(err, ShouldBeNil)
+		}
+
+		s, err := createFileSource(ctx, &IOParams{}, data.Map{
+			"path":          data.String(fn),
+			"follow":        data.True,
+			"poll_interval": data.Float(0.01),
+		})
+		So(err, ShouldBeNil)
+		w := newRecordCollectingWriter()
+		ch := make(chan error, 1)
+		go func() {
+			ch <- s.GenerateStream(ctx, w)
+		}()
+		Reset(func() {
+			s.Stop(ctx)
+		})
+
+		Convey("When the file hasn't been changed", func() {
+			Convey("Then it should emit existing records", func() {
+				So(w.wait(2), ShouldResemble, []data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}})
+			})
+
+			Convey("Then it should stop", func() {
+				w.wait(2)
+				So(s.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			})
+		})
+
+		Convey("When appending a record to the file", func() {
+			w.wait(2)
+			appendLine(fn, `{"a":3}`)
+
+			Convey("Then it should be emitted", func() {
+				So(w.wait(3)[2], ShouldResemble, data.Map{"a": data.Int(3)})
+			})
+		})
+
+		Convey("When the file is truncated", func() {
+			w.wait(2)
+			So(ioutil.WriteFile(fn, []byte("{\"a\":4}\n"), 0644), ShouldBeNil)
+
+			Convey("Then it should read the file from the beginning", func() {
+				So(w.wait(3)[2], ShouldResemble, data.Map{"a": data.Int(4)})
+			})
+		})
+
+		Convey("When the file is rotated", func() {
+			w.wait(2)
+			So(os.Rename(fn, fn+".1"), ShouldBeNil)
+			appendLine(fn+".1", `{"a":5}`)
+			appendLine(fn, `{"a":6}`)
+
+			Convey("Then it should read the rest of the old file and the new file", func() {
+				ms := w.wait(4)
+				So(ms[2], ShouldResemble, data.Map{"a": data.Int(5)})
+				So(ms[3], ShouldResemble, data.Map{"a": data.Int(6)})
+			})
+		})
+	})
+
+	Convey("Given an invalid combination of parameters", t, func() {
+		cases := []data.Map{
+			{"path": data.String("a.jsonl"), "follow": data.True, "repeat": data.Int(1)},
+			{"path": data.String("a.jsonl.gz"), "follow": data.True},
+			{"path": data.String("a.jsonl"), "follow": data.True, "poll_interval": data.Int(0)},
+		}
+		for _, params := range cases {
+			params := params
+
+			Convey(fmt.Sprintf("When creating a file source with %v", params), func() {
+				_, err := createFileSource(ctx, &IOParams{}, params)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
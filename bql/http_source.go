@@ -0,0 +1,206 @@
+package bql
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// ErrSourceBusy is returned from TupleReceiver.Receive when the source is
+// paused or doesn't have enough space in its queue.
+var ErrSourceBusy = errors.New("the source is paused or its queue is full")
+
+// ErrTooManyTuples is returned from TupleReceiver.Receive when the number of
+// tuples exceeds the size of the queue of the source. Unlike ErrSourceBusy,
+// retrying the same request never succeeds.
+var ErrTooManyTuples = errors.New("the number of tuples exceeds the queue size of the source")
+
+// TupleReceiver is implemented by sources receiving tuples pushed from outside
+// of a topology, such as "http" source which receives tuples posted to the
+// server.
+type TupleReceiver interface {
+	// Receive adds tuples to the queue of the source. Either all tuples or no
+	// tuple is added. It returns ErrSourceBusy when the source is paused or
+	// the queue doesn't have enough space, and ErrTooManyTuples when the
+	// queue can never have all tuples. It returns core.ErrSourceStopped when
+	// the source is already stopped.
+	Receive(ts []*core.Tuple) error
+
+	// CanReceive returns ErrSourceBusy or core.ErrSourceStopped when Receive
+	// would certainly fail regardless of tuples. It's used to reject requests
+	// before parsing them. Receive can still fail even if it returns nil.
+	CanReceive() error
+}
+
+// AsTupleReceiver returns the TupleReceiver of a Source. It returns false when
+// the source doesn't receive tuples. Sources wrapped by the topology builder,
+// e.g. to validate schemas, are unwrapped.
+func AsTupleReceiver(s core.Source) (TupleReceiver, bool) {
+	s, ok := findSource(s, func(s core.Source) bool {
+		_, ok := s.(TupleReceiver)
+		return ok
+	})
+	if !ok {
+		return nil, false
+	}
+	return s.(TupleReceiver), true
+}
+
+// findSource returns the first source for which f returns true, looking into
+// sources wrapped by the topology builder from the outermost one.
+func findSource(s core.Source, f func(core.Source) bool) (core.Source, bool) {
+	for {
+		if f(s) {
+			return s, true
+		}
+		u, ok := s.(interface {
+			unwrapSource() core.Source
+		})
+		if !ok {
+			return nil, false
+		}
+		s = u.unwrapSource()
+	}
+}
+
+// httpSource emits tuples pushed by TupleReceiver.Receive. The server calls
+// it when tuples are posted to the source.
+type httpSource struct {
+	queue  chan *core.Tuple
+	stopCh chan struct{}
+
+	m       sync.Mutex
+	paused  bool
+	stopped bool
+
+	// changed is closed and recreated when the state of the source changes.
+	changed chan struct{}
+}
+
+var (
+	_ TupleReceiver  = &httpSource{}
+	_ core.Resumable = &httpSource{}
+)
+
+func (s *httpSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	for {
+		s.m.Lock()
+		paused, changed := s.paused, s.changed
+		s.m.Unlock()
+
+		var q <-chan *core.Tuple
+		if !paused {
+			q = s.queue
+		}
+		select {
+		case <-s.stopCh:
+			return nil
+		case <-changed:
+		case t := <-q:
+			if err := w.Write(ctx, t); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *httpSource) Receive(ts []*core.Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if err := s.canReceive(); err != nil {
+		return err
+	}
+	if len(ts) > cap(s.queue) {
+		return ErrTooManyTuples
+	}
+	// Because only GenerateStream takes tuples from the queue while the lock
+	// is held, all tuples can be added without blocking when this check passes.
+	if len(s.queue)+len(ts) > cap(s.queue) {
+		return ErrSourceBusy
+	}
+	for _, t := range ts {
+		s.queue <- t
+	}
+	return nil
+}
+
+func (s *httpSource) CanReceive() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.canReceive()
+}
+
+// canReceive must be called while s.m is locked.
+func (s *httpSource) canReceive() error {
+	if s.stopped {
+		return core.ErrSourceStopped
+	}
+	if s.paused || len(s.queue) == cap(s.queue) {
+		return ErrSourceBusy
+	}
+	return nil
+}
+
+func (s *httpSource) Pause(ctx *core.Context) error {
+	s.setPaused(true)
+	return nil
+}
+
+func (s *httpSource) Resume(ctx *core.Context) error {
+	s.setPaused(false)
+	return nil
+}
+
+func (s *httpSource) setPaused(paused bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.paused = paused
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func (s *httpSource) Stop(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if !s.stopped {
+		s.stopped = true
+		close(s.stopCh)
+	}
+	return nil
+}
+
+func (s *httpSource) Status() data.Map {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return data.Map{
+		"queue_size":        data.Int(cap(s.queue)),
+		"num_queued_tuples": data.Int(len(s.queue)),
+	}
+}
+
+func createHTTPSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	v := &struct {
+		QueueSize int
+	}{
+		QueueSize: 1024,
+	}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+	if v.QueueSize <= 0 {
+		return nil, fmt.Errorf("'queue_size' parameter must be positive: %v", v.QueueSize)
+	}
+	return &httpSource{
+		queue:   make(chan *core.Tuple, v.QueueSize),
+		stopCh:  make(chan struct{}),
+		changed: make(chan struct{}),
+	}, nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("http", SourceCreatorFunc(createHTTPSource))
+}
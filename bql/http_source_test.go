@@ -0,0 +1,124 @@
+package bql
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestHTTPSource(t *testing.T) {
+	tuples := func(is ...int) []*core.Tuple {
+		ts := make([]*core.Tuple, len(is))
+		for i, v := range is {
+			ts[i] = core.NewTuple(data.Map{"int": data.Int(v)})
+		}
+		return ts
+	}
+
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		receiver := func(name string) TupleReceiver {
+			src, err := dt.Source(name)
+			So(err, ShouldBeNil)
+			r, ok := AsTupleReceiver(src.Source())
+			So(ok, ShouldBeTrue)
+			return r
+		}
+
+		Convey("When creating an http source", func() {
+			So(addBQLToTopology(tb, `
+				CREATE SOURCE s TYPE http WITH queue_size=3;
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM s;
+			`), ShouldBeNil)
+			r := receiver("s")
+			sink, err := dt.Sink("k")
+			So(err, ShouldBeNil)
+			si := sink.Sink().(*tupleCollectorSink)
+
+			Convey("Then received tuples should be emitted", func() {
+				So(r.Receive(tuples(1, 2)), ShouldBeNil)
+				So(r.Receive(tuples(3)), ShouldBeNil)
+				si.Wait(3)
+				for i := 0; i < 3; i++ {
+					So(si.get(i).Data, ShouldResemble, data.Map{"int": data.Int(i + 1)})
+				}
+			})
+
+			Convey("Then it should reject tuples exceeding the queue size", func() {
+				So(r.Receive(tuples(1, 2, 3, 4)), ShouldEqual, ErrTooManyTuples)
+			})
+
+			Convey("Then it should be able to receive tuples", func() {
+				So(r.CanReceive(), ShouldBeNil)
+			})
+
+			Convey("And pausing the source", func() {
+				So(addBQLToTopology(tb, `PAUSE SOURCE s;`), ShouldBeNil)
+
+				Convey("Then it should reject tuples", func() {
+					So(r.CanReceive(), ShouldEqual, ErrSourceBusy)
+					So(r.Receive(tuples(1)), ShouldEqual, ErrSourceBusy)
+				})
+
+				Convey("Then it should accept tuples after resuming the source", func() {
+					So(addBQLToTopology(tb, `RESUME SOURCE s;`), ShouldBeNil)
+					So(r.Receive(tuples(1)), ShouldBeNil)
+					si.Wait(1)
+					So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Int(1)})
+				})
+
+				Convey("Then it should stop", func() {
+					So(addBQLToTopology(tb, `DROP SOURCE s;`), ShouldBeNil)
+					So(r.CanReceive(), ShouldEqual, core.ErrSourceStopped)
+					So(r.Receive(tuples(1)), ShouldEqual, core.ErrSourceStopped)
+				})
+			})
+		})
+
+		Convey("When creating an http source with a schema", func() {
+			So(addBQLToTopology(tb, `
+				CREATE SOURCE s TYPE http SCHEMA (int string);
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM s;
+			`), ShouldBeNil)
+			r := receiver("s")
+
+			Convey("Then received tuples should be validated", func() {
+				So(r.Receive(tuples(1)), ShouldBeNil)
+				sink, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(1)
+				So(si.get(0).Data, ShouldResemble, data.Map{"int": data.String("1")})
+			})
+		})
+
+		Convey("When creating a source which doesn't receive tuples", func() {
+			So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy;`), ShouldBeNil)
+			src, err := dt.Source("s")
+			So(err, ShouldBeNil)
+
+			Convey("Then it should not be a TupleReceiver", func() {
+				_, ok := AsTupleReceiver(src.Source())
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When creating an http source with an invalid queue size", func() {
+			err := addBQLToTopology(tb, `CREATE SOURCE s TYPE http WITH queue_size=0;`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,474 @@
+package bql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+)
+
+// LintSeverity is the severity of an issue found by Lint.
+type LintSeverity int
+
+const (
+	// LintError means that the statement would fail when it's added to
+	// the topology.
+	LintError LintSeverity = iota
+
+	// LintWarning means that the statement can be added to the topology
+	// but it's probably a mistake.
+	LintWarning
+)
+
+func (s LintSeverity) String() string {
+	switch s {
+	case LintError:
+		return "error"
+	case LintWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// LintIssue is a problem found in a statement by Lint.
+type LintIssue struct {
+	// Index is the index of the statement having the issue.
+	Index    int
+	Severity LintSeverity
+	Message  string
+}
+
+func (i *LintIssue) String() string {
+	return fmt.Sprintf("statement #%v: %v: %v", i.Index+1, i.Severity, i.Message)
+}
+
+// Lint checks statements as if they were added to the topology by AddStmt
+// in the given order, but it doesn't create any node, state, view, or
+// function. Nodes, states, views, and functions which the topology and the
+// builder already have can be referred to by the statements. Issues are
+// returned in the order of statements.
+//
+// Lint reports the following errors:
+//
+//	* references to unknown nodes, states, views, and functions
+//	* unknown types of sources, sinks, and UDSs
+//	* unknown UDSFs
+//	* duplicated names
+//	* self loops of streams and views
+//	* SELECT statements from which execution plans cannot be created, e.g.
+//	  misuse of aggregate functions and columns missing in GROUP BY
+//	* statements which cannot be used in a transaction, nested BEGIN,
+//	  COMMIT or ROLLBACK without BEGIN, and transactions left open
+//
+// It also warns about sources and streams which are read by nothing and
+// sinks into which nothing is inserted. A UDSF is assumed to read a node
+// when one of its arguments is a string literal having the name of it.
+//
+// Parameters of sources, sinks, and states aren't validated since they can
+// only be validated by creating them.
+func (tb *TopologyBuilder) Lint(stmts []interface{}) []*LintIssue {
+	l := newLinter(tb)
+	for i, stmt := range stmts {
+		l.index = i
+		if l.lintTransaction(stmt) {
+			continue
+		}
+		l.lintStmt(stmt)
+	}
+	if l.txIndex >= 0 {
+		l.index = l.txIndex
+		l.report(LintError, "the transaction started by BEGIN isn't committed")
+	}
+	l.checkUnused()
+	sort.Stable(lintIssues(l.issues))
+	return l.issues
+}
+
+type lintIssues []*LintIssue
+
+func (s lintIssues) Len() int           { return len(s) }
+func (s lintIssues) Less(i, j int) bool { return s[i].Index < s[j].Index }
+func (s lintIssues) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type lintNode struct {
+	name     string
+	nodeType core.NodeType
+
+	// index is the index of the statement creating the node. It's -1 if
+	// the node already exists in the topology.
+	index int
+
+	// used is true when the node is read by a stream or a sink, or when
+	// a tuple is inserted into the sink.
+	used bool
+}
+
+type linter struct {
+	tb     *TopologyBuilder
+	reg    udf.FunctionManager
+	index  int
+	issues []*LintIssue
+
+	// nodes, views, and states have lower case names as their keys.
+	nodes  map[string]*lintNode
+	views  map[string]parser.SelectStmt
+	states map[string]bool
+
+	// txIndex is the index of BEGIN starting the transaction in progress.
+	// It's -1 when there's no transaction.
+	txIndex int
+}
+
+func newLinter(tb *TopologyBuilder) *linter {
+	l := &linter{
+		tb:      tb,
+		nodes:   map[string]*lintNode{},
+		views:   map[string]parser.SelectStmt{},
+		states:  map[string]bool{},
+		txIndex: -1,
+	}
+
+	// functions are copied because CREATE FUNCTION must not modify the
+	// registry of the builder. Functions of a registry which cannot list
+	// them are unknown to the linter.
+	l.reg = udf.NewDefaultFunctionRegistry(tb.topology.Context())
+	if lister, ok := tb.Reg.(udf.FunctionLister); ok {
+		if funcs, err := lister.List(); err == nil {
+			for name, f := range funcs {
+				l.reg.Register(name, f)
+			}
+		}
+	}
+
+	for name, n := range tb.topology.Nodes() {
+		l.nodes[strings.ToLower(name)] = &lintNode{
+			name:     name,
+			nodeType: n.Type(),
+			index:    -1,
+			used:     true,
+		}
+	}
+	tb.viewMutex.RLock()
+	for name, v := range tb.views {
+		l.views[name] = v
+	}
+	tb.viewMutex.RUnlock()
+	if states, err := tb.topology.Context().SharedStates.List(); err == nil {
+		for name := range states {
+			l.states[strings.ToLower(name)] = true
+		}
+	}
+	return l
+}
+
+func (l *linter) report(s LintSeverity, format string, args ...interface{}) {
+	l.issues = append(l.issues, &LintIssue{
+		Index:    l.index,
+		Severity: s,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// lintTransaction checks BEGIN, COMMIT, and ROLLBACK, and statements in a
+// transaction. It returns true when the statement is BEGIN, COMMIT, or
+// ROLLBACK.
+func (l *linter) lintTransaction(stmt interface{}) bool {
+	switch stmt.(type) {
+	case parser.BeginStmt:
+		if l.txIndex >= 0 {
+			l.report(LintError, "a transaction is already in progress")
+			return true
+		}
+		l.txIndex = l.index
+		return true
+
+	case parser.CommitStmt, parser.RollbackStmt:
+		if l.txIndex < 0 {
+			l.report(LintError, "%v without BEGIN", stmt)
+		}
+		l.txIndex = -1
+		return true
+	}
+
+	if l.txIndex >= 0 {
+		if err := validateTransactionalStmt(stmt); err != nil {
+			l.report(LintError, "%v", err)
+		}
+	}
+	return false
+}
+
+func (l *linter) lintStmt(stmt interface{}) {
+	switch stmt := stmt.(type) {
+	case parser.CreateSourceStmt:
+		if _, err := l.tb.SourceCreators.Lookup(string(stmt.Type)); err != nil {
+			l.report(LintError, "%v", err)
+		}
+		l.addNode(string(stmt.Name), core.NTSource)
+
+	case parser.CreateStreamAsSelectStmt:
+		name := string(stmt.Name)
+		l.lintSelect(&stmt.Select, name)
+		l.addNode(name, core.NTBox)
+
+	case parser.CreateStreamAsSelectUnionStmt:
+		name := string(stmt.Name)
+		for i := range stmt.Selects {
+			l.lintSelect(&stmt.Selects[i], name)
+		}
+		l.addNode(name, core.NTBox)
+
+	case parser.CreateViewStmt:
+		name := string(stmt.Name)
+		l.lintSelect(&stmt.Select, name)
+		if l.checkNewName(name) {
+			l.views[strings.ToLower(name)] = expandViews(stmt.Select, l.views)
+		}
+
+	case parser.SelectStmt:
+		l.lintSelect(&stmt, "")
+
+	case parser.SelectUnionStmt:
+		for i := range stmt.Selects {
+			l.lintSelect(&stmt.Selects[i], "")
+		}
+
+	case parser.ExplainStmt:
+		// EXPLAIN doesn't create a stream
+		switch s := stmt.Stmt.(type) {
+		case parser.CreateStreamAsSelectStmt:
+			l.lintSelect(&s.Select, string(s.Name))
+		case parser.CreateStreamAsSelectUnionStmt:
+			for i := range s.Selects {
+				l.lintSelect(&s.Selects[i], string(s.Name))
+			}
+		default:
+			l.lintStmt(s)
+		}
+
+	case parser.CreateSinkStmt:
+		if _, err := l.tb.SinkCreators.Lookup(string(stmt.Type)); err != nil {
+			l.report(LintError, "%v", err)
+		}
+		l.addNode(string(stmt.Name), core.NTSink)
+
+	case parser.InsertIntoFromStmt:
+		if sink := l.node(string(stmt.Sink), core.NTSink); sink != nil {
+			sink.used = true
+		}
+		l.readNode(string(stmt.Input))
+
+	case parser.UpdateSourceStmt:
+		l.node(string(stmt.Name), core.NTSource)
+	case parser.PauseSourceStmt:
+		l.node(string(stmt.Source), core.NTSource)
+	case parser.ResumeSourceStmt:
+		l.node(string(stmt.Source), core.NTSource)
+	case parser.RewindSourceStmt:
+		l.node(string(stmt.Source), core.NTSource)
+	case parser.UpdateSinkStmt:
+		l.node(string(stmt.Name), core.NTSink)
+
+	case parser.DropSourceStmt:
+		l.dropNode(string(stmt.Source), core.NTSource)
+	case parser.DropStreamStmt:
+		l.dropNode(string(stmt.Stream), core.NTBox)
+	case parser.DropSinkStmt:
+		l.dropNode(string(stmt.Sink), core.NTSink)
+
+	case parser.DropViewStmt:
+		key := strings.ToLower(string(stmt.View))
+		if _, ok := l.views[key]; !ok {
+			l.report(LintError, "view '%v' is unknown", stmt.View)
+		}
+		delete(l.views, key)
+
+	case parser.CreateStateStmt:
+		l.createState(string(stmt.Name), string(stmt.Type))
+	case parser.LoadStateStmt:
+		l.createState(string(stmt.Name), string(stmt.Type))
+	case parser.LoadStateOrCreateStmt:
+		l.createState(string(stmt.Name), string(stmt.Type))
+	case parser.UpdateStateStmt:
+		l.state(string(stmt.Name))
+	case parser.SaveStateStmt:
+		l.state(string(stmt.Name))
+	case parser.DropStateStmt:
+		if l.state(string(stmt.State)) {
+			delete(l.states, strings.ToLower(string(stmt.State)))
+		}
+
+	case parser.CreateFunctionStmt:
+		params := make([]string, len(stmt.Params))
+		for i, p := range stmt.Params {
+			params[i] = string(p)
+		}
+		f, err := execution.NewExpressionUDF(params, stmt.Body, l.reg)
+		if err != nil {
+			l.report(LintError, "%v", err)
+			return
+		}
+		if err := l.reg.Register(string(stmt.Name), f); err != nil {
+			l.report(LintError, "%v", err)
+		}
+
+	case parser.DropFunctionStmt:
+		if err := dropExpressionUDF(l.reg, string(stmt.Function)); err != nil {
+			l.report(LintError, "%v", err)
+		}
+	}
+	// Other statements such as EVAL don't refer to nodes. Statements in
+	// a transaction are checked as if the transaction were committed.
+}
+
+// lintSelect checks references to nodes and UDSFs in the statement and
+// validates its execution plan. name is the name of the stream or the
+// view defined by the statement, and it's empty for an ad hoc SELECT.
+func (l *linter) lintSelect(stmt *parser.SelectStmt, name string) {
+	l.lintRelations(stmt)
+
+	sel := expandViews(*stmt, l.views)
+	if name != "" && selectReadsFrom(&sel, name) {
+		l.report(LintError, "'%v' contains a selfloop", name)
+		return
+	}
+	if err := validateSelectStmt(&sel, l.reg); err != nil {
+		l.report(LintError, "%v", err)
+	}
+}
+
+func (l *linter) lintRelations(stmt *parser.SelectStmt) {
+	for _, rel := range stmt.Relations {
+		switch rel.Type {
+		case parser.ActualStream:
+			if _, ok := l.views[strings.ToLower(rel.Name)]; ok {
+				continue
+			}
+			l.readNode(rel.Name)
+
+		case parser.UDSFStream:
+			if _, err := l.tb.UDSFCreators.Lookup(rel.Name, len(rel.Params)); err != nil {
+				l.report(LintError, "%v", err)
+			}
+			for _, p := range rel.Params {
+				if s, ok := p.(parser.StringLiteral); ok {
+					if n, ok := l.nodes[strings.ToLower(s.Value)]; ok && n.nodeType != core.NTSink {
+						n.used = true
+					}
+				}
+			}
+
+		case parser.SubqueryStream:
+			l.lintRelations(rel.Subquery)
+		}
+	}
+}
+
+// checkNewName reports an error if the name is already used by a node or
+// a view. It returns true if the name can be used.
+func (l *linter) checkNewName(name string) bool {
+	key := strings.ToLower(name)
+	if n, ok := l.nodes[key]; ok {
+		l.report(LintError, "the name is already used by a %v: %v", lintNodeTypeName(n.nodeType), name)
+		return false
+	}
+	if _, ok := l.views[key]; ok {
+		l.report(LintError, "the name is already used by a view: %v", name)
+		return false
+	}
+	return true
+}
+
+func (l *linter) addNode(name string, nodeType core.NodeType) {
+	if !l.checkNewName(name) {
+		return
+	}
+	l.nodes[strings.ToLower(name)] = &lintNode{
+		name:     name,
+		nodeType: nodeType,
+		index:    l.index,
+	}
+}
+
+// node returns the node having the name and the type. It reports an error
+// and returns nil if there's no such node.
+func (l *linter) node(name string, nodeType core.NodeType) *lintNode {
+	n, ok := l.nodes[strings.ToLower(name)]
+	if !ok {
+		l.report(LintError, "%v '%v' is unknown", lintNodeTypeName(nodeType), name)
+		return nil
+	}
+	if n.nodeType != nodeType {
+		l.report(LintError, "'%v' is not a %v but a %v", name, lintNodeTypeName(nodeType),
+			lintNodeTypeName(n.nodeType))
+		return nil
+	}
+	return n
+}
+
+// readNode marks a source or a stream as used. It reports an error if
+// there's no such node.
+func (l *linter) readNode(name string) {
+	n, ok := l.nodes[strings.ToLower(name)]
+	if !ok {
+		l.report(LintError, "stream '%v' is unknown", name)
+		return
+	}
+	if n.nodeType == core.NTSink {
+		l.report(LintError, "sink '%v' cannot be read", name)
+		return
+	}
+	n.used = true
+}
+
+func (l *linter) dropNode(name string, nodeType core.NodeType) {
+	if n := l.node(name, nodeType); n != nil {
+		// a node dropped by the statements is intentionally unused
+		n.used = true
+		delete(l.nodes, strings.ToLower(name))
+	}
+}
+
+func (l *linter) createState(name, typeName string) {
+	if _, err := l.tb.UDSCreators.Lookup(typeName); err != nil {
+		l.report(LintError, "%v", err)
+	}
+	l.states[strings.ToLower(name)] = true
+}
+
+// state reports an error if there's no state having the name. It returns
+// true if the state exists.
+func (l *linter) state(name string) bool {
+	if !l.states[strings.ToLower(name)] {
+		l.report(LintError, "state '%v' is unknown", name)
+		return false
+	}
+	return true
+}
+
+func (l *linter) checkUnused() {
+	for _, n := range l.nodes {
+		if n.used || n.index < 0 {
+			continue
+		}
+		l.index = n.index
+		if n.nodeType == core.NTSink {
+			l.report(LintWarning, "nothing is inserted into sink '%v'", n.name)
+		} else {
+			l.report(LintWarning, "%v '%v' isn't read by any stream or sink",
+				lintNodeTypeName(n.nodeType), n.name)
+		}
+	}
+}
+
+func lintNodeTypeName(t core.NodeType) string {
+	if t == core.NTBox {
+		return "stream"
+	}
+	return t.String()
+}
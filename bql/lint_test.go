@@ -0,0 +1,131 @@
+package bql
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+)
+
+func TestLint(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		lint := func(bql string) []*LintIssue {
+			stmts, err := parser.New().ParseStmts(bql)
+			So(err, ShouldBeNil)
+			return tb.Lint(stmts)
+		}
+
+		Convey("When linting valid statements", func() {
+			issues := lint(`
+				CREATE SOURCE s TYPE dummy;
+				CREATE VIEW v AS SELECT RSTREAM int FROM s [RANGE 1 TUPLES];
+				CREATE FUNCTION twice(x) AS x * 2;
+				CREATE STREAM t AS SELECT ISTREAM twice(int) AS a, count(*) AS c
+					FROM v [RANGE 10 TUPLES] GROUP BY int;
+				CREATE STREAM m AS SELECT RSTREAM * FROM match_pattern("t", {
+					"pattern": ["a"], "define": {"a": "a > 1"}}) [RANGE 1 TUPLES];
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM m;
+				CREATE STATE st TYPE dummy_uds;
+				UPDATE STATE st SET num=1;
+				RESUME SOURCE s;
+				BEGIN;
+				CREATE SOURCE s2 TYPE dummy;
+				INSERT INTO k FROM s2;
+				COMMIT;
+			`)
+
+			Convey("Then no issue should be reported", func() {
+				So(issues, ShouldBeEmpty)
+			})
+
+			Convey("Then nothing should be added to the topology", func() {
+				So(dt.Nodes(), ShouldBeEmpty)
+				_, err := tb.Reg.Lookup("twice", 1)
+				So(err, ShouldNotBeNil)
+				So(tb.checkViewName("v"), ShouldBeNil)
+			})
+		})
+
+		Convey("When the topology already has nodes", func() {
+			So(addBQLToTopology(tb, `CREATE SOURCE s TYPE dummy; CREATE SINK k TYPE collector;`), ShouldBeNil)
+			issues := lint(`INSERT INTO k FROM s; CREATE SOURCE s TYPE dummy;`)
+
+			Convey("Then they should be referred to", func() {
+				So(len(issues), ShouldEqual, 1)
+				So(issues[0].Index, ShouldEqual, 1)
+				So(issues[0].Severity, ShouldEqual, LintError)
+				So(issues[0].Message, ShouldEqual, "the name is already used by a source: s")
+			})
+		})
+
+		cases := []struct {
+			bql      string
+			index    int
+			severity LintSeverity
+			message  string
+		}{
+			{`CREATE SOURCE s TYPE no_such_type`, 0, LintError, "source type 'no_such_type' is not registered"},
+			{`CREATE SINK k TYPE no_such_type`, 0, LintError, "sink type 'no_such_type' is not registered"},
+			{`CREATE STATE st TYPE no_such_type`, 0, LintError, "UDS type 'no_such_type' is not found"},
+			{`CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES]`, 0, LintError, "stream 's' is unknown"},
+			{`CREATE SOURCE s TYPE dummy; CREATE STREAM s AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES]`,
+				1, LintError, "'s' contains a selfloop"},
+			{`CREATE SOURCE s TYPE dummy; CREATE SINK k TYPE collector; INSERT INTO k FROM x`,
+				2, LintError, "stream 'x' is unknown"},
+			{`CREATE SOURCE s TYPE dummy; CREATE SINK k TYPE collector; INSERT INTO s FROM s`,
+				2, LintError, "'s' is not a sink but a source"},
+			{`CREATE SINK k TYPE collector; CREATE STREAM t AS SELECT ISTREAM * FROM k [RANGE 1 TUPLES]`,
+				1, LintError, "sink 'k' cannot be read"},
+			{`CREATE SOURCE s TYPE dummy; CREATE STREAM t AS SELECT ISTREAM no_such_func(int) FROM s [RANGE 1 TUPLES]`,
+				1, LintError, "function 'no_such_func' is unknown"},
+			{`CREATE STREAM t AS SELECT ISTREAM * FROM no_such_udsf("s") [RANGE 1 TUPLES]`,
+				0, LintError, "UDSF type 'no_such_udsf' is not registered"},
+			{`CREATE SOURCE s TYPE dummy; CREATE STREAM t AS SELECT ISTREAM count(int) FROM s [RANGE 1 TUPLES] WHERE count(int) > 1`,
+				1, LintError, "aggregates not allowed in WHERE clause"},
+			{`CREATE SOURCE s TYPE dummy; CREATE STREAM t AS SELECT ISTREAM int, count(*) FROM s [RANGE 1 TUPLES]`,
+				1, LintError, `column "s:int" must appear in the GROUP BY clause`},
+			{`CREATE SOURCE s TYPE dummy; CREATE STREAM t AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES]`,
+				1, LintWarning, "stream 't' isn't read by any stream or sink"},
+			{`CREATE SOURCE s TYPE dummy`, 0, LintWarning, "source 's' isn't read by any stream or sink"},
+			{`CREATE SINK k TYPE collector`, 0, LintWarning, "nothing is inserted into sink 'k'"},
+			{`UPDATE STATE st SET a=1`, 0, LintError, "state 'st' is unknown"},
+			{`DROP VIEW v`, 0, LintError, "view 'v' is unknown"},
+			{`PAUSE SOURCE s`, 0, LintError, "source 's' is unknown"},
+			{`CREATE SOURCE s TYPE dummy; DROP SOURCE s; RESUME SOURCE s`, 2, LintError, "source 's' is unknown"},
+			{`CREATE PAUSED SOURCE s TYPE dummy; BEGIN; PAUSE SOURCE s; COMMIT`,
+				2, LintError, "cannot be used in a transaction"},
+			{`BEGIN; CREATE SOURCE s TYPE dummy; BEGIN; COMMIT`, 2, LintError, "a transaction is already in progress"},
+			{`COMMIT`, 0, LintError, "COMMIT without BEGIN"},
+			{`CREATE SINK k TYPE collector; BEGIN; CREATE SOURCE s TYPE dummy; INSERT INTO k FROM s`,
+				1, LintError, "the transaction started by BEGIN isn't committed"},
+		}
+		for _, c := range cases {
+			c := c
+
+			Convey(fmt.Sprintf("When linting %v", c.bql), func() {
+				issues := lint(c.bql)
+
+				Convey("Then the issue should be reported", func() {
+					var found *LintIssue
+					for _, i := range issues {
+						if i.Index == c.index && i.Severity == c.severity &&
+							strings.Contains(i.Message, c.message) {
+							found = i
+						}
+					}
+					So(found, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
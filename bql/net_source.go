@@ -0,0 +1,292 @@
+package bql
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// newPeerWriter returns a Writer adding the address of the peer to each tuple
+// at peerField. It doesn't add the address when peerField is empty. When m
+// isn't nil, writes are serialized by it.
+func newPeerWriter(w core.Writer, m *sync.Mutex, peerField string, peer net.Addr) core.Writer {
+	addr := data.String(peer.String())
+	return core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+		if peerField != "" {
+			t.Data[peerField] = addr
+		}
+		if m != nil {
+			m.Lock()
+			defer m.Unlock()
+		}
+		return w.Write(ctx, t)
+	})
+}
+
+// tcpSource accepts TCP connections and emits records read from them. Each
+// connection is read by its own goroutine. Because writes block while the
+// source is paused, the source stops reading from connections and peers are
+// blocked by TCP's flow control.
+type tcpSource struct {
+	*recordEmitter
+	listener  net.Listener
+	peerField string
+
+	// wm serializes writes from goroutines reading connections.
+	wm sync.Mutex
+
+	m      sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func (s *tcpSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	defer s.wg.Wait()
+	defer s.shutdown()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			select {
+			case <-s.stopCh:
+				return core.ErrSourceStopped
+			default:
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+					Warning("Cannot accept a connection")
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			return err
+		}
+
+		if !s.addConn(conn) {
+			conn.Close()
+			return core.ErrSourceStopped
+		}
+		s.wg.Add(1)
+		go s.serve(ctx, w, conn)
+	}
+}
+
+func (s *tcpSource) serve(ctx *core.Context, w core.Writer, conn net.Conn) {
+	defer s.wg.Done()
+	defer s.removeConn(conn)
+
+	err := s.emit(ctx, newPeerWriter(w, &s.wm, s.peerField, conn.RemoteAddr()), conn)
+	if err == nil || err == core.ErrSourceStopped {
+		return
+	}
+	select {
+	case <-s.stopCh:
+		// The error was caused by closing the connection.
+	default:
+		ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+			WithField("peer", conn.RemoteAddr().String()).
+			Warning("Closing the connection due to an error")
+	}
+}
+
+func (s *tcpSource) addConn(conn net.Conn) bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.closed {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *tcpSource) removeConn(conn net.Conn) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	delete(s.conns, conn)
+	conn.Close()
+}
+
+// shutdown closes the listener and all connections. It can be called multiple
+// times.
+func (s *tcpSource) shutdown() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	close(s.stopCh)
+	for c := range s.conns {
+		c.Close()
+	}
+	return s.listener.Close()
+}
+
+func (s *tcpSource) Stop(ctx *core.Context) error {
+	return s.shutdown()
+}
+
+func (s *tcpSource) Status() data.Map {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return data.Map{
+		"address":         data.String(s.listener.Addr().String()),
+		"num_connections": data.Int(len(s.conns)),
+	}
+}
+
+func newTCPSource(ioParams *IOParams, params data.Map) (*tcpSource, error) {
+	v := &struct {
+		Address   string `bql:",required"`
+		PeerField string
+	}{
+		PeerField: "peer_address",
+	}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+
+	e, err := newRecordEmitter(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	l, err := net.Listen("tcp", v.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &tcpSource{
+		recordEmitter: e,
+		listener:      l,
+		peerField:     v.PeerField,
+		conns:         map[net.Conn]struct{}{},
+	}, nil
+}
+
+func createTCPSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	s, err := newTCPSource(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	return core.ImplementSourceStop(s), nil
+}
+
+// udpSource receives datagrams and emits records in them. Each datagram is
+// decoded independently, so a datagram can have multiple records but a
+// record cannot span datagrams. Datagrams arriving while the source is paused
+// are buffered by the OS and dropped when the buffer is full. Because the
+// header of CSV or TSV would have to be sent in every datagram, the source
+// requires 'header' parameter to be false and 'columns' parameter to be given.
+type udpSource struct {
+	*recordEmitter
+	conn      net.PacketConn
+	peerField string
+	maxSize   int
+	stopOnce  sync.Once
+}
+
+func (s *udpSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	defer s.shutdown()
+
+	buf := make([]byte, s.maxSize)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			select {
+			case <-s.stopCh:
+				return core.ErrSourceStopped
+			default:
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+					Warning("Cannot receive a datagram")
+				continue
+			}
+			return err
+		}
+
+		err = s.emit(ctx, newPeerWriter(w, nil, s.peerField, addr), bytes.NewReader(buf[:n]))
+		if err == core.ErrSourceStopped {
+			return err
+		} else if err != nil {
+			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
+				WithField("peer", addr.String()).
+				Warning("Ignoring the rest of the datagram due to an error")
+		}
+	}
+}
+
+// shutdown closes the connection. It can be called multiple times.
+func (s *udpSource) shutdown() error {
+	var err error
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+		err = s.conn.Close()
+	})
+	return err
+}
+
+func (s *udpSource) Stop(ctx *core.Context) error {
+	return s.shutdown()
+}
+
+func (s *udpSource) Status() data.Map {
+	return data.Map{
+		"address": data.String(s.conn.LocalAddr().String()),
+	}
+}
+
+func newUDPSource(ioParams *IOParams, params data.Map) (*udpSource, error) {
+	v := &struct {
+		Address         string `bql:",required"`
+		PeerField       string
+		MaxDatagramSize int
+	}{
+		PeerField:       "peer_address",
+		MaxDatagramSize: 65535,
+	}
+	dec := data.NewDecoder(nil)
+	if err := dec.Decode(params, v); err != nil {
+		return nil, err
+	}
+	if v.MaxDatagramSize <= 0 || v.MaxDatagramSize > 65535 {
+		return nil, fmt.Errorf("'max_datagram_size' parameter must be in [1, 65535]: %v", v.MaxDatagramSize)
+	}
+
+	e, err := newRecordEmitter(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	if f, ok := e.format.(*csvFormat); ok && f.header {
+		return nil, errors.New("'header' parameter must be false for a UDP source because each datagram is decoded independently, use 'columns' parameter instead")
+	}
+	conn, err := net.ListenPacket("udp", v.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &udpSource{
+		recordEmitter: e,
+		conn:          conn,
+		peerField:     v.PeerField,
+		maxSize:       v.MaxDatagramSize,
+	}, nil
+}
+
+func createUDPSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	s, err := newUDPSource(ioParams, params)
+	if err != nil {
+		return nil, err
+	}
+	return core.ImplementSourceStop(s), nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("tcp", SourceCreatorFunc(createTCPSource))
+	MustRegisterGlobalSourceCreator("udp", SourceCreatorFunc(createUDPSource))
+}
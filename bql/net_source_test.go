@@ -0,0 +1,211 @@
+package bql
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestTCPSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+	Convey("Given a TCP source", t, func() {
+		s, err := newTCPSource(&IOParams{}, data.Map{"address": data.String("127.0.0.1:0")})
+		So(err, ShouldBeNil)
+		src := core.ImplementSourceStop(s)
+		w := newRecordCollectingWriter()
+		ch := make(chan error, 1)
+		go func() {
+			ch <- src.GenerateStream(ctx, w)
+		}()
+		Reset(func() {
+			src.Stop(ctx)
+		})
+		addr := s.Status()["address"].(data.String)
+
+		Convey("When sending lines from connections", func() {
+			conns := make([]net.Conn, 2)
+			for i := range conns {
+				conn, err := net.Dial("tcp", string(addr))
+				So(err, ShouldBeNil)
+				defer conn.Close()
+				conns[i] = conn
+			}
+			_, err := fmt.Fprint(conns[0], "{\"a\":1}\ninvalid\n{\"a\"")
+			So(err, ShouldBeNil)
+			_, err = fmt.Fprint(conns[1], "{\"a\":2}\n")
+			So(err, ShouldBeNil)
+			_, err = fmt.Fprint(conns[0], ":3}\n")
+			So(err, ShouldBeNil)
+
+			Convey("Then valid records should be emitted with the peer address", func() {
+				ms := w.wait(3)
+				as := map[data.Value]string{}
+				for _, m := range ms {
+					as[m["a"]] = string(m["peer_address"].(data.String))
+				}
+				So(as, ShouldResemble, map[data.Value]string{
+					data.Int(1): conns[0].LocalAddr().String(),
+					data.Int(2): conns[1].LocalAddr().String(),
+					data.Int(3): conns[0].LocalAddr().String(),
+				})
+			})
+
+			Convey("Then it should stop even if connections are open", func() {
+				w.wait(3)
+				So(src.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+				So(s.Status()["num_connections"], ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("When pausing the source", func() {
+			So(src.(core.Resumable).Pause(ctx), ShouldBeNil)
+			conn, err := net.Dial("tcp", string(addr))
+			So(err, ShouldBeNil)
+			defer conn.Close()
+			_, err = fmt.Fprint(conn, "{\"a\":1}\n")
+			So(err, ShouldBeNil)
+
+			Convey("Then no record should be emitted", func() {
+				time.Sleep(50 * time.Millisecond)
+				w.m.Lock()
+				defer w.m.Unlock()
+				So(w.ms, ShouldBeEmpty)
+			})
+
+			Convey("Then the record should be emitted after resuming the source", func() {
+				time.Sleep(10 * time.Millisecond)
+				So(src.(core.Resumable).Resume(ctx), ShouldBeNil)
+				So(w.wait(1)[0]["a"], ShouldEqual, data.Int(1))
+			})
+		})
+	})
+
+	Convey("Given a TCP source without the peer field", t, func() {
+		s, err := newTCPSource(&IOParams{}, data.Map{"address": data.String("127.0.0.1:0"),
+			"format": data.String("csv"), "peer_field": data.String("")})
+		So(err, ShouldBeNil)
+		src := core.ImplementSourceStop(s)
+		w := newRecordCollectingWriter()
+		go src.GenerateStream(ctx, w)
+		Reset(func() {
+			src.Stop(ctx)
+		})
+
+		Convey("When sending CSV", func() {
+			conn, err := net.Dial("tcp", string(s.Status()["address"].(data.String)))
+			So(err, ShouldBeNil)
+			defer conn.Close()
+			_, err = fmt.Fprint(conn, "a,b\n1,x\n")
+			So(err, ShouldBeNil)
+
+			Convey("Then records should be emitted without the peer address", func() {
+				So(w.wait(1), ShouldResemble, []data.Map{{"a": data.Int(1), "b": data.String("x")}})
+			})
+		})
+	})
+
+	Convey("When creating a TCP source with an invalid address", t, func() {
+		_, err := createTCPSource(ctx, &IOParams{}, data.Map{"address": data.String("no_such_host_name:-1")})
+
+		Convey("Then it should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestUDPSource(t *testing.T) {
+	ctx := core.NewContext(nil)
+	Convey("Given a UDP source", t, func() {
+		s, err := newUDPSource(&IOParams{}, data.Map{"address": data.String("127.0.0.1:0"),
+			"format": data.String("raw"), "peer_field": data.String("peer")})
+		So(err, ShouldBeNil)
+		src := core.ImplementSourceStop(s)
+		w := newRecordCollectingWriter()
+		ch := make(chan error, 1)
+		go func() {
+			ch <- src.GenerateStream(ctx, w)
+		}()
+		Reset(func() {
+			src.Stop(ctx)
+		})
+
+		Convey("When sending datagrams", func() {
+			conn, err := net.Dial("udp", string(s.Status()["address"].(data.String)))
+			So(err, ShouldBeNil)
+			defer conn.Close()
+			_, err = conn.Write([]byte("a\nb"))
+			So(err, ShouldBeNil)
+			_, err = conn.Write([]byte("c"))
+			So(err, ShouldBeNil)
+
+			Convey("Then each line should be emitted with the peer address", func() {
+				peer := data.String(conn.LocalAddr().String())
+				So(w.wait(3), ShouldResemble, []data.Map{
+					{"line": data.String("a"), "peer": peer},
+					{"line": data.String("b"), "peer": peer},
+					{"line": data.String("c"), "peer": peer},
+				})
+			})
+
+			Convey("Then it should stop", func() {
+				w.wait(3)
+				So(src.Stop(ctx), ShouldBeNil)
+				So(<-ch, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a UDP source receiving CSV", t, func() {
+		s, err := newUDPSource(&IOParams{}, data.Map{"address": data.String("127.0.0.1:0"),
+			"format": data.String("csv"), "header": data.False,
+			"columns": data.Array{data.String("a"), data.String("b")}, "peer_field": data.String("")})
+		So(err, ShouldBeNil)
+		src := core.ImplementSourceStop(s)
+		w := newRecordCollectingWriter()
+		go src.GenerateStream(ctx, w)
+		Reset(func() {
+			src.Stop(ctx)
+		})
+
+		Convey("When sending datagrams", func() {
+			conn, err := net.Dial("udp", string(s.Status()["address"].(data.String)))
+			So(err, ShouldBeNil)
+			defer conn.Close()
+			_, err = conn.Write([]byte("1,x\n"))
+			So(err, ShouldBeNil)
+			_, err = conn.Write([]byte("2,y\n"))
+			So(err, ShouldBeNil)
+
+			Convey("Then every line should be emitted as a record", func() {
+				So(w.wait(2), ShouldResemble, []data.Map{
+					{"a": data.Int(1), "b": data.String("x")},
+					{"a": data.Int(2), "b": data.String("y")},
+				})
+			})
+		})
+	})
+
+	Convey("When creating a UDP source receiving CSV with a header", t, func() {
+		_, err := createUDPSource(ctx, &IOParams{}, data.Map{"address": data.String("127.0.0.1:0"),
+			"format": data.String("csv")})
+
+		Convey("Then it should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("When creating a UDP source with an invalid datagram size", t, func() {
+		_, err := createUDPSource(ctx, &IOParams{}, data.Map{"address": data.String("127.0.0.1:0"),
+			"max_datagram_size": data.Int(70000)})
+
+		Convey("Then it should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,139 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleCreateFunction(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains the correct CREATE FUNCTION items", func() {
+			ps.PushComponent(16, 17, FuncName("f"))
+			ps.PushComponent(18, 19, Identifier("a"))
+			ps.PushComponent(21, 22, Identifier("b"))
+			ps.PushComponent(27, 28, RowValue{"", "a"})
+			ps.AssembleCreateFunction(0, 28)
+
+			Convey("Then AssembleCreateFunction transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a CreateFunctionStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 0)
+					So(top.end, ShouldEqual, 28)
+					So(top.comp, ShouldHaveSameTypeAs, CreateFunctionStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(CreateFunctionStmt)
+						So(comp.Name, ShouldEqual, "f")
+						So(comp.Params, ShouldResemble, []Identifier{"a", "b"})
+						So(comp.Body, ShouldResemble, RowValue{"", "a"})
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(16, 17, StreamIdentifier("f")) // must be FuncName
+			ps.PushComponent(27, 28, RowValue{"", "a"})
+
+			Convey("Then AssembleCreateFunction panics", func() {
+				So(func() { ps.AssembleCreateFunction(0, 28) }, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a full CREATE FUNCTION", func() {
+			p.Buffer = "CREATE FUNCTION c_to_f(x) AS x * 9 / 5 + 32"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateFunctionStmt{})
+				comp := top.(CreateFunctionStmt)
+
+				So(comp.Name, ShouldEqual, "c_to_f")
+				So(comp.Params, ShouldResemble, []Identifier{"x"})
+				So(comp.Body, ShouldResemble, BinaryOpAST{Plus,
+					BinaryOpAST{Divide,
+						BinaryOpAST{Multiply, RowValue{"", "x"}, NumericLiteral{9}},
+						NumericLiteral{5}},
+					NumericLiteral{32}})
+
+				Convey("And String() should return a normalized statement", func() {
+					So(comp.String(), ShouldEqual, "CREATE FUNCTION c_to_f(x) AS (x * 9) / 5 + 32")
+				})
+			})
+		})
+
+		Convey("When doing a CREATE FUNCTION with several parameters", func() {
+			p.Buffer = "CREATE FUNCTION clamp(v, lo, hi) AS CASE WHEN v < lo THEN lo WHEN v > hi THEN hi ELSE v END"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateFunctionStmt{})
+				comp := top.(CreateFunctionStmt)
+
+				So(comp.Name, ShouldEqual, "clamp")
+				So(comp.Params, ShouldResemble, []Identifier{"v", "lo", "hi"})
+				So(comp.Body, ShouldHaveSameTypeAs, ConditionCaseAST{})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a CREATE FUNCTION without parameters", func() {
+			p.Buffer = "CREATE FUNCTION answer ( ) AS 42"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateFunctionStmt{})
+				comp := top.(CreateFunctionStmt)
+
+				So(comp.Name, ShouldEqual, "answer")
+				So(comp.Params, ShouldBeEmpty)
+				So(comp.Body, ShouldResemble, NumericLiteral{42})
+
+				Convey("And String() should return a normalized statement", func() {
+					So(comp.String(), ShouldEqual, "CREATE FUNCTION answer() AS 42")
+				})
+			})
+		})
+
+		Convey("When doing a CREATE FUNCTION without a body", func() {
+			p.Buffer = "CREATE FUNCTION f(x)"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
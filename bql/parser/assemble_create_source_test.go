@@ -17,6 +17,7 @@ func TestAssembleCreateSource(t *testing.T) {
 			ps.PushComponent(6, 8, SourceSinkParamAST{"c", data.String("d")})
 			ps.PushComponent(8, 10, SourceSinkParamAST{"e", data.String("f")})
 			ps.AssembleSourceSinkSpecs(6, 10)
+			ps.AssembleSchema(10, 10)
 			ps.AssembleCreateSource()
 
 			Convey("Then AssembleCreateSource transforms them into one item", func() {
@@ -59,6 +60,7 @@ func TestAssembleCreateSource(t *testing.T) {
 			ps.PushComponent(6, 8, SourceSinkParamAST{"c", data.String("d")})
 			ps.PushComponent(8, 10, SourceSinkParamAST{"e", data.String("f")})
 			ps.AssembleSourceSinkSpecs(6, 10)
+			ps.AssembleSchema(10, 10)
 
 			Convey("Then AssembleCreateSource panics", func() {
 				So(ps.AssembleCreateSource, ShouldPanic)
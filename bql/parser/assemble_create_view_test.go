@@ -0,0 +1,86 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleCreateView(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains the correct CREATE VIEW items", func() {
+			ps.PushComponent(12, 13, StreamIdentifier("v"))
+			ps.PushComponent(17, 40, SelectStmt{EmitterAST: EmitterAST{EmitterType: Istream}})
+			ps.AssembleCreateView()
+
+			Convey("Then AssembleCreateView transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a CreateViewStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 12)
+					So(top.end, ShouldEqual, 40)
+					So(top.comp, ShouldHaveSameTypeAs, CreateViewStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(CreateViewStmt)
+						So(comp.Name, ShouldEqual, "v")
+						So(comp.Select.EmitterType, ShouldEqual, Istream)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(12, 13, StreamIdentifier("v"))
+			ps.PushComponent(17, 40, StreamIdentifier("s")) // must be SelectStmt
+
+			Convey("Then AssembleCreateView panics", func() {
+				So(ps.AssembleCreateView, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a full CREATE VIEW", func() {
+			p.Buffer = "CREATE VIEW v AS SELECT ISTREAM a, b FROM s [RANGE 2 TUPLES] WHERE a > 1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateViewStmt{})
+				comp := top.(CreateViewStmt)
+
+				So(comp.Name, ShouldEqual, "v")
+				So(comp.Select.EmitterType, ShouldEqual, Istream)
+				So(len(comp.Select.Projections), ShouldEqual, 2)
+				So(len(comp.Select.Relations), ShouldEqual, 1)
+				So(comp.Select.Relations[0].Name, ShouldEqual, "s")
+				So(comp.Select.Filter, ShouldNotBeNil)
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing CREATE VIEW with a UNION", func() {
+			p.Buffer = "CREATE VIEW v AS SELECT ISTREAM a FROM s [RANGE 2 TUPLES] " +
+				"UNION ALL SELECT ISTREAM a FROM t [RANGE 2 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,91 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleExplain(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains a statement", func() {
+			ps.PushComponent(8, 20, PauseSourceStmt{"a"})
+			ps.AssembleExplain()
+
+			Convey("Then AssembleExplain transforms it into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is an ExplainStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 8)
+					So(top.end, ShouldEqual, 20)
+					So(top.comp, ShouldHaveSameTypeAs, ExplainStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(ExplainStmt)
+						So(comp.Stmt, ShouldResemble, PauseSourceStmt{"a"})
+					})
+				})
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing EXPLAIN on a SELECT statement", func() {
+			p.Buffer = "EXPLAIN SELECT ISTREAM a FROM s [RANGE 2 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, ExplainStmt{})
+				comp := top.(ExplainStmt)
+				So(comp.Stmt, ShouldHaveSameTypeAs, SelectStmt{})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing EXPLAIN on a CREATE STREAM statement", func() {
+			p.Buffer = "EXPLAIN CREATE STREAM t AS SELECT ISTREAM a FROM s [RANGE 2 TUPLES] " +
+				"UNION ALL SELECT ISTREAM a FROM u [RANGE 2 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, ExplainStmt{})
+				comp := top.(ExplainStmt)
+				So(comp.Stmt, ShouldHaveSameTypeAs, CreateStreamAsSelectUnionStmt{})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing EXPLAIN on another statement", func() {
+			p.Buffer = "EXPLAIN PAUSE SOURCE a"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"testing"
 )
@@ -128,5 +129,60 @@ func TestAssembleGrouping(t *testing.T) {
 				})
 			})
 		})
+
+		testCases := []struct {
+			stmt  string
+			list  []Expression
+			sets  [][]int
+			query string
+		}{
+			{"SELECT ISTREAM a GROUP BY ROLLUP(c, d)",
+				[]Expression{RowValue{"", "c"}, RowValue{"", "d"}},
+				[][]int{{0, 1}, {0}, {}},
+				"SELECT ISTREAM a GROUP BY GROUPING SETS ((c, d), (c), ())"},
+			{"SELECT ISTREAM a GROUP BY cube (c,d)",
+				[]Expression{RowValue{"", "c"}, RowValue{"", "d"}},
+				[][]int{{0, 1}, {0}, {1}, {}},
+				"SELECT ISTREAM a GROUP BY GROUPING SETS ((c, d), (c), (d), ())"},
+			{"SELECT ISTREAM a GROUP BY GROUPING SETS ((c, d), e, ())",
+				[]Expression{RowValue{"", "c"}, RowValue{"", "d"}, RowValue{"", "e"}},
+				[][]int{{0, 1}, {2}, {}},
+				"SELECT ISTREAM a GROUP BY GROUPING SETS ((c, d), (e), ())"},
+			{"SELECT ISTREAM a GROUP BY e, ROLLUP(c), GROUPING SETS ((e), (d))",
+				[]Expression{RowValue{"", "e"}, RowValue{"", "c"}, RowValue{"", "d"}},
+				[][]int{{0, 1}, {0, 1, 2}, {0}, {0, 2}},
+				"SELECT ISTREAM a GROUP BY GROUPING SETS ((e, c), (e, c, d), (e), (e, d))"},
+			{"SELECT ISTREAM a GROUP BY GROUPING SETS (())",
+				nil,
+				[][]int{{}},
+				"SELECT ISTREAM a GROUP BY GROUPING SETS (())"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			Convey(fmt.Sprintf("When selecting with %s", tc.stmt), func() {
+				p.Buffer = tc.stmt
+				p.Init()
+
+				Convey("Then the statement should be parsed correctly", func() {
+					err := p.Parse()
+					So(err, ShouldBeNil)
+					p.Execute()
+
+					ps := p.parseStack
+					So(ps.Len(), ShouldEqual, 1)
+					top := ps.Peek().comp
+					So(top, ShouldHaveSameTypeAs, SelectStmt{})
+					s := top.(SelectStmt)
+					So(s.GroupList, ShouldResemble, tc.list)
+					So(s.GroupingSets, ShouldResemble, tc.sets)
+
+					Convey("And String() should return an equivalent statement", func() {
+						So(s.String(), ShouldEqual, tc.query)
+					})
+				})
+			})
+		}
 	})
 }
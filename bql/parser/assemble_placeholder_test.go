@@ -0,0 +1,131 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestAssemblePlaceholder(t *testing.T) {
+	Convey("Given a parseStack with bound values", t, func() {
+		ps := parseStack{
+			params: data.Map{
+				"int":   data.Int(3),
+				"null":  data.Null{},
+				"array": data.Array{data.Float(1.5), data.True},
+				"map":   data.Map{"b": data.String("x"), "a": data.Int(-1)},
+				"blob":  data.Blob("blob"),
+			},
+		}
+
+		Convey("When assembling a placeholder having a bound value", func() {
+			ps.AssemblePlaceholder(3, 7, "int")
+
+			Convey("Then the literal of the value should be pushed", func() {
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 3)
+				So(top.end, ShouldEqual, 7)
+				So(top.comp, ShouldResemble, NumericLiteral{3})
+			})
+		})
+
+		Convey("When assembling placeholders having nested values", func() {
+			ps.AssemblePlaceholder(0, 5, "null")
+			ps.AssemblePlaceholder(6, 12, "array")
+			ps.AssemblePlaceholder(13, 17, "map")
+
+			Convey("Then the literals should be pushed", func() {
+				So(ps.Len(), ShouldEqual, 3)
+				So(ps.Pop().comp, ShouldResemble, MapAST{[]KeyValuePairAST{
+					{"a", NumericLiteral{-1}},
+					{"b", StringLiteral{"x"}},
+				}})
+				So(ps.Pop().comp, ShouldResemble, ArrayAST{ExpressionsAST{[]Expression{
+					FloatLiteral{1.5}, BoolLiteral{true},
+				}}})
+				So(ps.Pop().comp, ShouldResemble, NullLiteral{})
+			})
+		})
+
+		Convey("When assembling a placeholder without a bound value", func() {
+			Convey("Then AssemblePlaceholder panics", func() {
+				So(func() { ps.AssemblePlaceholder(3, 7, "undefined") }, ShouldPanic)
+			})
+		})
+
+		Convey("When assembling a placeholder having a value which cannot be a literal", func() {
+			Convey("Then AssemblePlaceholder panics", func() {
+				So(func() { ps.AssemblePlaceholder(3, 7, "blob") }, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser with bound values", t, func() {
+		p := NewWithParams(data.Map{
+			"threshold": data.Int(10),
+			"path":      data.String("/tmp/data.jsonl"),
+			"opts":      data.Map{"repeat": data.Int(2)},
+			"evil":      data.String(`x"; DROP SOURCE s; --`),
+		})
+
+		Convey("When parsing a SELECT statement having a placeholder", func() {
+			stmt, _, err := p.ParseStmt("SELECT ISTREAM * FROM s [RANGE 1 TUPLES] WHERE a > $threshold")
+			So(err, ShouldBeNil)
+
+			Convey("Then the placeholder should be replaced by the literal", func() {
+				So(stmt, ShouldHaveSameTypeAs, SelectStmt{})
+				s := stmt.(SelectStmt)
+				So(s.Filter, ShouldResemble, BinaryOpAST{Greater, RowValue{"", "a"}, NumericLiteral{10}})
+				So(s.String(), ShouldEqual, "SELECT ISTREAM * FROM s [RANGE 1 TUPLES] WHERE a > 10")
+			})
+		})
+
+		Convey("When parsing a CREATE SOURCE statement having placeholders", func() {
+			stmt, _, err := p.ParseStmt("CREATE SOURCE s TYPE file WITH path=$path, opts=$opts, n=[$threshold]")
+			So(err, ShouldBeNil)
+
+			Convey("Then the parameters should have the bound values", func() {
+				So(stmt, ShouldHaveSameTypeAs, CreateSourceStmt{})
+				s := stmt.(CreateSourceStmt)
+				So(s.Params, ShouldResemble, []SourceSinkParamAST{
+					{"path", data.String("/tmp/data.jsonl")},
+					{"opts", data.Map{"repeat": data.Int(2)}},
+					{"n", data.Array{data.Int(10)}},
+				})
+			})
+		})
+
+		Convey("When binding a string containing BQL", func() {
+			stmts, err := p.ParseStmts(`EVAL $evil`)
+
+			Convey("Then it should be parsed as a string literal", func() {
+				So(err, ShouldBeNil)
+				So(len(stmts), ShouldEqual, 1)
+				s := stmts[0].(EvalStmt)
+				So(s.Expr, ShouldResemble, StringLiteral{`x"; DROP SOURCE s; --`})
+			})
+		})
+
+		Convey("When parsing a statement having an unbound placeholder", func() {
+			_, _, err := p.ParseStmt("EVAL $undefined + 1")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "$undefined")
+			})
+		})
+	})
+
+	Convey("Given a parser without bound values", t, func() {
+		p := New()
+
+		Convey("When parsing a statement having a placeholder", func() {
+			_, _, err := p.ParseStmt("EVAL $a")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
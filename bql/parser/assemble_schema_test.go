@@ -0,0 +1,141 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAssembleSchema(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains schema fields in the given range", func() {
+			ps.PushComponent(0, 2, Raw{"PRE"})
+			ps.PushComponent(2, 4, Identifier("a"))
+			ps.PushComponent(4, 6, Int)
+			ps.PushComponent(6, 8, Yes)
+			ps.AssembleSchemaField()
+			ps.PushComponent(8, 10, Identifier("b"))
+			ps.PushComponent(10, 12, Float)
+			ps.EnsureKeywordPresent(12, 12)
+			ps.AssembleSchemaField()
+			ps.AssembleSchema(2, 12)
+
+			Convey("Then AssembleSchema transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a SchemaAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 2)
+					So(top.end, ShouldEqual, 12)
+					So(top.comp, ShouldResemble, SchemaAST{[]SchemaFieldAST{
+						{"a", Int, Yes},
+						{"b", Float, UnspecifiedKeyword},
+					}})
+				})
+			})
+		})
+
+		Convey("When the given range is empty", func() {
+			ps.PushComponent(0, 2, Raw{"PRE"})
+			ps.AssembleSchema(2, 2)
+
+			Convey("Then AssembleSchema pushes an empty SchemaAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				So(ps.Peek().comp, ShouldResemble, SchemaAST{})
+			})
+		})
+
+		Convey("When the same field is declared twice", func() {
+			ps.PushComponent(2, 4, Identifier("a"))
+			ps.PushComponent(4, 6, Int)
+			ps.EnsureKeywordPresent(6, 6)
+			ps.AssembleSchemaField()
+			ps.PushComponent(6, 8, Identifier("a"))
+			ps.PushComponent(8, 10, String)
+			ps.EnsureKeywordPresent(10, 10)
+			ps.AssembleSchemaField()
+
+			Convey("Then AssembleSchema panics", func() {
+				So(func() { ps.AssembleSchema(2, 10) }, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		testCases := []struct {
+			stmt   string
+			schema SchemaAST
+			query  string
+		}{
+			{"CREATE STREAM s (id int NOT NULL, temp float, ts timestamp) AS SELECT ISTREAM * FROM t [RANGE 1 TUPLES]",
+				SchemaAST{[]SchemaFieldAST{
+					{"id", Int, Yes},
+					{"temp", Float, UnspecifiedKeyword},
+					{"ts", Timestamp, UnspecifiedKeyword},
+				}},
+				"CREATE STREAM s (id INT NOT NULL, temp FLOAT, ts TIMESTAMP) AS SELECT ISTREAM * FROM t [RANGE 1 TUPLES]"},
+			{"CREATE STREAM s(a string) AS SELECT ISTREAM * FROM t [RANGE 1 TUPLES]",
+				SchemaAST{[]SchemaFieldAST{{"a", String, UnspecifiedKeyword}}},
+				"CREATE STREAM s (a STRING) AS SELECT ISTREAM * FROM t [RANGE 1 TUPLES]"},
+			{"CREATE STREAM s AS SELECT ISTREAM * FROM t [RANGE 1 TUPLES]",
+				SchemaAST{},
+				"CREATE STREAM s AS SELECT ISTREAM * FROM t [RANGE 1 TUPLES]"},
+			{"CREATE SOURCE s TYPE t WITH a=1 SCHEMA (id int NOT NULL, tags array)",
+				SchemaAST{[]SchemaFieldAST{
+					{"id", Int, Yes},
+					{"tags", Array, UnspecifiedKeyword},
+				}},
+				"CREATE SOURCE s TYPE t WITH a=1 SCHEMA (id INT NOT NULL, tags ARRAY)"},
+			{"CREATE SOURCE s TYPE t schema(m map not null)",
+				SchemaAST{[]SchemaFieldAST{{"m", Map, Yes}}},
+				"CREATE SOURCE s TYPE t SCHEMA (m MAP NOT NULL)"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			Convey(fmt.Sprintf("When parsing %s", tc.stmt), func() {
+				p.Buffer = tc.stmt
+				p.Init()
+
+				Convey("Then the statement should be parsed correctly", func() {
+					So(p.Parse(), ShouldBeNil)
+					p.Execute()
+
+					ps := p.parseStack
+					So(ps.Len(), ShouldEqual, 1)
+					var str string
+					switch s := ps.Peek().comp.(type) {
+					case CreateStreamAsSelectStmt:
+						So(s.Schema, ShouldResemble, tc.schema)
+						str = s.String()
+					case CreateSourceStmt:
+						So(s.Schema, ShouldResemble, tc.schema)
+						str = s.String()
+					default:
+						So(s, ShouldBeNil)
+					}
+
+					Convey("And String() should return an equivalent statement", func() {
+						So(str, ShouldEqual, tc.query)
+					})
+				})
+			})
+		}
+
+		Convey("When declaring a field with an unknown type", func() {
+			p.Buffer = "CREATE SOURCE s TYPE t SCHEMA (a integer)"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
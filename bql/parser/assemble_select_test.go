@@ -13,21 +13,28 @@ func TestAssembleSelect(t *testing.T) {
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
+			ps.AssembleDistinct(6, 6)
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.AssembleProjections(6, 8)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.EnsureWindowType(12, 12)
+			ps.EnsureLatenessSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.EnsureWindowType(18, 18)
+			ps.EnsureLatenessSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -42,6 +49,12 @@ func TestAssembleSelect(t *testing.T) {
 			ps.AssembleGrouping(24, 28)
 			ps.PushComponent(28, 30, RowValue{"", "h"})
 			ps.AssembleHaving(28, 30)
+			ps.PushComponent(30, 31, RowValue{"", "i"})
+			ps.PushComponent(31, 32, No)
+			ps.AssembleSortedExpression()
+			ps.AssembleOrdering(30, 32)
+			ps.PushComponent(32, 34, NumericLiteral{5})
+			ps.AssembleLimit(32, 34)
 			ps.AssembleSelect()
 
 			Convey("Then AssembleSelect transforms them into one item", func() {
@@ -51,7 +64,7 @@ func TestAssembleSelect(t *testing.T) {
 					top := ps.Peek()
 					So(top, ShouldNotBeNil)
 					So(top.begin, ShouldEqual, 4)
-					So(top.end, ShouldEqual, 30)
+					So(top.end, ShouldEqual, 34)
 					So(top.comp, ShouldHaveSameTypeAs, SelectStmt{})
 
 					Convey("And it contains the previously pushed data", func() {
@@ -79,6 +92,10 @@ func TestAssembleSelect(t *testing.T) {
 						So(comp.GroupList[0], ShouldResemble, RowValue{"", "f"})
 						So(comp.GroupList[1], ShouldResemble, RowValue{"", "g"})
 						So(comp.Having, ShouldResemble, RowValue{"", "h"})
+						So(comp.OrderBy, ShouldResemble, []SortedExpressionAST{
+							{RowValue{"", "i"}, No},
+						})
+						So(comp.LimitAST, ShouldResemble, LimitAST{true, 5})
 					})
 				})
 			})
@@ -96,21 +113,28 @@ func TestAssembleSelect(t *testing.T) {
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
+			ps.AssembleDistinct(6, 6)
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.AssembleProjections(6, 8)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.EnsureWindowType(12, 12)
+			ps.EnsureLatenessSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.EnsureWindowType(18, 18)
+			ps.EnsureLatenessSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -184,5 +208,108 @@ func TestAssembleSelect(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When doing a SELECT with ORDER BY and LIMIT", func() {
+			p.Buffer = `SELECT RSTREAM a, count(*) AS score FROM c [RANGE 3 TUPLES] GROUP BY a ORDER BY score DESC, a LIMIT 5`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(comp.OrderBy, ShouldResemble, []SortedExpressionAST{
+					{RowValue{"", "score"}, No},
+					{RowValue{"", "a"}, UnspecifiedKeyword},
+				})
+				So(comp.LimitAST, ShouldResemble, LimitAST{true, 5})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a SELECT without ORDER BY and LIMIT", func() {
+			p.Buffer = `SELECT RSTREAM [LIMIT 3] a FROM c [RANGE 3 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(comp.OrderBy, ShouldBeNil)
+				So(comp.LimitAST, ShouldResemble, LimitAST{})
+				So(comp.EmitterOptions, ShouldResemble, []interface{}{EmitterLimit{3}})
+			})
+		})
+
+		Convey("When using a negative LIMIT", func() {
+			p.Buffer = `SELECT RSTREAM a FROM c [RANGE 3 TUPLES] LIMIT -1`
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When doing a SELECT DISTINCT", func() {
+			p.Buffer = `SELECT RSTREAM DISTINCT a, distinct_b FROM c [RANGE 3 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(comp.Distinct, ShouldBeTrue)
+				So(comp.Projections, ShouldResemble, []Expression{
+					RowValue{"", "a"}, RowValue{"", "distinct_b"},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a SELECT with a column whose name starts with DISTINCT", func() {
+			p.Buffer = `SELECT RSTREAM distinct_a FROM c [RANGE 3 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(comp.Distinct, ShouldBeFalse)
+				So(comp.Projections, ShouldResemble, []Expression{RowValue{"", "distinct_a"}})
+			})
+		})
 	})
 }
@@ -0,0 +1,153 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleSubquery(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains a SELECT statement", func() {
+			ps.PushComponent(6, 30, SelectStmt{EmitterAST: EmitterAST{EmitterType: Istream}})
+			ps.AssembleSubquery(5, 31)
+
+			Convey("Then AssembleSubquery transforms it into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a Stream", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 5)
+					So(top.end, ShouldEqual, 31)
+					So(top.comp, ShouldHaveSameTypeAs, Stream{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(Stream)
+						So(comp.Type, ShouldEqual, SubqueryStream)
+						So(comp.Name, ShouldEqual, "")
+						So(comp.Subquery, ShouldNotBeNil)
+						So(comp.Subquery.EmitterType, ShouldEqual, Istream)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(6, 30, StreamIdentifier("s")) // must be SelectStmt
+
+			Convey("Then AssembleSubquery panics", func() {
+				So(func() { ps.AssembleSubquery(5, 31) }, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting from a subquery", func() {
+			p.Buffer = "SELECT RSTREAM x:a, count(*) FROM " +
+				"(SELECT ISTREAM a FROM s [RANGE 2 TUPLES] WHERE a > 1) [RANGE 5 TUPLES] AS x " +
+				"GROUP BY x:a"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+
+				So(len(comp.Relations), ShouldEqual, 1)
+				rel := comp.Relations[0]
+				So(rel.Type, ShouldEqual, SubqueryStream)
+				So(rel.Alias, ShouldEqual, "x")
+				So(rel.Value, ShouldEqual, 5)
+				So(rel.Unit, ShouldEqual, Tuples)
+				So(rel.Subquery, ShouldNotBeNil)
+				So(rel.Subquery.EmitterType, ShouldEqual, Istream)
+				So(len(rel.Subquery.Relations), ShouldEqual, 1)
+				So(rel.Subquery.Relations[0].Name, ShouldEqual, "s")
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When joining a subquery with a stream", func() {
+			p.Buffer = "SELECT ISTREAM x:a, t:b FROM " +
+				"( SELECT ISTREAM a FROM s [RANGE 2 TUPLES] ) [RANGE 1 TUPLES] AS x " +
+				"INNER JOIN t [RANGE 1 TUPLES] ON x:a = t:a"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				comp := ps.Peek().comp.(SelectStmt)
+
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(comp.Relations[0].Type, ShouldEqual, SubqueryStream)
+				So(comp.Relations[0].Alias, ShouldEqual, "x")
+				So(comp.Relations[1].Type, ShouldEqual, ActualStream)
+				So(comp.Relations[1].Name, ShouldEqual, "t")
+			})
+		})
+
+		Convey("When nesting subqueries", func() {
+			p.Buffer = "CREATE STREAM u AS SELECT ISTREAM * FROM " +
+				"(SELECT ISTREAM * FROM (SELECT ISTREAM a FROM s [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS y) " +
+				"[RANGE 1 TUPLES] AS x"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				comp := ps.Peek().comp.(CreateStreamAsSelectStmt)
+
+				outer := comp.Select.Relations[0]
+				So(outer.Type, ShouldEqual, SubqueryStream)
+				So(outer.Alias, ShouldEqual, "x")
+				inner := outer.Subquery.Relations[0]
+				So(inner.Type, ShouldEqual, SubqueryStream)
+				So(inner.Alias, ShouldEqual, "y")
+				So(inner.Subquery.Relations[0].Name, ShouldEqual, "s")
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting from a subquery without an alias", func() {
+			p.Buffer = "SELECT ISTREAM a FROM (SELECT ISTREAM a FROM s [RANGE 2 TUPLES]) [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be rejected", func() {
+				So(p.Parse(), ShouldBeNil)
+				So(p.Execute, ShouldPanic)
+			})
+		})
+
+		Convey("When selecting from a subquery without a window", func() {
+			p.Buffer = "SELECT ISTREAM a FROM (SELECT ISTREAM a FROM s [RANGE 2 TUPLES]) AS x"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -13,11 +13,11 @@ func TestAssembleWindowedFrom(t *testing.T) {
 		Convey("When the stack contains only AliasedStreamWindows in the given range", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 8, AliasedStreamWindowAST{
-				StreamWindowAST{Stream{ActualStream, "a", nil}, IntervalAST{FloatLiteral{3}, Tuples},
+				StreamWindowAST{Stream{ActualStream, "a", nil, nil}, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, UnspecifiedWindowType, IntervalAST{},
 					2, UnspecifiedSheddingOption}, "",
 			})
 			ps.PushComponent(8, 10, AliasedStreamWindowAST{
-				StreamWindowAST{Stream{ActualStream, "b", nil}, IntervalAST{FloatLiteral{2}, Seconds},
+				StreamWindowAST{Stream{ActualStream, "b", nil, nil}, IntervalAST{FloatLiteral{2}, Seconds}, IntervalAST{}, UnspecifiedWindowType, IntervalAST{},
 					UnspecifiedCapacity, Wait}, "",
 			})
 			ps.AssembleWindowedFrom(6, 10)
@@ -160,5 +160,174 @@ func TestAssembleWindowedFrom(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When selecting with an INNER JOIN", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM c:a, d:b FROM c [RANGE 3 TUPLES] INNER JOIN d [RANGE 2 SECONDS] AS e ON c:a = e:a AND e:b > 2"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Alias, ShouldEqual, "")
+				So(comp.Relations[1].Name, ShouldEqual, "d")
+				So(comp.Relations[1].Alias, ShouldEqual, "e")
+				So(comp.Joins, ShouldResemble, []JoinAST{
+					{InnerJoin, BinaryOpAST{And,
+						BinaryOpAST{Equal, RowValue{"c", "a"}, RowValue{"e", "a"}},
+						BinaryOpAST{Greater, RowValue{"e", "b"}, NumericLiteral{2}}}},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a JOIN without INNER", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM c:a FROM c [RANGE 3 TUPLES] JOIN d [RANGE 2 TUPLES] ON c:a = d:a"
+			p.Init()
+
+			Convey("Then the statement should be parsed as an INNER JOIN", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(len(comp.Joins), ShouldEqual, 1)
+				So(comp.Joins[0].Type, ShouldEqual, InnerJoin)
+			})
+		})
+
+		Convey("When selecting with a LEFT JOIN", func() {
+			for _, join := range []string{"LEFT JOIN", "LEFT OUTER JOIN"} {
+				join := join
+				Convey("Using "+join, func() {
+					p.Buffer = "CREATE STREAM x AS SELECT ISTREAM c:a FROM c [RANGE 3 TUPLES] " +
+						join + " d [RANGE 2 TUPLES] ON c:a = d:a WHERE d:b IS NULL"
+					p.Init()
+
+					Convey("Then the statement should be parsed as a LEFT OUTER JOIN", func() {
+						err := p.Parse()
+						So(err, ShouldBeNil)
+						p.Execute()
+
+						ps := p.parseStack
+						So(ps.Len(), ShouldEqual, 1)
+						top := ps.Peek().comp
+						So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+						comp := top.(CreateStreamAsSelectStmt).Select
+						So(len(comp.Relations), ShouldEqual, 2)
+						So(len(comp.Joins), ShouldEqual, 1)
+						So(comp.Joins[0].Type, ShouldEqual, LeftOuterJoin)
+						So(comp.Filter, ShouldNotBeNil)
+
+						Convey("And String() should return the normalized statement", func() {
+							stmt := top.(CreateStreamAsSelectStmt)
+							So(stmt.String(), ShouldEqual, "CREATE STREAM x AS SELECT ISTREAM c:a "+
+								"FROM c [RANGE 3 TUPLES] LEFT OUTER JOIN d [RANGE 2 TUPLES] "+
+								"ON c:a = d:a WHERE d:b IS NULL")
+						})
+					})
+				})
+			}
+		})
+
+		Convey("When selecting with LOOKUP clauses", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM r:v, d:loc, calib:offset FROM readings [RANGE 1 TUPLES] AS r " +
+				"LOOKUP devices AS d ON r:id LOOKUP calib ON r:id || r:sensor"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(len(comp.Relations), ShouldEqual, 1)
+				So(comp.Joins, ShouldBeEmpty)
+				So(comp.Lookups, ShouldResemble, []LookupAST{
+					{"devices", "d", RowValue{"r", "id"}},
+					{"calib", "", BinaryOpAST{Concat, RowValue{"r", "id"}, RowValue{"r", "sensor"}}},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a JOIN and a LOOKUP clause", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM c:a FROM c [RANGE 3 TUPLES] LEFT OUTER JOIN d [RANGE 2 TUPLES] ON c:a = d:a " +
+				"LOOKUP devices ON d:id WHERE devices:loc = 1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(len(comp.Joins), ShouldEqual, 1)
+				So(len(comp.Lookups), ShouldEqual, 1)
+				So(comp.Filter, ShouldNotBeNil)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When using LOOKUP without ON", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM c:a FROM c [RANGE 3 TUPLES] LOOKUP devices"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When mixing JOIN and comma-separated relations", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM c:a FROM c [RANGE 3 TUPLES] JOIN d [RANGE 2 TUPLES] ON c:a = d:a, e [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When using JOIN without ON", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM c:a FROM c [RANGE 3 TUPLES] JOIN d [RANGE 2 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
 	})
 }
@@ -12,8 +12,11 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains two correct items", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
 			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.EnsureSlideSpec(10, 10)
+			ps.EnsureWindowType(10, 10)
+			ps.EnsureLatenessSpec(10, 10)
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropOldest)
@@ -44,8 +47,11 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains two correct items (float)", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
 			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{0.2}, Seconds})
+			ps.EnsureSlideSpec(10, 10)
+			ps.EnsureWindowType(10, 10)
+			ps.EnsureLatenessSpec(10, 10)
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropNewest)
@@ -74,9 +80,124 @@ func TestAssembleStreamWindow(t *testing.T) {
 			})
 		})
 
+		Convey("When the stack contains a SLIDE specification", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{6}, Tuples})
+			ps.PushComponent(10, 12, IntervalAST{FloatLiteral{2}, Tuples})
+			ps.EnsureSlideSpec(10, 12)
+			ps.EnsureWindowType(12, 12)
+			ps.EnsureLatenessSpec(12, 12)
+			ps.EnsureCapacitySpec(12, 12)
+			ps.EnsureSheddingSpec(12, 12)
+			ps.AssembleStreamWindow()
+
+			Convey("Then AssembleStreamWindow transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a StreamWindowAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 12)
+					So(top.comp, ShouldHaveSameTypeAs, StreamWindowAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(StreamWindowAST)
+						So(comp.Name, ShouldEqual, "a")
+						So(comp.Value, ShouldEqual, 6)
+						So(comp.Unit, ShouldEqual, Tuples)
+						So(comp.Slide, ShouldResemble, IntervalAST{FloatLiteral{2}, Tuples})
+						So(comp.Capacity, ShouldEqual, UnspecifiedCapacity)
+						So(comp.Shedding, ShouldEqual, UnspecifiedSheddingOption)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a TUMBLING window with ALLOWED LATENESS", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{1}, Minutes})
+			ps.EnsureSlideSpec(10, 10)
+			ps.PushComponent(10, 12, TumblingWindow)
+			ps.EnsureWindowType(10, 12)
+			ps.PushComponent(12, 14, IntervalAST{FloatLiteral{5}, Seconds})
+			ps.EnsureLatenessSpec(12, 14)
+			ps.EnsureCapacitySpec(14, 14)
+			ps.EnsureSheddingSpec(14, 14)
+			ps.AssembleStreamWindow()
+
+			Convey("Then AssembleStreamWindow transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a StreamWindowAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 14)
+					So(top.comp, ShouldHaveSameTypeAs, StreamWindowAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(StreamWindowAST)
+						So(comp.Name, ShouldEqual, "a")
+						So(comp.Value, ShouldEqual, 1)
+						So(comp.Unit, ShouldEqual, Minutes)
+						So(comp.Slide, ShouldResemble, IntervalAST{})
+						So(comp.WindowType, ShouldEqual, TumblingWindow)
+						So(comp.AllowedLateness, ShouldResemble, IntervalAST{FloatLiteral{5}, Seconds})
+						So(comp.Capacity, ShouldEqual, UnspecifiedCapacity)
+						So(comp.Shedding, ShouldEqual, UnspecifiedSheddingOption)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a SESSION specification", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{30}, Seconds})
+			ps.AssembleSessionWindowSpec()
+			ps.EnsureCapacitySpec(10, 10)
+			ps.EnsureSheddingSpec(10, 10)
+			ps.AssembleStreamWindow()
+
+			Convey("Then AssembleStreamWindow transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a StreamWindowAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 10)
+					So(top.comp, ShouldHaveSameTypeAs, StreamWindowAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(StreamWindowAST)
+						So(comp.Name, ShouldEqual, "a")
+						So(comp.Value, ShouldEqual, 30)
+						So(comp.Unit, ShouldEqual, Seconds)
+						So(comp.Slide, ShouldResemble, IntervalAST{})
+						So(comp.WindowType, ShouldEqual, SessionWindow)
+						So(comp.AllowedLateness, ShouldResemble, IntervalAST{})
+						So(comp.Capacity, ShouldEqual, UnspecifiedCapacity)
+						So(comp.Shedding, ShouldEqual, UnspecifiedSheddingOption)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains no SESSION GAP", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+
+			Convey("Then AssembleSessionWindowSpec panics", func() {
+				So(ps.AssembleSessionWindowSpec, ShouldPanic)
+			})
+		})
+
 		Convey("When the stack contains a wrong item", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
 
 			Convey("Then AssembleStreamWindow panics", func() {
 				So(ps.AssembleStreamWindow, ShouldPanic)
@@ -121,6 +242,172 @@ func TestAssembleStreamWindow(t *testing.T) {
 			})
 		})
 
+		Convey("When selecting with a FROM and a SLIDE (TUPLES)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 6 TUPLES SLIDE 2 TUPLES, BUFFER SIZE 1]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 6)
+				So(comp.Relations[0].Unit, ShouldEqual, Tuples)
+				So(comp.Relations[0].Slide, ShouldResemble, IntervalAST{FloatLiteral{2}, Tuples})
+				So(comp.Relations[0].Capacity, ShouldEqual, 1)
+				So(comp.Relations[0].Shedding, ShouldEqual, UnspecifiedSheddingOption)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM and a SLIDE (SECONDS/float)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 60 SECONDS SLIDE 0.5 SECONDS] AS d"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 60)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Slide, ShouldResemble, IntervalAST{FloatLiteral{0.5}, Seconds})
+				So(comp.Relations[0].Alias, ShouldEqual, "d")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM and a SLIDE (TUPLES/float)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 3 TUPLES SLIDE 1.5 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotEqual, nil)
+			})
+		})
+
+		Convey("When selecting with a FROM and a TUMBLING window", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 1 MINUTES TUMBLING, ALLOWED LATENESS 5 SECONDS, DROP OLDEST IF FULL]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 1)
+				So(comp.Relations[0].Unit, ShouldEqual, Minutes)
+				So(comp.Relations[0].WindowType, ShouldEqual, TumblingWindow)
+				So(comp.Relations[0].AllowedLateness, ShouldResemble, IntervalAST{FloatLiteral{5}, Seconds})
+				So(comp.Relations[0].Shedding, ShouldEqual, DropOldest)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM and a TUMBLING window without lateness", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 500 MILLISECONDS TUMBLING]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Value, ShouldEqual, 500)
+				So(comp.Relations[0].Unit, ShouldEqual, Milliseconds)
+				So(comp.Relations[0].WindowType, ShouldEqual, TumblingWindow)
+				So(comp.Relations[0].AllowedLateness, ShouldResemble, IntervalAST{})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM and a TUPLES lateness", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 1 MINUTES TUMBLING, ALLOWED LATENESS 5 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotEqual, nil)
+			})
+		})
+
+		Convey("When selecting with a FROM and a SESSION window", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, count(b) FROM c [SESSION GAP 30 SECONDS, BUFFER SIZE 5] GROUP BY a"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldBeNil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 30)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].WindowType, ShouldEqual, SessionWindow)
+				So(comp.Relations[0].Capacity, ShouldEqual, 5)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM and a SESSION window with TUPLES", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, count(b) FROM c [SESSION GAP 3 TUPLES] GROUP BY a"
+			p.Init()
+
+			Convey("Then parsing the statement should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotEqual, nil)
+			})
+		})
+
 		Convey("When selecting with a FROM (TUPLES/float)", func() {
 			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 3.0 TUPLES]"
 			p.Init()
@@ -24,20 +24,26 @@ type Expression interface {
 
 type SelectStmt struct {
 	EmitterAST
+	DistinctAST
 	ProjectionsAST
 	WindowedFromAST
 	FilterAST
 	GroupingAST
 	HavingAST
+	OrderingAST
+	LimitAST
 }
 
 func (s SelectStmt) String() string {
 	str := []string{"SELECT", s.EmitterAST.string()}
+	str = append(str, s.DistinctAST.string())
 	str = append(str, s.ProjectionsAST.string())
 	str = append(str, s.WindowedFromAST.string())
 	str = append(str, s.FilterAST.string())
 	str = append(str, s.GroupingAST.string())
 	str = append(str, s.HavingAST.string())
+	str = append(str, s.OrderingAST.string())
+	str = append(str, s.LimitAST.string())
 
 	st := []string{}
 	for _, s := range str {
@@ -63,10 +69,29 @@ func (s SelectUnionStmt) String() string {
 type CreateStreamAsSelectStmt struct {
 	Name   StreamIdentifier
 	Select SelectStmt
+	// Schema has the fields declared for the stream. Tuples emitted by
+	// the stream are validated and coerced with it if it isn't empty.
+	Schema SchemaAST
 }
 
 func (s CreateStreamAsSelectStmt) String() string {
-	str := []string{"CREATE", "STREAM", string(s.Name), "AS", s.Select.String()}
+	str := []string{"CREATE", "STREAM", string(s.Name)}
+	if schema := s.Schema.string(); schema != "" {
+		str = append(str, schema)
+	}
+	str = append(str, "AS", s.Select.String())
+	return strings.Join(str, " ")
+}
+
+// CreateViewStmt defines a named SELECT statement which is expanded as a
+// subquery wherever the name is referred to in a FROM clause.
+type CreateViewStmt struct {
+	Name   StreamIdentifier
+	Select SelectStmt
+}
+
+func (s CreateViewStmt) String() string {
+	str := []string{"CREATE", "VIEW", string(s.Name), "AS", s.Select.String()}
 	return strings.Join(str, " ")
 }
 
@@ -85,6 +110,9 @@ type CreateSourceStmt struct {
 	Name   StreamIdentifier
 	Type   SourceSinkType
 	SourceSinkSpecsAST
+	// Schema has the fields declared for the source. Tuples generated by
+	// the source are validated and coerced with it if it isn't empty.
+	Schema SchemaAST
 }
 
 func (s CreateSourceStmt) String() string {
@@ -97,9 +125,45 @@ func (s CreateSourceStmt) String() string {
 	if specs != "" {
 		str = append(str, specs)
 	}
+	if schema := s.Schema.string(); schema != "" {
+		str = append(str, "SCHEMA", schema)
+	}
 	return strings.Join(str, " ")
 }
 
+// SchemaAST is a list of fields declared for a stream or a source.
+type SchemaAST struct {
+	Fields []SchemaFieldAST
+}
+
+func (a SchemaAST) string() string {
+	if len(a.Fields) == 0 {
+		return ""
+	}
+	fields := make([]string, len(a.Fields))
+	for i, f := range a.Fields {
+		fields[i] = f.string()
+	}
+	return "(" + strings.Join(fields, ", ") + ")"
+}
+
+// SchemaFieldAST is a field of a schema. The value of the field is
+// converted to Type. When NotNull is Yes, the field must exist and
+// must not be NULL.
+type SchemaFieldAST struct {
+	Name    Identifier
+	Type    Type
+	NotNull BinaryKeyword
+}
+
+func (a SchemaFieldAST) string() string {
+	s := string(a.Name) + " " + a.Type.String()
+	if a.NotNull == Yes {
+		s += " NOT NULL"
+	}
+	return s
+}
+
 type CreateSinkStmt struct {
 	Name StreamIdentifier
 	Type SourceSinkType
@@ -227,6 +291,15 @@ func (s DropStreamStmt) String() string {
 	return strings.Join(str, " ")
 }
 
+type DropViewStmt struct {
+	View StreamIdentifier
+}
+
+func (s DropViewStmt) String() string {
+	str := []string{"DROP", "VIEW", string(s.View)}
+	return strings.Join(str, " ")
+}
+
 type DropSinkStmt struct {
 	Sink StreamIdentifier
 }
@@ -317,6 +390,65 @@ func (s EvalStmt) String() string {
 	return strings.Join(str, " ")
 }
 
+// ExplainStmt describes how the statement it holds would be executed
+// instead of executing it. Stmt is a SelectStmt, SelectUnionStmt,
+// CreateStreamAsSelectStmt, or CreateStreamAsSelectUnionStmt.
+type ExplainStmt struct {
+	Stmt interface{}
+}
+
+func (s ExplainStmt) String() string {
+	return fmt.Sprintf("EXPLAIN %v", s.Stmt)
+}
+
+// BeginStmt starts a transaction. The statements following it are only
+// applied when the transaction is committed by a CommitStmt.
+type BeginStmt struct{}
+
+func (s BeginStmt) String() string {
+	return "BEGIN"
+}
+
+// CommitStmt commits a transaction started by a BeginStmt.
+type CommitStmt struct{}
+
+func (s CommitStmt) String() string {
+	return "COMMIT"
+}
+
+// RollbackStmt discards a transaction started by a BeginStmt.
+type RollbackStmt struct{}
+
+func (s RollbackStmt) String() string {
+	return "ROLLBACK"
+}
+
+// CreateFunctionStmt defines a function whose body is an expression.
+// Parameters of the function are referred to as columns in the body.
+type CreateFunctionStmt struct {
+	Name   FuncName
+	Params []Identifier
+	Body   Expression
+}
+
+func (s CreateFunctionStmt) String() string {
+	params := make([]string, len(s.Params))
+	for i, p := range s.Params {
+		params[i] = string(p)
+	}
+	return fmt.Sprintf("CREATE FUNCTION %s(%s) AS %s", s.Name,
+		strings.Join(params, ", "), s.Body.String())
+}
+
+type DropFunctionStmt struct {
+	Function FuncName
+}
+
+func (s DropFunctionStmt) String() string {
+	str := []string{"DROP", "FUNCTION", string(s.Function)}
+	return strings.Join(str, " ")
+}
+
 type EmitterAST struct {
 	EmitterType    Emitter
 	EmitterOptions []interface{}
@@ -371,6 +503,19 @@ func (e EmitterSampling) string() string {
 	return ""
 }
 
+// DistinctAST holds whether duplicate rows are removed from the
+// results of a SELECT statement, as in `SELECT RSTREAM DISTINCT a`.
+type DistinctAST struct {
+	Distinct bool
+}
+
+func (a DistinctAST) string() string {
+	if !a.Distinct {
+		return ""
+	}
+	return "DISTINCT"
+}
+
 type ProjectionsAST struct {
 	Projections []Expression
 }
@@ -406,6 +551,14 @@ func (a AliasAST) String() string {
 
 type WindowedFromAST struct {
 	Relations []AliasedStreamWindowAST
+	// Joins holds the JOIN conditions if the relations were combined
+	// using JOIN ... ON. Joins[i] describes how Relations[i+1] is joined
+	// with the relations before it. If the relations were given as a
+	// comma-separated list, Joins is empty.
+	Joins []JoinAST
+	// Lookups holds the shared states that are looked up for every
+	// input row using the LOOKUP clause.
+	Lookups []LookupAST
 }
 
 func (a WindowedFromAST) string() string {
@@ -413,11 +566,48 @@ func (a WindowedFromAST) string() string {
 		return ""
 	}
 
-	str := []string{}
-	for _, r := range a.Relations {
-		str = append(str, r.string())
+	var str string
+	if len(a.Joins) > 0 {
+		str = a.Relations[0].string()
+		for i, j := range a.Joins {
+			if i+1 >= len(a.Relations) {
+				break
+			}
+			str += " " + j.Type.String() + " " + a.Relations[i+1].string() +
+				" ON " + j.On.String()
+		}
+	} else {
+		rels := []string{}
+		for _, r := range a.Relations {
+			rels = append(rels, r.string())
+		}
+		str = strings.Join(rels, ", ")
 	}
-	return "FROM " + strings.Join(str, ", ")
+	for _, l := range a.Lookups {
+		str += " " + l.string()
+	}
+	return "FROM " + str
+}
+
+type JoinAST struct {
+	Type JoinType
+	On   Expression
+}
+
+type LookupAST struct {
+	State StreamIdentifier
+	// Alias is the name the looked up value can be referred to by.
+	// If it is empty, the name of the state is used.
+	Alias string
+	Key   Expression
+}
+
+func (a LookupAST) string() string {
+	str := "LOOKUP " + string(a.State)
+	if a.Alias != "" {
+		str += " AS " + a.Alias
+	}
+	return str + " ON " + a.Key.String()
 }
 
 type AliasedStreamWindowAST struct {
@@ -437,13 +627,40 @@ const UnspecifiedCapacity int64 = -1
 
 type StreamWindowAST struct {
 	Stream
+	// IntervalAST is the RANGE of the window or, for a SESSION
+	// window, the GAP after which a session is closed.
 	IntervalAST
-	Capacity int64
-	Shedding SheddingOption
+	// Slide is the interval after which the window is evaluated.
+	// Its Unit is UnspecifiedIntervalUnit if there was no SLIDE
+	// clause, meaning that the window is evaluated on every tuple.
+	Slide IntervalAST
+	// WindowType is TumblingWindow if the window is an event-time
+	// window that is evaluated when it is closed by the watermark and
+	// SessionWindow if the window is closed after a gap of inactivity.
+	WindowType WindowType
+	// AllowedLateness is the time the watermark lags behind the
+	// largest timestamp seen so far. Its Unit is
+	// UnspecifiedIntervalUnit if there was no ALLOWED LATENESS clause.
+	AllowedLateness IntervalAST
+	Capacity        int64
+	Shedding        SheddingOption
 }
 
 func (a StreamWindowAST) string() string {
 	interval := a.IntervalAST.string()
+	if a.Slide.Unit != UnspecifiedIntervalUnit {
+		interval += " SLIDE " + a.Slide.FloatLiteral.String() + " " + a.Slide.Unit.String()
+	}
+	switch a.WindowType {
+	case TumblingWindow:
+		interval += " " + a.WindowType.String()
+	case SessionWindow:
+		interval = "SESSION GAP " + a.FloatLiteral.String() + " " + a.Unit.String()
+	}
+	if a.AllowedLateness.Unit != UnspecifiedIntervalUnit {
+		interval += ", ALLOWED LATENESS " + a.AllowedLateness.FloatLiteral.String() +
+			" " + a.AllowedLateness.Unit.String()
+	}
 	capacity := ""
 	if a.Capacity != UnspecifiedCapacity {
 		capacity = fmt.Sprintf(", BUFFER SIZE %d", a.Capacity)
@@ -464,6 +681,9 @@ func (a StreamWindowAST) string() string {
 			ps = append(ps, p.String())
 		}
 		return a.Stream.Name + "(" + strings.Join(ps, ", ") + ") " + suffix
+
+	case SubqueryStream:
+		return "(" + a.Stream.Subquery.String() + ") " + suffix
 	}
 
 	return "UnknownStreamType"
@@ -491,18 +711,40 @@ func (a FilterAST) string() string {
 
 type GroupingAST struct {
 	GroupList []Expression
+	// GroupingSets has the grouping sets specified by GROUPING SETS,
+	// ROLLUP, or CUBE. Each set is a list of indexes of expressions in
+	// GroupList. GroupingSets is nil when GROUP BY only has expressions,
+	// which is the same as having one set containing all of them.
+	GroupingSets [][]int
 }
 
 func (a GroupingAST) string() string {
-	if len(a.GroupList) == 0 {
+	if len(a.GroupList) == 0 && a.GroupingSets == nil {
 		return ""
 	}
 
 	str := []string{}
-	for _, e := range a.GroupList {
-		str = append(str, e.String())
+	if a.GroupingSets == nil {
+		for _, e := range a.GroupList {
+			str = append(str, e.String())
+		}
+		return "GROUP BY " + strings.Join(str, ", ")
+	}
+	for _, set := range a.GroupingSets {
+		exprs := make([]string, len(set))
+		for i, idx := range set {
+			exprs[i] = a.GroupList[idx].String()
+		}
+		str = append(str, "("+strings.Join(exprs, ", ")+")")
 	}
-	return "GROUP BY " + strings.Join(str, ", ")
+	return "GROUP BY GROUPING SETS (" + strings.Join(str, ", ") + ")"
+}
+
+// GroupingSetsAST is a list of grouping sets specified by GROUPING SETS,
+// ROLLUP, or CUBE in a GROUP BY clause. It's only used while parsing a
+// statement and is converted to GroupingAST.GroupingSets.
+type GroupingSetsAST struct {
+	Sets [][]Expression
 }
 
 type HavingAST struct {
@@ -516,6 +758,36 @@ func (a HavingAST) string() string {
 	return "HAVING " + a.Having.String()
 }
 
+type OrderingAST struct {
+	OrderBy []SortedExpressionAST
+}
+
+func (a OrderingAST) string() string {
+	if len(a.OrderBy) == 0 {
+		return ""
+	}
+
+	str := []string{}
+	for _, e := range a.OrderBy {
+		str = append(str, e.String())
+	}
+	return "ORDER BY " + strings.Join(str, ", ")
+}
+
+// LimitAST holds the LIMIT clause of a SELECT statement. Limited is
+// false when there is no LIMIT clause.
+type LimitAST struct {
+	Limited bool
+	Limit   int64
+}
+
+func (a LimitAST) string() string {
+	if !a.Limited {
+		return ""
+	}
+	return fmt.Sprintf("LIMIT %d", a.Limit)
+}
+
 type SourceSinkSpecsAST struct {
 	Params []SourceSinkParamAST
 }
@@ -627,6 +899,14 @@ func (b BinaryOpAST) String() string {
 			encloseRight = true
 		}
 	}
+	// BETWEEN can only be combined with other expressions using
+	// AND/OR without parentheses
+	if _, ok := b.Left.(BetweenAST); ok && b.Op != And && b.Op != Or {
+		encloseLeft = true
+	}
+	if _, ok := b.Right.(BetweenAST); ok && b.Op != And && b.Op != Or {
+		encloseRight = true
+	}
 
 	if encloseLeft {
 		str[0] = "(" + str[0] + ")"
@@ -638,6 +918,51 @@ func (b BinaryOpAST) String() string {
 	return strings.Join(str, " ")
 }
 
+// BetweenAST represents `Expr BETWEEN Lower AND Upper` (or NOT BETWEEN,
+// depending on Op).
+type BetweenAST struct {
+	Op    Operator
+	Expr  Expression
+	Lower Expression
+	Upper Expression
+}
+
+func (b BetweenAST) ReferencedRelations() map[string]bool {
+	rels := map[string]bool{}
+	for _, e := range []Expression{b.Expr, b.Lower, b.Upper} {
+		for rel := range e.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (b BetweenAST) RenameReferencedRelation(from, to string) Expression {
+	return BetweenAST{b.Op,
+		b.Expr.RenameReferencedRelation(from, to),
+		b.Lower.RenameReferencedRelation(from, to),
+		b.Upper.RenameReferencedRelation(from, to)}
+}
+
+func (b BetweenAST) Foldable() bool {
+	return b.Expr.Foldable() && b.Lower.Foldable() && b.Upper.Foldable()
+}
+
+func (b BetweenAST) String() string {
+	str := []string{b.Expr.String(), b.Lower.String(), b.Upper.String()}
+	for i, e := range []Expression{b.Expr, b.Lower, b.Upper} {
+		switch obj := e.(type) {
+		case BinaryOpAST:
+			if !obj.Op.hasHigherPrecedenceThan(b.Op) {
+				str[i] = "(" + str[i] + ")"
+			}
+		case BetweenAST:
+			str[i] = "(" + str[i] + ")"
+		}
+	}
+	return fmt.Sprintf("%s %s %s AND %s", str[0], b.Op, str[1], str[2])
+}
+
 type UnaryOpAST struct {
 	Op   Operator
 	Expr Expression
@@ -707,6 +1032,10 @@ type FuncAppAST struct {
 	Function FuncName
 	ExpressionsAST
 	Ordering []SortedExpressionAST
+	// Filter is the condition of a FILTER (WHERE ...) clause, or nil
+	// if there is no such clause. Only the rows satisfying the
+	// condition are used as the input of an aggregate function.
+	Filter Expression
 }
 
 func (f FuncAppAST) ReferencedRelations() map[string]bool {
@@ -721,6 +1050,11 @@ func (f FuncAppAST) ReferencedRelations() map[string]bool {
 			rels[rel] = true
 		}
 	}
+	if f.Filter != nil {
+		for rel := range f.Filter.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
 	return rels
 }
 
@@ -733,7 +1067,11 @@ func (f FuncAppAST) RenameReferencedRelation(from, to string) Expression {
 	for i, expr := range f.Ordering {
 		newOrderExprs[i] = expr.RenameReferencedRelation(from, to).(SortedExpressionAST)
 	}
-	return FuncAppAST{f.Function, ExpressionsAST{newExprs}, newOrderExprs}
+	var newFilter Expression
+	if f.Filter != nil {
+		newFilter = f.Filter.RenameReferencedRelation(from, to)
+	}
+	return FuncAppAST{f.Function, ExpressionsAST{newExprs}, newOrderExprs, newFilter}
 }
 
 func (f FuncAppAST) Foldable() bool {
@@ -742,9 +1080,9 @@ func (f FuncAppAST) Foldable() bool {
 	if string(f.Function) == "now" && len(f.Expressions) == 0 {
 		return false
 	}
-	// if there is a ORDER BY clause, then this is definitely an
-	// aggregate function and therefore not foldable
-	if len(f.Ordering) > 0 {
+	// if there is a ORDER BY or FILTER clause, then this is definitely
+	// an aggregate function and therefore not foldable
+	if len(f.Ordering) > 0 || f.Filter != nil {
 		return false
 	}
 	for _, expr := range f.Expressions {
@@ -765,7 +1103,83 @@ func (f FuncAppAST) String() string {
 		}
 		s += " ORDER BY " + strings.Join(orderStrings, ", ")
 	}
-	return s + ")"
+	s += ")"
+	if f.Filter != nil {
+		s += " FILTER (WHERE " + f.Filter.String() + ")"
+	}
+	return s
+}
+
+// AnalyticFuncAppAST is the application of an analytic function,
+// e.g., `lag(x) OVER (PARTITION BY k ORDER BY ts())`.
+type AnalyticFuncAppAST struct {
+	FuncAppAST
+	Over OverAST
+}
+
+func (f AnalyticFuncAppAST) ReferencedRelations() map[string]bool {
+	rels := f.FuncAppAST.ReferencedRelations()
+	for _, expr := range f.Over.Partition {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	for _, expr := range f.Over.Ordering {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (f AnalyticFuncAppAST) RenameReferencedRelation(from, to string) Expression {
+	var newPartition []Expression
+	for _, expr := range f.Over.Partition {
+		newPartition = append(newPartition, expr.RenameReferencedRelation(from, to))
+	}
+	var newOrderExprs []SortedExpressionAST
+	for _, expr := range f.Over.Ordering {
+		newOrderExprs = append(newOrderExprs,
+			expr.RenameReferencedRelation(from, to).(SortedExpressionAST))
+	}
+	return AnalyticFuncAppAST{
+		f.FuncAppAST.RenameReferencedRelation(from, to).(FuncAppAST),
+		OverAST{newPartition, newOrderExprs},
+	}
+}
+
+func (f AnalyticFuncAppAST) Foldable() bool {
+	// the result depends on other rows
+	return false
+}
+
+func (f AnalyticFuncAppAST) String() string {
+	return f.FuncAppAST.String() + " OVER (" + f.Over.string() + ")"
+}
+
+// OverAST represents the OVER clause of an analytic function application.
+type OverAST struct {
+	Partition []Expression
+	Ordering  []SortedExpressionAST
+}
+
+func (a OverAST) string() string {
+	str := []string{}
+	if len(a.Partition) > 0 {
+		partitionStrings := make([]string, len(a.Partition))
+		for i, expr := range a.Partition {
+			partitionStrings[i] = expr.String()
+		}
+		str = append(str, "PARTITION BY "+strings.Join(partitionStrings, ", "))
+	}
+	if len(a.Ordering) > 0 {
+		orderStrings := make([]string, len(a.Ordering))
+		for i, expr := range a.Ordering {
+			orderStrings[i] = expr.String()
+		}
+		str = append(str, "ORDER BY "+strings.Join(orderStrings, ", "))
+	}
+	return strings.Join(str, " ")
 }
 
 type FuncAppSelectorAST struct {
@@ -821,6 +1235,28 @@ func (s SortedExpressionAST) String() string {
 	return ret
 }
 
+// DistinctExpressionAST is a parameter of an aggregate function that
+// only takes distinct values into account, as in `count(DISTINCT a)`.
+type DistinctExpressionAST struct {
+	Expr Expression
+}
+
+func (d DistinctExpressionAST) ReferencedRelations() map[string]bool {
+	return d.Expr.ReferencedRelations()
+}
+
+func (d DistinctExpressionAST) RenameReferencedRelation(from, to string) Expression {
+	return DistinctExpressionAST{d.Expr.RenameReferencedRelation(from, to)}
+}
+
+func (d DistinctExpressionAST) Foldable() bool {
+	return d.Expr.Foldable()
+}
+
+func (d DistinctExpressionAST) String() string {
+	return "DISTINCT " + d.Expr.String()
+}
+
 type ArrayAST struct {
 	ExpressionsAST
 }
@@ -931,16 +1367,18 @@ func (k KeyValuePairAST) string() string {
 
 // It seems not possible in Go to have a variable that says "this is
 // either struct A or struct B or struct C", so we build one struct
-// that serves both for "real" streams (as in `FROM x`) and stream-
-// generating functions (as in `FROM series(1, 5)`).
+// that serves both for "real" streams (as in `FROM x`), stream-
+// generating functions (as in `FROM series(1, 5)`), and subqueries
+// (as in `FROM (SELECT ISTREAM * FROM x [RANGE 1 TUPLES])`).
 type Stream struct {
-	Type   StreamType
-	Name   string
-	Params []Expression
+	Type     StreamType
+	Name     string
+	Params   []Expression
+	Subquery *SelectStmt
 }
 
 func NewStream(s string) Stream {
-	return Stream{ActualStream, s, nil}
+	return Stream{ActualStream, s, nil, nil}
 }
 
 type Wildcard struct {
@@ -1396,6 +1834,7 @@ const (
 	UnknownStreamType StreamType = iota
 	ActualStream
 	UDSFStream
+	SubqueryStream
 )
 
 func (st StreamType) String() string {
@@ -1405,6 +1844,8 @@ func (st StreamType) String() string {
 		s = "ActualStream"
 	case UDSFStream:
 		s = "UDSFStream"
+	case SubqueryStream:
+		s = "SubqueryStream"
 	}
 	return s
 }
@@ -1416,6 +1857,7 @@ const (
 	Tuples
 	Seconds
 	Milliseconds
+	Minutes
 )
 
 func (i IntervalUnit) String() string {
@@ -1427,6 +1869,46 @@ func (i IntervalUnit) String() string {
 		s = "SECONDS"
 	case Milliseconds:
 		s = "MILLISECONDS"
+	case Minutes:
+		s = "MINUTES"
+	}
+	return s
+}
+
+type WindowType int
+
+const (
+	UnspecifiedWindowType WindowType = iota
+	TumblingWindow
+	SessionWindow
+)
+
+func (t WindowType) String() string {
+	s := "UnspecifiedWindowType"
+	switch t {
+	case TumblingWindow:
+		s = "TUMBLING"
+	case SessionWindow:
+		s = "SESSION"
+	}
+	return s
+}
+
+type JoinType int
+
+const (
+	UnspecifiedJoinType JoinType = iota
+	InnerJoin
+	LeftOuterJoin
+)
+
+func (t JoinType) String() string {
+	s := "UnspecifiedJoinType"
+	switch t {
+	case InnerJoin:
+		s = "INNER JOIN"
+	case LeftOuterJoin:
+		s = "LEFT OUTER JOIN"
 	}
 	return s
 }
@@ -1564,6 +2046,18 @@ const (
 	Greater
 	GreaterOrEqual
 	NotEqual
+	Like
+	NotLike
+	ILike
+	NotILike
+	SimilarTo
+	NotSimilarTo
+	RegexpMatch
+	NotRegexpMatch
+	In
+	NotIn
+	Between
+	NotBetween
 	Concat
 	Is
 	IsNot
@@ -1583,6 +2077,9 @@ func (op Operator) hasSamePrecedenceAs(rhs Operator) bool {
 	if Less <= op && op <= GreaterOrEqual && Less <= rhs && rhs <= GreaterOrEqual {
 		return true
 	}
+	if Like <= op && op <= NotBetween && Like <= rhs && rhs <= NotBetween {
+		return true
+	}
 	if Is <= op && op <= IsNot && Is <= rhs && rhs <= IsNot {
 		return true
 	}
@@ -1625,6 +2122,30 @@ func (o Operator) String() string {
 		s = ">="
 	case NotEqual:
 		s = "!="
+	case Like:
+		s = "LIKE"
+	case NotLike:
+		s = "NOT LIKE"
+	case ILike:
+		s = "ILIKE"
+	case NotILike:
+		s = "NOT ILIKE"
+	case SimilarTo:
+		s = "SIMILAR TO"
+	case NotSimilarTo:
+		s = "NOT SIMILAR TO"
+	case RegexpMatch:
+		s = "~"
+	case NotRegexpMatch:
+		s = "!~"
+	case In:
+		s = "IN"
+	case NotIn:
+		s = "NOT IN"
+	case Between:
+		s = "BETWEEN"
+	case NotBetween:
+		s = "NOT BETWEEN"
 	case Concat:
 		s = "||"
 	case Is:
@@ -21,11 +21,15 @@ const (
 	ruleSourceStmt
 	ruleSinkStmt
 	ruleStateStmt
+	ruleViewStmt
+	ruleFunctionStmt
+	ruleTransactionStmt
 	ruleStreamStmt
 	ruleSelectStmt
 	ruleSelectUnionStmt
 	ruleCreateStreamAsSelectStmt
 	ruleCreateStreamAsSelectUnionStmt
+	ruleCreateViewStmt
 	ruleCreateSourceStmt
 	ruleCreateSinkStmt
 	ruleCreateStateStmt
@@ -38,12 +42,19 @@ const (
 	ruleRewindSourceStmt
 	ruleDropSourceStmt
 	ruleDropStreamStmt
+	ruleDropViewStmt
 	ruleDropSinkStmt
 	ruleDropStateStmt
+	ruleCreateFunctionStmt
+	ruleDropFunctionStmt
 	ruleLoadStateStmt
 	ruleLoadStateOrCreateStmt
 	ruleSaveStateStmt
+	ruleBeginStmt
+	ruleCommitStmt
+	ruleRollbackStmt
 	ruleEvalStmt
+	ruleExplainStmt
 	ruleEmitter
 	ruleEmitterOptions
 	ruleEmitterOptionCombinations
@@ -54,6 +65,7 @@ const (
 	ruleTimeBasedSampling
 	ruleTimeBasedSamplingSeconds
 	ruleTimeBasedSamplingMilliseconds
+	ruleDistinct
 	ruleProjections
 	ruleProjection
 	ruleAliasExpression
@@ -62,15 +74,35 @@ const (
 	ruleTimeInterval
 	ruleTuplesInterval
 	ruleRelations
+	ruleJoinedRelations
+	ruleJoinClause
+	ruleJoinType
+	ruleLookup
+	ruleLookupAliasOpt
+	ruleInnerJoin
+	ruleLeftOuterJoin
 	ruleFilter
 	ruleGrouping
 	ruleGroupList
+	ruleGroupingElement
+	ruleGroupingSets
+	ruleGroupingSet
+	ruleRollup
+	ruleCube
 	ruleHaving
+	ruleOrdering
+	ruleLimit
 	ruleRelationLike
 	ruleAliasedStreamWindow
 	ruleStreamWindow
+	ruleRangeWindowSpec
+	ruleSessionWindowSpec
 	ruleStreamLike
+	ruleSubquery
 	ruleUDSFFuncApp
+	ruleSlideSpecOpt
+	ruleWindowTypeOpt
+	ruleLatenessSpecOpt
 	ruleCapacitySpecOpt
 	ruleSheddingSpecOpt
 	ruleSheddingOption
@@ -84,6 +116,12 @@ const (
 	ruleParamArrayExpr
 	ruleParamMapExpr
 	ruleParamKeyValuePair
+	ruleStreamSchemaOpt
+	ruleSourceSchemaOpt
+	ruleSchemaFields
+	ruleSchemaField
+	ruleNotNullOpt
+	ruleNotNull
 	rulePausedOpt
 	ruleExpressionOrWildcard
 	ruleExpression
@@ -91,6 +129,7 @@ const (
 	ruleandExpr
 	rulenotExpr
 	rulecomparisonExpr
+	ruleInList
 	ruleotherOpExpr
 	ruleisExpr
 	ruletermExpr
@@ -100,11 +139,17 @@ const (
 	rulebaseExpr
 	ruleFuncTypeCast
 	ruleFuncApp
+	ruleFuncFilter
 	ruleFuncAppSelector
 	ruleFuncElemAccessor
+	ruleAnalyticFuncApp
+	rulePartitionBy
+	ruleOverOrdering
 	ruleFuncAppWithOrderBy
 	ruleFuncAppWithoutOrderBy
 	ruleFuncParams
+	ruleFuncParam
+	ruleDistinctExpression
 	ruleParamsOrder
 	ruleSortedExpression
 	ruleOrderDirectionOpt
@@ -117,6 +162,9 @@ const (
 	ruleWhenThenPair
 	ruleLiteral
 	ruleComparisonOp
+	rulePatternMatchOp
+	ruleInOp
+	ruleBetweenOp
 	ruleOtherOp
 	ruleIsOp
 	rulePlusMinusOp
@@ -129,6 +177,7 @@ const (
 	ruleNonNegativeNumericLiteral
 	ruleFloatLiteral
 	ruleFunction
+	rulePlaceholder
 	ruleNullLiteral
 	ruleMissing
 	ruleBooleanLiteral
@@ -140,8 +189,10 @@ const (
 	ruleDSTREAM
 	ruleRSTREAM
 	ruleTUPLES
+	ruleMINUTES
 	ruleSECONDS
 	ruleMILLISECONDS
+	ruleTUMBLING
 	ruleWait
 	ruleDropOldest
 	ruleDropNewest
@@ -170,6 +221,18 @@ const (
 	ruleGreater
 	ruleGreaterOrEqual
 	ruleNotEqual
+	ruleLike
+	ruleNotLike
+	ruleILike
+	ruleNotILike
+	ruleSimilarTo
+	ruleNotSimilarTo
+	ruleRegexpMatch
+	ruleNotRegexpMatch
+	ruleIn
+	ruleNotIn
+	ruleBetween
+	ruleNotBetween
 	ruleConcat
 	ruleIs
 	ruleIsNot
@@ -339,6 +402,58 @@ const (
 	ruleAction133
 	ruleAction134
 	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
+	ruleAction166
+	ruleAction167
+	ruleAction168
+	ruleAction169
+	ruleAction170
+	ruleAction171
+	ruleAction172
+	ruleAction173
+	ruleAction174
+	ruleAction175
+	ruleAction176
+	ruleAction177
+	ruleAction178
+	ruleAction179
+	ruleAction180
+	ruleAction181
+	ruleAction182
+	ruleAction183
+	ruleAction184
+	ruleAction185
+	ruleAction186
+	ruleAction187
 )
 
 var rul3s = [...]string{
@@ -350,11 +465,15 @@ var rul3s = [...]string{
 	"SourceStmt",
 	"SinkStmt",
 	"StateStmt",
+	"ViewStmt",
+	"FunctionStmt",
+	"TransactionStmt",
 	"StreamStmt",
 	"SelectStmt",
 	"SelectUnionStmt",
 	"CreateStreamAsSelectStmt",
 	"CreateStreamAsSelectUnionStmt",
+	"CreateViewStmt",
 	"CreateSourceStmt",
 	"CreateSinkStmt",
 	"CreateStateStmt",
@@ -367,12 +486,19 @@ var rul3s = [...]string{
 	"RewindSourceStmt",
 	"DropSourceStmt",
 	"DropStreamStmt",
+	"DropViewStmt",
 	"DropSinkStmt",
 	"DropStateStmt",
+	"CreateFunctionStmt",
+	"DropFunctionStmt",
 	"LoadStateStmt",
 	"LoadStateOrCreateStmt",
 	"SaveStateStmt",
+	"BeginStmt",
+	"CommitStmt",
+	"RollbackStmt",
 	"EvalStmt",
+	"ExplainStmt",
 	"Emitter",
 	"EmitterOptions",
 	"EmitterOptionCombinations",
@@ -383,6 +509,7 @@ var rul3s = [...]string{
 	"TimeBasedSampling",
 	"TimeBasedSamplingSeconds",
 	"TimeBasedSamplingMilliseconds",
+	"Distinct",
 	"Projections",
 	"Projection",
 	"AliasExpression",
@@ -391,15 +518,35 @@ var rul3s = [...]string{
 	"TimeInterval",
 	"TuplesInterval",
 	"Relations",
+	"JoinedRelations",
+	"JoinClause",
+	"JoinType",
+	"Lookup",
+	"LookupAliasOpt",
+	"InnerJoin",
+	"LeftOuterJoin",
 	"Filter",
 	"Grouping",
 	"GroupList",
+	"GroupingElement",
+	"GroupingSets",
+	"GroupingSet",
+	"Rollup",
+	"Cube",
 	"Having",
+	"Ordering",
+	"Limit",
 	"RelationLike",
 	"AliasedStreamWindow",
 	"StreamWindow",
+	"RangeWindowSpec",
+	"SessionWindowSpec",
 	"StreamLike",
+	"Subquery",
 	"UDSFFuncApp",
+	"SlideSpecOpt",
+	"WindowTypeOpt",
+	"LatenessSpecOpt",
 	"CapacitySpecOpt",
 	"SheddingSpecOpt",
 	"SheddingOption",
@@ -413,6 +560,12 @@ var rul3s = [...]string{
 	"ParamArrayExpr",
 	"ParamMapExpr",
 	"ParamKeyValuePair",
+	"StreamSchemaOpt",
+	"SourceSchemaOpt",
+	"SchemaFields",
+	"SchemaField",
+	"NotNullOpt",
+	"NotNull",
 	"PausedOpt",
 	"ExpressionOrWildcard",
 	"Expression",
@@ -420,6 +573,7 @@ var rul3s = [...]string{
 	"andExpr",
 	"notExpr",
 	"comparisonExpr",
+	"InList",
 	"otherOpExpr",
 	"isExpr",
 	"termExpr",
@@ -429,11 +583,17 @@ var rul3s = [...]string{
 	"baseExpr",
 	"FuncTypeCast",
 	"FuncApp",
+	"FuncFilter",
 	"FuncAppSelector",
 	"FuncElemAccessor",
+	"AnalyticFuncApp",
+	"PartitionBy",
+	"OverOrdering",
 	"FuncAppWithOrderBy",
 	"FuncAppWithoutOrderBy",
 	"FuncParams",
+	"FuncParam",
+	"DistinctExpression",
 	"ParamsOrder",
 	"SortedExpression",
 	"OrderDirectionOpt",
@@ -446,6 +606,9 @@ var rul3s = [...]string{
 	"WhenThenPair",
 	"Literal",
 	"ComparisonOp",
+	"PatternMatchOp",
+	"InOp",
+	"BetweenOp",
 	"OtherOp",
 	"IsOp",
 	"PlusMinusOp",
@@ -458,6 +621,7 @@ var rul3s = [...]string{
 	"NonNegativeNumericLiteral",
 	"FloatLiteral",
 	"Function",
+	"Placeholder",
 	"NullLiteral",
 	"Missing",
 	"BooleanLiteral",
@@ -469,8 +633,10 @@ var rul3s = [...]string{
 	"DSTREAM",
 	"RSTREAM",
 	"TUPLES",
+	"MINUTES",
 	"SECONDS",
 	"MILLISECONDS",
+	"TUMBLING",
 	"Wait",
 	"DropOldest",
 	"DropNewest",
@@ -499,6 +665,18 @@ var rul3s = [...]string{
 	"Greater",
 	"GreaterOrEqual",
 	"NotEqual",
+	"Like",
+	"NotLike",
+	"ILike",
+	"NotILike",
+	"SimilarTo",
+	"NotSimilarTo",
+	"RegexpMatch",
+	"NotRegexpMatch",
+	"In",
+	"NotIn",
+	"Between",
+	"NotBetween",
 	"Concat",
 	"Is",
 	"IsNot",
@@ -668,6 +846,58 @@ var rul3s = [...]string{
 	"Action133",
 	"Action134",
 	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
+	"Action166",
+	"Action167",
+	"Action168",
+	"Action169",
+	"Action170",
+	"Action171",
+	"Action172",
+	"Action173",
+	"Action174",
+	"Action175",
+	"Action176",
+	"Action177",
+	"Action178",
+	"Action179",
+	"Action180",
+	"Action181",
+	"Action182",
+	"Action183",
+	"Action184",
+	"Action185",
+	"Action186",
+	"Action187",
 }
 
 type token32 struct {
@@ -784,7 +1014,7 @@ type bqlPegBackend struct {
 
 	Buffer string
 	buffer []rune
-	rules  [326]func() bool
+	rules  [441]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -902,546 +1132,762 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction6:
 
-			p.AssembleCreateSource()
+			p.AssembleCreateView()
 
 		case ruleAction7:
 
-			p.AssembleCreateSink()
+			p.AssembleCreateSource()
 
 		case ruleAction8:
 
-			p.AssembleCreateState()
+			p.AssembleCreateSink()
 
 		case ruleAction9:
 
-			p.AssembleUpdateState()
+			p.AssembleCreateState()
 
 		case ruleAction10:
 
-			p.AssembleUpdateSource()
+			p.AssembleUpdateState()
 
 		case ruleAction11:
 
-			p.AssembleUpdateSink()
+			p.AssembleUpdateSource()
 
 		case ruleAction12:
 
-			p.AssembleInsertIntoFrom()
+			p.AssembleUpdateSink()
 
 		case ruleAction13:
 
-			p.AssemblePauseSource()
+			p.AssembleInsertIntoFrom()
 
 		case ruleAction14:
 
-			p.AssembleResumeSource()
+			p.AssemblePauseSource()
 
 		case ruleAction15:
 
-			p.AssembleRewindSource()
+			p.AssembleResumeSource()
 
 		case ruleAction16:
 
-			p.AssembleDropSource()
+			p.AssembleRewindSource()
 
 		case ruleAction17:
 
-			p.AssembleDropStream()
+			p.AssembleDropSource()
 
 		case ruleAction18:
 
-			p.AssembleDropSink()
+			p.AssembleDropStream()
 
 		case ruleAction19:
 
-			p.AssembleDropState()
+			p.AssembleDropView()
 
 		case ruleAction20:
 
-			p.AssembleLoadState()
+			p.AssembleDropSink()
 
 		case ruleAction21:
 
-			p.AssembleLoadStateOrCreate()
+			p.AssembleDropState()
 
 		case ruleAction22:
 
-			p.AssembleSaveState()
+			p.AssembleCreateFunction(begin, end)
 
 		case ruleAction23:
 
-			p.AssembleEval(begin, end)
+			p.AssembleDropFunction()
 
 		case ruleAction24:
 
-			p.AssembleEmitter()
+			p.AssembleLoadState()
 
 		case ruleAction25:
 
-			p.AssembleEmitterOptions(begin, end)
+			p.AssembleLoadStateOrCreate()
 
 		case ruleAction26:
 
-			p.AssembleEmitterLimit()
+			p.AssembleSaveState()
 
 		case ruleAction27:
 
-			p.AssembleEmitterSampling(CountBasedSampling, 1)
+			p.PushComponent(begin, end, BeginStmt{})
 
 		case ruleAction28:
 
-			p.AssembleEmitterSampling(RandomizedSampling, 1)
+			p.PushComponent(begin, end, CommitStmt{})
 
 		case ruleAction29:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 1)
+			p.PushComponent(begin, end, RollbackStmt{})
 
 		case ruleAction30:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+			p.AssembleEval(begin, end)
 
 		case ruleAction31:
 
-			p.AssembleProjections(begin, end)
+			p.AssembleExplain()
 
 		case ruleAction32:
 
-			p.AssembleAlias()
+			p.AssembleEmitter()
 
 		case ruleAction33:
 
-			// This is *always* executed, even if there is no
-			// FROM clause present in the statement.
-			p.AssembleWindowedFrom(begin, end)
+			p.AssembleEmitterOptions(begin, end)
 
 		case ruleAction34:
 
-			p.AssembleInterval()
+			p.AssembleEmitterLimit()
 
 		case ruleAction35:
 
-			p.AssembleInterval()
+			p.AssembleEmitterSampling(CountBasedSampling, 1)
 
 		case ruleAction36:
 
-			// This is *always* executed, even if there is no
-			// WHERE clause present in the statement.
-			p.AssembleFilter(begin, end)
+			p.AssembleEmitterSampling(RandomizedSampling, 1)
 
 		case ruleAction37:
 
-			// This is *always* executed, even if there is no
-			// GROUP BY clause present in the statement.
-			p.AssembleGrouping(begin, end)
+			p.AssembleEmitterSampling(TimeBasedSampling, 1)
 
 		case ruleAction38:
 
-			// This is *always* executed, even if there is no
-			// HAVING clause present in the statement.
-			p.AssembleHaving(begin, end)
+			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 
 		case ruleAction39:
 
-			p.EnsureAliasedStreamWindow()
+			p.AssembleDistinct(begin, end)
 
 		case ruleAction40:
 
-			p.AssembleAliasedStreamWindow()
+			p.AssembleProjections(begin, end)
 
 		case ruleAction41:
 
-			p.AssembleStreamWindow()
+			p.AssembleAlias()
 
 		case ruleAction42:
 
-			p.AssembleUDSFFuncApp()
+			// This is *always* executed, even if there is no
+			// FROM clause present in the statement.
+			p.AssembleWindowedFrom(begin, end)
 
 		case ruleAction43:
 
-			p.EnsureCapacitySpec(begin, end)
+			p.AssembleInterval()
 
 		case ruleAction44:
 
-			p.EnsureSheddingSpec(begin, end)
+			p.AssembleInterval()
 
 		case ruleAction45:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.AssembleJoin()
 
 		case ruleAction46:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.AssembleLookup()
 
 		case ruleAction47:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.EnsureIdentifier(begin, end)
 
 		case ruleAction48:
 
-			p.EnsureIdentifier(begin, end)
+			p.PushComponent(begin, end, InnerJoin)
 
 		case ruleAction49:
 
-			p.AssembleSourceSinkParam()
+			p.PushComponent(begin, end, LeftOuterJoin)
 
 		case ruleAction50:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			// This is *always* executed, even if there is no
+			// WHERE clause present in the statement.
+			p.AssembleFilter(begin, end)
 
 		case ruleAction51:
 
-			p.AssembleMap(begin, end)
+			// This is *always* executed, even if there is no
+			// GROUP BY clause present in the statement.
+			p.AssembleGrouping(begin, end)
 
 		case ruleAction52:
 
-			p.AssembleKeyValuePair()
+			p.AssembleGroupingSets(begin, end)
 
 		case ruleAction53:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction54:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleRollup(begin, end)
 
 		case ruleAction55:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleCube(begin, end)
 
 		case ruleAction56:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// HAVING clause present in the statement.
+			p.AssembleHaving(begin, end)
 
 		case ruleAction57:
 
-			p.AssembleBinaryOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// ORDER BY clause present in the statement.
+			p.AssembleOrdering(begin, end)
 
 		case ruleAction58:
 
-			p.AssembleBinaryOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// LIMIT clause present in the statement.
+			p.AssembleLimit(begin, end)
 
 		case ruleAction59:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureAliasedStreamWindow()
 
 		case ruleAction60:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleAliasedStreamWindow()
 
 		case ruleAction61:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleStreamWindow()
 
 		case ruleAction62:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.AssembleSessionWindowSpec()
 
 		case ruleAction63:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleSubquery(begin, end)
 
 		case ruleAction64:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleUDSFFuncApp()
 
 		case ruleAction65:
 
-			p.AssembleFuncAppSelector()
+			p.EnsureSlideSpec(begin, end)
 
 		case ruleAction66:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRaw(substr))
+			p.EnsureWindowType(begin, end)
 
 		case ruleAction67:
 
-			p.AssembleFuncApp()
+			p.EnsureLatenessSpec(begin, end)
 
 		case ruleAction68:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleFuncApp()
+			p.EnsureCapacitySpec(begin, end)
 
 		case ruleAction69:
 
-			p.AssembleExpressions(begin, end)
+			p.EnsureSheddingSpec(begin, end)
 
 		case ruleAction70:
 
-			p.AssembleExpressions(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction71:
 
-			p.AssembleSortedExpression()
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction72:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction73:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			p.EnsureIdentifier(begin, end)
 
 		case ruleAction74:
 
-			p.AssembleMap(begin, end)
+			p.AssembleSourceSinkParam()
 
 		case ruleAction75:
 
-			p.AssembleKeyValuePair()
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction76:
 
-			p.AssembleConditionCase(begin, end)
+			p.AssembleMap(begin, end)
 
 		case ruleAction77:
 
-			p.AssembleExpressionCase(begin, end)
+			p.AssembleKeyValuePair()
 
 		case ruleAction78:
 
-			p.AssembleWhenThenPair()
+			p.AssembleSchema(begin, end)
 
 		case ruleAction79:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStream(substr))
+			p.AssembleSchema(begin, end)
 
 		case ruleAction80:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
+			p.AssembleSchemaField()
 
 		case ruleAction81:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowValue(substr))
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction82:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction83:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction84:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewFloatLiteral(substr))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction85:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, FuncName(substr))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction86:
 
-			p.PushComponent(begin, end, NewNullLiteral())
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction87:
 
-			p.PushComponent(begin, end, NewMissing())
+			p.AssembleComparison(begin, end)
 
 		case ruleAction88:
 
-			p.PushComponent(begin, end, NewBoolLiteral(true))
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction89:
 
-			p.PushComponent(begin, end, NewBoolLiteral(false))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction90:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewWildcard(substr))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction91:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStringLiteral(substr))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction92:
 
-			p.PushComponent(begin, end, Istream)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction93:
 
-			p.PushComponent(begin, end, Dstream)
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction94:
 
-			p.PushComponent(begin, end, Rstream)
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction95:
 
-			p.PushComponent(begin, end, Tuples)
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction96:
 
-			p.PushComponent(begin, end, Seconds)
+			p.AssembleFuncFilter()
 
 		case ruleAction97:
 
-			p.PushComponent(begin, end, Milliseconds)
+			// This is *always* executed, even if there is no
+			// FILTER clause present in the function application.
+			p.AssembleFilter(begin, end)
 
 		case ruleAction98:
 
-			p.PushComponent(begin, end, Wait)
+			p.AssembleFuncAppSelector()
 
 		case ruleAction99:
 
-			p.PushComponent(begin, end, DropOldest)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRaw(substr))
 
 		case ruleAction100:
 
-			p.PushComponent(begin, end, DropNewest)
+			p.AssembleAnalyticFuncApp()
 
 		case ruleAction101:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, StreamIdentifier(substr))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction102:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkType(substr))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction103:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+			p.AssembleFuncApp()
 
 		case ruleAction104:
 
-			p.PushComponent(begin, end, Yes)
+			p.AssembleExpressions(begin, end)
+			p.AssembleFuncApp()
 
 		case ruleAction105:
 
-			p.PushComponent(begin, end, No)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction106:
 
-			p.PushComponent(begin, end, Yes)
+			p.AssembleDistinctExpression(begin, end)
 
 		case ruleAction107:
 
-			p.PushComponent(begin, end, No)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction108:
 
-			p.PushComponent(begin, end, Bool)
+			p.AssembleSortedExpression()
 
 		case ruleAction109:
 
-			p.PushComponent(begin, end, Int)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction110:
 
-			p.PushComponent(begin, end, Float)
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction111:
 
-			p.PushComponent(begin, end, String)
+			p.AssembleMap(begin, end)
 
 		case ruleAction112:
 
-			p.PushComponent(begin, end, Blob)
+			p.AssembleKeyValuePair()
 
 		case ruleAction113:
 
-			p.PushComponent(begin, end, Timestamp)
+			p.AssembleConditionCase(begin, end)
 
 		case ruleAction114:
 
-			p.PushComponent(begin, end, Array)
+			p.AssembleExpressionCase(begin, end)
 
 		case ruleAction115:
 
-			p.PushComponent(begin, end, Map)
+			p.AssembleWhenThenPair()
 
 		case ruleAction116:
 
-			p.PushComponent(begin, end, Or)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStream(substr))
 
 		case ruleAction117:
 
-			p.PushComponent(begin, end, And)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 
 		case ruleAction118:
 
-			p.PushComponent(begin, end, Not)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRowValue(substr))
 
 		case ruleAction119:
 
-			p.PushComponent(begin, end, Equal)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction120:
 
-			p.PushComponent(begin, end, Less)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction121:
 
-			p.PushComponent(begin, end, LessOrEqual)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewFloatLiteral(substr))
 
 		case ruleAction122:
 
-			p.PushComponent(begin, end, Greater)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, FuncName(substr))
 
 		case ruleAction123:
 
-			p.PushComponent(begin, end, GreaterOrEqual)
+			substr := string([]rune(buffer)[begin:end])
+			p.AssemblePlaceholder(begin, end, substr[1:])
 
 		case ruleAction124:
 
-			p.PushComponent(begin, end, NotEqual)
+			p.PushComponent(begin, end, NewNullLiteral())
 
 		case ruleAction125:
 
-			p.PushComponent(begin, end, Concat)
+			p.PushComponent(begin, end, NewMissing())
 
 		case ruleAction126:
 
-			p.PushComponent(begin, end, Is)
+			p.PushComponent(begin, end, NewBoolLiteral(true))
 
 		case ruleAction127:
 
-			p.PushComponent(begin, end, IsNot)
+			p.PushComponent(begin, end, NewBoolLiteral(false))
 
 		case ruleAction128:
 
-			p.PushComponent(begin, end, Plus)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewWildcard(substr))
 
 		case ruleAction129:
 
-			p.PushComponent(begin, end, Minus)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStringLiteral(substr))
 
 		case ruleAction130:
 
-			p.PushComponent(begin, end, Multiply)
+			p.PushComponent(begin, end, Istream)
 
 		case ruleAction131:
 
-			p.PushComponent(begin, end, Divide)
+			p.PushComponent(begin, end, Dstream)
 
 		case ruleAction132:
 
-			p.PushComponent(begin, end, Modulo)
+			p.PushComponent(begin, end, Rstream)
 
 		case ruleAction133:
 
-			p.PushComponent(begin, end, UnaryMinus)
+			p.PushComponent(begin, end, Tuples)
 
 		case ruleAction134:
 
+			p.PushComponent(begin, end, Minutes)
+
+		case ruleAction135:
+
+			p.PushComponent(begin, end, Seconds)
+
+		case ruleAction136:
+
+			p.PushComponent(begin, end, Milliseconds)
+
+		case ruleAction137:
+
+			p.PushComponent(begin, end, TumblingWindow)
+
+		case ruleAction138:
+
+			p.PushComponent(begin, end, Wait)
+
+		case ruleAction139:
+
+			p.PushComponent(begin, end, DropOldest)
+
+		case ruleAction140:
+
+			p.PushComponent(begin, end, DropNewest)
+
+		case ruleAction141:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, StreamIdentifier(substr))
+
+		case ruleAction142:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkType(substr))
+
+		case ruleAction143:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+
+		case ruleAction144:
+
+			p.PushComponent(begin, end, Yes)
+
+		case ruleAction145:
+
+			p.PushComponent(begin, end, No)
+
+		case ruleAction146:
+
+			p.PushComponent(begin, end, Yes)
+
+		case ruleAction147:
+
+			p.PushComponent(begin, end, No)
+
+		case ruleAction148:
+
+			p.PushComponent(begin, end, Bool)
+
+		case ruleAction149:
+
+			p.PushComponent(begin, end, Int)
+
+		case ruleAction150:
+
+			p.PushComponent(begin, end, Float)
+
+		case ruleAction151:
+
+			p.PushComponent(begin, end, String)
+
+		case ruleAction152:
+
+			p.PushComponent(begin, end, Blob)
+
+		case ruleAction153:
+
+			p.PushComponent(begin, end, Timestamp)
+
+		case ruleAction154:
+
+			p.PushComponent(begin, end, Array)
+
+		case ruleAction155:
+
+			p.PushComponent(begin, end, Map)
+
+		case ruleAction156:
+
+			p.PushComponent(begin, end, Or)
+
+		case ruleAction157:
+
+			p.PushComponent(begin, end, And)
+
+		case ruleAction158:
+
+			p.PushComponent(begin, end, Not)
+
+		case ruleAction159:
+
+			p.PushComponent(begin, end, Equal)
+
+		case ruleAction160:
+
+			p.PushComponent(begin, end, Less)
+
+		case ruleAction161:
+
+			p.PushComponent(begin, end, LessOrEqual)
+
+		case ruleAction162:
+
+			p.PushComponent(begin, end, Greater)
+
+		case ruleAction163:
+
+			p.PushComponent(begin, end, GreaterOrEqual)
+
+		case ruleAction164:
+
+			p.PushComponent(begin, end, NotEqual)
+
+		case ruleAction165:
+
+			p.PushComponent(begin, end, Like)
+
+		case ruleAction166:
+
+			p.PushComponent(begin, end, NotLike)
+
+		case ruleAction167:
+
+			p.PushComponent(begin, end, ILike)
+
+		case ruleAction168:
+
+			p.PushComponent(begin, end, NotILike)
+
+		case ruleAction169:
+
+			p.PushComponent(begin, end, SimilarTo)
+
+		case ruleAction170:
+
+			p.PushComponent(begin, end, NotSimilarTo)
+
+		case ruleAction171:
+
+			p.PushComponent(begin, end, RegexpMatch)
+
+		case ruleAction172:
+
+			p.PushComponent(begin, end, NotRegexpMatch)
+
+		case ruleAction173:
+
+			p.PushComponent(begin, end, In)
+
+		case ruleAction174:
+
+			p.PushComponent(begin, end, NotIn)
+
+		case ruleAction175:
+
+			p.PushComponent(begin, end, Between)
+
+		case ruleAction176:
+
+			p.PushComponent(begin, end, NotBetween)
+
+		case ruleAction177:
+
+			p.PushComponent(begin, end, Concat)
+
+		case ruleAction178:
+
+			p.PushComponent(begin, end, Is)
+
+		case ruleAction179:
+
+			p.PushComponent(begin, end, IsNot)
+
+		case ruleAction180:
+
+			p.PushComponent(begin, end, Plus)
+
+		case ruleAction181:
+
+			p.PushComponent(begin, end, Minus)
+
+		case ruleAction182:
+
+			p.PushComponent(begin, end, Multiply)
+
+		case ruleAction183:
+
+			p.PushComponent(begin, end, Divide)
+
+		case ruleAction184:
+
+			p.PushComponent(begin, end, Modulo)
+
+		case ruleAction185:
+
+			p.PushComponent(begin, end, UnaryMinus)
+
+		case ruleAction186:
+
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
 
-		case ruleAction135:
+		case ruleAction187:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
@@ -1623,7 +2069,7 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex = position10, tokenIndex10
 			return false
 		},
-		/* 3 Statement <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt)> */
+		/* 3 Statement <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt / ViewStmt / FunctionStmt / TransactionStmt / ExplainStmt)> */
 		func() bool {
 			position13, tokenIndex13 := position, tokenIndex
 			{
@@ -1667,6 +2113,30 @@ func (p *bqlPegBackend) Init() {
 				l21:
 					position, tokenIndex = position15, tokenIndex15
 					if !_rules[ruleEvalStmt]() {
+						goto l22
+					}
+					goto l15
+				l22:
+					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleViewStmt]() {
+						goto l23
+					}
+					goto l15
+				l23:
+					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleFunctionStmt]() {
+						goto l24
+					}
+					goto l15
+				l24:
+					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleTransactionStmt]() {
+						goto l25
+					}
+					goto l15
+				l25:
+					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleExplainStmt]() {
 						goto l13
 					}
 				}
@@ -1680,4457 +2150,4415 @@ func (p *bqlPegBackend) Init() {
 		},
 		/* 4 SourceStmt <- <(CreateSourceStmt / UpdateSourceStmt / DropSourceStmt / PauseSourceStmt / ResumeSourceStmt / RewindSourceStmt)> */
 		func() bool {
-			position22, tokenIndex22 := position, tokenIndex
+			position26, tokenIndex26 := position, tokenIndex
 			{
-				position23 := position
+				position27 := position
 				{
-					position24, tokenIndex24 := position, tokenIndex
+					position28, tokenIndex28 := position, tokenIndex
 					if !_rules[ruleCreateSourceStmt]() {
-						goto l25
+						goto l29
 					}
-					goto l24
-				l25:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l29:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleUpdateSourceStmt]() {
-						goto l26
+						goto l30
 					}
-					goto l24
-				l26:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l30:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleDropSourceStmt]() {
-						goto l27
+						goto l31
 					}
-					goto l24
-				l27:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l31:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[rulePauseSourceStmt]() {
-						goto l28
+						goto l32
 					}
-					goto l24
-				l28:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l32:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleResumeSourceStmt]() {
-						goto l29
+						goto l33
 					}
-					goto l24
-				l29:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l33:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleRewindSourceStmt]() {
-						goto l22
+						goto l26
 					}
 				}
-			l24:
-				add(ruleSourceStmt, position23)
+			l28:
+				add(ruleSourceStmt, position27)
 			}
 			return true
-		l22:
-			position, tokenIndex = position22, tokenIndex22
+		l26:
+			position, tokenIndex = position26, tokenIndex26
 			return false
 		},
 		/* 5 SinkStmt <- <(CreateSinkStmt / UpdateSinkStmt / DropSinkStmt)> */
 		func() bool {
-			position30, tokenIndex30 := position, tokenIndex
+			position34, tokenIndex34 := position, tokenIndex
 			{
-				position31 := position
+				position35 := position
 				{
-					position32, tokenIndex32 := position, tokenIndex
+					position36, tokenIndex36 := position, tokenIndex
 					if !_rules[ruleCreateSinkStmt]() {
-						goto l33
+						goto l37
 					}
-					goto l32
-				l33:
-					position, tokenIndex = position32, tokenIndex32
+					goto l36
+				l37:
+					position, tokenIndex = position36, tokenIndex36
 					if !_rules[ruleUpdateSinkStmt]() {
-						goto l34
+						goto l38
 					}
-					goto l32
-				l34:
-					position, tokenIndex = position32, tokenIndex32
+					goto l36
+				l38:
+					position, tokenIndex = position36, tokenIndex36
 					if !_rules[ruleDropSinkStmt]() {
-						goto l30
+						goto l34
 					}
 				}
-			l32:
-				add(ruleSinkStmt, position31)
+			l36:
+				add(ruleSinkStmt, position35)
 			}
 			return true
-		l30:
-			position, tokenIndex = position30, tokenIndex30
+		l34:
+			position, tokenIndex = position34, tokenIndex34
 			return false
 		},
 		/* 6 StateStmt <- <(CreateStateStmt / UpdateStateStmt / DropStateStmt / LoadStateOrCreateStmt / LoadStateStmt / SaveStateStmt)> */
 		func() bool {
-			position35, tokenIndex35 := position, tokenIndex
+			position39, tokenIndex39 := position, tokenIndex
 			{
-				position36 := position
+				position40 := position
 				{
-					position37, tokenIndex37 := position, tokenIndex
+					position41, tokenIndex41 := position, tokenIndex
 					if !_rules[ruleCreateStateStmt]() {
-						goto l38
+						goto l42
 					}
-					goto l37
-				l38:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l42:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleUpdateStateStmt]() {
-						goto l39
+						goto l43
 					}
-					goto l37
-				l39:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l43:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleDropStateStmt]() {
-						goto l40
+						goto l44
 					}
-					goto l37
-				l40:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l44:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleLoadStateOrCreateStmt]() {
-						goto l41
+						goto l45
 					}
-					goto l37
-				l41:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l45:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleLoadStateStmt]() {
-						goto l42
+						goto l46
 					}
-					goto l37
-				l42:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l46:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleSaveStateStmt]() {
-						goto l35
+						goto l39
 					}
 				}
-			l37:
-				add(ruleStateStmt, position36)
+			l41:
+				add(ruleStateStmt, position40)
 			}
 			return true
-		l35:
-			position, tokenIndex = position35, tokenIndex35
+		l39:
+			position, tokenIndex = position39, tokenIndex39
 			return false
 		},
-		/* 7 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
+		/* 7 ViewStmt <- <(CreateViewStmt / DropViewStmt)> */
 		func() bool {
-			position43, tokenIndex43 := position, tokenIndex
+			position47, tokenIndex47 := position, tokenIndex
 			{
-				position44 := position
+				position48 := position
 				{
-					position45, tokenIndex45 := position, tokenIndex
-					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
-						goto l46
+					position49, tokenIndex49 := position, tokenIndex
+					if !_rules[ruleCreateViewStmt]() {
+						goto l50
 					}
-					goto l45
-				l46:
-					position, tokenIndex = position45, tokenIndex45
-					if !_rules[ruleCreateStreamAsSelectStmt]() {
+					goto l49
+				l50:
+					position, tokenIndex = position49, tokenIndex49
+					if !_rules[ruleDropViewStmt]() {
 						goto l47
 					}
-					goto l45
-				l47:
-					position, tokenIndex = position45, tokenIndex45
-					if !_rules[ruleDropStreamStmt]() {
-						goto l48
-					}
-					goto l45
-				l48:
-					position, tokenIndex = position45, tokenIndex45
-					if !_rules[ruleInsertIntoFromStmt]() {
-						goto l43
-					}
 				}
-			l45:
-				add(ruleStreamStmt, position44)
+			l49:
+				add(ruleViewStmt, position48)
 			}
 			return true
-		l43:
-			position, tokenIndex = position43, tokenIndex43
+		l47:
+			position, tokenIndex = position47, tokenIndex47
 			return false
 		},
-		/* 8 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having Action2)> */
+		/* 8 FunctionStmt <- <(CreateFunctionStmt / DropFunctionStmt)> */
 		func() bool {
-			position49, tokenIndex49 := position, tokenIndex
+			position51, tokenIndex51 := position, tokenIndex
 			{
-				position50 := position
-				{
-					position51, tokenIndex51 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l52
-					}
-					position++
-					goto l51
-				l52:
-					position, tokenIndex = position51, tokenIndex51
-					if buffer[position] != rune('S') {
-						goto l49
-					}
-					position++
-				}
-			l51:
+				position52 := position
 				{
 					position53, tokenIndex53 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if !_rules[ruleCreateFunctionStmt]() {
 						goto l54
 					}
-					position++
 					goto l53
 				l54:
 					position, tokenIndex = position53, tokenIndex53
-					if buffer[position] != rune('E') {
-						goto l49
+					if !_rules[ruleDropFunctionStmt]() {
+						goto l51
 					}
-					position++
 				}
 			l53:
-				{
-					position55, tokenIndex55 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l56
-					}
-					position++
-					goto l55
-				l56:
-					position, tokenIndex = position55, tokenIndex55
-					if buffer[position] != rune('L') {
-						goto l49
-					}
-					position++
-				}
-			l55:
+				add(ruleFunctionStmt, position52)
+			}
+			return true
+		l51:
+			position, tokenIndex = position51, tokenIndex51
+			return false
+		},
+		/* 9 TransactionStmt <- <(BeginStmt / CommitStmt / RollbackStmt)> */
+		func() bool {
+			position55, tokenIndex55 := position, tokenIndex
+			{
+				position56 := position
 				{
 					position57, tokenIndex57 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if !_rules[ruleBeginStmt]() {
 						goto l58
 					}
-					position++
 					goto l57
 				l58:
 					position, tokenIndex = position57, tokenIndex57
-					if buffer[position] != rune('E') {
-						goto l49
+					if !_rules[ruleCommitStmt]() {
+						goto l59
+					}
+					goto l57
+				l59:
+					position, tokenIndex = position57, tokenIndex57
+					if !_rules[ruleRollbackStmt]() {
+						goto l55
 					}
-					position++
 				}
 			l57:
-				{
-					position59, tokenIndex59 := position, tokenIndex
-					if buffer[position] != rune('c') {
+				add(ruleTransactionStmt, position56)
+			}
+			return true
+		l55:
+			position, tokenIndex = position55, tokenIndex55
+			return false
+		},
+		/* 10 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
+		func() bool {
+			position60, tokenIndex60 := position, tokenIndex
+			{
+				position61 := position
+				{
+					position62, tokenIndex62 := position, tokenIndex
+					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
+						goto l63
+					}
+					goto l62
+				l63:
+					position, tokenIndex = position62, tokenIndex62
+					if !_rules[ruleCreateStreamAsSelectStmt]() {
+						goto l64
+					}
+					goto l62
+				l64:
+					position, tokenIndex = position62, tokenIndex62
+					if !_rules[ruleDropStreamStmt]() {
+						goto l65
+					}
+					goto l62
+				l65:
+					position, tokenIndex = position62, tokenIndex62
+					if !_rules[ruleInsertIntoFromStmt]() {
 						goto l60
 					}
+				}
+			l62:
+				add(ruleStreamStmt, position61)
+			}
+			return true
+		l60:
+			position, tokenIndex = position60, tokenIndex60
+			return false
+		},
+		/* 11 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Distinct Projections WindowedFrom Filter Grouping Having Ordering Limit Action2)> */
+		func() bool {
+			position66, tokenIndex66 := position, tokenIndex
+			{
+				position67 := position
+				{
+					position68, tokenIndex68 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l69
+					}
+					position++
+					goto l68
+				l69:
+					position, tokenIndex = position68, tokenIndex68
+					if buffer[position] != rune('S') {
+						goto l66
+					}
+					position++
+				}
+			l68:
+				{
+					position70, tokenIndex70 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l71
+					}
+					position++
+					goto l70
+				l71:
+					position, tokenIndex = position70, tokenIndex70
+					if buffer[position] != rune('E') {
+						goto l66
+					}
+					position++
+				}
+			l70:
+				{
+					position72, tokenIndex72 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l73
+					}
+					position++
+					goto l72
+				l73:
+					position, tokenIndex = position72, tokenIndex72
+					if buffer[position] != rune('L') {
+						goto l66
+					}
+					position++
+				}
+			l72:
+				{
+					position74, tokenIndex74 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l75
+					}
+					position++
+					goto l74
+				l75:
+					position, tokenIndex = position74, tokenIndex74
+					if buffer[position] != rune('E') {
+						goto l66
+					}
 					position++
-					goto l59
-				l60:
-					position, tokenIndex = position59, tokenIndex59
+				}
+			l74:
+				{
+					position76, tokenIndex76 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l77
+					}
+					position++
+					goto l76
+				l77:
+					position, tokenIndex = position76, tokenIndex76
 					if buffer[position] != rune('C') {
-						goto l49
+						goto l66
 					}
 					position++
 				}
-			l59:
+			l76:
 				{
-					position61, tokenIndex61 := position, tokenIndex
+					position78, tokenIndex78 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l62
+						goto l79
 					}
 					position++
-					goto l61
-				l62:
-					position, tokenIndex = position61, tokenIndex61
+					goto l78
+				l79:
+					position, tokenIndex = position78, tokenIndex78
 					if buffer[position] != rune('T') {
-						goto l49
+						goto l66
 					}
 					position++
 				}
-			l61:
+			l78:
 				if !_rules[ruleEmitter]() {
-					goto l49
+					goto l66
+				}
+				if !_rules[ruleDistinct]() {
+					goto l66
 				}
 				if !_rules[ruleProjections]() {
-					goto l49
+					goto l66
 				}
 				if !_rules[ruleWindowedFrom]() {
-					goto l49
+					goto l66
 				}
 				if !_rules[ruleFilter]() {
-					goto l49
+					goto l66
 				}
 				if !_rules[ruleGrouping]() {
-					goto l49
+					goto l66
 				}
 				if !_rules[ruleHaving]() {
-					goto l49
+					goto l66
+				}
+				if !_rules[ruleOrdering]() {
+					goto l66
+				}
+				if !_rules[ruleLimit]() {
+					goto l66
 				}
 				if !_rules[ruleAction2]() {
-					goto l49
+					goto l66
 				}
-				add(ruleSelectStmt, position50)
+				add(ruleSelectStmt, position67)
 			}
 			return true
-		l49:
-			position, tokenIndex = position49, tokenIndex49
+		l66:
+			position, tokenIndex = position66, tokenIndex66
 			return false
 		},
-		/* 9 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action3)> */
+		/* 12 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action3)> */
 		func() bool {
-			position63, tokenIndex63 := position, tokenIndex
+			position80, tokenIndex80 := position, tokenIndex
 			{
-				position64 := position
+				position81 := position
 				{
-					position65 := position
+					position82 := position
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l80
 					}
 					if !_rules[rulesp]() {
-						goto l63
+						goto l80
 					}
 					{
-						position68, tokenIndex68 := position, tokenIndex
+						position85, tokenIndex85 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l69
+							goto l86
 						}
 						position++
-						goto l68
-					l69:
-						position, tokenIndex = position68, tokenIndex68
+						goto l85
+					l86:
+						position, tokenIndex = position85, tokenIndex85
 						if buffer[position] != rune('U') {
-							goto l63
+							goto l80
 						}
 						position++
 					}
-				l68:
+				l85:
 					{
-						position70, tokenIndex70 := position, tokenIndex
+						position87, tokenIndex87 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l71
+							goto l88
 						}
 						position++
-						goto l70
-					l71:
-						position, tokenIndex = position70, tokenIndex70
+						goto l87
+					l88:
+						position, tokenIndex = position87, tokenIndex87
 						if buffer[position] != rune('N') {
-							goto l63
+							goto l80
 						}
 						position++
 					}
-				l70:
+				l87:
 					{
-						position72, tokenIndex72 := position, tokenIndex
+						position89, tokenIndex89 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l73
+							goto l90
 						}
 						position++
-						goto l72
-					l73:
-						position, tokenIndex = position72, tokenIndex72
+						goto l89
+					l90:
+						position, tokenIndex = position89, tokenIndex89
 						if buffer[position] != rune('I') {
-							goto l63
+							goto l80
 						}
 						position++
 					}
-				l72:
+				l89:
 					{
-						position74, tokenIndex74 := position, tokenIndex
+						position91, tokenIndex91 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l75
+							goto l92
 						}
 						position++
-						goto l74
-					l75:
-						position, tokenIndex = position74, tokenIndex74
+						goto l91
+					l92:
+						position, tokenIndex = position91, tokenIndex91
 						if buffer[position] != rune('O') {
-							goto l63
+							goto l80
 						}
 						position++
 					}
-				l74:
+				l91:
 					{
-						position76, tokenIndex76 := position, tokenIndex
+						position93, tokenIndex93 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l77
+							goto l94
 						}
 						position++
-						goto l76
-					l77:
-						position, tokenIndex = position76, tokenIndex76
+						goto l93
+					l94:
+						position, tokenIndex = position93, tokenIndex93
 						if buffer[position] != rune('N') {
-							goto l63
+							goto l80
 						}
 						position++
 					}
-				l76:
+				l93:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l80
 					}
 					{
-						position78, tokenIndex78 := position, tokenIndex
+						position95, tokenIndex95 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l79
+							goto l96
 						}
 						position++
-						goto l78
-					l79:
-						position, tokenIndex = position78, tokenIndex78
+						goto l95
+					l96:
+						position, tokenIndex = position95, tokenIndex95
 						if buffer[position] != rune('A') {
-							goto l63
+							goto l80
 						}
 						position++
 					}
-				l78:
+				l95:
 					{
-						position80, tokenIndex80 := position, tokenIndex
+						position97, tokenIndex97 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l81
+							goto l98
 						}
 						position++
-						goto l80
-					l81:
-						position, tokenIndex = position80, tokenIndex80
+						goto l97
+					l98:
+						position, tokenIndex = position97, tokenIndex97
 						if buffer[position] != rune('L') {
-							goto l63
+							goto l80
 						}
 						position++
 					}
-				l80:
+				l97:
 					{
-						position82, tokenIndex82 := position, tokenIndex
+						position99, tokenIndex99 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l83
+							goto l100
 						}
 						position++
-						goto l82
-					l83:
-						position, tokenIndex = position82, tokenIndex82
+						goto l99
+					l100:
+						position, tokenIndex = position99, tokenIndex99
 						if buffer[position] != rune('L') {
-							goto l63
+							goto l80
 						}
 						position++
 					}
-				l82:
+				l99:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l80
 					}
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l80
 					}
-				l66:
+				l83:
 					{
-						position67, tokenIndex67 := position, tokenIndex
+						position84, tokenIndex84 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l67
+							goto l84
 						}
 						{
-							position84, tokenIndex84 := position, tokenIndex
+							position101, tokenIndex101 := position, tokenIndex
 							if buffer[position] != rune('u') {
-								goto l85
+								goto l102
 							}
 							position++
-							goto l84
-						l85:
-							position, tokenIndex = position84, tokenIndex84
+							goto l101
+						l102:
+							position, tokenIndex = position101, tokenIndex101
 							if buffer[position] != rune('U') {
-								goto l67
+								goto l84
 							}
 							position++
 						}
-					l84:
+					l101:
 						{
-							position86, tokenIndex86 := position, tokenIndex
+							position103, tokenIndex103 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l87
+								goto l104
 							}
 							position++
-							goto l86
-						l87:
-							position, tokenIndex = position86, tokenIndex86
+							goto l103
+						l104:
+							position, tokenIndex = position103, tokenIndex103
 							if buffer[position] != rune('N') {
-								goto l67
+								goto l84
 							}
 							position++
 						}
-					l86:
+					l103:
 						{
-							position88, tokenIndex88 := position, tokenIndex
+							position105, tokenIndex105 := position, tokenIndex
 							if buffer[position] != rune('i') {
-								goto l89
+								goto l106
 							}
 							position++
-							goto l88
-						l89:
-							position, tokenIndex = position88, tokenIndex88
+							goto l105
+						l106:
+							position, tokenIndex = position105, tokenIndex105
 							if buffer[position] != rune('I') {
-								goto l67
+								goto l84
 							}
 							position++
 						}
-					l88:
+					l105:
 						{
-							position90, tokenIndex90 := position, tokenIndex
+							position107, tokenIndex107 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l91
+								goto l108
 							}
 							position++
-							goto l90
-						l91:
-							position, tokenIndex = position90, tokenIndex90
+							goto l107
+						l108:
+							position, tokenIndex = position107, tokenIndex107
 							if buffer[position] != rune('O') {
-								goto l67
+								goto l84
 							}
 							position++
 						}
-					l90:
+					l107:
 						{
-							position92, tokenIndex92 := position, tokenIndex
+							position109, tokenIndex109 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l93
+								goto l110
 							}
 							position++
-							goto l92
-						l93:
-							position, tokenIndex = position92, tokenIndex92
+							goto l109
+						l110:
+							position, tokenIndex = position109, tokenIndex109
 							if buffer[position] != rune('N') {
-								goto l67
+								goto l84
 							}
 							position++
 						}
-					l92:
+					l109:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l84
 						}
 						{
-							position94, tokenIndex94 := position, tokenIndex
+							position111, tokenIndex111 := position, tokenIndex
 							if buffer[position] != rune('a') {
-								goto l95
+								goto l112
 							}
 							position++
-							goto l94
-						l95:
-							position, tokenIndex = position94, tokenIndex94
+							goto l111
+						l112:
+							position, tokenIndex = position111, tokenIndex111
 							if buffer[position] != rune('A') {
-								goto l67
+								goto l84
 							}
 							position++
 						}
-					l94:
+					l111:
 						{
-							position96, tokenIndex96 := position, tokenIndex
+							position113, tokenIndex113 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l97
+								goto l114
 							}
 							position++
-							goto l96
-						l97:
-							position, tokenIndex = position96, tokenIndex96
+							goto l113
+						l114:
+							position, tokenIndex = position113, tokenIndex113
 							if buffer[position] != rune('L') {
-								goto l67
+								goto l84
 							}
 							position++
 						}
-					l96:
+					l113:
 						{
-							position98, tokenIndex98 := position, tokenIndex
+							position115, tokenIndex115 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l99
+								goto l116
 							}
 							position++
-							goto l98
-						l99:
-							position, tokenIndex = position98, tokenIndex98
+							goto l115
+						l116:
+							position, tokenIndex = position115, tokenIndex115
 							if buffer[position] != rune('L') {
-								goto l67
+								goto l84
 							}
 							position++
 						}
-					l98:
+					l115:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l84
 						}
 						if !_rules[ruleSelectStmt]() {
-							goto l67
+							goto l84
 						}
-						goto l66
-					l67:
-						position, tokenIndex = position67, tokenIndex67
+						goto l83
+					l84:
+						position, tokenIndex = position84, tokenIndex84
 					}
-					add(rulePegText, position65)
+					add(rulePegText, position82)
 				}
 				if !_rules[ruleAction3]() {
-					goto l63
+					goto l80
 				}
-				add(ruleSelectUnionStmt, position64)
+				add(ruleSelectUnionStmt, position81)
 			}
 			return true
-		l63:
-			position, tokenIndex = position63, tokenIndex63
+		l80:
+			position, tokenIndex = position80, tokenIndex80
 			return false
 		},
-		/* 10 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
+		/* 13 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier StreamSchemaOpt sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
 		func() bool {
-			position100, tokenIndex100 := position, tokenIndex
+			position117, tokenIndex117 := position, tokenIndex
 			{
-				position101 := position
+				position118 := position
 				{
-					position102, tokenIndex102 := position, tokenIndex
+					position119, tokenIndex119 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l103
+						goto l120
 					}
 					position++
-					goto l102
-				l103:
-					position, tokenIndex = position102, tokenIndex102
+					goto l119
+				l120:
+					position, tokenIndex = position119, tokenIndex119
 					if buffer[position] != rune('C') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l102:
+			l119:
 				{
-					position104, tokenIndex104 := position, tokenIndex
+					position121, tokenIndex121 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l105
+						goto l122
 					}
 					position++
-					goto l104
-				l105:
-					position, tokenIndex = position104, tokenIndex104
+					goto l121
+				l122:
+					position, tokenIndex = position121, tokenIndex121
 					if buffer[position] != rune('R') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l104:
+			l121:
 				{
-					position106, tokenIndex106 := position, tokenIndex
+					position123, tokenIndex123 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l107
+						goto l124
 					}
 					position++
-					goto l106
-				l107:
-					position, tokenIndex = position106, tokenIndex106
+					goto l123
+				l124:
+					position, tokenIndex = position123, tokenIndex123
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l106:
+			l123:
 				{
-					position108, tokenIndex108 := position, tokenIndex
+					position125, tokenIndex125 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l109
+						goto l126
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex = position108, tokenIndex108
+					goto l125
+				l126:
+					position, tokenIndex = position125, tokenIndex125
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l108:
+			l125:
 				{
-					position110, tokenIndex110 := position, tokenIndex
+					position127, tokenIndex127 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l111
+						goto l128
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex = position110, tokenIndex110
+					goto l127
+				l128:
+					position, tokenIndex = position127, tokenIndex127
 					if buffer[position] != rune('T') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l110:
+			l127:
 				{
-					position112, tokenIndex112 := position, tokenIndex
+					position129, tokenIndex129 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l113
+						goto l130
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex = position112, tokenIndex112
+					goto l129
+				l130:
+					position, tokenIndex = position129, tokenIndex129
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l112:
+			l129:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l117
 				}
 				{
-					position114, tokenIndex114 := position, tokenIndex
+					position131, tokenIndex131 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l115
+						goto l132
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex = position114, tokenIndex114
+					goto l131
+				l132:
+					position, tokenIndex = position131, tokenIndex131
 					if buffer[position] != rune('S') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l114:
+			l131:
 				{
-					position116, tokenIndex116 := position, tokenIndex
+					position133, tokenIndex133 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l117
+						goto l134
 					}
 					position++
-					goto l116
-				l117:
-					position, tokenIndex = position116, tokenIndex116
+					goto l133
+				l134:
+					position, tokenIndex = position133, tokenIndex133
 					if buffer[position] != rune('T') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l116:
+			l133:
 				{
-					position118, tokenIndex118 := position, tokenIndex
+					position135, tokenIndex135 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l119
+						goto l136
 					}
 					position++
-					goto l118
-				l119:
-					position, tokenIndex = position118, tokenIndex118
+					goto l135
+				l136:
+					position, tokenIndex = position135, tokenIndex135
 					if buffer[position] != rune('R') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l118:
+			l135:
 				{
-					position120, tokenIndex120 := position, tokenIndex
+					position137, tokenIndex137 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l121
+						goto l138
 					}
 					position++
-					goto l120
-				l121:
-					position, tokenIndex = position120, tokenIndex120
+					goto l137
+				l138:
+					position, tokenIndex = position137, tokenIndex137
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l120:
+			l137:
 				{
-					position122, tokenIndex122 := position, tokenIndex
+					position139, tokenIndex139 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l123
+						goto l140
 					}
 					position++
-					goto l122
-				l123:
-					position, tokenIndex = position122, tokenIndex122
+					goto l139
+				l140:
+					position, tokenIndex = position139, tokenIndex139
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l122:
+			l139:
 				{
-					position124, tokenIndex124 := position, tokenIndex
+					position141, tokenIndex141 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l125
+						goto l142
 					}
 					position++
-					goto l124
-				l125:
-					position, tokenIndex = position124, tokenIndex124
+					goto l141
+				l142:
+					position, tokenIndex = position141, tokenIndex141
 					if buffer[position] != rune('M') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l124:
+			l141:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l117
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l100
+					goto l117
+				}
+				if !_rules[ruleStreamSchemaOpt]() {
+					goto l117
 				}
 				if !_rules[rulesp]() {
-					goto l100
+					goto l117
 				}
 				{
-					position126, tokenIndex126 := position, tokenIndex
+					position143, tokenIndex143 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l127
+						goto l144
 					}
 					position++
-					goto l126
-				l127:
-					position, tokenIndex = position126, tokenIndex126
+					goto l143
+				l144:
+					position, tokenIndex = position143, tokenIndex143
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l126:
+			l143:
 				{
-					position128, tokenIndex128 := position, tokenIndex
+					position145, tokenIndex145 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l129
+						goto l146
 					}
 					position++
-					goto l128
-				l129:
-					position, tokenIndex = position128, tokenIndex128
+					goto l145
+				l146:
+					position, tokenIndex = position145, tokenIndex145
 					if buffer[position] != rune('S') {
-						goto l100
+						goto l117
 					}
 					position++
 				}
-			l128:
+			l145:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l117
 				}
 				if !_rules[ruleSelectStmt]() {
-					goto l100
+					goto l117
 				}
 				if !_rules[ruleAction4]() {
-					goto l100
+					goto l117
 				}
-				add(ruleCreateStreamAsSelectStmt, position101)
+				add(ruleCreateStreamAsSelectStmt, position118)
 			}
 			return true
-		l100:
-			position, tokenIndex = position100, tokenIndex100
+		l117:
+			position, tokenIndex = position117, tokenIndex117
 			return false
 		},
-		/* 11 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action5)> */
+		/* 14 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action5)> */
 		func() bool {
-			position130, tokenIndex130 := position, tokenIndex
+			position147, tokenIndex147 := position, tokenIndex
 			{
-				position131 := position
+				position148 := position
 				{
-					position132, tokenIndex132 := position, tokenIndex
+					position149, tokenIndex149 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l133
+						goto l150
 					}
 					position++
-					goto l132
-				l133:
-					position, tokenIndex = position132, tokenIndex132
+					goto l149
+				l150:
+					position, tokenIndex = position149, tokenIndex149
 					if buffer[position] != rune('C') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l132:
+			l149:
 				{
-					position134, tokenIndex134 := position, tokenIndex
+					position151, tokenIndex151 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l135
+						goto l152
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex = position134, tokenIndex134
+					goto l151
+				l152:
+					position, tokenIndex = position151, tokenIndex151
 					if buffer[position] != rune('R') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l134:
+			l151:
 				{
-					position136, tokenIndex136 := position, tokenIndex
+					position153, tokenIndex153 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l137
+						goto l154
 					}
 					position++
-					goto l136
-				l137:
-					position, tokenIndex = position136, tokenIndex136
+					goto l153
+				l154:
+					position, tokenIndex = position153, tokenIndex153
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l136:
+			l153:
 				{
-					position138, tokenIndex138 := position, tokenIndex
+					position155, tokenIndex155 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l139
+						goto l156
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex = position138, tokenIndex138
+					goto l155
+				l156:
+					position, tokenIndex = position155, tokenIndex155
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l138:
+			l155:
 				{
-					position140, tokenIndex140 := position, tokenIndex
+					position157, tokenIndex157 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l141
+						goto l158
 					}
 					position++
-					goto l140
-				l141:
-					position, tokenIndex = position140, tokenIndex140
+					goto l157
+				l158:
+					position, tokenIndex = position157, tokenIndex157
 					if buffer[position] != rune('T') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l140:
+			l157:
 				{
-					position142, tokenIndex142 := position, tokenIndex
+					position159, tokenIndex159 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l143
+						goto l160
 					}
 					position++
-					goto l142
-				l143:
-					position, tokenIndex = position142, tokenIndex142
+					goto l159
+				l160:
+					position, tokenIndex = position159, tokenIndex159
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l142:
+			l159:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l147
 				}
 				{
-					position144, tokenIndex144 := position, tokenIndex
+					position161, tokenIndex161 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l145
+						goto l162
 					}
 					position++
-					goto l144
-				l145:
-					position, tokenIndex = position144, tokenIndex144
+					goto l161
+				l162:
+					position, tokenIndex = position161, tokenIndex161
 					if buffer[position] != rune('S') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l144:
+			l161:
 				{
-					position146, tokenIndex146 := position, tokenIndex
+					position163, tokenIndex163 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l147
+						goto l164
 					}
 					position++
-					goto l146
-				l147:
-					position, tokenIndex = position146, tokenIndex146
+					goto l163
+				l164:
+					position, tokenIndex = position163, tokenIndex163
 					if buffer[position] != rune('T') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l146:
+			l163:
 				{
-					position148, tokenIndex148 := position, tokenIndex
+					position165, tokenIndex165 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l149
+						goto l166
 					}
 					position++
-					goto l148
-				l149:
-					position, tokenIndex = position148, tokenIndex148
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
 					if buffer[position] != rune('R') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l148:
+			l165:
 				{
-					position150, tokenIndex150 := position, tokenIndex
+					position167, tokenIndex167 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l151
+						goto l168
 					}
 					position++
-					goto l150
-				l151:
-					position, tokenIndex = position150, tokenIndex150
+					goto l167
+				l168:
+					position, tokenIndex = position167, tokenIndex167
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l150:
+			l167:
 				{
-					position152, tokenIndex152 := position, tokenIndex
+					position169, tokenIndex169 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l153
+						goto l170
 					}
 					position++
-					goto l152
-				l153:
-					position, tokenIndex = position152, tokenIndex152
+					goto l169
+				l170:
+					position, tokenIndex = position169, tokenIndex169
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l152:
+			l169:
 				{
-					position154, tokenIndex154 := position, tokenIndex
+					position171, tokenIndex171 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l155
+						goto l172
 					}
 					position++
-					goto l154
-				l155:
-					position, tokenIndex = position154, tokenIndex154
+					goto l171
+				l172:
+					position, tokenIndex = position171, tokenIndex171
 					if buffer[position] != rune('M') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l154:
+			l171:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l147
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l130
+					goto l147
 				}
 				if !_rules[rulesp]() {
-					goto l130
+					goto l147
 				}
 				{
-					position156, tokenIndex156 := position, tokenIndex
+					position173, tokenIndex173 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l157
+						goto l174
 					}
 					position++
-					goto l156
-				l157:
-					position, tokenIndex = position156, tokenIndex156
+					goto l173
+				l174:
+					position, tokenIndex = position173, tokenIndex173
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l156:
+			l173:
 				{
-					position158, tokenIndex158 := position, tokenIndex
+					position175, tokenIndex175 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l159
+						goto l176
 					}
 					position++
-					goto l158
-				l159:
-					position, tokenIndex = position158, tokenIndex158
+					goto l175
+				l176:
+					position, tokenIndex = position175, tokenIndex175
 					if buffer[position] != rune('S') {
-						goto l130
+						goto l147
 					}
 					position++
 				}
-			l158:
+			l175:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l147
 				}
 				if !_rules[ruleSelectUnionStmt]() {
-					goto l130
+					goto l147
 				}
 				if !_rules[ruleAction5]() {
-					goto l130
+					goto l147
 				}
-				add(ruleCreateStreamAsSelectUnionStmt, position131)
+				add(ruleCreateStreamAsSelectUnionStmt, position148)
 			}
 			return true
-		l130:
-			position, tokenIndex = position130, tokenIndex130
+		l147:
+			position, tokenIndex = position147, tokenIndex147
 			return false
 		},
-		/* 12 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action6)> */
+		/* 15 CreateViewStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('v' / 'V') ('i' / 'I') ('e' / 'E') ('w' / 'W')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action6)> */
 		func() bool {
-			position160, tokenIndex160 := position, tokenIndex
+			position177, tokenIndex177 := position, tokenIndex
 			{
-				position161 := position
+				position178 := position
 				{
-					position162, tokenIndex162 := position, tokenIndex
+					position179, tokenIndex179 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l163
+						goto l180
 					}
 					position++
-					goto l162
-				l163:
-					position, tokenIndex = position162, tokenIndex162
+					goto l179
+				l180:
+					position, tokenIndex = position179, tokenIndex179
 					if buffer[position] != rune('C') {
-						goto l160
+						goto l177
 					}
 					position++
 				}
-			l162:
+			l179:
 				{
-					position164, tokenIndex164 := position, tokenIndex
+					position181, tokenIndex181 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l165
+						goto l182
 					}
 					position++
-					goto l164
-				l165:
-					position, tokenIndex = position164, tokenIndex164
+					goto l181
+				l182:
+					position, tokenIndex = position181, tokenIndex181
 					if buffer[position] != rune('R') {
-						goto l160
+						goto l177
 					}
 					position++
 				}
-			l164:
+			l181:
 				{
-					position166, tokenIndex166 := position, tokenIndex
+					position183, tokenIndex183 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l167
+						goto l184
 					}
 					position++
-					goto l166
-				l167:
-					position, tokenIndex = position166, tokenIndex166
+					goto l183
+				l184:
+					position, tokenIndex = position183, tokenIndex183
 					if buffer[position] != rune('E') {
-						goto l160
+						goto l177
 					}
 					position++
 				}
-			l166:
+			l183:
 				{
-					position168, tokenIndex168 := position, tokenIndex
+					position185, tokenIndex185 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l169
+						goto l186
 					}
 					position++
-					goto l168
-				l169:
-					position, tokenIndex = position168, tokenIndex168
+					goto l185
+				l186:
+					position, tokenIndex = position185, tokenIndex185
 					if buffer[position] != rune('A') {
-						goto l160
+						goto l177
 					}
 					position++
 				}
-			l168:
+			l185:
 				{
-					position170, tokenIndex170 := position, tokenIndex
+					position187, tokenIndex187 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l171
+						goto l188
 					}
 					position++
-					goto l170
-				l171:
-					position, tokenIndex = position170, tokenIndex170
+					goto l187
+				l188:
+					position, tokenIndex = position187, tokenIndex187
 					if buffer[position] != rune('T') {
-						goto l160
+						goto l177
 					}
 					position++
 				}
-			l170:
+			l187:
 				{
-					position172, tokenIndex172 := position, tokenIndex
+					position189, tokenIndex189 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l173
+						goto l190
 					}
 					position++
-					goto l172
-				l173:
-					position, tokenIndex = position172, tokenIndex172
+					goto l189
+				l190:
+					position, tokenIndex = position189, tokenIndex189
 					if buffer[position] != rune('E') {
-						goto l160
+						goto l177
 					}
 					position++
 				}
-			l172:
-				if !_rules[rulePausedOpt]() {
-					goto l160
-				}
+			l189:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l177
 				}
 				{
-					position174, tokenIndex174 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l175
+					position191, tokenIndex191 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l192
 					}
 					position++
-					goto l174
-				l175:
-					position, tokenIndex = position174, tokenIndex174
-					if buffer[position] != rune('S') {
-						goto l160
+					goto l191
+				l192:
+					position, tokenIndex = position191, tokenIndex191
+					if buffer[position] != rune('V') {
+						goto l177
 					}
 					position++
 				}
-			l174:
+			l191:
 				{
-					position176, tokenIndex176 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l177
+					position193, tokenIndex193 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l194
 					}
 					position++
-					goto l176
-				l177:
-					position, tokenIndex = position176, tokenIndex176
-					if buffer[position] != rune('O') {
-						goto l160
+					goto l193
+				l194:
+					position, tokenIndex = position193, tokenIndex193
+					if buffer[position] != rune('I') {
+						goto l177
 					}
 					position++
 				}
-			l176:
+			l193:
 				{
-					position178, tokenIndex178 := position, tokenIndex
-					if buffer[position] != rune('u') {
-						goto l179
+					position195, tokenIndex195 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l196
 					}
 					position++
-					goto l178
-				l179:
-					position, tokenIndex = position178, tokenIndex178
-					if buffer[position] != rune('U') {
-						goto l160
+					goto l195
+				l196:
+					position, tokenIndex = position195, tokenIndex195
+					if buffer[position] != rune('E') {
+						goto l177
 					}
 					position++
 				}
-			l178:
+			l195:
 				{
-					position180, tokenIndex180 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l181
+					position197, tokenIndex197 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l198
 					}
 					position++
-					goto l180
-				l181:
-					position, tokenIndex = position180, tokenIndex180
-					if buffer[position] != rune('R') {
-						goto l160
+					goto l197
+				l198:
+					position, tokenIndex = position197, tokenIndex197
+					if buffer[position] != rune('W') {
+						goto l177
 					}
 					position++
 				}
-			l180:
+			l197:
+				if !_rules[rulesp]() {
+					goto l177
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l177
+				}
+				if !_rules[rulesp]() {
+					goto l177
+				}
 				{
-					position182, tokenIndex182 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l183
+					position199, tokenIndex199 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l200
 					}
 					position++
-					goto l182
-				l183:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('C') {
-						goto l160
+					goto l199
+				l200:
+					position, tokenIndex = position199, tokenIndex199
+					if buffer[position] != rune('A') {
+						goto l177
 					}
 					position++
 				}
-			l182:
+			l199:
 				{
-					position184, tokenIndex184 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l185
+					position201, tokenIndex201 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l202
 					}
 					position++
-					goto l184
-				l185:
-					position, tokenIndex = position184, tokenIndex184
-					if buffer[position] != rune('E') {
-						goto l160
+					goto l201
+				l202:
+					position, tokenIndex = position201, tokenIndex201
+					if buffer[position] != rune('S') {
+						goto l177
 					}
 					position++
 				}
-			l184:
+			l201:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l177
 				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l160
+				if !_rules[ruleSelectStmt]() {
+					goto l177
 				}
-				if !_rules[rulesp]() {
-					goto l160
+				if !_rules[ruleAction6]() {
+					goto l177
 				}
+				add(ruleCreateViewStmt, position178)
+			}
+			return true
+		l177:
+			position, tokenIndex = position177, tokenIndex177
+			return false
+		},
+		/* 16 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs SourceSchemaOpt Action7)> */
+		func() bool {
+			position203, tokenIndex203 := position, tokenIndex
+			{
+				position204 := position
 				{
-					position186, tokenIndex186 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l187
+					position205, tokenIndex205 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l206
 					}
 					position++
-					goto l186
-				l187:
-					position, tokenIndex = position186, tokenIndex186
-					if buffer[position] != rune('T') {
-						goto l160
+					goto l205
+				l206:
+					position, tokenIndex = position205, tokenIndex205
+					if buffer[position] != rune('C') {
+						goto l203
 					}
 					position++
 				}
-			l186:
+			l205:
 				{
-					position188, tokenIndex188 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l189
+					position207, tokenIndex207 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l208
 					}
 					position++
-					goto l188
-				l189:
-					position, tokenIndex = position188, tokenIndex188
-					if buffer[position] != rune('Y') {
-						goto l160
+					goto l207
+				l208:
+					position, tokenIndex = position207, tokenIndex207
+					if buffer[position] != rune('R') {
+						goto l203
 					}
 					position++
 				}
-			l188:
+			l207:
 				{
-					position190, tokenIndex190 := position, tokenIndex
-					if buffer[position] != rune('p') {
-						goto l191
+					position209, tokenIndex209 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l210
 					}
 					position++
-					goto l190
-				l191:
-					position, tokenIndex = position190, tokenIndex190
-					if buffer[position] != rune('P') {
-						goto l160
+					goto l209
+				l210:
+					position, tokenIndex = position209, tokenIndex209
+					if buffer[position] != rune('E') {
+						goto l203
 					}
 					position++
 				}
-			l190:
+			l209:
 				{
-					position192, tokenIndex192 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l193
+					position211, tokenIndex211 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l212
 					}
 					position++
-					goto l192
-				l193:
-					position, tokenIndex = position192, tokenIndex192
-					if buffer[position] != rune('E') {
-						goto l160
+					goto l211
+				l212:
+					position, tokenIndex = position211, tokenIndex211
+					if buffer[position] != rune('A') {
+						goto l203
+					}
+					position++
+				}
+			l211:
+				{
+					position213, tokenIndex213 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l214
+					}
+					position++
+					goto l213
+				l214:
+					position, tokenIndex = position213, tokenIndex213
+					if buffer[position] != rune('T') {
+						goto l203
+					}
+					position++
+				}
+			l213:
+				{
+					position215, tokenIndex215 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l216
+					}
+					position++
+					goto l215
+				l216:
+					position, tokenIndex = position215, tokenIndex215
+					if buffer[position] != rune('E') {
+						goto l203
+					}
+					position++
+				}
+			l215:
+				if !_rules[rulePausedOpt]() {
+					goto l203
+				}
+				if !_rules[rulesp]() {
+					goto l203
+				}
+				{
+					position217, tokenIndex217 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l218
+					}
+					position++
+					goto l217
+				l218:
+					position, tokenIndex = position217, tokenIndex217
+					if buffer[position] != rune('S') {
+						goto l203
+					}
+					position++
+				}
+			l217:
+				{
+					position219, tokenIndex219 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l220
+					}
+					position++
+					goto l219
+				l220:
+					position, tokenIndex = position219, tokenIndex219
+					if buffer[position] != rune('O') {
+						goto l203
+					}
+					position++
+				}
+			l219:
+				{
+					position221, tokenIndex221 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l222
+					}
+					position++
+					goto l221
+				l222:
+					position, tokenIndex = position221, tokenIndex221
+					if buffer[position] != rune('U') {
+						goto l203
+					}
+					position++
+				}
+			l221:
+				{
+					position223, tokenIndex223 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l224
+					}
+					position++
+					goto l223
+				l224:
+					position, tokenIndex = position223, tokenIndex223
+					if buffer[position] != rune('R') {
+						goto l203
+					}
+					position++
+				}
+			l223:
+				{
+					position225, tokenIndex225 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l226
+					}
+					position++
+					goto l225
+				l226:
+					position, tokenIndex = position225, tokenIndex225
+					if buffer[position] != rune('C') {
+						goto l203
+					}
+					position++
+				}
+			l225:
+				{
+					position227, tokenIndex227 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l228
+					}
+					position++
+					goto l227
+				l228:
+					position, tokenIndex = position227, tokenIndex227
+					if buffer[position] != rune('E') {
+						goto l203
+					}
+					position++
+				}
+			l227:
+				if !_rules[rulesp]() {
+					goto l203
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l203
+				}
+				if !_rules[rulesp]() {
+					goto l203
+				}
+				{
+					position229, tokenIndex229 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l230
+					}
+					position++
+					goto l229
+				l230:
+					position, tokenIndex = position229, tokenIndex229
+					if buffer[position] != rune('T') {
+						goto l203
+					}
+					position++
+				}
+			l229:
+				{
+					position231, tokenIndex231 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l232
+					}
+					position++
+					goto l231
+				l232:
+					position, tokenIndex = position231, tokenIndex231
+					if buffer[position] != rune('Y') {
+						goto l203
+					}
+					position++
+				}
+			l231:
+				{
+					position233, tokenIndex233 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l234
+					}
+					position++
+					goto l233
+				l234:
+					position, tokenIndex = position233, tokenIndex233
+					if buffer[position] != rune('P') {
+						goto l203
+					}
+					position++
+				}
+			l233:
+				{
+					position235, tokenIndex235 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l236
+					}
+					position++
+					goto l235
+				l236:
+					position, tokenIndex = position235, tokenIndex235
+					if buffer[position] != rune('E') {
+						goto l203
 					}
 					position++
 				}
-			l192:
+			l235:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l203
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l160
+					goto l203
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l160
+					goto l203
 				}
-				if !_rules[ruleAction6]() {
-					goto l160
+				if !_rules[ruleSourceSchemaOpt]() {
+					goto l203
+				}
+				if !_rules[ruleAction7]() {
+					goto l203
 				}
-				add(ruleCreateSourceStmt, position161)
+				add(ruleCreateSourceStmt, position204)
 			}
 			return true
-		l160:
-			position, tokenIndex = position160, tokenIndex160
+		l203:
+			position, tokenIndex = position203, tokenIndex203
 			return false
 		},
-		/* 13 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action7)> */
+		/* 17 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
 		func() bool {
-			position194, tokenIndex194 := position, tokenIndex
+			position237, tokenIndex237 := position, tokenIndex
 			{
-				position195 := position
+				position238 := position
 				{
-					position196, tokenIndex196 := position, tokenIndex
+					position239, tokenIndex239 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l197
+						goto l240
 					}
 					position++
-					goto l196
-				l197:
-					position, tokenIndex = position196, tokenIndex196
+					goto l239
+				l240:
+					position, tokenIndex = position239, tokenIndex239
 					if buffer[position] != rune('C') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l196:
+			l239:
 				{
-					position198, tokenIndex198 := position, tokenIndex
+					position241, tokenIndex241 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l199
+						goto l242
 					}
 					position++
-					goto l198
-				l199:
-					position, tokenIndex = position198, tokenIndex198
+					goto l241
+				l242:
+					position, tokenIndex = position241, tokenIndex241
 					if buffer[position] != rune('R') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l198:
+			l241:
 				{
-					position200, tokenIndex200 := position, tokenIndex
+					position243, tokenIndex243 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l201
+						goto l244
 					}
 					position++
-					goto l200
-				l201:
-					position, tokenIndex = position200, tokenIndex200
+					goto l243
+				l244:
+					position, tokenIndex = position243, tokenIndex243
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l200:
+			l243:
 				{
-					position202, tokenIndex202 := position, tokenIndex
+					position245, tokenIndex245 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l203
+						goto l246
 					}
 					position++
-					goto l202
-				l203:
-					position, tokenIndex = position202, tokenIndex202
+					goto l245
+				l246:
+					position, tokenIndex = position245, tokenIndex245
 					if buffer[position] != rune('A') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l202:
+			l245:
 				{
-					position204, tokenIndex204 := position, tokenIndex
+					position247, tokenIndex247 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l205
+						goto l248
 					}
 					position++
-					goto l204
-				l205:
-					position, tokenIndex = position204, tokenIndex204
+					goto l247
+				l248:
+					position, tokenIndex = position247, tokenIndex247
 					if buffer[position] != rune('T') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l204:
+			l247:
 				{
-					position206, tokenIndex206 := position, tokenIndex
+					position249, tokenIndex249 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l207
+						goto l250
 					}
 					position++
-					goto l206
-				l207:
-					position, tokenIndex = position206, tokenIndex206
+					goto l249
+				l250:
+					position, tokenIndex = position249, tokenIndex249
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l206:
+			l249:
 				if !_rules[rulesp]() {
-					goto l194
+					goto l237
 				}
 				{
-					position208, tokenIndex208 := position, tokenIndex
+					position251, tokenIndex251 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l209
+						goto l252
 					}
 					position++
-					goto l208
-				l209:
-					position, tokenIndex = position208, tokenIndex208
+					goto l251
+				l252:
+					position, tokenIndex = position251, tokenIndex251
 					if buffer[position] != rune('S') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l208:
+			l251:
 				{
-					position210, tokenIndex210 := position, tokenIndex
+					position253, tokenIndex253 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l211
+						goto l254
 					}
 					position++
-					goto l210
-				l211:
-					position, tokenIndex = position210, tokenIndex210
+					goto l253
+				l254:
+					position, tokenIndex = position253, tokenIndex253
 					if buffer[position] != rune('I') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l210:
+			l253:
 				{
-					position212, tokenIndex212 := position, tokenIndex
+					position255, tokenIndex255 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l213
+						goto l256
 					}
 					position++
-					goto l212
-				l213:
-					position, tokenIndex = position212, tokenIndex212
+					goto l255
+				l256:
+					position, tokenIndex = position255, tokenIndex255
 					if buffer[position] != rune('N') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l212:
+			l255:
 				{
-					position214, tokenIndex214 := position, tokenIndex
+					position257, tokenIndex257 := position, tokenIndex
 					if buffer[position] != rune('k') {
-						goto l215
+						goto l258
 					}
 					position++
-					goto l214
-				l215:
-					position, tokenIndex = position214, tokenIndex214
+					goto l257
+				l258:
+					position, tokenIndex = position257, tokenIndex257
 					if buffer[position] != rune('K') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l214:
+			l257:
 				if !_rules[rulesp]() {
-					goto l194
+					goto l237
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l194
+					goto l237
 				}
 				if !_rules[rulesp]() {
-					goto l194
+					goto l237
 				}
 				{
-					position216, tokenIndex216 := position, tokenIndex
+					position259, tokenIndex259 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l217
+						goto l260
 					}
 					position++
-					goto l216
-				l217:
-					position, tokenIndex = position216, tokenIndex216
+					goto l259
+				l260:
+					position, tokenIndex = position259, tokenIndex259
 					if buffer[position] != rune('T') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l216:
+			l259:
 				{
-					position218, tokenIndex218 := position, tokenIndex
+					position261, tokenIndex261 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l219
+						goto l262
 					}
 					position++
-					goto l218
-				l219:
-					position, tokenIndex = position218, tokenIndex218
+					goto l261
+				l262:
+					position, tokenIndex = position261, tokenIndex261
 					if buffer[position] != rune('Y') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l218:
+			l261:
 				{
-					position220, tokenIndex220 := position, tokenIndex
+					position263, tokenIndex263 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l221
+						goto l264
 					}
 					position++
-					goto l220
-				l221:
-					position, tokenIndex = position220, tokenIndex220
+					goto l263
+				l264:
+					position, tokenIndex = position263, tokenIndex263
 					if buffer[position] != rune('P') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l220:
+			l263:
 				{
-					position222, tokenIndex222 := position, tokenIndex
+					position265, tokenIndex265 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l223
+						goto l266
 					}
 					position++
-					goto l222
-				l223:
-					position, tokenIndex = position222, tokenIndex222
+					goto l265
+				l266:
+					position, tokenIndex = position265, tokenIndex265
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l237
 					}
 					position++
 				}
-			l222:
+			l265:
 				if !_rules[rulesp]() {
-					goto l194
+					goto l237
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l194
+					goto l237
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l194
+					goto l237
 				}
-				if !_rules[ruleAction7]() {
-					goto l194
+				if !_rules[ruleAction8]() {
+					goto l237
 				}
-				add(ruleCreateSinkStmt, position195)
+				add(ruleCreateSinkStmt, position238)
 			}
 			return true
-		l194:
-			position, tokenIndex = position194, tokenIndex194
+		l237:
+			position, tokenIndex = position237, tokenIndex237
 			return false
 		},
-		/* 14 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
+		/* 18 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action9)> */
 		func() bool {
-			position224, tokenIndex224 := position, tokenIndex
+			position267, tokenIndex267 := position, tokenIndex
 			{
-				position225 := position
+				position268 := position
 				{
-					position226, tokenIndex226 := position, tokenIndex
+					position269, tokenIndex269 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l227
+						goto l270
 					}
 					position++
-					goto l226
-				l227:
-					position, tokenIndex = position226, tokenIndex226
+					goto l269
+				l270:
+					position, tokenIndex = position269, tokenIndex269
 					if buffer[position] != rune('C') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l226:
+			l269:
 				{
-					position228, tokenIndex228 := position, tokenIndex
+					position271, tokenIndex271 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l229
+						goto l272
 					}
 					position++
-					goto l228
-				l229:
-					position, tokenIndex = position228, tokenIndex228
+					goto l271
+				l272:
+					position, tokenIndex = position271, tokenIndex271
 					if buffer[position] != rune('R') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l228:
+			l271:
 				{
-					position230, tokenIndex230 := position, tokenIndex
+					position273, tokenIndex273 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l231
+						goto l274
 					}
 					position++
-					goto l230
-				l231:
-					position, tokenIndex = position230, tokenIndex230
+					goto l273
+				l274:
+					position, tokenIndex = position273, tokenIndex273
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l230:
+			l273:
 				{
-					position232, tokenIndex232 := position, tokenIndex
+					position275, tokenIndex275 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l233
+						goto l276
 					}
 					position++
-					goto l232
-				l233:
-					position, tokenIndex = position232, tokenIndex232
+					goto l275
+				l276:
+					position, tokenIndex = position275, tokenIndex275
 					if buffer[position] != rune('A') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l232:
+			l275:
 				{
-					position234, tokenIndex234 := position, tokenIndex
+					position277, tokenIndex277 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l235
+						goto l278
 					}
 					position++
-					goto l234
-				l235:
-					position, tokenIndex = position234, tokenIndex234
+					goto l277
+				l278:
+					position, tokenIndex = position277, tokenIndex277
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l234:
+			l277:
 				{
-					position236, tokenIndex236 := position, tokenIndex
+					position279, tokenIndex279 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l237
+						goto l280
 					}
 					position++
-					goto l236
-				l237:
-					position, tokenIndex = position236, tokenIndex236
+					goto l279
+				l280:
+					position, tokenIndex = position279, tokenIndex279
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l236:
+			l279:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l267
 				}
 				{
-					position238, tokenIndex238 := position, tokenIndex
+					position281, tokenIndex281 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l239
+						goto l282
 					}
 					position++
-					goto l238
-				l239:
-					position, tokenIndex = position238, tokenIndex238
+					goto l281
+				l282:
+					position, tokenIndex = position281, tokenIndex281
 					if buffer[position] != rune('S') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l238:
+			l281:
 				{
-					position240, tokenIndex240 := position, tokenIndex
+					position283, tokenIndex283 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l241
+						goto l284
 					}
 					position++
-					goto l240
-				l241:
-					position, tokenIndex = position240, tokenIndex240
+					goto l283
+				l284:
+					position, tokenIndex = position283, tokenIndex283
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l240:
+			l283:
 				{
-					position242, tokenIndex242 := position, tokenIndex
+					position285, tokenIndex285 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l243
+						goto l286
 					}
 					position++
-					goto l242
-				l243:
-					position, tokenIndex = position242, tokenIndex242
+					goto l285
+				l286:
+					position, tokenIndex = position285, tokenIndex285
 					if buffer[position] != rune('A') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l242:
+			l285:
 				{
-					position244, tokenIndex244 := position, tokenIndex
+					position287, tokenIndex287 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l245
+						goto l288
 					}
 					position++
-					goto l244
-				l245:
-					position, tokenIndex = position244, tokenIndex244
+					goto l287
+				l288:
+					position, tokenIndex = position287, tokenIndex287
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l244:
+			l287:
 				{
-					position246, tokenIndex246 := position, tokenIndex
+					position289, tokenIndex289 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l247
+						goto l290
 					}
 					position++
-					goto l246
-				l247:
-					position, tokenIndex = position246, tokenIndex246
+					goto l289
+				l290:
+					position, tokenIndex = position289, tokenIndex289
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l246:
+			l289:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l267
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l224
+					goto l267
 				}
 				if !_rules[rulesp]() {
-					goto l224
+					goto l267
 				}
 				{
-					position248, tokenIndex248 := position, tokenIndex
+					position291, tokenIndex291 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l249
+						goto l292
 					}
 					position++
-					goto l248
-				l249:
-					position, tokenIndex = position248, tokenIndex248
+					goto l291
+				l292:
+					position, tokenIndex = position291, tokenIndex291
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l248:
+			l291:
 				{
-					position250, tokenIndex250 := position, tokenIndex
+					position293, tokenIndex293 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l251
+						goto l294
 					}
 					position++
-					goto l250
-				l251:
-					position, tokenIndex = position250, tokenIndex250
+					goto l293
+				l294:
+					position, tokenIndex = position293, tokenIndex293
 					if buffer[position] != rune('Y') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l250:
+			l293:
 				{
-					position252, tokenIndex252 := position, tokenIndex
+					position295, tokenIndex295 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l253
+						goto l296
 					}
 					position++
-					goto l252
-				l253:
-					position, tokenIndex = position252, tokenIndex252
+					goto l295
+				l296:
+					position, tokenIndex = position295, tokenIndex295
 					if buffer[position] != rune('P') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l252:
+			l295:
 				{
-					position254, tokenIndex254 := position, tokenIndex
+					position297, tokenIndex297 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l255
+						goto l298
 					}
 					position++
-					goto l254
-				l255:
-					position, tokenIndex = position254, tokenIndex254
+					goto l297
+				l298:
+					position, tokenIndex = position297, tokenIndex297
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l267
 					}
 					position++
 				}
-			l254:
+			l297:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l267
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l224
+					goto l267
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l224
+					goto l267
 				}
-				if !_rules[ruleAction8]() {
-					goto l224
+				if !_rules[ruleAction9]() {
+					goto l267
 				}
-				add(ruleCreateStateStmt, position225)
+				add(ruleCreateStateStmt, position268)
 			}
 			return true
-		l224:
-			position, tokenIndex = position224, tokenIndex224
+		l267:
+			position, tokenIndex = position267, tokenIndex267
 			return false
 		},
-		/* 15 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action9)> */
+		/* 19 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
 		func() bool {
-			position256, tokenIndex256 := position, tokenIndex
+			position299, tokenIndex299 := position, tokenIndex
 			{
-				position257 := position
+				position300 := position
 				{
-					position258, tokenIndex258 := position, tokenIndex
+					position301, tokenIndex301 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l259
+						goto l302
 					}
 					position++
-					goto l258
-				l259:
-					position, tokenIndex = position258, tokenIndex258
+					goto l301
+				l302:
+					position, tokenIndex = position301, tokenIndex301
 					if buffer[position] != rune('U') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l258:
+			l301:
 				{
-					position260, tokenIndex260 := position, tokenIndex
+					position303, tokenIndex303 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l261
+						goto l304
 					}
 					position++
-					goto l260
-				l261:
-					position, tokenIndex = position260, tokenIndex260
+					goto l303
+				l304:
+					position, tokenIndex = position303, tokenIndex303
 					if buffer[position] != rune('P') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l260:
+			l303:
 				{
-					position262, tokenIndex262 := position, tokenIndex
+					position305, tokenIndex305 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l263
+						goto l306
 					}
 					position++
-					goto l262
-				l263:
-					position, tokenIndex = position262, tokenIndex262
+					goto l305
+				l306:
+					position, tokenIndex = position305, tokenIndex305
 					if buffer[position] != rune('D') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l262:
+			l305:
 				{
-					position264, tokenIndex264 := position, tokenIndex
+					position307, tokenIndex307 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l265
+						goto l308
 					}
 					position++
-					goto l264
-				l265:
-					position, tokenIndex = position264, tokenIndex264
+					goto l307
+				l308:
+					position, tokenIndex = position307, tokenIndex307
 					if buffer[position] != rune('A') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l264:
+			l307:
 				{
-					position266, tokenIndex266 := position, tokenIndex
+					position309, tokenIndex309 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l267
+						goto l310
 					}
 					position++
-					goto l266
-				l267:
-					position, tokenIndex = position266, tokenIndex266
+					goto l309
+				l310:
+					position, tokenIndex = position309, tokenIndex309
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l266:
+			l309:
 				{
-					position268, tokenIndex268 := position, tokenIndex
+					position311, tokenIndex311 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l269
+						goto l312
 					}
 					position++
-					goto l268
-				l269:
-					position, tokenIndex = position268, tokenIndex268
+					goto l311
+				l312:
+					position, tokenIndex = position311, tokenIndex311
 					if buffer[position] != rune('E') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l268:
+			l311:
 				if !_rules[rulesp]() {
-					goto l256
+					goto l299
 				}
 				{
-					position270, tokenIndex270 := position, tokenIndex
+					position313, tokenIndex313 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l271
+						goto l314
 					}
 					position++
-					goto l270
-				l271:
-					position, tokenIndex = position270, tokenIndex270
+					goto l313
+				l314:
+					position, tokenIndex = position313, tokenIndex313
 					if buffer[position] != rune('S') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l270:
+			l313:
 				{
-					position272, tokenIndex272 := position, tokenIndex
+					position315, tokenIndex315 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l273
+						goto l316
 					}
 					position++
-					goto l272
-				l273:
-					position, tokenIndex = position272, tokenIndex272
+					goto l315
+				l316:
+					position, tokenIndex = position315, tokenIndex315
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l272:
+			l315:
 				{
-					position274, tokenIndex274 := position, tokenIndex
+					position317, tokenIndex317 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l275
+						goto l318
 					}
 					position++
-					goto l274
-				l275:
-					position, tokenIndex = position274, tokenIndex274
+					goto l317
+				l318:
+					position, tokenIndex = position317, tokenIndex317
 					if buffer[position] != rune('A') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l274:
+			l317:
 				{
-					position276, tokenIndex276 := position, tokenIndex
+					position319, tokenIndex319 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l277
+						goto l320
 					}
 					position++
-					goto l276
-				l277:
-					position, tokenIndex = position276, tokenIndex276
+					goto l319
+				l320:
+					position, tokenIndex = position319, tokenIndex319
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l276:
+			l319:
 				{
-					position278, tokenIndex278 := position, tokenIndex
+					position321, tokenIndex321 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l279
+						goto l322
 					}
 					position++
-					goto l278
-				l279:
-					position, tokenIndex = position278, tokenIndex278
+					goto l321
+				l322:
+					position, tokenIndex = position321, tokenIndex321
 					if buffer[position] != rune('E') {
-						goto l256
+						goto l299
 					}
 					position++
 				}
-			l278:
+			l321:
 				if !_rules[rulesp]() {
-					goto l256
+					goto l299
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l256
+					goto l299
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l256
+					goto l299
 				}
-				if !_rules[ruleAction9]() {
-					goto l256
+				if !_rules[ruleAction10]() {
+					goto l299
 				}
-				add(ruleUpdateStateStmt, position257)
+				add(ruleUpdateStateStmt, position300)
 			}
 			return true
-		l256:
-			position, tokenIndex = position256, tokenIndex256
+		l299:
+			position, tokenIndex = position299, tokenIndex299
 			return false
 		},
-		/* 16 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
+		/* 20 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
 		func() bool {
-			position280, tokenIndex280 := position, tokenIndex
+			position323, tokenIndex323 := position, tokenIndex
 			{
-				position281 := position
+				position324 := position
 				{
-					position282, tokenIndex282 := position, tokenIndex
+					position325, tokenIndex325 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l283
+						goto l326
 					}
 					position++
-					goto l282
-				l283:
-					position, tokenIndex = position282, tokenIndex282
+					goto l325
+				l326:
+					position, tokenIndex = position325, tokenIndex325
 					if buffer[position] != rune('U') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l282:
+			l325:
 				{
-					position284, tokenIndex284 := position, tokenIndex
+					position327, tokenIndex327 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l285
+						goto l328
 					}
 					position++
-					goto l284
-				l285:
-					position, tokenIndex = position284, tokenIndex284
+					goto l327
+				l328:
+					position, tokenIndex = position327, tokenIndex327
 					if buffer[position] != rune('P') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l284:
+			l327:
 				{
-					position286, tokenIndex286 := position, tokenIndex
+					position329, tokenIndex329 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l287
+						goto l330
 					}
 					position++
-					goto l286
-				l287:
-					position, tokenIndex = position286, tokenIndex286
+					goto l329
+				l330:
+					position, tokenIndex = position329, tokenIndex329
 					if buffer[position] != rune('D') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l286:
+			l329:
 				{
-					position288, tokenIndex288 := position, tokenIndex
+					position331, tokenIndex331 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l289
+						goto l332
 					}
 					position++
-					goto l288
-				l289:
-					position, tokenIndex = position288, tokenIndex288
+					goto l331
+				l332:
+					position, tokenIndex = position331, tokenIndex331
 					if buffer[position] != rune('A') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l288:
+			l331:
 				{
-					position290, tokenIndex290 := position, tokenIndex
+					position333, tokenIndex333 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l291
+						goto l334
 					}
 					position++
-					goto l290
-				l291:
-					position, tokenIndex = position290, tokenIndex290
+					goto l333
+				l334:
+					position, tokenIndex = position333, tokenIndex333
 					if buffer[position] != rune('T') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l290:
+			l333:
 				{
-					position292, tokenIndex292 := position, tokenIndex
+					position335, tokenIndex335 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l293
+						goto l336
 					}
 					position++
-					goto l292
-				l293:
-					position, tokenIndex = position292, tokenIndex292
+					goto l335
+				l336:
+					position, tokenIndex = position335, tokenIndex335
 					if buffer[position] != rune('E') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l292:
+			l335:
 				if !_rules[rulesp]() {
-					goto l280
+					goto l323
 				}
 				{
-					position294, tokenIndex294 := position, tokenIndex
+					position337, tokenIndex337 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l295
+						goto l338
 					}
 					position++
-					goto l294
-				l295:
-					position, tokenIndex = position294, tokenIndex294
+					goto l337
+				l338:
+					position, tokenIndex = position337, tokenIndex337
 					if buffer[position] != rune('S') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l294:
+			l337:
 				{
-					position296, tokenIndex296 := position, tokenIndex
+					position339, tokenIndex339 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l297
+						goto l340
 					}
 					position++
-					goto l296
-				l297:
-					position, tokenIndex = position296, tokenIndex296
+					goto l339
+				l340:
+					position, tokenIndex = position339, tokenIndex339
 					if buffer[position] != rune('O') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l296:
+			l339:
 				{
-					position298, tokenIndex298 := position, tokenIndex
+					position341, tokenIndex341 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l299
+						goto l342
 					}
 					position++
-					goto l298
-				l299:
-					position, tokenIndex = position298, tokenIndex298
+					goto l341
+				l342:
+					position, tokenIndex = position341, tokenIndex341
 					if buffer[position] != rune('U') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l298:
+			l341:
 				{
-					position300, tokenIndex300 := position, tokenIndex
+					position343, tokenIndex343 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l301
+						goto l344
 					}
 					position++
-					goto l300
-				l301:
-					position, tokenIndex = position300, tokenIndex300
+					goto l343
+				l344:
+					position, tokenIndex = position343, tokenIndex343
 					if buffer[position] != rune('R') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l300:
+			l343:
 				{
-					position302, tokenIndex302 := position, tokenIndex
+					position345, tokenIndex345 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l303
+						goto l346
 					}
 					position++
-					goto l302
-				l303:
-					position, tokenIndex = position302, tokenIndex302
+					goto l345
+				l346:
+					position, tokenIndex = position345, tokenIndex345
 					if buffer[position] != rune('C') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l302:
+			l345:
 				{
-					position304, tokenIndex304 := position, tokenIndex
+					position347, tokenIndex347 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l305
+						goto l348
 					}
 					position++
-					goto l304
-				l305:
-					position, tokenIndex = position304, tokenIndex304
+					goto l347
+				l348:
+					position, tokenIndex = position347, tokenIndex347
 					if buffer[position] != rune('E') {
-						goto l280
+						goto l323
 					}
 					position++
 				}
-			l304:
+			l347:
 				if !_rules[rulesp]() {
-					goto l280
+					goto l323
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l280
+					goto l323
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l280
+					goto l323
 				}
-				if !_rules[ruleAction10]() {
-					goto l280
+				if !_rules[ruleAction11]() {
+					goto l323
 				}
-				add(ruleUpdateSourceStmt, position281)
+				add(ruleUpdateSourceStmt, position324)
 			}
 			return true
-		l280:
-			position, tokenIndex = position280, tokenIndex280
+		l323:
+			position, tokenIndex = position323, tokenIndex323
 			return false
 		},
-		/* 17 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
+		/* 21 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action12)> */
 		func() bool {
-			position306, tokenIndex306 := position, tokenIndex
+			position349, tokenIndex349 := position, tokenIndex
 			{
-				position307 := position
+				position350 := position
 				{
-					position308, tokenIndex308 := position, tokenIndex
+					position351, tokenIndex351 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l309
+						goto l352
 					}
 					position++
-					goto l308
-				l309:
-					position, tokenIndex = position308, tokenIndex308
+					goto l351
+				l352:
+					position, tokenIndex = position351, tokenIndex351
 					if buffer[position] != rune('U') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l308:
+			l351:
 				{
-					position310, tokenIndex310 := position, tokenIndex
+					position353, tokenIndex353 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l311
+						goto l354
 					}
 					position++
-					goto l310
-				l311:
-					position, tokenIndex = position310, tokenIndex310
+					goto l353
+				l354:
+					position, tokenIndex = position353, tokenIndex353
 					if buffer[position] != rune('P') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l310:
+			l353:
 				{
-					position312, tokenIndex312 := position, tokenIndex
+					position355, tokenIndex355 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l313
+						goto l356
 					}
 					position++
-					goto l312
-				l313:
-					position, tokenIndex = position312, tokenIndex312
+					goto l355
+				l356:
+					position, tokenIndex = position355, tokenIndex355
 					if buffer[position] != rune('D') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l312:
+			l355:
 				{
-					position314, tokenIndex314 := position, tokenIndex
+					position357, tokenIndex357 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l315
+						goto l358
 					}
 					position++
-					goto l314
-				l315:
-					position, tokenIndex = position314, tokenIndex314
+					goto l357
+				l358:
+					position, tokenIndex = position357, tokenIndex357
 					if buffer[position] != rune('A') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l314:
+			l357:
 				{
-					position316, tokenIndex316 := position, tokenIndex
+					position359, tokenIndex359 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l317
+						goto l360
 					}
 					position++
-					goto l316
-				l317:
-					position, tokenIndex = position316, tokenIndex316
+					goto l359
+				l360:
+					position, tokenIndex = position359, tokenIndex359
 					if buffer[position] != rune('T') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l316:
+			l359:
 				{
-					position318, tokenIndex318 := position, tokenIndex
+					position361, tokenIndex361 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l319
+						goto l362
 					}
 					position++
-					goto l318
-				l319:
-					position, tokenIndex = position318, tokenIndex318
+					goto l361
+				l362:
+					position, tokenIndex = position361, tokenIndex361
 					if buffer[position] != rune('E') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l318:
+			l361:
 				if !_rules[rulesp]() {
-					goto l306
+					goto l349
 				}
 				{
-					position320, tokenIndex320 := position, tokenIndex
+					position363, tokenIndex363 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l321
+						goto l364
 					}
 					position++
-					goto l320
-				l321:
-					position, tokenIndex = position320, tokenIndex320
+					goto l363
+				l364:
+					position, tokenIndex = position363, tokenIndex363
 					if buffer[position] != rune('S') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l320:
+			l363:
 				{
-					position322, tokenIndex322 := position, tokenIndex
+					position365, tokenIndex365 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l323
+						goto l366
 					}
 					position++
-					goto l322
-				l323:
-					position, tokenIndex = position322, tokenIndex322
+					goto l365
+				l366:
+					position, tokenIndex = position365, tokenIndex365
 					if buffer[position] != rune('I') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l322:
+			l365:
 				{
-					position324, tokenIndex324 := position, tokenIndex
+					position367, tokenIndex367 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l325
+						goto l368
 					}
 					position++
-					goto l324
-				l325:
-					position, tokenIndex = position324, tokenIndex324
+					goto l367
+				l368:
+					position, tokenIndex = position367, tokenIndex367
 					if buffer[position] != rune('N') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l324:
+			l367:
 				{
-					position326, tokenIndex326 := position, tokenIndex
+					position369, tokenIndex369 := position, tokenIndex
 					if buffer[position] != rune('k') {
-						goto l327
+						goto l370
 					}
 					position++
-					goto l326
-				l327:
-					position, tokenIndex = position326, tokenIndex326
+					goto l369
+				l370:
+					position, tokenIndex = position369, tokenIndex369
 					if buffer[position] != rune('K') {
-						goto l306
+						goto l349
 					}
 					position++
 				}
-			l326:
+			l369:
 				if !_rules[rulesp]() {
-					goto l306
+					goto l349
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l306
+					goto l349
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l306
+					goto l349
 				}
-				if !_rules[ruleAction11]() {
-					goto l306
+				if !_rules[ruleAction12]() {
+					goto l349
 				}
-				add(ruleUpdateSinkStmt, position307)
+				add(ruleUpdateSinkStmt, position350)
 			}
 			return true
-		l306:
-			position, tokenIndex = position306, tokenIndex306
+		l349:
+			position, tokenIndex = position349, tokenIndex349
 			return false
 		},
-		/* 18 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action12)> */
+		/* 22 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action13)> */
 		func() bool {
-			position328, tokenIndex328 := position, tokenIndex
+			position371, tokenIndex371 := position, tokenIndex
 			{
-				position329 := position
+				position372 := position
 				{
-					position330, tokenIndex330 := position, tokenIndex
+					position373, tokenIndex373 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l331
+						goto l374
 					}
 					position++
-					goto l330
-				l331:
-					position, tokenIndex = position330, tokenIndex330
+					goto l373
+				l374:
+					position, tokenIndex = position373, tokenIndex373
 					if buffer[position] != rune('I') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l330:
+			l373:
 				{
-					position332, tokenIndex332 := position, tokenIndex
+					position375, tokenIndex375 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l333
+						goto l376
 					}
 					position++
-					goto l332
-				l333:
-					position, tokenIndex = position332, tokenIndex332
+					goto l375
+				l376:
+					position, tokenIndex = position375, tokenIndex375
 					if buffer[position] != rune('N') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l332:
+			l375:
 				{
-					position334, tokenIndex334 := position, tokenIndex
+					position377, tokenIndex377 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l335
+						goto l378
 					}
 					position++
-					goto l334
-				l335:
-					position, tokenIndex = position334, tokenIndex334
+					goto l377
+				l378:
+					position, tokenIndex = position377, tokenIndex377
 					if buffer[position] != rune('S') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l334:
+			l377:
 				{
-					position336, tokenIndex336 := position, tokenIndex
+					position379, tokenIndex379 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l337
+						goto l380
 					}
 					position++
-					goto l336
-				l337:
-					position, tokenIndex = position336, tokenIndex336
+					goto l379
+				l380:
+					position, tokenIndex = position379, tokenIndex379
 					if buffer[position] != rune('E') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l336:
+			l379:
 				{
-					position338, tokenIndex338 := position, tokenIndex
+					position381, tokenIndex381 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l339
+						goto l382
 					}
 					position++
-					goto l338
-				l339:
-					position, tokenIndex = position338, tokenIndex338
+					goto l381
+				l382:
+					position, tokenIndex = position381, tokenIndex381
 					if buffer[position] != rune('R') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l338:
+			l381:
 				{
-					position340, tokenIndex340 := position, tokenIndex
+					position383, tokenIndex383 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l341
+						goto l384
 					}
 					position++
-					goto l340
-				l341:
-					position, tokenIndex = position340, tokenIndex340
+					goto l383
+				l384:
+					position, tokenIndex = position383, tokenIndex383
 					if buffer[position] != rune('T') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l340:
+			l383:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l371
 				}
 				{
-					position342, tokenIndex342 := position, tokenIndex
+					position385, tokenIndex385 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l343
+						goto l386
 					}
 					position++
-					goto l342
-				l343:
-					position, tokenIndex = position342, tokenIndex342
+					goto l385
+				l386:
+					position, tokenIndex = position385, tokenIndex385
 					if buffer[position] != rune('I') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l342:
+			l385:
 				{
-					position344, tokenIndex344 := position, tokenIndex
+					position387, tokenIndex387 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l345
+						goto l388
 					}
 					position++
-					goto l344
-				l345:
-					position, tokenIndex = position344, tokenIndex344
+					goto l387
+				l388:
+					position, tokenIndex = position387, tokenIndex387
 					if buffer[position] != rune('N') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l344:
+			l387:
 				{
-					position346, tokenIndex346 := position, tokenIndex
+					position389, tokenIndex389 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l347
+						goto l390
 					}
 					position++
-					goto l346
-				l347:
-					position, tokenIndex = position346, tokenIndex346
+					goto l389
+				l390:
+					position, tokenIndex = position389, tokenIndex389
 					if buffer[position] != rune('T') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l346:
+			l389:
 				{
-					position348, tokenIndex348 := position, tokenIndex
+					position391, tokenIndex391 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l349
+						goto l392
 					}
 					position++
-					goto l348
-				l349:
-					position, tokenIndex = position348, tokenIndex348
+					goto l391
+				l392:
+					position, tokenIndex = position391, tokenIndex391
 					if buffer[position] != rune('O') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l348:
+			l391:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l371
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l328
+					goto l371
 				}
 				if !_rules[rulesp]() {
-					goto l328
+					goto l371
 				}
 				{
-					position350, tokenIndex350 := position, tokenIndex
+					position393, tokenIndex393 := position, tokenIndex
 					if buffer[position] != rune('f') {
-						goto l351
+						goto l394
 					}
 					position++
-					goto l350
-				l351:
-					position, tokenIndex = position350, tokenIndex350
+					goto l393
+				l394:
+					position, tokenIndex = position393, tokenIndex393
 					if buffer[position] != rune('F') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l350:
+			l393:
 				{
-					position352, tokenIndex352 := position, tokenIndex
+					position395, tokenIndex395 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l353
+						goto l396
 					}
 					position++
-					goto l352
-				l353:
-					position, tokenIndex = position352, tokenIndex352
+					goto l395
+				l396:
+					position, tokenIndex = position395, tokenIndex395
 					if buffer[position] != rune('R') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l352:
+			l395:
 				{
-					position354, tokenIndex354 := position, tokenIndex
+					position397, tokenIndex397 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l355
+						goto l398
 					}
 					position++
-					goto l354
-				l355:
-					position, tokenIndex = position354, tokenIndex354
+					goto l397
+				l398:
+					position, tokenIndex = position397, tokenIndex397
 					if buffer[position] != rune('O') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l354:
+			l397:
 				{
-					position356, tokenIndex356 := position, tokenIndex
+					position399, tokenIndex399 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l357
+						goto l400
 					}
 					position++
-					goto l356
-				l357:
-					position, tokenIndex = position356, tokenIndex356
+					goto l399
+				l400:
+					position, tokenIndex = position399, tokenIndex399
 					if buffer[position] != rune('M') {
-						goto l328
+						goto l371
 					}
 					position++
 				}
-			l356:
+			l399:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l371
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l328
+					goto l371
 				}
-				if !_rules[ruleAction12]() {
-					goto l328
+				if !_rules[ruleAction13]() {
+					goto l371
 				}
-				add(ruleInsertIntoFromStmt, position329)
+				add(ruleInsertIntoFromStmt, position372)
 			}
 			return true
-		l328:
-			position, tokenIndex = position328, tokenIndex328
+		l371:
+			position, tokenIndex = position371, tokenIndex371
 			return false
 		},
-		/* 19 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action13)> */
+		/* 23 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
 		func() bool {
-			position358, tokenIndex358 := position, tokenIndex
+			position401, tokenIndex401 := position, tokenIndex
 			{
-				position359 := position
+				position402 := position
 				{
-					position360, tokenIndex360 := position, tokenIndex
+					position403, tokenIndex403 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l361
+						goto l404
 					}
 					position++
-					goto l360
-				l361:
-					position, tokenIndex = position360, tokenIndex360
+					goto l403
+				l404:
+					position, tokenIndex = position403, tokenIndex403
 					if buffer[position] != rune('P') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l360:
+			l403:
 				{
-					position362, tokenIndex362 := position, tokenIndex
+					position405, tokenIndex405 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l363
+						goto l406
 					}
 					position++
-					goto l362
-				l363:
-					position, tokenIndex = position362, tokenIndex362
+					goto l405
+				l406:
+					position, tokenIndex = position405, tokenIndex405
 					if buffer[position] != rune('A') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l362:
+			l405:
 				{
-					position364, tokenIndex364 := position, tokenIndex
+					position407, tokenIndex407 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l365
+						goto l408
 					}
 					position++
-					goto l364
-				l365:
-					position, tokenIndex = position364, tokenIndex364
+					goto l407
+				l408:
+					position, tokenIndex = position407, tokenIndex407
 					if buffer[position] != rune('U') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l364:
+			l407:
 				{
-					position366, tokenIndex366 := position, tokenIndex
+					position409, tokenIndex409 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l367
+						goto l410
 					}
 					position++
-					goto l366
-				l367:
-					position, tokenIndex = position366, tokenIndex366
+					goto l409
+				l410:
+					position, tokenIndex = position409, tokenIndex409
 					if buffer[position] != rune('S') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l366:
+			l409:
 				{
-					position368, tokenIndex368 := position, tokenIndex
+					position411, tokenIndex411 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l369
+						goto l412
 					}
 					position++
-					goto l368
-				l369:
-					position, tokenIndex = position368, tokenIndex368
+					goto l411
+				l412:
+					position, tokenIndex = position411, tokenIndex411
 					if buffer[position] != rune('E') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l368:
+			l411:
 				if !_rules[rulesp]() {
-					goto l358
+					goto l401
 				}
 				{
-					position370, tokenIndex370 := position, tokenIndex
+					position413, tokenIndex413 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l371
+						goto l414
 					}
 					position++
-					goto l370
-				l371:
-					position, tokenIndex = position370, tokenIndex370
+					goto l413
+				l414:
+					position, tokenIndex = position413, tokenIndex413
 					if buffer[position] != rune('S') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l370:
+			l413:
 				{
-					position372, tokenIndex372 := position, tokenIndex
+					position415, tokenIndex415 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l373
+						goto l416
 					}
 					position++
-					goto l372
-				l373:
-					position, tokenIndex = position372, tokenIndex372
+					goto l415
+				l416:
+					position, tokenIndex = position415, tokenIndex415
 					if buffer[position] != rune('O') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l372:
+			l415:
 				{
-					position374, tokenIndex374 := position, tokenIndex
+					position417, tokenIndex417 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l375
+						goto l418
 					}
 					position++
-					goto l374
-				l375:
-					position, tokenIndex = position374, tokenIndex374
+					goto l417
+				l418:
+					position, tokenIndex = position417, tokenIndex417
 					if buffer[position] != rune('U') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l374:
+			l417:
 				{
-					position376, tokenIndex376 := position, tokenIndex
+					position419, tokenIndex419 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l377
+						goto l420
 					}
 					position++
-					goto l376
-				l377:
-					position, tokenIndex = position376, tokenIndex376
+					goto l419
+				l420:
+					position, tokenIndex = position419, tokenIndex419
 					if buffer[position] != rune('R') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l376:
+			l419:
 				{
-					position378, tokenIndex378 := position, tokenIndex
+					position421, tokenIndex421 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l379
+						goto l422
 					}
 					position++
-					goto l378
-				l379:
-					position, tokenIndex = position378, tokenIndex378
+					goto l421
+				l422:
+					position, tokenIndex = position421, tokenIndex421
 					if buffer[position] != rune('C') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l378:
+			l421:
 				{
-					position380, tokenIndex380 := position, tokenIndex
+					position423, tokenIndex423 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l381
+						goto l424
 					}
 					position++
-					goto l380
-				l381:
-					position, tokenIndex = position380, tokenIndex380
+					goto l423
+				l424:
+					position, tokenIndex = position423, tokenIndex423
 					if buffer[position] != rune('E') {
-						goto l358
+						goto l401
 					}
 					position++
 				}
-			l380:
+			l423:
 				if !_rules[rulesp]() {
-					goto l358
+					goto l401
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l358
+					goto l401
 				}
-				if !_rules[ruleAction13]() {
-					goto l358
+				if !_rules[ruleAction14]() {
+					goto l401
 				}
-				add(rulePauseSourceStmt, position359)
+				add(rulePauseSourceStmt, position402)
 			}
 			return true
-		l358:
-			position, tokenIndex = position358, tokenIndex358
+		l401:
+			position, tokenIndex = position401, tokenIndex401
 			return false
 		},
-		/* 20 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
+		/* 24 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
 		func() bool {
-			position382, tokenIndex382 := position, tokenIndex
+			position425, tokenIndex425 := position, tokenIndex
 			{
-				position383 := position
+				position426 := position
 				{
-					position384, tokenIndex384 := position, tokenIndex
+					position427, tokenIndex427 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l385
+						goto l428
 					}
 					position++
-					goto l384
-				l385:
-					position, tokenIndex = position384, tokenIndex384
+					goto l427
+				l428:
+					position, tokenIndex = position427, tokenIndex427
 					if buffer[position] != rune('R') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l384:
+			l427:
 				{
-					position386, tokenIndex386 := position, tokenIndex
+					position429, tokenIndex429 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l387
+						goto l430
 					}
 					position++
-					goto l386
-				l387:
-					position, tokenIndex = position386, tokenIndex386
+					goto l429
+				l430:
+					position, tokenIndex = position429, tokenIndex429
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l386:
+			l429:
 				{
-					position388, tokenIndex388 := position, tokenIndex
+					position431, tokenIndex431 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l389
+						goto l432
 					}
 					position++
-					goto l388
-				l389:
-					position, tokenIndex = position388, tokenIndex388
+					goto l431
+				l432:
+					position, tokenIndex = position431, tokenIndex431
 					if buffer[position] != rune('S') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l388:
+			l431:
 				{
-					position390, tokenIndex390 := position, tokenIndex
+					position433, tokenIndex433 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l391
+						goto l434
 					}
 					position++
-					goto l390
-				l391:
-					position, tokenIndex = position390, tokenIndex390
+					goto l433
+				l434:
+					position, tokenIndex = position433, tokenIndex433
 					if buffer[position] != rune('U') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l390:
+			l433:
 				{
-					position392, tokenIndex392 := position, tokenIndex
+					position435, tokenIndex435 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l393
+						goto l436
 					}
 					position++
-					goto l392
-				l393:
-					position, tokenIndex = position392, tokenIndex392
+					goto l435
+				l436:
+					position, tokenIndex = position435, tokenIndex435
 					if buffer[position] != rune('M') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l392:
+			l435:
 				{
-					position394, tokenIndex394 := position, tokenIndex
+					position437, tokenIndex437 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l395
+						goto l438
 					}
 					position++
-					goto l394
-				l395:
-					position, tokenIndex = position394, tokenIndex394
+					goto l437
+				l438:
+					position, tokenIndex = position437, tokenIndex437
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l394:
+			l437:
 				if !_rules[rulesp]() {
-					goto l382
+					goto l425
 				}
 				{
-					position396, tokenIndex396 := position, tokenIndex
+					position439, tokenIndex439 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l397
+						goto l440
 					}
 					position++
-					goto l396
-				l397:
-					position, tokenIndex = position396, tokenIndex396
+					goto l439
+				l440:
+					position, tokenIndex = position439, tokenIndex439
 					if buffer[position] != rune('S') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l396:
+			l439:
 				{
-					position398, tokenIndex398 := position, tokenIndex
+					position441, tokenIndex441 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l399
+						goto l442
 					}
 					position++
-					goto l398
-				l399:
-					position, tokenIndex = position398, tokenIndex398
+					goto l441
+				l442:
+					position, tokenIndex = position441, tokenIndex441
 					if buffer[position] != rune('O') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l398:
+			l441:
 				{
-					position400, tokenIndex400 := position, tokenIndex
+					position443, tokenIndex443 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l401
+						goto l444
 					}
 					position++
-					goto l400
-				l401:
-					position, tokenIndex = position400, tokenIndex400
+					goto l443
+				l444:
+					position, tokenIndex = position443, tokenIndex443
 					if buffer[position] != rune('U') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l400:
+			l443:
 				{
-					position402, tokenIndex402 := position, tokenIndex
+					position445, tokenIndex445 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l403
+						goto l446
 					}
 					position++
-					goto l402
-				l403:
-					position, tokenIndex = position402, tokenIndex402
+					goto l445
+				l446:
+					position, tokenIndex = position445, tokenIndex445
 					if buffer[position] != rune('R') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l402:
+			l445:
 				{
-					position404, tokenIndex404 := position, tokenIndex
+					position447, tokenIndex447 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l405
+						goto l448
 					}
 					position++
-					goto l404
-				l405:
-					position, tokenIndex = position404, tokenIndex404
+					goto l447
+				l448:
+					position, tokenIndex = position447, tokenIndex447
 					if buffer[position] != rune('C') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l404:
+			l447:
 				{
-					position406, tokenIndex406 := position, tokenIndex
+					position449, tokenIndex449 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l407
+						goto l450
 					}
 					position++
-					goto l406
-				l407:
-					position, tokenIndex = position406, tokenIndex406
+					goto l449
+				l450:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l425
 					}
 					position++
 				}
-			l406:
+			l449:
 				if !_rules[rulesp]() {
-					goto l382
+					goto l425
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l382
+					goto l425
 				}
-				if !_rules[ruleAction14]() {
-					goto l382
+				if !_rules[ruleAction15]() {
+					goto l425
 				}
-				add(ruleResumeSourceStmt, position383)
+				add(ruleResumeSourceStmt, position426)
 			}
 			return true
-		l382:
-			position, tokenIndex = position382, tokenIndex382
+		l425:
+			position, tokenIndex = position425, tokenIndex425
 			return false
 		},
-		/* 21 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
+		/* 25 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
 		func() bool {
-			position408, tokenIndex408 := position, tokenIndex
+			position451, tokenIndex451 := position, tokenIndex
 			{
-				position409 := position
+				position452 := position
 				{
-					position410, tokenIndex410 := position, tokenIndex
+					position453, tokenIndex453 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l411
+						goto l454
 					}
 					position++
-					goto l410
-				l411:
-					position, tokenIndex = position410, tokenIndex410
+					goto l453
+				l454:
+					position, tokenIndex = position453, tokenIndex453
 					if buffer[position] != rune('R') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l410:
+			l453:
 				{
-					position412, tokenIndex412 := position, tokenIndex
+					position455, tokenIndex455 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l413
+						goto l456
 					}
 					position++
-					goto l412
-				l413:
-					position, tokenIndex = position412, tokenIndex412
+					goto l455
+				l456:
+					position, tokenIndex = position455, tokenIndex455
 					if buffer[position] != rune('E') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l412:
+			l455:
 				{
-					position414, tokenIndex414 := position, tokenIndex
+					position457, tokenIndex457 := position, tokenIndex
 					if buffer[position] != rune('w') {
-						goto l415
+						goto l458
 					}
 					position++
-					goto l414
-				l415:
-					position, tokenIndex = position414, tokenIndex414
+					goto l457
+				l458:
+					position, tokenIndex = position457, tokenIndex457
 					if buffer[position] != rune('W') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l414:
+			l457:
 				{
-					position416, tokenIndex416 := position, tokenIndex
+					position459, tokenIndex459 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l417
+						goto l460
 					}
 					position++
-					goto l416
-				l417:
-					position, tokenIndex = position416, tokenIndex416
+					goto l459
+				l460:
+					position, tokenIndex = position459, tokenIndex459
 					if buffer[position] != rune('I') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l416:
+			l459:
 				{
-					position418, tokenIndex418 := position, tokenIndex
+					position461, tokenIndex461 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l419
+						goto l462
 					}
 					position++
-					goto l418
-				l419:
-					position, tokenIndex = position418, tokenIndex418
+					goto l461
+				l462:
+					position, tokenIndex = position461, tokenIndex461
 					if buffer[position] != rune('N') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l418:
+			l461:
 				{
-					position420, tokenIndex420 := position, tokenIndex
+					position463, tokenIndex463 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l421
+						goto l464
 					}
 					position++
-					goto l420
-				l421:
-					position, tokenIndex = position420, tokenIndex420
+					goto l463
+				l464:
+					position, tokenIndex = position463, tokenIndex463
 					if buffer[position] != rune('D') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l420:
+			l463:
 				if !_rules[rulesp]() {
-					goto l408
+					goto l451
 				}
 				{
-					position422, tokenIndex422 := position, tokenIndex
+					position465, tokenIndex465 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l423
+						goto l466
 					}
 					position++
-					goto l422
-				l423:
-					position, tokenIndex = position422, tokenIndex422
+					goto l465
+				l466:
+					position, tokenIndex = position465, tokenIndex465
 					if buffer[position] != rune('S') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l422:
+			l465:
 				{
-					position424, tokenIndex424 := position, tokenIndex
+					position467, tokenIndex467 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l425
+						goto l468
 					}
 					position++
-					goto l424
-				l425:
-					position, tokenIndex = position424, tokenIndex424
+					goto l467
+				l468:
+					position, tokenIndex = position467, tokenIndex467
 					if buffer[position] != rune('O') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l424:
+			l467:
 				{
-					position426, tokenIndex426 := position, tokenIndex
+					position469, tokenIndex469 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l427
+						goto l470
 					}
 					position++
-					goto l426
-				l427:
-					position, tokenIndex = position426, tokenIndex426
+					goto l469
+				l470:
+					position, tokenIndex = position469, tokenIndex469
 					if buffer[position] != rune('U') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l426:
+			l469:
 				{
-					position428, tokenIndex428 := position, tokenIndex
+					position471, tokenIndex471 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l429
+						goto l472
 					}
 					position++
-					goto l428
-				l429:
-					position, tokenIndex = position428, tokenIndex428
+					goto l471
+				l472:
+					position, tokenIndex = position471, tokenIndex471
 					if buffer[position] != rune('R') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l428:
+			l471:
 				{
-					position430, tokenIndex430 := position, tokenIndex
+					position473, tokenIndex473 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l431
+						goto l474
 					}
 					position++
-					goto l430
-				l431:
-					position, tokenIndex = position430, tokenIndex430
+					goto l473
+				l474:
+					position, tokenIndex = position473, tokenIndex473
 					if buffer[position] != rune('C') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l430:
+			l473:
 				{
-					position432, tokenIndex432 := position, tokenIndex
+					position475, tokenIndex475 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l433
+						goto l476
 					}
 					position++
-					goto l432
-				l433:
-					position, tokenIndex = position432, tokenIndex432
+					goto l475
+				l476:
+					position, tokenIndex = position475, tokenIndex475
 					if buffer[position] != rune('E') {
-						goto l408
+						goto l451
 					}
 					position++
 				}
-			l432:
+			l475:
 				if !_rules[rulesp]() {
-					goto l408
+					goto l451
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l408
+					goto l451
 				}
-				if !_rules[ruleAction15]() {
-					goto l408
+				if !_rules[ruleAction16]() {
+					goto l451
 				}
-				add(ruleRewindSourceStmt, position409)
+				add(ruleRewindSourceStmt, position452)
 			}
 			return true
-		l408:
-			position, tokenIndex = position408, tokenIndex408
+		l451:
+			position, tokenIndex = position451, tokenIndex451
 			return false
 		},
-		/* 22 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
+		/* 26 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action17)> */
 		func() bool {
-			position434, tokenIndex434 := position, tokenIndex
+			position477, tokenIndex477 := position, tokenIndex
 			{
-				position435 := position
+				position478 := position
 				{
-					position436, tokenIndex436 := position, tokenIndex
+					position479, tokenIndex479 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l437
+						goto l480
 					}
 					position++
-					goto l436
-				l437:
-					position, tokenIndex = position436, tokenIndex436
+					goto l479
+				l480:
+					position, tokenIndex = position479, tokenIndex479
 					if buffer[position] != rune('D') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l436:
+			l479:
 				{
-					position438, tokenIndex438 := position, tokenIndex
+					position481, tokenIndex481 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l439
+						goto l482
 					}
 					position++
-					goto l438
-				l439:
-					position, tokenIndex = position438, tokenIndex438
+					goto l481
+				l482:
+					position, tokenIndex = position481, tokenIndex481
 					if buffer[position] != rune('R') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l438:
+			l481:
 				{
-					position440, tokenIndex440 := position, tokenIndex
+					position483, tokenIndex483 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l441
+						goto l484
 					}
 					position++
-					goto l440
-				l441:
-					position, tokenIndex = position440, tokenIndex440
+					goto l483
+				l484:
+					position, tokenIndex = position483, tokenIndex483
 					if buffer[position] != rune('O') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l440:
+			l483:
 				{
-					position442, tokenIndex442 := position, tokenIndex
+					position485, tokenIndex485 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l443
+						goto l486
 					}
 					position++
-					goto l442
-				l443:
-					position, tokenIndex = position442, tokenIndex442
+					goto l485
+				l486:
+					position, tokenIndex = position485, tokenIndex485
 					if buffer[position] != rune('P') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l442:
+			l485:
 				if !_rules[rulesp]() {
-					goto l434
+					goto l477
 				}
 				{
-					position444, tokenIndex444 := position, tokenIndex
+					position487, tokenIndex487 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l445
+						goto l488
 					}
 					position++
-					goto l444
-				l445:
-					position, tokenIndex = position444, tokenIndex444
+					goto l487
+				l488:
+					position, tokenIndex = position487, tokenIndex487
 					if buffer[position] != rune('S') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l444:
+			l487:
 				{
-					position446, tokenIndex446 := position, tokenIndex
+					position489, tokenIndex489 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l447
+						goto l490
 					}
 					position++
-					goto l446
-				l447:
-					position, tokenIndex = position446, tokenIndex446
+					goto l489
+				l490:
+					position, tokenIndex = position489, tokenIndex489
 					if buffer[position] != rune('O') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l446:
+			l489:
 				{
-					position448, tokenIndex448 := position, tokenIndex
+					position491, tokenIndex491 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l449
+						goto l492
 					}
 					position++
-					goto l448
-				l449:
-					position, tokenIndex = position448, tokenIndex448
+					goto l491
+				l492:
+					position, tokenIndex = position491, tokenIndex491
 					if buffer[position] != rune('U') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l448:
+			l491:
 				{
-					position450, tokenIndex450 := position, tokenIndex
+					position493, tokenIndex493 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l451
+						goto l494
 					}
 					position++
-					goto l450
-				l451:
-					position, tokenIndex = position450, tokenIndex450
+					goto l493
+				l494:
+					position, tokenIndex = position493, tokenIndex493
 					if buffer[position] != rune('R') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l450:
+			l493:
 				{
-					position452, tokenIndex452 := position, tokenIndex
+					position495, tokenIndex495 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l453
+						goto l496
 					}
 					position++
-					goto l452
-				l453:
-					position, tokenIndex = position452, tokenIndex452
+					goto l495
+				l496:
+					position, tokenIndex = position495, tokenIndex495
 					if buffer[position] != rune('C') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l452:
+			l495:
 				{
-					position454, tokenIndex454 := position, tokenIndex
+					position497, tokenIndex497 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l455
+						goto l498
 					}
 					position++
-					goto l454
-				l455:
-					position, tokenIndex = position454, tokenIndex454
+					goto l497
+				l498:
+					position, tokenIndex = position497, tokenIndex497
 					if buffer[position] != rune('E') {
-						goto l434
+						goto l477
 					}
 					position++
 				}
-			l454:
+			l497:
 				if !_rules[rulesp]() {
-					goto l434
+					goto l477
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l434
+					goto l477
 				}
-				if !_rules[ruleAction16]() {
-					goto l434
+				if !_rules[ruleAction17]() {
+					goto l477
 				}
-				add(ruleDropSourceStmt, position435)
+				add(ruleDropSourceStmt, position478)
 			}
 			return true
-		l434:
-			position, tokenIndex = position434, tokenIndex434
+		l477:
+			position, tokenIndex = position477, tokenIndex477
 			return false
 		},
-		/* 23 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action17)> */
+		/* 27 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action18)> */
 		func() bool {
-			position456, tokenIndex456 := position, tokenIndex
+			position499, tokenIndex499 := position, tokenIndex
 			{
-				position457 := position
+				position500 := position
 				{
-					position458, tokenIndex458 := position, tokenIndex
+					position501, tokenIndex501 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l459
+						goto l502
 					}
 					position++
-					goto l458
-				l459:
-					position, tokenIndex = position458, tokenIndex458
+					goto l501
+				l502:
+					position, tokenIndex = position501, tokenIndex501
 					if buffer[position] != rune('D') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l458:
+			l501:
 				{
-					position460, tokenIndex460 := position, tokenIndex
+					position503, tokenIndex503 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l461
+						goto l504
 					}
 					position++
-					goto l460
-				l461:
-					position, tokenIndex = position460, tokenIndex460
+					goto l503
+				l504:
+					position, tokenIndex = position503, tokenIndex503
 					if buffer[position] != rune('R') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l460:
+			l503:
 				{
-					position462, tokenIndex462 := position, tokenIndex
+					position505, tokenIndex505 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l463
+						goto l506
 					}
 					position++
-					goto l462
-				l463:
-					position, tokenIndex = position462, tokenIndex462
+					goto l505
+				l506:
+					position, tokenIndex = position505, tokenIndex505
 					if buffer[position] != rune('O') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l462:
+			l505:
 				{
-					position464, tokenIndex464 := position, tokenIndex
+					position507, tokenIndex507 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l465
+						goto l508
 					}
 					position++
-					goto l464
-				l465:
-					position, tokenIndex = position464, tokenIndex464
+					goto l507
+				l508:
+					position, tokenIndex = position507, tokenIndex507
 					if buffer[position] != rune('P') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l464:
+			l507:
 				if !_rules[rulesp]() {
-					goto l456
+					goto l499
 				}
 				{
-					position466, tokenIndex466 := position, tokenIndex
+					position509, tokenIndex509 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l467
+						goto l510
 					}
 					position++
-					goto l466
-				l467:
-					position, tokenIndex = position466, tokenIndex466
+					goto l509
+				l510:
+					position, tokenIndex = position509, tokenIndex509
 					if buffer[position] != rune('S') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l466:
+			l509:
 				{
-					position468, tokenIndex468 := position, tokenIndex
+					position511, tokenIndex511 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l469
+						goto l512
 					}
 					position++
-					goto l468
-				l469:
-					position, tokenIndex = position468, tokenIndex468
+					goto l511
+				l512:
+					position, tokenIndex = position511, tokenIndex511
 					if buffer[position] != rune('T') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l468:
+			l511:
 				{
-					position470, tokenIndex470 := position, tokenIndex
+					position513, tokenIndex513 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l471
+						goto l514
 					}
 					position++
-					goto l470
-				l471:
-					position, tokenIndex = position470, tokenIndex470
+					goto l513
+				l514:
+					position, tokenIndex = position513, tokenIndex513
 					if buffer[position] != rune('R') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l470:
+			l513:
 				{
-					position472, tokenIndex472 := position, tokenIndex
+					position515, tokenIndex515 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l473
+						goto l516
 					}
 					position++
-					goto l472
-				l473:
-					position, tokenIndex = position472, tokenIndex472
+					goto l515
+				l516:
+					position, tokenIndex = position515, tokenIndex515
 					if buffer[position] != rune('E') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l472:
+			l515:
 				{
-					position474, tokenIndex474 := position, tokenIndex
+					position517, tokenIndex517 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l475
+						goto l518
 					}
 					position++
-					goto l474
-				l475:
-					position, tokenIndex = position474, tokenIndex474
+					goto l517
+				l518:
+					position, tokenIndex = position517, tokenIndex517
 					if buffer[position] != rune('A') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l474:
+			l517:
 				{
-					position476, tokenIndex476 := position, tokenIndex
+					position519, tokenIndex519 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l477
+						goto l520
 					}
 					position++
-					goto l476
-				l477:
-					position, tokenIndex = position476, tokenIndex476
+					goto l519
+				l520:
+					position, tokenIndex = position519, tokenIndex519
 					if buffer[position] != rune('M') {
-						goto l456
+						goto l499
 					}
 					position++
 				}
-			l476:
+			l519:
 				if !_rules[rulesp]() {
-					goto l456
+					goto l499
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l456
+					goto l499
 				}
-				if !_rules[ruleAction17]() {
-					goto l456
+				if !_rules[ruleAction18]() {
+					goto l499
 				}
-				add(ruleDropStreamStmt, position457)
+				add(ruleDropStreamStmt, position500)
 			}
 			return true
-		l456:
-			position, tokenIndex = position456, tokenIndex456
+		l499:
+			position, tokenIndex = position499, tokenIndex499
 			return false
 		},
-		/* 24 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action18)> */
+		/* 28 DropViewStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('v' / 'V') ('i' / 'I') ('e' / 'E') ('w' / 'W')) sp StreamIdentifier Action19)> */
 		func() bool {
-			position478, tokenIndex478 := position, tokenIndex
+			position521, tokenIndex521 := position, tokenIndex
 			{
-				position479 := position
+				position522 := position
 				{
-					position480, tokenIndex480 := position, tokenIndex
+					position523, tokenIndex523 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l481
+						goto l524
 					}
 					position++
-					goto l480
-				l481:
-					position, tokenIndex = position480, tokenIndex480
+					goto l523
+				l524:
+					position, tokenIndex = position523, tokenIndex523
 					if buffer[position] != rune('D') {
-						goto l478
+						goto l521
 					}
 					position++
 				}
-			l480:
+			l523:
 				{
-					position482, tokenIndex482 := position, tokenIndex
+					position525, tokenIndex525 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l483
+						goto l526
 					}
 					position++
-					goto l482
-				l483:
-					position, tokenIndex = position482, tokenIndex482
+					goto l525
+				l526:
+					position, tokenIndex = position525, tokenIndex525
 					if buffer[position] != rune('R') {
-						goto l478
+						goto l521
 					}
 					position++
 				}
-			l482:
+			l525:
 				{
-					position484, tokenIndex484 := position, tokenIndex
+					position527, tokenIndex527 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l485
+						goto l528
 					}
 					position++
-					goto l484
-				l485:
-					position, tokenIndex = position484, tokenIndex484
+					goto l527
+				l528:
+					position, tokenIndex = position527, tokenIndex527
 					if buffer[position] != rune('O') {
-						goto l478
+						goto l521
 					}
 					position++
 				}
-			l484:
+			l527:
 				{
-					position486, tokenIndex486 := position, tokenIndex
+					position529, tokenIndex529 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l487
+						goto l530
 					}
 					position++
-					goto l486
-				l487:
-					position, tokenIndex = position486, tokenIndex486
+					goto l529
+				l530:
+					position, tokenIndex = position529, tokenIndex529
 					if buffer[position] != rune('P') {
-						goto l478
+						goto l521
 					}
 					position++
 				}
-			l486:
+			l529:
 				if !_rules[rulesp]() {
-					goto l478
+					goto l521
 				}
 				{
-					position488, tokenIndex488 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l489
+					position531, tokenIndex531 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l532
 					}
 					position++
-					goto l488
-				l489:
-					position, tokenIndex = position488, tokenIndex488
-					if buffer[position] != rune('S') {
-						goto l478
+					goto l531
+				l532:
+					position, tokenIndex = position531, tokenIndex531
+					if buffer[position] != rune('V') {
+						goto l521
 					}
 					position++
 				}
-			l488:
+			l531:
 				{
-					position490, tokenIndex490 := position, tokenIndex
+					position533, tokenIndex533 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l491
+						goto l534
 					}
 					position++
-					goto l490
-				l491:
-					position, tokenIndex = position490, tokenIndex490
+					goto l533
+				l534:
+					position, tokenIndex = position533, tokenIndex533
 					if buffer[position] != rune('I') {
-						goto l478
+						goto l521
 					}
 					position++
 				}
-			l490:
+			l533:
 				{
-					position492, tokenIndex492 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l493
+					position535, tokenIndex535 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l536
 					}
 					position++
-					goto l492
-				l493:
-					position, tokenIndex = position492, tokenIndex492
-					if buffer[position] != rune('N') {
-						goto l478
+					goto l535
+				l536:
+					position, tokenIndex = position535, tokenIndex535
+					if buffer[position] != rune('E') {
+						goto l521
 					}
 					position++
 				}
-			l492:
+			l535:
 				{
-					position494, tokenIndex494 := position, tokenIndex
-					if buffer[position] != rune('k') {
-						goto l495
+					position537, tokenIndex537 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l538
 					}
 					position++
-					goto l494
-				l495:
-					position, tokenIndex = position494, tokenIndex494
-					if buffer[position] != rune('K') {
-						goto l478
+					goto l537
+				l538:
+					position, tokenIndex = position537, tokenIndex537
+					if buffer[position] != rune('W') {
+						goto l521
 					}
 					position++
 				}
-			l494:
+			l537:
 				if !_rules[rulesp]() {
-					goto l478
+					goto l521
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l478
+					goto l521
 				}
-				if !_rules[ruleAction18]() {
-					goto l478
+				if !_rules[ruleAction19]() {
+					goto l521
 				}
-				add(ruleDropSinkStmt, position479)
+				add(ruleDropViewStmt, position522)
 			}
 			return true
-		l478:
-			position, tokenIndex = position478, tokenIndex478
+		l521:
+			position, tokenIndex = position521, tokenIndex521
 			return false
 		},
-		/* 25 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action19)> */
+		/* 29 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action20)> */
 		func() bool {
-			position496, tokenIndex496 := position, tokenIndex
+			position539, tokenIndex539 := position, tokenIndex
 			{
-				position497 := position
+				position540 := position
 				{
-					position498, tokenIndex498 := position, tokenIndex
+					position541, tokenIndex541 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l499
+						goto l542
 					}
 					position++
-					goto l498
-				l499:
-					position, tokenIndex = position498, tokenIndex498
+					goto l541
+				l542:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('D') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l498:
+			l541:
 				{
-					position500, tokenIndex500 := position, tokenIndex
+					position543, tokenIndex543 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l501
+						goto l544
 					}
 					position++
-					goto l500
-				l501:
-					position, tokenIndex = position500, tokenIndex500
+					goto l543
+				l544:
+					position, tokenIndex = position543, tokenIndex543
 					if buffer[position] != rune('R') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l500:
+			l543:
 				{
-					position502, tokenIndex502 := position, tokenIndex
+					position545, tokenIndex545 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l503
+						goto l546
 					}
 					position++
-					goto l502
-				l503:
-					position, tokenIndex = position502, tokenIndex502
+					goto l545
+				l546:
+					position, tokenIndex = position545, tokenIndex545
 					if buffer[position] != rune('O') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l502:
+			l545:
 				{
-					position504, tokenIndex504 := position, tokenIndex
+					position547, tokenIndex547 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l505
+						goto l548
 					}
 					position++
-					goto l504
-				l505:
-					position, tokenIndex = position504, tokenIndex504
+					goto l547
+				l548:
+					position, tokenIndex = position547, tokenIndex547
 					if buffer[position] != rune('P') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l504:
+			l547:
 				if !_rules[rulesp]() {
-					goto l496
+					goto l539
 				}
 				{
-					position506, tokenIndex506 := position, tokenIndex
+					position549, tokenIndex549 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l507
+						goto l550
 					}
 					position++
-					goto l506
-				l507:
-					position, tokenIndex = position506, tokenIndex506
+					goto l549
+				l550:
+					position, tokenIndex = position549, tokenIndex549
 					if buffer[position] != rune('S') {
-						goto l496
-					}
-					position++
-				}
-			l506:
-				{
-					position508, tokenIndex508 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l509
-					}
-					position++
-					goto l508
-				l509:
-					position, tokenIndex = position508, tokenIndex508
-					if buffer[position] != rune('T') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l508:
+			l549:
 				{
-					position510, tokenIndex510 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l511
+					position551, tokenIndex551 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l552
 					}
 					position++
-					goto l510
-				l511:
-					position, tokenIndex = position510, tokenIndex510
-					if buffer[position] != rune('A') {
-						goto l496
+					goto l551
+				l552:
+					position, tokenIndex = position551, tokenIndex551
+					if buffer[position] != rune('I') {
+						goto l539
 					}
 					position++
 				}
-			l510:
+			l551:
 				{
-					position512, tokenIndex512 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l513
+					position553, tokenIndex553 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l554
 					}
 					position++
-					goto l512
-				l513:
-					position, tokenIndex = position512, tokenIndex512
-					if buffer[position] != rune('T') {
-						goto l496
+					goto l553
+				l554:
+					position, tokenIndex = position553, tokenIndex553
+					if buffer[position] != rune('N') {
+						goto l539
 					}
 					position++
 				}
-			l512:
+			l553:
 				{
-					position514, tokenIndex514 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l515
+					position555, tokenIndex555 := position, tokenIndex
+					if buffer[position] != rune('k') {
+						goto l556
 					}
 					position++
-					goto l514
-				l515:
-					position, tokenIndex = position514, tokenIndex514
-					if buffer[position] != rune('E') {
-						goto l496
+					goto l555
+				l556:
+					position, tokenIndex = position555, tokenIndex555
+					if buffer[position] != rune('K') {
+						goto l539
 					}
 					position++
 				}
-			l514:
+			l555:
 				if !_rules[rulesp]() {
-					goto l496
+					goto l539
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l496
+					goto l539
 				}
-				if !_rules[ruleAction19]() {
-					goto l496
+				if !_rules[ruleAction20]() {
+					goto l539
 				}
-				add(ruleDropStateStmt, position497)
+				add(ruleDropSinkStmt, position540)
 			}
 			return true
-		l496:
-			position, tokenIndex = position496, tokenIndex496
+		l539:
+			position, tokenIndex = position539, tokenIndex539
 			return false
 		},
-		/* 26 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action20)> */
+		/* 30 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action21)> */
 		func() bool {
-			position516, tokenIndex516 := position, tokenIndex
+			position557, tokenIndex557 := position, tokenIndex
 			{
-				position517 := position
+				position558 := position
 				{
-					position518, tokenIndex518 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l519
+					position559, tokenIndex559 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l560
 					}
 					position++
-					goto l518
-				l519:
-					position, tokenIndex = position518, tokenIndex518
-					if buffer[position] != rune('L') {
-						goto l516
+					goto l559
+				l560:
+					position, tokenIndex = position559, tokenIndex559
+					if buffer[position] != rune('D') {
+						goto l557
 					}
 					position++
 				}
-			l518:
+			l559:
 				{
-					position520, tokenIndex520 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l521
+					position561, tokenIndex561 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l562
 					}
 					position++
-					goto l520
-				l521:
-					position, tokenIndex = position520, tokenIndex520
-					if buffer[position] != rune('O') {
-						goto l516
+					goto l561
+				l562:
+					position, tokenIndex = position561, tokenIndex561
+					if buffer[position] != rune('R') {
+						goto l557
 					}
 					position++
 				}
-			l520:
+			l561:
 				{
-					position522, tokenIndex522 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l523
+					position563, tokenIndex563 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l564
 					}
 					position++
-					goto l522
-				l523:
-					position, tokenIndex = position522, tokenIndex522
-					if buffer[position] != rune('A') {
-						goto l516
-					}
+					goto l563
+				l564:
+					position, tokenIndex = position563, tokenIndex563
+					if buffer[position] != rune('O') {
+						goto l557
+					}
 					position++
 				}
-			l522:
+			l563:
 				{
-					position524, tokenIndex524 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l525
+					position565, tokenIndex565 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l566
 					}
 					position++
-					goto l524
-				l525:
-					position, tokenIndex = position524, tokenIndex524
-					if buffer[position] != rune('D') {
-						goto l516
+					goto l565
+				l566:
+					position, tokenIndex = position565, tokenIndex565
+					if buffer[position] != rune('P') {
+						goto l557
 					}
 					position++
 				}
-			l524:
+			l565:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l557
 				}
 				{
-					position526, tokenIndex526 := position, tokenIndex
+					position567, tokenIndex567 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l527
+						goto l568
 					}
 					position++
-					goto l526
-				l527:
-					position, tokenIndex = position526, tokenIndex526
+					goto l567
+				l568:
+					position, tokenIndex = position567, tokenIndex567
 					if buffer[position] != rune('S') {
-						goto l516
+						goto l557
 					}
 					position++
 				}
-			l526:
+			l567:
 				{
-					position528, tokenIndex528 := position, tokenIndex
+					position569, tokenIndex569 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l529
+						goto l570
 					}
 					position++
-					goto l528
-				l529:
-					position, tokenIndex = position528, tokenIndex528
+					goto l569
+				l570:
+					position, tokenIndex = position569, tokenIndex569
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l557
 					}
 					position++
 				}
-			l528:
+			l569:
 				{
-					position530, tokenIndex530 := position, tokenIndex
+					position571, tokenIndex571 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l531
+						goto l572
 					}
 					position++
-					goto l530
-				l531:
-					position, tokenIndex = position530, tokenIndex530
+					goto l571
+				l572:
+					position, tokenIndex = position571, tokenIndex571
 					if buffer[position] != rune('A') {
-						goto l516
+						goto l557
 					}
 					position++
 				}
-			l530:
+			l571:
 				{
-					position532, tokenIndex532 := position, tokenIndex
+					position573, tokenIndex573 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l533
+						goto l574
 					}
 					position++
-					goto l532
-				l533:
-					position, tokenIndex = position532, tokenIndex532
+					goto l573
+				l574:
+					position, tokenIndex = position573, tokenIndex573
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l557
 					}
 					position++
 				}
-			l532:
+			l573:
 				{
-					position534, tokenIndex534 := position, tokenIndex
+					position575, tokenIndex575 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l535
+						goto l576
 					}
 					position++
-					goto l534
-				l535:
-					position, tokenIndex = position534, tokenIndex534
+					goto l575
+				l576:
+					position, tokenIndex = position575, tokenIndex575
 					if buffer[position] != rune('E') {
-						goto l516
+						goto l557
 					}
 					position++
 				}
-			l534:
+			l575:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l557
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l516
-				}
-				if !_rules[rulesp]() {
-					goto l516
-				}
-				{
-					position536, tokenIndex536 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l537
-					}
-					position++
-					goto l536
-				l537:
-					position, tokenIndex = position536, tokenIndex536
-					if buffer[position] != rune('T') {
-						goto l516
-					}
-					position++
-				}
-			l536:
-				{
-					position538, tokenIndex538 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l539
-					}
-					position++
-					goto l538
-				l539:
-					position, tokenIndex = position538, tokenIndex538
-					if buffer[position] != rune('Y') {
-						goto l516
-					}
-					position++
-				}
-			l538:
-				{
-					position540, tokenIndex540 := position, tokenIndex
-					if buffer[position] != rune('p') {
-						goto l541
-					}
-					position++
-					goto l540
-				l541:
-					position, tokenIndex = position540, tokenIndex540
-					if buffer[position] != rune('P') {
-						goto l516
-					}
-					position++
-				}
-			l540:
-				{
-					position542, tokenIndex542 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l543
-					}
-					position++
-					goto l542
-				l543:
-					position, tokenIndex = position542, tokenIndex542
-					if buffer[position] != rune('E') {
-						goto l516
-					}
-					position++
-				}
-			l542:
-				if !_rules[rulesp]() {
-					goto l516
+					goto l557
 				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l516
-				}
-				if !_rules[ruleStateTagOpt]() {
-					goto l516
-				}
-				if !_rules[ruleSetOptSpecs]() {
-					goto l516
-				}
-				if !_rules[ruleAction20]() {
-					goto l516
+				if !_rules[ruleAction21]() {
+					goto l557
 				}
-				add(ruleLoadStateStmt, position517)
+				add(ruleDropStateStmt, position558)
 			}
 			return true
-		l516:
-			position, tokenIndex = position516, tokenIndex516
+		l557:
+			position, tokenIndex = position557, tokenIndex557
 			return false
 		},
-		/* 27 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action21)> */
+		/* 31 CreateFunctionStmt <- <(<(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp Function spOpt '(' spOpt (Identifier (spOpt ',' spOpt Identifier)*)? spOpt ')' sp (('a' / 'A') ('s' / 'S')) sp Expression)> Action22)> */
 		func() bool {
-			position544, tokenIndex544 := position, tokenIndex
+			position577, tokenIndex577 := position, tokenIndex
 			{
-				position545 := position
-				if !_rules[ruleLoadStateStmt]() {
-					goto l544
-				}
-				if !_rules[rulesp]() {
-					goto l544
-				}
-				{
-					position546, tokenIndex546 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l547
-					}
-					position++
-					goto l546
-				l547:
-					position, tokenIndex = position546, tokenIndex546
-					if buffer[position] != rune('O') {
-						goto l544
-					}
-					position++
-				}
-			l546:
-				{
-					position548, tokenIndex548 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l549
-					}
-					position++
-					goto l548
-				l549:
-					position, tokenIndex = position548, tokenIndex548
-					if buffer[position] != rune('R') {
-						goto l544
-					}
-					position++
-				}
-			l548:
-				if !_rules[rulesp]() {
-					goto l544
-				}
-				{
-					position550, tokenIndex550 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l551
-					}
-					position++
-					goto l550
-				l551:
-					position, tokenIndex = position550, tokenIndex550
-					if buffer[position] != rune('C') {
-						goto l544
-					}
-					position++
-				}
-			l550:
-				{
-					position552, tokenIndex552 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l553
-					}
-					position++
-					goto l552
-				l553:
-					position, tokenIndex = position552, tokenIndex552
-					if buffer[position] != rune('R') {
-						goto l544
-					}
-					position++
-				}
-			l552:
-				{
-					position554, tokenIndex554 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l555
-					}
-					position++
-					goto l554
-				l555:
-					position, tokenIndex = position554, tokenIndex554
-					if buffer[position] != rune('E') {
-						goto l544
-					}
-					position++
-				}
-			l554:
-				{
-					position556, tokenIndex556 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l557
-					}
-					position++
-					goto l556
-				l557:
-					position, tokenIndex = position556, tokenIndex556
-					if buffer[position] != rune('A') {
-						goto l544
-					}
-					position++
-				}
-			l556:
-				{
-					position558, tokenIndex558 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l559
-					}
-					position++
-					goto l558
-				l559:
-					position, tokenIndex = position558, tokenIndex558
-					if buffer[position] != rune('T') {
-						goto l544
-					}
-					position++
-				}
-			l558:
-				{
-					position560, tokenIndex560 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l561
-					}
-					position++
-					goto l560
-				l561:
-					position, tokenIndex = position560, tokenIndex560
-					if buffer[position] != rune('E') {
-						goto l544
-					}
-					position++
-				}
-			l560:
-				if !_rules[rulesp]() {
-					goto l544
-				}
-				{
-					position562, tokenIndex562 := position, tokenIndex
-					if buffer[position] != rune('i') {
-						goto l563
-					}
-					position++
-					goto l562
-				l563:
-					position, tokenIndex = position562, tokenIndex562
-					if buffer[position] != rune('I') {
-						goto l544
-					}
-					position++
-				}
-			l562:
-				{
-					position564, tokenIndex564 := position, tokenIndex
-					if buffer[position] != rune('f') {
-						goto l565
-					}
-					position++
-					goto l564
-				l565:
-					position, tokenIndex = position564, tokenIndex564
-					if buffer[position] != rune('F') {
-						goto l544
-					}
-					position++
-				}
-			l564:
-				if !_rules[rulesp]() {
-					goto l544
-				}
-				{
-					position566, tokenIndex566 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l567
-					}
-					position++
-					goto l566
-				l567:
-					position, tokenIndex = position566, tokenIndex566
-					if buffer[position] != rune('N') {
-						goto l544
-					}
-					position++
-				}
-			l566:
-				{
-					position568, tokenIndex568 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l569
-					}
-					position++
-					goto l568
-				l569:
-					position, tokenIndex = position568, tokenIndex568
-					if buffer[position] != rune('O') {
-						goto l544
-					}
-					position++
-				}
-			l568:
-				{
-					position570, tokenIndex570 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l571
-					}
-					position++
-					goto l570
-				l571:
-					position, tokenIndex = position570, tokenIndex570
-					if buffer[position] != rune('T') {
-						goto l544
-					}
-					position++
-				}
-			l570:
-				if !_rules[rulesp]() {
-					goto l544
-				}
+				position578 := position
 				{
-					position572, tokenIndex572 := position, tokenIndex
-					{
-						position574, tokenIndex574 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l575
-						}
-						position++
-						goto l574
-					l575:
-						position, tokenIndex = position574, tokenIndex574
-						if buffer[position] != rune('S') {
-							goto l573
-						}
-						position++
-					}
-				l574:
-					{
-						position576, tokenIndex576 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l577
-						}
-						position++
-						goto l576
-					l577:
-						position, tokenIndex = position576, tokenIndex576
-						if buffer[position] != rune('A') {
-							goto l573
-						}
-						position++
-					}
-				l576:
-					{
-						position578, tokenIndex578 := position, tokenIndex
-						if buffer[position] != rune('v') {
-							goto l579
-						}
-						position++
-						goto l578
-					l579:
-						position, tokenIndex = position578, tokenIndex578
-						if buffer[position] != rune('V') {
-							goto l573
-						}
-						position++
-					}
-				l578:
+					position579 := position
 					{
 						position580, tokenIndex580 := position, tokenIndex
-						if buffer[position] != rune('e') {
+						if buffer[position] != rune('c') {
 							goto l581
 						}
 						position++
 						goto l580
 					l581:
 						position, tokenIndex = position580, tokenIndex580
-						if buffer[position] != rune('E') {
-							goto l573
+						if buffer[position] != rune('C') {
+							goto l577
 						}
 						position++
 					}
 				l580:
 					{
 						position582, tokenIndex582 := position, tokenIndex
-						if buffer[position] != rune('d') {
+						if buffer[position] != rune('r') {
 							goto l583
 						}
 						position++
 						goto l582
 					l583:
 						position, tokenIndex = position582, tokenIndex582
-						if buffer[position] != rune('D') {
-							goto l573
+						if buffer[position] != rune('R') {
+							goto l577
 						}
 						position++
 					}
 				l582:
-					goto l572
-				l573:
-					position, tokenIndex = position572, tokenIndex572
 					{
 						position584, tokenIndex584 := position, tokenIndex
 						if buffer[position] != rune('e') {
@@ -6141,278 +6569,298 @@ func (p *bqlPegBackend) Init() {
 					l585:
 						position, tokenIndex = position584, tokenIndex584
 						if buffer[position] != rune('E') {
-							goto l544
+							goto l577
 						}
 						position++
 					}
 				l584:
 					{
 						position586, tokenIndex586 := position, tokenIndex
-						if buffer[position] != rune('x') {
+						if buffer[position] != rune('a') {
 							goto l587
 						}
 						position++
 						goto l586
 					l587:
 						position, tokenIndex = position586, tokenIndex586
-						if buffer[position] != rune('X') {
-							goto l544
+						if buffer[position] != rune('A') {
+							goto l577
 						}
 						position++
 					}
 				l586:
 					{
 						position588, tokenIndex588 := position, tokenIndex
-						if buffer[position] != rune('i') {
+						if buffer[position] != rune('t') {
 							goto l589
 						}
 						position++
 						goto l588
 					l589:
 						position, tokenIndex = position588, tokenIndex588
-						if buffer[position] != rune('I') {
-							goto l544
+						if buffer[position] != rune('T') {
+							goto l577
 						}
 						position++
 					}
 				l588:
 					{
 						position590, tokenIndex590 := position, tokenIndex
-						if buffer[position] != rune('s') {
+						if buffer[position] != rune('e') {
 							goto l591
 						}
 						position++
 						goto l590
 					l591:
 						position, tokenIndex = position590, tokenIndex590
-						if buffer[position] != rune('S') {
-							goto l544
-						}
+						if buffer[position] != rune('E') {
+							goto l577
+						}
 						position++
 					}
 				l590:
+					if !_rules[rulesp]() {
+						goto l577
+					}
 					{
 						position592, tokenIndex592 := position, tokenIndex
-						if buffer[position] != rune('t') {
+						if buffer[position] != rune('f') {
 							goto l593
 						}
 						position++
 						goto l592
 					l593:
 						position, tokenIndex = position592, tokenIndex592
-						if buffer[position] != rune('T') {
-							goto l544
+						if buffer[position] != rune('F') {
+							goto l577
 						}
 						position++
 					}
 				l592:
 					{
 						position594, tokenIndex594 := position, tokenIndex
-						if buffer[position] != rune('s') {
+						if buffer[position] != rune('u') {
 							goto l595
 						}
 						position++
 						goto l594
 					l595:
 						position, tokenIndex = position594, tokenIndex594
-						if buffer[position] != rune('S') {
-							goto l544
+						if buffer[position] != rune('U') {
+							goto l577
 						}
 						position++
 					}
 				l594:
-				}
-			l572:
-				if !_rules[ruleSourceSinkSpecs]() {
-					goto l544
-				}
-				if !_rules[ruleAction21]() {
-					goto l544
-				}
-				add(ruleLoadStateOrCreateStmt, position545)
-			}
-			return true
-		l544:
-			position, tokenIndex = position544, tokenIndex544
-			return false
-		},
-		/* 28 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action22)> */
-		func() bool {
-			position596, tokenIndex596 := position, tokenIndex
-			{
-				position597 := position
-				{
-					position598, tokenIndex598 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l599
-					}
-					position++
-					goto l598
-				l599:
-					position, tokenIndex = position598, tokenIndex598
-					if buffer[position] != rune('S') {
+					{
+						position596, tokenIndex596 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l597
+						}
+						position++
 						goto l596
+					l597:
+						position, tokenIndex = position596, tokenIndex596
+						if buffer[position] != rune('N') {
+							goto l577
+						}
+						position++
 					}
-					position++
-				}
-			l598:
-				{
-					position600, tokenIndex600 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l601
+				l596:
+					{
+						position598, tokenIndex598 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l599
+						}
+						position++
+						goto l598
+					l599:
+						position, tokenIndex = position598, tokenIndex598
+						if buffer[position] != rune('C') {
+							goto l577
+						}
+						position++
 					}
-					position++
-					goto l600
-				l601:
-					position, tokenIndex = position600, tokenIndex600
-					if buffer[position] != rune('A') {
-						goto l596
+				l598:
+					{
+						position600, tokenIndex600 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l601
+						}
+						position++
+						goto l600
+					l601:
+						position, tokenIndex = position600, tokenIndex600
+						if buffer[position] != rune('T') {
+							goto l577
+						}
+						position++
 					}
-					position++
-				}
-			l600:
-				{
-					position602, tokenIndex602 := position, tokenIndex
-					if buffer[position] != rune('v') {
-						goto l603
+				l600:
+					{
+						position602, tokenIndex602 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l603
+						}
+						position++
+						goto l602
+					l603:
+						position, tokenIndex = position602, tokenIndex602
+						if buffer[position] != rune('I') {
+							goto l577
+						}
+						position++
 					}
-					position++
-					goto l602
-				l603:
-					position, tokenIndex = position602, tokenIndex602
-					if buffer[position] != rune('V') {
-						goto l596
+				l602:
+					{
+						position604, tokenIndex604 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l605
+						}
+						position++
+						goto l604
+					l605:
+						position, tokenIndex = position604, tokenIndex604
+						if buffer[position] != rune('O') {
+							goto l577
+						}
+						position++
 					}
-					position++
-				}
-			l602:
-				{
-					position604, tokenIndex604 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l605
+				l604:
+					{
+						position606, tokenIndex606 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l607
+						}
+						position++
+						goto l606
+					l607:
+						position, tokenIndex = position606, tokenIndex606
+						if buffer[position] != rune('N') {
+							goto l577
+						}
+						position++
 					}
-					position++
-					goto l604
-				l605:
-					position, tokenIndex = position604, tokenIndex604
-					if buffer[position] != rune('E') {
-						goto l596
+				l606:
+					if !_rules[rulesp]() {
+						goto l577
 					}
-					position++
-				}
-			l604:
-				if !_rules[rulesp]() {
-					goto l596
-				}
-				{
-					position606, tokenIndex606 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l607
+					if !_rules[ruleFunction]() {
+						goto l577
 					}
-					position++
-					goto l606
-				l607:
-					position, tokenIndex = position606, tokenIndex606
-					if buffer[position] != rune('S') {
-						goto l596
+					if !_rules[rulespOpt]() {
+						goto l577
+					}
+					if buffer[position] != rune('(') {
+						goto l577
 					}
 					position++
-				}
-			l606:
-				{
-					position608, tokenIndex608 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					if !_rules[rulespOpt]() {
+						goto l577
+					}
+					{
+						position608, tokenIndex608 := position, tokenIndex
+						if !_rules[ruleIdentifier]() {
+							goto l608
+						}
+					l610:
+						{
+							position611, tokenIndex611 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l611
+							}
+							if buffer[position] != rune(',') {
+								goto l611
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l611
+							}
+							if !_rules[ruleIdentifier]() {
+								goto l611
+							}
+							goto l610
+						l611:
+							position, tokenIndex = position611, tokenIndex611
+						}
 						goto l609
+					l608:
+						position, tokenIndex = position608, tokenIndex608
 					}
-					position++
-					goto l608
 				l609:
-					position, tokenIndex = position608, tokenIndex608
-					if buffer[position] != rune('T') {
-						goto l596
+					if !_rules[rulespOpt]() {
+						goto l577
 					}
-					position++
-				}
-			l608:
-				{
-					position610, tokenIndex610 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l611
+					if buffer[position] != rune(')') {
+						goto l577
 					}
 					position++
-					goto l610
-				l611:
-					position, tokenIndex = position610, tokenIndex610
-					if buffer[position] != rune('A') {
-						goto l596
+					if !_rules[rulesp]() {
+						goto l577
 					}
-					position++
-				}
-			l610:
-				{
-					position612, tokenIndex612 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l613
+					{
+						position612, tokenIndex612 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l613
+						}
+						position++
+						goto l612
+					l613:
+						position, tokenIndex = position612, tokenIndex612
+						if buffer[position] != rune('A') {
+							goto l577
+						}
+						position++
 					}
-					position++
-					goto l612
-				l613:
-					position, tokenIndex = position612, tokenIndex612
-					if buffer[position] != rune('T') {
-						goto l596
+				l612:
+					{
+						position614, tokenIndex614 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l615
+						}
+						position++
+						goto l614
+					l615:
+						position, tokenIndex = position614, tokenIndex614
+						if buffer[position] != rune('S') {
+							goto l577
+						}
+						position++
 					}
-					position++
-				}
-			l612:
-				{
-					position614, tokenIndex614 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l615
+				l614:
+					if !_rules[rulesp]() {
+						goto l577
 					}
-					position++
-					goto l614
-				l615:
-					position, tokenIndex = position614, tokenIndex614
-					if buffer[position] != rune('E') {
-						goto l596
+					if !_rules[ruleExpression]() {
+						goto l577
 					}
-					position++
-				}
-			l614:
-				if !_rules[rulesp]() {
-					goto l596
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l596
-				}
-				if !_rules[ruleStateTagOpt]() {
-					goto l596
+					add(rulePegText, position579)
 				}
 				if !_rules[ruleAction22]() {
-					goto l596
+					goto l577
 				}
-				add(ruleSaveStateStmt, position597)
+				add(ruleCreateFunctionStmt, position578)
 			}
 			return true
-		l596:
-			position, tokenIndex = position596, tokenIndex596
+		l577:
+			position, tokenIndex = position577, tokenIndex577
 			return false
 		},
-		/* 29 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action23)> */
+		/* 32 DropFunctionStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp Function Action23)> */
 		func() bool {
 			position616, tokenIndex616 := position, tokenIndex
 			{
 				position617 := position
 				{
 					position618, tokenIndex618 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('d') {
 						goto l619
 					}
 					position++
 					goto l618
 				l619:
 					position, tokenIndex = position618, tokenIndex618
-					if buffer[position] != rune('E') {
+					if buffer[position] != rune('D') {
 						goto l616
 					}
 					position++
@@ -6420,14 +6868,14 @@ func (p *bqlPegBackend) Init() {
 			l618:
 				{
 					position620, tokenIndex620 := position, tokenIndex
-					if buffer[position] != rune('v') {
+					if buffer[position] != rune('r') {
 						goto l621
 					}
 					position++
 					goto l620
 				l621:
 					position, tokenIndex = position620, tokenIndex620
-					if buffer[position] != rune('V') {
+					if buffer[position] != rune('R') {
 						goto l616
 					}
 					position++
@@ -6435,14 +6883,14 @@ func (p *bqlPegBackend) Init() {
 			l620:
 				{
 					position622, tokenIndex622 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('o') {
 						goto l623
 					}
 					position++
 					goto l622
 				l623:
 					position, tokenIndex = position622, tokenIndex622
-					if buffer[position] != rune('A') {
+					if buffer[position] != rune('O') {
 						goto l616
 					}
 					position++
@@ -6450,14 +6898,14 @@ func (p *bqlPegBackend) Init() {
 			l622:
 				{
 					position624, tokenIndex624 := position, tokenIndex
-					if buffer[position] != rune('l') {
+					if buffer[position] != rune('p') {
 						goto l625
 					}
 					position++
 					goto l624
 				l625:
 					position, tokenIndex = position624, tokenIndex624
-					if buffer[position] != rune('L') {
+					if buffer[position] != rune('P') {
 						goto l616
 					}
 					position++
@@ -6466,253 +6914,251 @@ func (p *bqlPegBackend) Init() {
 				if !_rules[rulesp]() {
 					goto l616
 				}
-				if !_rules[ruleExpression]() {
-					goto l616
-				}
 				{
-					position626 := position
-					{
-						position627, tokenIndex627 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l627
-						}
-						{
-							position629, tokenIndex629 := position, tokenIndex
-							if buffer[position] != rune('o') {
-								goto l630
-							}
-							position++
-							goto l629
-						l630:
-							position, tokenIndex = position629, tokenIndex629
-							if buffer[position] != rune('O') {
-								goto l627
-							}
-							position++
-						}
-					l629:
-						{
-							position631, tokenIndex631 := position, tokenIndex
-							if buffer[position] != rune('n') {
-								goto l632
-							}
-							position++
-							goto l631
-						l632:
-							position, tokenIndex = position631, tokenIndex631
-							if buffer[position] != rune('N') {
-								goto l627
-							}
-							position++
-						}
-					l631:
-						if !_rules[rulesp]() {
-							goto l627
-						}
-						if !_rules[ruleMapExpr]() {
-							goto l627
-						}
-						goto l628
-					l627:
-						position, tokenIndex = position627, tokenIndex627
+					position626, tokenIndex626 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l627
 					}
-				l628:
-					add(rulePegText, position626)
-				}
-				if !_rules[ruleAction23]() {
-					goto l616
-				}
-				add(ruleEvalStmt, position617)
-			}
-			return true
-		l616:
-			position, tokenIndex = position616, tokenIndex616
-			return false
-		},
-		/* 30 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action24)> */
-		func() bool {
-			position633, tokenIndex633 := position, tokenIndex
-			{
-				position634 := position
-				if !_rules[rulesp]() {
-					goto l633
+					position++
+					goto l626
+				l627:
+					position, tokenIndex = position626, tokenIndex626
+					if buffer[position] != rune('F') {
+						goto l616
+					}
+					position++
 				}
+			l626:
 				{
-					position635, tokenIndex635 := position, tokenIndex
-					if !_rules[ruleISTREAM]() {
-						goto l636
-					}
-					goto l635
-				l636:
-					position, tokenIndex = position635, tokenIndex635
-					if !_rules[ruleDSTREAM]() {
-						goto l637
+					position628, tokenIndex628 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l629
 					}
-					goto l635
-				l637:
-					position, tokenIndex = position635, tokenIndex635
-					if !_rules[ruleRSTREAM]() {
-						goto l633
+					position++
+					goto l628
+				l629:
+					position, tokenIndex = position628, tokenIndex628
+					if buffer[position] != rune('U') {
+						goto l616
 					}
+					position++
 				}
-			l635:
-				if !_rules[ruleEmitterOptions]() {
-					goto l633
+			l628:
+				{
+					position630, tokenIndex630 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l631
+					}
+					position++
+					goto l630
+				l631:
+					position, tokenIndex = position630, tokenIndex630
+					if buffer[position] != rune('N') {
+						goto l616
+					}
+					position++
 				}
-				if !_rules[ruleAction24]() {
-					goto l633
+			l630:
+				{
+					position632, tokenIndex632 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l633
+					}
+					position++
+					goto l632
+				l633:
+					position, tokenIndex = position632, tokenIndex632
+					if buffer[position] != rune('C') {
+						goto l616
+					}
+					position++
 				}
-				add(ruleEmitter, position634)
-			}
-			return true
-		l633:
-			position, tokenIndex = position633, tokenIndex633
-			return false
-		},
-		/* 31 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action25)> */
-		func() bool {
-			position638, tokenIndex638 := position, tokenIndex
-			{
-				position639 := position
+			l632:
 				{
-					position640 := position
-					{
-						position641, tokenIndex641 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l641
-						}
-						if buffer[position] != rune('[') {
-							goto l641
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l641
-						}
-						if !_rules[ruleEmitterOptionCombinations]() {
-							goto l641
-						}
-						if !_rules[rulespOpt]() {
-							goto l641
-						}
-						if buffer[position] != rune(']') {
-							goto l641
-						}
-						position++
-						goto l642
-					l641:
-						position, tokenIndex = position641, tokenIndex641
+					position634, tokenIndex634 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l635
+					}
+					position++
+					goto l634
+				l635:
+					position, tokenIndex = position634, tokenIndex634
+					if buffer[position] != rune('T') {
+						goto l616
 					}
-				l642:
-					add(rulePegText, position640)
+					position++
 				}
-				if !_rules[ruleAction25]() {
+			l634:
+				{
+					position636, tokenIndex636 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l637
+					}
+					position++
+					goto l636
+				l637:
+					position, tokenIndex = position636, tokenIndex636
+					if buffer[position] != rune('I') {
+						goto l616
+					}
+					position++
+				}
+			l636:
+				{
+					position638, tokenIndex638 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l639
+					}
+					position++
 					goto l638
+				l639:
+					position, tokenIndex = position638, tokenIndex638
+					if buffer[position] != rune('O') {
+						goto l616
+					}
+					position++
+				}
+			l638:
+				{
+					position640, tokenIndex640 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l641
+					}
+					position++
+					goto l640
+				l641:
+					position, tokenIndex = position640, tokenIndex640
+					if buffer[position] != rune('N') {
+						goto l616
+					}
+					position++
+				}
+			l640:
+				if !_rules[rulesp]() {
+					goto l616
+				}
+				if !_rules[ruleFunction]() {
+					goto l616
+				}
+				if !_rules[ruleAction23]() {
+					goto l616
 				}
-				add(ruleEmitterOptions, position639)
+				add(ruleDropFunctionStmt, position617)
 			}
 			return true
-		l638:
-			position, tokenIndex = position638, tokenIndex638
+		l616:
+			position, tokenIndex = position616, tokenIndex616
 			return false
 		},
-		/* 32 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
+		/* 33 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action24)> */
 		func() bool {
-			position643, tokenIndex643 := position, tokenIndex
+			position642, tokenIndex642 := position, tokenIndex
 			{
-				position644 := position
+				position643 := position
 				{
-					position645, tokenIndex645 := position, tokenIndex
-					if !_rules[ruleEmitterLimit]() {
-						goto l646
-					}
-					goto l645
-				l646:
-					position, tokenIndex = position645, tokenIndex645
-					if !_rules[ruleEmitterSample]() {
-						goto l647
+					position644, tokenIndex644 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l645
 					}
-					if !_rules[rulesp]() {
-						goto l647
+					position++
+					goto l644
+				l645:
+					position, tokenIndex = position644, tokenIndex644
+					if buffer[position] != rune('L') {
+						goto l642
 					}
-					if !_rules[ruleEmitterLimit]() {
+					position++
+				}
+			l644:
+				{
+					position646, tokenIndex646 := position, tokenIndex
+					if buffer[position] != rune('o') {
 						goto l647
 					}
-					goto l645
+					position++
+					goto l646
 				l647:
-					position, tokenIndex = position645, tokenIndex645
-					if !_rules[ruleEmitterSample]() {
-						goto l643
+					position, tokenIndex = position646, tokenIndex646
+					if buffer[position] != rune('O') {
+						goto l642
 					}
+					position++
 				}
-			l645:
-				add(ruleEmitterOptionCombinations, position644)
-			}
-			return true
-		l643:
-			position, tokenIndex = position643, tokenIndex643
-			return false
-		},
-		/* 33 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action26)> */
-		func() bool {
-			position648, tokenIndex648 := position, tokenIndex
-			{
-				position649 := position
+			l646:
+				{
+					position648, tokenIndex648 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l649
+					}
+					position++
+					goto l648
+				l649:
+					position, tokenIndex = position648, tokenIndex648
+					if buffer[position] != rune('A') {
+						goto l642
+					}
+					position++
+				}
+			l648:
 				{
 					position650, tokenIndex650 := position, tokenIndex
-					if buffer[position] != rune('l') {
+					if buffer[position] != rune('d') {
 						goto l651
 					}
 					position++
 					goto l650
 				l651:
 					position, tokenIndex = position650, tokenIndex650
-					if buffer[position] != rune('L') {
-						goto l648
+					if buffer[position] != rune('D') {
+						goto l642
 					}
 					position++
 				}
 			l650:
+				if !_rules[rulesp]() {
+					goto l642
+				}
 				{
 					position652, tokenIndex652 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('s') {
 						goto l653
 					}
 					position++
 					goto l652
 				l653:
 					position, tokenIndex = position652, tokenIndex652
-					if buffer[position] != rune('I') {
-						goto l648
+					if buffer[position] != rune('S') {
+						goto l642
 					}
 					position++
 				}
 			l652:
 				{
 					position654, tokenIndex654 := position, tokenIndex
-					if buffer[position] != rune('m') {
+					if buffer[position] != rune('t') {
 						goto l655
 					}
 					position++
 					goto l654
 				l655:
 					position, tokenIndex = position654, tokenIndex654
-					if buffer[position] != rune('M') {
-						goto l648
+					if buffer[position] != rune('T') {
+						goto l642
 					}
 					position++
 				}
 			l654:
 				{
 					position656, tokenIndex656 := position, tokenIndex
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('a') {
 						goto l657
 					}
 					position++
 					goto l656
 				l657:
 					position, tokenIndex = position656, tokenIndex656
-					if buffer[position] != rune('I') {
-						goto l648
+					if buffer[position] != rune('A') {
+						goto l642
 					}
 					position++
 				}
@@ -6727,11421 +7173,19017 @@ func (p *bqlPegBackend) Init() {
 				l659:
 					position, tokenIndex = position658, tokenIndex658
 					if buffer[position] != rune('T') {
-						goto l648
+						goto l642
 					}
 					position++
 				}
 			l658:
+				{
+					position660, tokenIndex660 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l661
+					}
+					position++
+					goto l660
+				l661:
+					position, tokenIndex = position660, tokenIndex660
+					if buffer[position] != rune('E') {
+						goto l642
+					}
+					position++
+				}
+			l660:
 				if !_rules[rulesp]() {
-					goto l648
+					goto l642
 				}
-				if !_rules[ruleNumericLiteral]() {
-					goto l648
+				if !_rules[ruleStreamIdentifier]() {
+					goto l642
 				}
-				if !_rules[ruleAction26]() {
-					goto l648
+				if !_rules[rulesp]() {
+					goto l642
 				}
-				add(ruleEmitterLimit, position649)
-			}
-			return true
-		l648:
-			position, tokenIndex = position648, tokenIndex648
-			return false
-		},
-		/* 34 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
-		func() bool {
-			position660, tokenIndex660 := position, tokenIndex
-			{
-				position661 := position
 				{
 					position662, tokenIndex662 := position, tokenIndex
-					if !_rules[ruleCountBasedSampling]() {
+					if buffer[position] != rune('t') {
 						goto l663
 					}
+					position++
 					goto l662
 				l663:
 					position, tokenIndex = position662, tokenIndex662
-					if !_rules[ruleRandomizedSampling]() {
-						goto l664
-					}
-					goto l662
-				l664:
-					position, tokenIndex = position662, tokenIndex662
-					if !_rules[ruleTimeBasedSampling]() {
-						goto l660
+					if buffer[position] != rune('T') {
+						goto l642
 					}
+					position++
 				}
 			l662:
-				add(ruleEmitterSample, position661)
-			}
-			return true
-		l660:
-			position, tokenIndex = position660, tokenIndex660
-			return false
-		},
-		/* 35 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action27)> */
-		func() bool {
-			position665, tokenIndex665 := position, tokenIndex
-			{
-				position666 := position
 				{
-					position667, tokenIndex667 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l668
+					position664, tokenIndex664 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l665
 					}
 					position++
-					goto l667
-				l668:
-					position, tokenIndex = position667, tokenIndex667
-					if buffer[position] != rune('E') {
-						goto l665
+					goto l664
+				l665:
+					position, tokenIndex = position664, tokenIndex664
+					if buffer[position] != rune('Y') {
+						goto l642
 					}
 					position++
 				}
-			l667:
+			l664:
 				{
-					position669, tokenIndex669 := position, tokenIndex
-					if buffer[position] != rune('v') {
-						goto l670
+					position666, tokenIndex666 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l667
 					}
 					position++
-					goto l669
-				l670:
-					position, tokenIndex = position669, tokenIndex669
-					if buffer[position] != rune('V') {
-						goto l665
+					goto l666
+				l667:
+					position, tokenIndex = position666, tokenIndex666
+					if buffer[position] != rune('P') {
+						goto l642
 					}
 					position++
 				}
-			l669:
+			l666:
 				{
-					position671, tokenIndex671 := position, tokenIndex
+					position668, tokenIndex668 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l672
+						goto l669
 					}
 					position++
-					goto l671
-				l672:
-					position, tokenIndex = position671, tokenIndex671
+					goto l668
+				l669:
+					position, tokenIndex = position668, tokenIndex668
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l642
 					}
 					position++
 				}
-			l671:
+			l668:
+				if !_rules[rulesp]() {
+					goto l642
+				}
+				if !_rules[ruleSourceSinkType]() {
+					goto l642
+				}
+				if !_rules[ruleStateTagOpt]() {
+					goto l642
+				}
+				if !_rules[ruleSetOptSpecs]() {
+					goto l642
+				}
+				if !_rules[ruleAction24]() {
+					goto l642
+				}
+				add(ruleLoadStateStmt, position643)
+			}
+			return true
+		l642:
+			position, tokenIndex = position642, tokenIndex642
+			return false
+		},
+		/* 34 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action25)> */
+		func() bool {
+			position670, tokenIndex670 := position, tokenIndex
+			{
+				position671 := position
+				if !_rules[ruleLoadStateStmt]() {
+					goto l670
+				}
+				if !_rules[rulesp]() {
+					goto l670
+				}
 				{
-					position673, tokenIndex673 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l674
+					position672, tokenIndex672 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l673
 					}
 					position++
-					goto l673
-				l674:
-					position, tokenIndex = position673, tokenIndex673
-					if buffer[position] != rune('R') {
-						goto l665
+					goto l672
+				l673:
+					position, tokenIndex = position672, tokenIndex672
+					if buffer[position] != rune('O') {
+						goto l670
 					}
 					position++
 				}
-			l673:
+			l672:
 				{
-					position675, tokenIndex675 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l676
+					position674, tokenIndex674 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l675
 					}
 					position++
-					goto l675
-				l676:
-					position, tokenIndex = position675, tokenIndex675
-					if buffer[position] != rune('Y') {
-						goto l665
+					goto l674
+				l675:
+					position, tokenIndex = position674, tokenIndex674
+					if buffer[position] != rune('R') {
+						goto l670
 					}
 					position++
 				}
-			l675:
+			l674:
 				if !_rules[rulesp]() {
-					goto l665
-				}
-				if !_rules[ruleNumericLiteral]() {
-					goto l665
-				}
-				if !_rules[rulespOpt]() {
-					goto l665
+					goto l670
 				}
 				{
-					position677, tokenIndex677 := position, tokenIndex
-					if buffer[position] != rune('-') {
+					position676, tokenIndex676 := position, tokenIndex
+					if buffer[position] != rune('c') {
 						goto l677
 					}
 					position++
-					goto l678
+					goto l676
 				l677:
-					position, tokenIndex = position677, tokenIndex677
-				}
-			l678:
-				if !_rules[rulespOpt]() {
-					goto l665
+					position, tokenIndex = position676, tokenIndex676
+					if buffer[position] != rune('C') {
+						goto l670
+					}
+					position++
 				}
+			l676:
 				{
-					position679, tokenIndex679 := position, tokenIndex
-					{
-						position681, tokenIndex681 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l682
-						}
-						position++
-						goto l681
-					l682:
-						position, tokenIndex = position681, tokenIndex681
-						if buffer[position] != rune('S') {
-							goto l680
-						}
-						position++
+					position678, tokenIndex678 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l679
 					}
-				l681:
+					position++
+					goto l678
+				l679:
+					position, tokenIndex = position678, tokenIndex678
+					if buffer[position] != rune('R') {
+						goto l670
+					}
+					position++
+				}
+			l678:
+				{
+					position680, tokenIndex680 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l681
+					}
+					position++
+					goto l680
+				l681:
+					position, tokenIndex = position680, tokenIndex680
+					if buffer[position] != rune('E') {
+						goto l670
+					}
+					position++
+				}
+			l680:
+				{
+					position682, tokenIndex682 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l683
+					}
+					position++
+					goto l682
+				l683:
+					position, tokenIndex = position682, tokenIndex682
+					if buffer[position] != rune('A') {
+						goto l670
+					}
+					position++
+				}
+			l682:
+				{
+					position684, tokenIndex684 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l685
+					}
+					position++
+					goto l684
+				l685:
+					position, tokenIndex = position684, tokenIndex684
+					if buffer[position] != rune('T') {
+						goto l670
+					}
+					position++
+				}
+			l684:
+				{
+					position686, tokenIndex686 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l687
+					}
+					position++
+					goto l686
+				l687:
+					position, tokenIndex = position686, tokenIndex686
+					if buffer[position] != rune('E') {
+						goto l670
+					}
+					position++
+				}
+			l686:
+				if !_rules[rulesp]() {
+					goto l670
+				}
+				{
+					position688, tokenIndex688 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l689
+					}
+					position++
+					goto l688
+				l689:
+					position, tokenIndex = position688, tokenIndex688
+					if buffer[position] != rune('I') {
+						goto l670
+					}
+					position++
+				}
+			l688:
+				{
+					position690, tokenIndex690 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l691
+					}
+					position++
+					goto l690
+				l691:
+					position, tokenIndex = position690, tokenIndex690
+					if buffer[position] != rune('F') {
+						goto l670
+					}
+					position++
+				}
+			l690:
+				if !_rules[rulesp]() {
+					goto l670
+				}
+				{
+					position692, tokenIndex692 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l693
+					}
+					position++
+					goto l692
+				l693:
+					position, tokenIndex = position692, tokenIndex692
+					if buffer[position] != rune('N') {
+						goto l670
+					}
+					position++
+				}
+			l692:
+				{
+					position694, tokenIndex694 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l695
+					}
+					position++
+					goto l694
+				l695:
+					position, tokenIndex = position694, tokenIndex694
+					if buffer[position] != rune('O') {
+						goto l670
+					}
+					position++
+				}
+			l694:
+				{
+					position696, tokenIndex696 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l697
+					}
+					position++
+					goto l696
+				l697:
+					position, tokenIndex = position696, tokenIndex696
+					if buffer[position] != rune('T') {
+						goto l670
+					}
+					position++
+				}
+			l696:
+				if !_rules[rulesp]() {
+					goto l670
+				}
+				{
+					position698, tokenIndex698 := position, tokenIndex
 					{
-						position683, tokenIndex683 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l684
+						position700, tokenIndex700 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l701
 						}
 						position++
-						goto l683
-					l684:
-						position, tokenIndex = position683, tokenIndex683
-						if buffer[position] != rune('T') {
-							goto l680
+						goto l700
+					l701:
+						position, tokenIndex = position700, tokenIndex700
+						if buffer[position] != rune('S') {
+							goto l699
 						}
 						position++
 					}
-				l683:
-					goto l679
-				l680:
-					position, tokenIndex = position679, tokenIndex679
+				l700:
 					{
-						position686, tokenIndex686 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l687
+						position702, tokenIndex702 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l703
 						}
 						position++
-						goto l686
-					l687:
-						position, tokenIndex = position686, tokenIndex686
-						if buffer[position] != rune('N') {
-							goto l685
+						goto l702
+					l703:
+						position, tokenIndex = position702, tokenIndex702
+						if buffer[position] != rune('A') {
+							goto l699
 						}
 						position++
 					}
-				l686:
+				l702:
 					{
-						position688, tokenIndex688 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l689
+						position704, tokenIndex704 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l705
 						}
 						position++
-						goto l688
-					l689:
-						position, tokenIndex = position688, tokenIndex688
-						if buffer[position] != rune('D') {
-							goto l685
+						goto l704
+					l705:
+						position, tokenIndex = position704, tokenIndex704
+						if buffer[position] != rune('V') {
+							goto l699
 						}
 						position++
 					}
-				l688:
-					goto l679
-				l685:
-					position, tokenIndex = position679, tokenIndex679
+				l704:
 					{
-						position691, tokenIndex691 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l692
+						position706, tokenIndex706 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l707
 						}
 						position++
-						goto l691
-					l692:
-						position, tokenIndex = position691, tokenIndex691
-						if buffer[position] != rune('R') {
-							goto l690
+						goto l706
+					l707:
+						position, tokenIndex = position706, tokenIndex706
+						if buffer[position] != rune('E') {
+							goto l699
 						}
 						position++
 					}
-				l691:
+				l706:
 					{
-						position693, tokenIndex693 := position, tokenIndex
+						position708, tokenIndex708 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l694
+							goto l709
 						}
 						position++
-						goto l693
-					l694:
-						position, tokenIndex = position693, tokenIndex693
+						goto l708
+					l709:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune('D') {
-							goto l690
+							goto l699
 						}
 						position++
 					}
-				l693:
-					goto l679
-				l690:
-					position, tokenIndex = position679, tokenIndex679
+				l708:
+					goto l698
+				l699:
+					position, tokenIndex = position698, tokenIndex698
+					{
+						position710, tokenIndex710 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l711
+						}
+						position++
+						goto l710
+					l711:
+						position, tokenIndex = position710, tokenIndex710
+						if buffer[position] != rune('E') {
+							goto l670
+						}
+						position++
+					}
+				l710:
+					{
+						position712, tokenIndex712 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l713
+						}
+						position++
+						goto l712
+					l713:
+						position, tokenIndex = position712, tokenIndex712
+						if buffer[position] != rune('X') {
+							goto l670
+						}
+						position++
+					}
+				l712:
 					{
-						position695, tokenIndex695 := position, tokenIndex
+						position714, tokenIndex714 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l715
+						}
+						position++
+						goto l714
+					l715:
+						position, tokenIndex = position714, tokenIndex714
+						if buffer[position] != rune('I') {
+							goto l670
+						}
+						position++
+					}
+				l714:
+					{
+						position716, tokenIndex716 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l717
+						}
+						position++
+						goto l716
+					l717:
+						position, tokenIndex = position716, tokenIndex716
+						if buffer[position] != rune('S') {
+							goto l670
+						}
+						position++
+					}
+				l716:
+					{
+						position718, tokenIndex718 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l696
+							goto l719
 						}
 						position++
-						goto l695
-					l696:
-						position, tokenIndex = position695, tokenIndex695
+						goto l718
+					l719:
+						position, tokenIndex = position718, tokenIndex718
 						if buffer[position] != rune('T') {
-							goto l665
+							goto l670
 						}
 						position++
 					}
-				l695:
+				l718:
 					{
-						position697, tokenIndex697 := position, tokenIndex
-						if buffer[position] != rune('h') {
-							goto l698
+						position720, tokenIndex720 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l721
 						}
 						position++
-						goto l697
-					l698:
-						position, tokenIndex = position697, tokenIndex697
-						if buffer[position] != rune('H') {
-							goto l665
+						goto l720
+					l721:
+						position, tokenIndex = position720, tokenIndex720
+						if buffer[position] != rune('S') {
+							goto l670
 						}
 						position++
 					}
-				l697:
+				l720:
 				}
-			l679:
-				if !_rules[rulesp]() {
-					goto l665
+			l698:
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l670
 				}
-				{
-					position699, tokenIndex699 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l700
-					}
-					position++
-					goto l699
-				l700:
-					position, tokenIndex = position699, tokenIndex699
-					if buffer[position] != rune('T') {
-						goto l665
-					}
-					position++
+				if !_rules[ruleAction25]() {
+					goto l670
 				}
-			l699:
+				add(ruleLoadStateOrCreateStmt, position671)
+			}
+			return true
+		l670:
+			position, tokenIndex = position670, tokenIndex670
+			return false
+		},
+		/* 35 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action26)> */
+		func() bool {
+			position722, tokenIndex722 := position, tokenIndex
+			{
+				position723 := position
 				{
-					position701, tokenIndex701 := position, tokenIndex
-					if buffer[position] != rune('u') {
-						goto l702
+					position724, tokenIndex724 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l725
 					}
 					position++
-					goto l701
-				l702:
-					position, tokenIndex = position701, tokenIndex701
-					if buffer[position] != rune('U') {
-						goto l665
+					goto l724
+				l725:
+					position, tokenIndex = position724, tokenIndex724
+					if buffer[position] != rune('S') {
+						goto l722
 					}
 					position++
 				}
-			l701:
+			l724:
 				{
-					position703, tokenIndex703 := position, tokenIndex
-					if buffer[position] != rune('p') {
-						goto l704
+					position726, tokenIndex726 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l727
 					}
 					position++
-					goto l703
-				l704:
-					position, tokenIndex = position703, tokenIndex703
-					if buffer[position] != rune('P') {
-						goto l665
+					goto l726
+				l727:
+					position, tokenIndex = position726, tokenIndex726
+					if buffer[position] != rune('A') {
+						goto l722
 					}
 					position++
 				}
-			l703:
+			l726:
 				{
-					position705, tokenIndex705 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l706
+					position728, tokenIndex728 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l729
 					}
 					position++
-					goto l705
-				l706:
-					position, tokenIndex = position705, tokenIndex705
-					if buffer[position] != rune('L') {
-						goto l665
+					goto l728
+				l729:
+					position, tokenIndex = position728, tokenIndex728
+					if buffer[position] != rune('V') {
+						goto l722
 					}
 					position++
 				}
-			l705:
+			l728:
 				{
-					position707, tokenIndex707 := position, tokenIndex
+					position730, tokenIndex730 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l708
+						goto l731
 					}
 					position++
-					goto l707
-				l708:
-					position, tokenIndex = position707, tokenIndex707
+					goto l730
+				l731:
+					position, tokenIndex = position730, tokenIndex730
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l722
 					}
 					position++
 				}
-			l707:
-				if !_rules[ruleAction27]() {
-					goto l665
+			l730:
+				if !_rules[rulesp]() {
+					goto l722
 				}
-				add(ruleCountBasedSampling, position666)
-			}
-			return true
-		l665:
-			position, tokenIndex = position665, tokenIndex665
-			return false
-		},
-		/* 36 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action28)> */
-		func() bool {
-			position709, tokenIndex709 := position, tokenIndex
-			{
-				position710 := position
 				{
-					position711, tokenIndex711 := position, tokenIndex
+					position732, tokenIndex732 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l712
+						goto l733
 					}
 					position++
-					goto l711
-				l712:
-					position, tokenIndex = position711, tokenIndex711
+					goto l732
+				l733:
+					position, tokenIndex = position732, tokenIndex732
 					if buffer[position] != rune('S') {
-						goto l709
+						goto l722
 					}
 					position++
 				}
-			l711:
+			l732:
 				{
-					position713, tokenIndex713 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l714
+					position734, tokenIndex734 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l735
 					}
 					position++
-					goto l713
-				l714:
-					position, tokenIndex = position713, tokenIndex713
-					if buffer[position] != rune('A') {
-						goto l709
-					}
-					position++
-				}
-			l713:
-				{
-					position715, tokenIndex715 := position, tokenIndex
-					if buffer[position] != rune('m') {
-						goto l716
-					}
-					position++
-					goto l715
-				l716:
-					position, tokenIndex = position715, tokenIndex715
-					if buffer[position] != rune('M') {
-						goto l709
+					goto l734
+				l735:
+					position, tokenIndex = position734, tokenIndex734
+					if buffer[position] != rune('T') {
+						goto l722
 					}
 					position++
 				}
-			l715:
+			l734:
 				{
-					position717, tokenIndex717 := position, tokenIndex
-					if buffer[position] != rune('p') {
-						goto l718
+					position736, tokenIndex736 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l737
 					}
 					position++
-					goto l717
-				l718:
-					position, tokenIndex = position717, tokenIndex717
-					if buffer[position] != rune('P') {
-						goto l709
+					goto l736
+				l737:
+					position, tokenIndex = position736, tokenIndex736
+					if buffer[position] != rune('A') {
+						goto l722
 					}
 					position++
 				}
-			l717:
+			l736:
 				{
-					position719, tokenIndex719 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l720
+					position738, tokenIndex738 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l739
 					}
 					position++
-					goto l719
-				l720:
-					position, tokenIndex = position719, tokenIndex719
-					if buffer[position] != rune('L') {
-						goto l709
+					goto l738
+				l739:
+					position, tokenIndex = position738, tokenIndex738
+					if buffer[position] != rune('T') {
+						goto l722
 					}
 					position++
 				}
-			l719:
+			l738:
 				{
-					position721, tokenIndex721 := position, tokenIndex
+					position740, tokenIndex740 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l722
+						goto l741
 					}
 					position++
-					goto l721
-				l722:
-					position, tokenIndex = position721, tokenIndex721
+					goto l740
+				l741:
+					position, tokenIndex = position740, tokenIndex740
 					if buffer[position] != rune('E') {
-						goto l709
+						goto l722
 					}
 					position++
 				}
-			l721:
+			l740:
 				if !_rules[rulesp]() {
-					goto l709
+					goto l722
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l722
+				}
+				if !_rules[ruleStateTagOpt]() {
+					goto l722
+				}
+				if !_rules[ruleAction26]() {
+					goto l722
 				}
+				add(ruleSaveStateStmt, position723)
+			}
+			return true
+		l722:
+			position, tokenIndex = position722, tokenIndex722
+			return false
+		},
+		/* 36 BeginStmt <- <(<(('b' / 'B') ('e' / 'E') ('g' / 'G') ('i' / 'I') ('n' / 'N'))> Action27)> */
+		func() bool {
+			position742, tokenIndex742 := position, tokenIndex
+			{
+				position743 := position
 				{
-					position723, tokenIndex723 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l724
+					position744 := position
+					{
+						position745, tokenIndex745 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l746
+						}
+						position++
+						goto l745
+					l746:
+						position, tokenIndex = position745, tokenIndex745
+						if buffer[position] != rune('B') {
+							goto l742
+						}
+						position++
 					}
-					goto l723
-				l724:
-					position, tokenIndex = position723, tokenIndex723
-					if !_rules[ruleNumericLiteral]() {
-						goto l709
+				l745:
+					{
+						position747, tokenIndex747 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l748
+						}
+						position++
+						goto l747
+					l748:
+						position, tokenIndex = position747, tokenIndex747
+						if buffer[position] != rune('E') {
+							goto l742
+						}
+						position++
+					}
+				l747:
+					{
+						position749, tokenIndex749 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l750
+						}
+						position++
+						goto l749
+					l750:
+						position, tokenIndex = position749, tokenIndex749
+						if buffer[position] != rune('G') {
+							goto l742
+						}
+						position++
+					}
+				l749:
+					{
+						position751, tokenIndex751 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l752
+						}
+						position++
+						goto l751
+					l752:
+						position, tokenIndex = position751, tokenIndex751
+						if buffer[position] != rune('I') {
+							goto l742
+						}
+						position++
+					}
+				l751:
+					{
+						position753, tokenIndex753 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l754
+						}
+						position++
+						goto l753
+					l754:
+						position, tokenIndex = position753, tokenIndex753
+						if buffer[position] != rune('N') {
+							goto l742
+						}
+						position++
 					}
+				l753:
+					add(rulePegText, position744)
 				}
-			l723:
-				if !_rules[rulespOpt]() {
-					goto l709
+				if !_rules[ruleAction27]() {
+					goto l742
 				}
-				if buffer[position] != rune('%') {
-					goto l709
+				add(ruleBeginStmt, position743)
+			}
+			return true
+		l742:
+			position, tokenIndex = position742, tokenIndex742
+			return false
+		},
+		/* 37 CommitStmt <- <(<(('c' / 'C') ('o' / 'O') ('m' / 'M') ('m' / 'M') ('i' / 'I') ('t' / 'T'))> Action28)> */
+		func() bool {
+			position755, tokenIndex755 := position, tokenIndex
+			{
+				position756 := position
+				{
+					position757 := position
+					{
+						position758, tokenIndex758 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l759
+						}
+						position++
+						goto l758
+					l759:
+						position, tokenIndex = position758, tokenIndex758
+						if buffer[position] != rune('C') {
+							goto l755
+						}
+						position++
+					}
+				l758:
+					{
+						position760, tokenIndex760 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l761
+						}
+						position++
+						goto l760
+					l761:
+						position, tokenIndex = position760, tokenIndex760
+						if buffer[position] != rune('O') {
+							goto l755
+						}
+						position++
+					}
+				l760:
+					{
+						position762, tokenIndex762 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l763
+						}
+						position++
+						goto l762
+					l763:
+						position, tokenIndex = position762, tokenIndex762
+						if buffer[position] != rune('M') {
+							goto l755
+						}
+						position++
+					}
+				l762:
+					{
+						position764, tokenIndex764 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l765
+						}
+						position++
+						goto l764
+					l765:
+						position, tokenIndex = position764, tokenIndex764
+						if buffer[position] != rune('M') {
+							goto l755
+						}
+						position++
+					}
+				l764:
+					{
+						position766, tokenIndex766 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l767
+						}
+						position++
+						goto l766
+					l767:
+						position, tokenIndex = position766, tokenIndex766
+						if buffer[position] != rune('I') {
+							goto l755
+						}
+						position++
+					}
+				l766:
+					{
+						position768, tokenIndex768 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l769
+						}
+						position++
+						goto l768
+					l769:
+						position, tokenIndex = position768, tokenIndex768
+						if buffer[position] != rune('T') {
+							goto l755
+						}
+						position++
+					}
+				l768:
+					add(rulePegText, position757)
 				}
-				position++
 				if !_rules[ruleAction28]() {
-					goto l709
+					goto l755
 				}
-				add(ruleRandomizedSampling, position710)
+				add(ruleCommitStmt, position756)
 			}
 			return true
-		l709:
-			position, tokenIndex = position709, tokenIndex709
+		l755:
+			position, tokenIndex = position755, tokenIndex755
 			return false
 		},
-		/* 37 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
+		/* 38 RollbackStmt <- <(<(('r' / 'R') ('o' / 'O') ('l' / 'L') ('l' / 'L') ('b' / 'B') ('a' / 'A') ('c' / 'C') ('k' / 'K'))> Action29)> */
 		func() bool {
-			position725, tokenIndex725 := position, tokenIndex
+			position770, tokenIndex770 := position, tokenIndex
 			{
-				position726 := position
+				position771 := position
 				{
-					position727, tokenIndex727 := position, tokenIndex
-					if !_rules[ruleTimeBasedSamplingSeconds]() {
-						goto l728
+					position772 := position
+					{
+						position773, tokenIndex773 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l774
+						}
+						position++
+						goto l773
+					l774:
+						position, tokenIndex = position773, tokenIndex773
+						if buffer[position] != rune('R') {
+							goto l770
+						}
+						position++
 					}
-					goto l727
-				l728:
-					position, tokenIndex = position727, tokenIndex727
-					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
-						goto l725
+				l773:
+					{
+						position775, tokenIndex775 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l776
+						}
+						position++
+						goto l775
+					l776:
+						position, tokenIndex = position775, tokenIndex775
+						if buffer[position] != rune('O') {
+							goto l770
+						}
+						position++
+					}
+				l775:
+					{
+						position777, tokenIndex777 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l778
+						}
+						position++
+						goto l777
+					l778:
+						position, tokenIndex = position777, tokenIndex777
+						if buffer[position] != rune('L') {
+							goto l770
+						}
+						position++
+					}
+				l777:
+					{
+						position779, tokenIndex779 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l780
+						}
+						position++
+						goto l779
+					l780:
+						position, tokenIndex = position779, tokenIndex779
+						if buffer[position] != rune('L') {
+							goto l770
+						}
+						position++
+					}
+				l779:
+					{
+						position781, tokenIndex781 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l782
+						}
+						position++
+						goto l781
+					l782:
+						position, tokenIndex = position781, tokenIndex781
+						if buffer[position] != rune('B') {
+							goto l770
+						}
+						position++
 					}
+				l781:
+					{
+						position783, tokenIndex783 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l784
+						}
+						position++
+						goto l783
+					l784:
+						position, tokenIndex = position783, tokenIndex783
+						if buffer[position] != rune('A') {
+							goto l770
+						}
+						position++
+					}
+				l783:
+					{
+						position785, tokenIndex785 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l786
+						}
+						position++
+						goto l785
+					l786:
+						position, tokenIndex = position785, tokenIndex785
+						if buffer[position] != rune('C') {
+							goto l770
+						}
+						position++
+					}
+				l785:
+					{
+						position787, tokenIndex787 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l788
+						}
+						position++
+						goto l787
+					l788:
+						position, tokenIndex = position787, tokenIndex787
+						if buffer[position] != rune('K') {
+							goto l770
+						}
+						position++
+					}
+				l787:
+					add(rulePegText, position772)
+				}
+				if !_rules[ruleAction29]() {
+					goto l770
 				}
-			l727:
-				add(ruleTimeBasedSampling, position726)
+				add(ruleRollbackStmt, position771)
 			}
 			return true
-		l725:
-			position, tokenIndex = position725, tokenIndex725
+		l770:
+			position, tokenIndex = position770, tokenIndex770
 			return false
 		},
-		/* 38 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action29)> */
+		/* 39 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action30)> */
 		func() bool {
-			position729, tokenIndex729 := position, tokenIndex
+			position789, tokenIndex789 := position, tokenIndex
 			{
-				position730 := position
+				position790 := position
 				{
-					position731, tokenIndex731 := position, tokenIndex
+					position791, tokenIndex791 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l732
+						goto l792
 					}
 					position++
-					goto l731
-				l732:
-					position, tokenIndex = position731, tokenIndex731
+					goto l791
+				l792:
+					position, tokenIndex = position791, tokenIndex791
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l789
 					}
 					position++
 				}
-			l731:
+			l791:
 				{
-					position733, tokenIndex733 := position, tokenIndex
+					position793, tokenIndex793 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l734
+						goto l794
 					}
 					position++
-					goto l733
-				l734:
-					position, tokenIndex = position733, tokenIndex733
+					goto l793
+				l794:
+					position, tokenIndex = position793, tokenIndex793
 					if buffer[position] != rune('V') {
-						goto l729
-					}
-					position++
-				}
-			l733:
-				{
-					position735, tokenIndex735 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l736
-					}
-					position++
-					goto l735
-				l736:
-					position, tokenIndex = position735, tokenIndex735
-					if buffer[position] != rune('E') {
-						goto l729
+						goto l789
 					}
 					position++
 				}
-			l735:
+			l793:
 				{
-					position737, tokenIndex737 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l738
+					position795, tokenIndex795 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l796
 					}
 					position++
-					goto l737
-				l738:
-					position, tokenIndex = position737, tokenIndex737
-					if buffer[position] != rune('R') {
-						goto l729
+					goto l795
+				l796:
+					position, tokenIndex = position795, tokenIndex795
+					if buffer[position] != rune('A') {
+						goto l789
 					}
 					position++
 				}
-			l737:
+			l795:
 				{
-					position739, tokenIndex739 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l740
+					position797, tokenIndex797 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l798
 					}
 					position++
-					goto l739
-				l740:
-					position, tokenIndex = position739, tokenIndex739
-					if buffer[position] != rune('Y') {
-						goto l729
+					goto l797
+				l798:
+					position, tokenIndex = position797, tokenIndex797
+					if buffer[position] != rune('L') {
+						goto l789
 					}
 					position++
 				}
-			l739:
+			l797:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l789
+				}
+				if !_rules[ruleExpression]() {
+					goto l789
 				}
 				{
-					position741, tokenIndex741 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l742
-					}
-					goto l741
-				l742:
-					position, tokenIndex = position741, tokenIndex741
-					if !_rules[ruleNumericLiteral]() {
-						goto l729
+					position799 := position
+					{
+						position800, tokenIndex800 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l800
+						}
+						{
+							position802, tokenIndex802 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l803
+							}
+							position++
+							goto l802
+						l803:
+							position, tokenIndex = position802, tokenIndex802
+							if buffer[position] != rune('O') {
+								goto l800
+							}
+							position++
+						}
+					l802:
+						{
+							position804, tokenIndex804 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l805
+							}
+							position++
+							goto l804
+						l805:
+							position, tokenIndex = position804, tokenIndex804
+							if buffer[position] != rune('N') {
+								goto l800
+							}
+							position++
+						}
+					l804:
+						if !_rules[rulesp]() {
+							goto l800
+						}
+						if !_rules[ruleMapExpr]() {
+							goto l800
+						}
+						goto l801
+					l800:
+						position, tokenIndex = position800, tokenIndex800
 					}
+				l801:
+					add(rulePegText, position799)
 				}
-			l741:
-				if !_rules[rulesp]() {
-					goto l729
+				if !_rules[ruleAction30]() {
+					goto l789
 				}
+				add(ruleEvalStmt, position790)
+			}
+			return true
+		l789:
+			position, tokenIndex = position789, tokenIndex789
+			return false
+		},
+		/* 40 ExplainStmt <- <(('e' / 'E') ('x' / 'X') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('i' / 'I') ('n' / 'N') sp (SelectUnionStmt / SelectStmt / CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt) Action31)> */
+		func() bool {
+			position806, tokenIndex806 := position, tokenIndex
+			{
+				position807 := position
 				{
-					position743, tokenIndex743 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l744
+					position808, tokenIndex808 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l809
 					}
 					position++
-					goto l743
-				l744:
-					position, tokenIndex = position743, tokenIndex743
-					if buffer[position] != rune('S') {
-						goto l729
+					goto l808
+				l809:
+					position, tokenIndex = position808, tokenIndex808
+					if buffer[position] != rune('E') {
+						goto l806
 					}
 					position++
 				}
-			l743:
+			l808:
 				{
-					position745, tokenIndex745 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l746
+					position810, tokenIndex810 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						goto l811
 					}
 					position++
-					goto l745
-				l746:
-					position, tokenIndex = position745, tokenIndex745
-					if buffer[position] != rune('E') {
-						goto l729
+					goto l810
+				l811:
+					position, tokenIndex = position810, tokenIndex810
+					if buffer[position] != rune('X') {
+						goto l806
 					}
 					position++
 				}
-			l745:
+			l810:
 				{
-					position747, tokenIndex747 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l748
+					position812, tokenIndex812 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l813
 					}
 					position++
-					goto l747
-				l748:
-					position, tokenIndex = position747, tokenIndex747
-					if buffer[position] != rune('C') {
-						goto l729
+					goto l812
+				l813:
+					position, tokenIndex = position812, tokenIndex812
+					if buffer[position] != rune('P') {
+						goto l806
 					}
 					position++
 				}
-			l747:
+			l812:
 				{
-					position749, tokenIndex749 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l750
+					position814, tokenIndex814 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l815
 					}
 					position++
-					goto l749
-				l750:
-					position, tokenIndex = position749, tokenIndex749
-					if buffer[position] != rune('O') {
-						goto l729
+					goto l814
+				l815:
+					position, tokenIndex = position814, tokenIndex814
+					if buffer[position] != rune('L') {
+						goto l806
 					}
 					position++
 				}
-			l749:
+			l814:
 				{
-					position751, tokenIndex751 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l752
+					position816, tokenIndex816 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l817
 					}
 					position++
-					goto l751
-				l752:
-					position, tokenIndex = position751, tokenIndex751
-					if buffer[position] != rune('N') {
-						goto l729
+					goto l816
+				l817:
+					position, tokenIndex = position816, tokenIndex816
+					if buffer[position] != rune('A') {
+						goto l806
 					}
 					position++
 				}
-			l751:
+			l816:
 				{
-					position753, tokenIndex753 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l754
+					position818, tokenIndex818 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l819
 					}
 					position++
-					goto l753
-				l754:
-					position, tokenIndex = position753, tokenIndex753
-					if buffer[position] != rune('D') {
-						goto l729
+					goto l818
+				l819:
+					position, tokenIndex = position818, tokenIndex818
+					if buffer[position] != rune('I') {
+						goto l806
 					}
 					position++
 				}
-			l753:
+			l818:
 				{
-					position755, tokenIndex755 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l756
+					position820, tokenIndex820 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l821
 					}
 					position++
-					goto l755
-				l756:
-					position, tokenIndex = position755, tokenIndex755
-					if buffer[position] != rune('S') {
-						goto l729
+					goto l820
+				l821:
+					position, tokenIndex = position820, tokenIndex820
+					if buffer[position] != rune('N') {
+						goto l806
 					}
 					position++
 				}
-			l755:
-				if !_rules[ruleAction29]() {
-					goto l729
+			l820:
+				if !_rules[rulesp]() {
+					goto l806
 				}
-				add(ruleTimeBasedSamplingSeconds, position730)
+				{
+					position822, tokenIndex822 := position, tokenIndex
+					if !_rules[ruleSelectUnionStmt]() {
+						goto l823
+					}
+					goto l822
+				l823:
+					position, tokenIndex = position822, tokenIndex822
+					if !_rules[ruleSelectStmt]() {
+						goto l824
+					}
+					goto l822
+				l824:
+					position, tokenIndex = position822, tokenIndex822
+					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
+						goto l825
+					}
+					goto l822
+				l825:
+					position, tokenIndex = position822, tokenIndex822
+					if !_rules[ruleCreateStreamAsSelectStmt]() {
+						goto l806
+					}
+				}
+			l822:
+				if !_rules[ruleAction31]() {
+					goto l806
+				}
+				add(ruleExplainStmt, position807)
 			}
 			return true
-		l729:
-			position, tokenIndex = position729, tokenIndex729
+		l806:
+			position, tokenIndex = position806, tokenIndex806
 			return false
 		},
-		/* 39 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action30)> */
+		/* 41 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action32)> */
 		func() bool {
-			position757, tokenIndex757 := position, tokenIndex
+			position826, tokenIndex826 := position, tokenIndex
 			{
-				position758 := position
+				position827 := position
+				if !_rules[rulesp]() {
+					goto l826
+				}
 				{
-					position759, tokenIndex759 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l760
+					position828, tokenIndex828 := position, tokenIndex
+					if !_rules[ruleISTREAM]() {
+						goto l829
 					}
-					position++
-					goto l759
-				l760:
-					position, tokenIndex = position759, tokenIndex759
-					if buffer[position] != rune('E') {
-						goto l757
+					goto l828
+				l829:
+					position, tokenIndex = position828, tokenIndex828
+					if !_rules[ruleDSTREAM]() {
+						goto l830
 					}
-					position++
+					goto l828
+				l830:
+					position, tokenIndex = position828, tokenIndex828
+					if !_rules[ruleRSTREAM]() {
+						goto l826
+					}
+				}
+			l828:
+				if !_rules[ruleEmitterOptions]() {
+					goto l826
+				}
+				if !_rules[ruleAction32]() {
+					goto l826
 				}
-			l759:
+				add(ruleEmitter, position827)
+			}
+			return true
+		l826:
+			position, tokenIndex = position826, tokenIndex826
+			return false
+		},
+		/* 42 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action33)> */
+		func() bool {
+			position831, tokenIndex831 := position, tokenIndex
+			{
+				position832 := position
 				{
-					position761, tokenIndex761 := position, tokenIndex
-					if buffer[position] != rune('v') {
-						goto l762
-					}
-					position++
-					goto l761
-				l762:
-					position, tokenIndex = position761, tokenIndex761
-					if buffer[position] != rune('V') {
-						goto l757
+					position833 := position
+					{
+						position834, tokenIndex834 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l834
+						}
+						if buffer[position] != rune('[') {
+							goto l834
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l834
+						}
+						if !_rules[ruleEmitterOptionCombinations]() {
+							goto l834
+						}
+						if !_rules[rulespOpt]() {
+							goto l834
+						}
+						if buffer[position] != rune(']') {
+							goto l834
+						}
+						position++
+						goto l835
+					l834:
+						position, tokenIndex = position834, tokenIndex834
 					}
-					position++
+				l835:
+					add(rulePegText, position833)
+				}
+				if !_rules[ruleAction33]() {
+					goto l831
 				}
-			l761:
+				add(ruleEmitterOptions, position832)
+			}
+			return true
+		l831:
+			position, tokenIndex = position831, tokenIndex831
+			return false
+		},
+		/* 43 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
+		func() bool {
+			position836, tokenIndex836 := position, tokenIndex
+			{
+				position837 := position
 				{
-					position763, tokenIndex763 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l764
+					position838, tokenIndex838 := position, tokenIndex
+					if !_rules[ruleEmitterLimit]() {
+						goto l839
 					}
-					position++
-					goto l763
-				l764:
-					position, tokenIndex = position763, tokenIndex763
-					if buffer[position] != rune('E') {
-						goto l757
+					goto l838
+				l839:
+					position, tokenIndex = position838, tokenIndex838
+					if !_rules[ruleEmitterSample]() {
+						goto l840
 					}
-					position++
-				}
-			l763:
-				{
-					position765, tokenIndex765 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l766
+					if !_rules[rulesp]() {
+						goto l840
 					}
-					position++
-					goto l765
-				l766:
-					position, tokenIndex = position765, tokenIndex765
-					if buffer[position] != rune('R') {
-						goto l757
+					if !_rules[ruleEmitterLimit]() {
+						goto l840
+					}
+					goto l838
+				l840:
+					position, tokenIndex = position838, tokenIndex838
+					if !_rules[ruleEmitterSample]() {
+						goto l836
 					}
-					position++
 				}
-			l765:
+			l838:
+				add(ruleEmitterOptionCombinations, position837)
+			}
+			return true
+		l836:
+			position, tokenIndex = position836, tokenIndex836
+			return false
+		},
+		/* 44 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action34)> */
+		func() bool {
+			position841, tokenIndex841 := position, tokenIndex
+			{
+				position842 := position
 				{
-					position767, tokenIndex767 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l768
+					position843, tokenIndex843 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l844
 					}
 					position++
-					goto l767
-				l768:
-					position, tokenIndex = position767, tokenIndex767
-					if buffer[position] != rune('Y') {
-						goto l757
+					goto l843
+				l844:
+					position, tokenIndex = position843, tokenIndex843
+					if buffer[position] != rune('L') {
+						goto l841
 					}
 					position++
 				}
-			l767:
-				if !_rules[rulesp]() {
-					goto l757
-				}
+			l843:
 				{
-					position769, tokenIndex769 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l770
+					position845, tokenIndex845 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l846
 					}
-					goto l769
-				l770:
-					position, tokenIndex = position769, tokenIndex769
-					if !_rules[ruleNumericLiteral]() {
-						goto l757
+					position++
+					goto l845
+				l846:
+					position, tokenIndex = position845, tokenIndex845
+					if buffer[position] != rune('I') {
+						goto l841
 					}
+					position++
 				}
-			l769:
-				if !_rules[rulesp]() {
-					goto l757
-				}
+			l845:
 				{
-					position771, tokenIndex771 := position, tokenIndex
+					position847, tokenIndex847 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l772
+						goto l848
 					}
 					position++
-					goto l771
-				l772:
-					position, tokenIndex = position771, tokenIndex771
+					goto l847
+				l848:
+					position, tokenIndex = position847, tokenIndex847
 					if buffer[position] != rune('M') {
-						goto l757
+						goto l841
 					}
 					position++
 				}
-			l771:
+			l847:
 				{
-					position773, tokenIndex773 := position, tokenIndex
+					position849, tokenIndex849 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l774
+						goto l850
 					}
 					position++
-					goto l773
-				l774:
-					position, tokenIndex = position773, tokenIndex773
+					goto l849
+				l850:
+					position, tokenIndex = position849, tokenIndex849
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l841
 					}
 					position++
 				}
-			l773:
+			l849:
 				{
-					position775, tokenIndex775 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l776
+					position851, tokenIndex851 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l852
 					}
 					position++
-					goto l775
-				l776:
-					position, tokenIndex = position775, tokenIndex775
-					if buffer[position] != rune('L') {
-						goto l757
+					goto l851
+				l852:
+					position, tokenIndex = position851, tokenIndex851
+					if buffer[position] != rune('T') {
+						goto l841
 					}
 					position++
 				}
-			l775:
+			l851:
+				if !_rules[rulesp]() {
+					goto l841
+				}
+				if !_rules[ruleNumericLiteral]() {
+					goto l841
+				}
+				if !_rules[ruleAction34]() {
+					goto l841
+				}
+				add(ruleEmitterLimit, position842)
+			}
+			return true
+		l841:
+			position, tokenIndex = position841, tokenIndex841
+			return false
+		},
+		/* 45 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
+		func() bool {
+			position853, tokenIndex853 := position, tokenIndex
+			{
+				position854 := position
 				{
-					position777, tokenIndex777 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l778
+					position855, tokenIndex855 := position, tokenIndex
+					if !_rules[ruleCountBasedSampling]() {
+						goto l856
 					}
-					position++
-					goto l777
-				l778:
-					position, tokenIndex = position777, tokenIndex777
-					if buffer[position] != rune('L') {
-						goto l757
+					goto l855
+				l856:
+					position, tokenIndex = position855, tokenIndex855
+					if !_rules[ruleRandomizedSampling]() {
+						goto l857
+					}
+					goto l855
+				l857:
+					position, tokenIndex = position855, tokenIndex855
+					if !_rules[ruleTimeBasedSampling]() {
+						goto l853
 					}
-					position++
 				}
-			l777:
+			l855:
+				add(ruleEmitterSample, position854)
+			}
+			return true
+		l853:
+			position, tokenIndex = position853, tokenIndex853
+			return false
+		},
+		/* 46 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action35)> */
+		func() bool {
+			position858, tokenIndex858 := position, tokenIndex
+			{
+				position859 := position
 				{
-					position779, tokenIndex779 := position, tokenIndex
-					if buffer[position] != rune('i') {
-						goto l780
+					position860, tokenIndex860 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l861
 					}
 					position++
-					goto l779
-				l780:
-					position, tokenIndex = position779, tokenIndex779
-					if buffer[position] != rune('I') {
-						goto l757
+					goto l860
+				l861:
+					position, tokenIndex = position860, tokenIndex860
+					if buffer[position] != rune('E') {
+						goto l858
 					}
 					position++
 				}
-			l779:
+			l860:
 				{
-					position781, tokenIndex781 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l782
+					position862, tokenIndex862 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l863
 					}
 					position++
-					goto l781
-				l782:
-					position, tokenIndex = position781, tokenIndex781
-					if buffer[position] != rune('S') {
-						goto l757
+					goto l862
+				l863:
+					position, tokenIndex = position862, tokenIndex862
+					if buffer[position] != rune('V') {
+						goto l858
 					}
 					position++
 				}
-			l781:
+			l862:
 				{
-					position783, tokenIndex783 := position, tokenIndex
+					position864, tokenIndex864 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l784
+						goto l865
 					}
 					position++
-					goto l783
-				l784:
-					position, tokenIndex = position783, tokenIndex783
+					goto l864
+				l865:
+					position, tokenIndex = position864, tokenIndex864
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l858
 					}
 					position++
 				}
-			l783:
+			l864:
 				{
-					position785, tokenIndex785 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l786
+					position866, tokenIndex866 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l867
 					}
 					position++
-					goto l785
-				l786:
-					position, tokenIndex = position785, tokenIndex785
-					if buffer[position] != rune('C') {
-						goto l757
+					goto l866
+				l867:
+					position, tokenIndex = position866, tokenIndex866
+					if buffer[position] != rune('R') {
+						goto l858
 					}
 					position++
 				}
-			l785:
+			l866:
 				{
-					position787, tokenIndex787 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l788
+					position868, tokenIndex868 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l869
 					}
 					position++
-					goto l787
-				l788:
-					position, tokenIndex = position787, tokenIndex787
-					if buffer[position] != rune('O') {
-						goto l757
+					goto l868
+				l869:
+					position, tokenIndex = position868, tokenIndex868
+					if buffer[position] != rune('Y') {
+						goto l858
 					}
 					position++
 				}
-			l787:
+			l868:
+				if !_rules[rulesp]() {
+					goto l858
+				}
+				if !_rules[ruleNumericLiteral]() {
+					goto l858
+				}
+				if !_rules[rulespOpt]() {
+					goto l858
+				}
 				{
-					position789, tokenIndex789 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l790
-					}
-					position++
-					goto l789
-				l790:
-					position, tokenIndex = position789, tokenIndex789
-					if buffer[position] != rune('N') {
-						goto l757
+					position870, tokenIndex870 := position, tokenIndex
+					if buffer[position] != rune('-') {
+						goto l870
 					}
 					position++
+					goto l871
+				l870:
+					position, tokenIndex = position870, tokenIndex870
+				}
+			l871:
+				if !_rules[rulespOpt]() {
+					goto l858
 				}
-			l789:
 				{
-					position791, tokenIndex791 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l792
+					position872, tokenIndex872 := position, tokenIndex
+					{
+						position874, tokenIndex874 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l875
+						}
+						position++
+						goto l874
+					l875:
+						position, tokenIndex = position874, tokenIndex874
+						if buffer[position] != rune('S') {
+							goto l873
+						}
+						position++
 					}
-					position++
-					goto l791
-				l792:
-					position, tokenIndex = position791, tokenIndex791
-					if buffer[position] != rune('D') {
-						goto l757
+				l874:
+					{
+						position876, tokenIndex876 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l877
+						}
+						position++
+						goto l876
+					l877:
+						position, tokenIndex = position876, tokenIndex876
+						if buffer[position] != rune('T') {
+							goto l873
+						}
+						position++
 					}
-					position++
-				}
-			l791:
-				{
-					position793, tokenIndex793 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l794
+				l876:
+					goto l872
+				l873:
+					position, tokenIndex = position872, tokenIndex872
+					{
+						position879, tokenIndex879 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l880
+						}
+						position++
+						goto l879
+					l880:
+						position, tokenIndex = position879, tokenIndex879
+						if buffer[position] != rune('N') {
+							goto l878
+						}
+						position++
 					}
-					position++
-					goto l793
-				l794:
-					position, tokenIndex = position793, tokenIndex793
-					if buffer[position] != rune('S') {
-						goto l757
+				l879:
+					{
+						position881, tokenIndex881 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l882
+						}
+						position++
+						goto l881
+					l882:
+						position, tokenIndex = position881, tokenIndex881
+						if buffer[position] != rune('D') {
+							goto l878
+						}
+						position++
 					}
-					position++
-				}
-			l793:
-				if !_rules[ruleAction30]() {
-					goto l757
-				}
-				add(ruleTimeBasedSamplingMilliseconds, position758)
-			}
-			return true
-		l757:
-			position, tokenIndex = position757, tokenIndex757
-			return false
-		},
-		/* 40 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action31)> */
-		func() bool {
-			position795, tokenIndex795 := position, tokenIndex
-			{
-				position796 := position
-				{
-					position797 := position
-					if !_rules[rulesp]() {
-						goto l795
+				l881:
+					goto l872
+				l878:
+					position, tokenIndex = position872, tokenIndex872
+					{
+						position884, tokenIndex884 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l885
+						}
+						position++
+						goto l884
+					l885:
+						position, tokenIndex = position884, tokenIndex884
+						if buffer[position] != rune('R') {
+							goto l883
+						}
+						position++
 					}
-					if !_rules[ruleProjection]() {
-						goto l795
+				l884:
+					{
+						position886, tokenIndex886 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l887
+						}
+						position++
+						goto l886
+					l887:
+						position, tokenIndex = position886, tokenIndex886
+						if buffer[position] != rune('D') {
+							goto l883
+						}
+						position++
 					}
-				l798:
+				l886:
+					goto l872
+				l883:
+					position, tokenIndex = position872, tokenIndex872
 					{
-						position799, tokenIndex799 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l799
+						position888, tokenIndex888 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l889
 						}
-						if buffer[position] != rune(',') {
-							goto l799
+						position++
+						goto l888
+					l889:
+						position, tokenIndex = position888, tokenIndex888
+						if buffer[position] != rune('T') {
+							goto l858
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l799
+					}
+				l888:
+					{
+						position890, tokenIndex890 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l891
 						}
-						if !_rules[ruleProjection]() {
-							goto l799
+						position++
+						goto l890
+					l891:
+						position, tokenIndex = position890, tokenIndex890
+						if buffer[position] != rune('H') {
+							goto l858
 						}
-						goto l798
-					l799:
-						position, tokenIndex = position799, tokenIndex799
+						position++
 					}
-					add(rulePegText, position797)
+				l890:
 				}
-				if !_rules[ruleAction31]() {
-					goto l795
+			l872:
+				if !_rules[rulesp]() {
+					goto l858
 				}
-				add(ruleProjections, position796)
-			}
-			return true
-		l795:
-			position, tokenIndex = position795, tokenIndex795
-			return false
-		},
-		/* 41 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
-		func() bool {
-			position800, tokenIndex800 := position, tokenIndex
-			{
-				position801 := position
 				{
-					position802, tokenIndex802 := position, tokenIndex
-					if !_rules[ruleAliasExpression]() {
-						goto l803
+					position892, tokenIndex892 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l893
 					}
-					goto l802
-				l803:
-					position, tokenIndex = position802, tokenIndex802
-					if !_rules[ruleExpressionOrWildcard]() {
-						goto l800
+					position++
+					goto l892
+				l893:
+					position, tokenIndex = position892, tokenIndex892
+					if buffer[position] != rune('T') {
+						goto l858
 					}
+					position++
 				}
-			l802:
-				add(ruleProjection, position801)
-			}
-			return true
-		l800:
-			position, tokenIndex = position800, tokenIndex800
-			return false
-		},
-		/* 42 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action32)> */
-		func() bool {
-			position804, tokenIndex804 := position, tokenIndex
-			{
-				position805 := position
-				if !_rules[ruleExpressionOrWildcard]() {
-					goto l804
-				}
-				if !_rules[rulesp]() {
-					goto l804
-				}
+			l892:
 				{
-					position806, tokenIndex806 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l807
+					position894, tokenIndex894 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l895
 					}
 					position++
-					goto l806
-				l807:
-					position, tokenIndex = position806, tokenIndex806
-					if buffer[position] != rune('A') {
-						goto l804
+					goto l894
+				l895:
+					position, tokenIndex = position894, tokenIndex894
+					if buffer[position] != rune('U') {
+						goto l858
 					}
 					position++
 				}
-			l806:
+			l894:
 				{
-					position808, tokenIndex808 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l809
+					position896, tokenIndex896 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l897
 					}
 					position++
-					goto l808
-				l809:
-					position, tokenIndex = position808, tokenIndex808
-					if buffer[position] != rune('S') {
-						goto l804
+					goto l896
+				l897:
+					position, tokenIndex = position896, tokenIndex896
+					if buffer[position] != rune('P') {
+						goto l858
 					}
 					position++
 				}
-			l808:
-				if !_rules[rulesp]() {
-					goto l804
-				}
-				if !_rules[ruleTargetIdentifier]() {
-					goto l804
-				}
-				if !_rules[ruleAction32]() {
-					goto l804
+			l896:
+				{
+					position898, tokenIndex898 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l899
+					}
+					position++
+					goto l898
+				l899:
+					position, tokenIndex = position898, tokenIndex898
+					if buffer[position] != rune('L') {
+						goto l858
+					}
+					position++
 				}
-				add(ruleAliasExpression, position805)
-			}
-			return true
-		l804:
-			position, tokenIndex = position804, tokenIndex804
-			return false
-		},
-		/* 43 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action33)> */
-		func() bool {
-			position810, tokenIndex810 := position, tokenIndex
-			{
-				position811 := position
+			l898:
 				{
-					position812 := position
-					{
-						position813, tokenIndex813 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l813
-						}
-						{
-							position815, tokenIndex815 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l816
-							}
-							position++
-							goto l815
-						l816:
-							position, tokenIndex = position815, tokenIndex815
-							if buffer[position] != rune('F') {
-								goto l813
-							}
-							position++
-						}
-					l815:
-						{
-							position817, tokenIndex817 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l818
-							}
-							position++
-							goto l817
-						l818:
-							position, tokenIndex = position817, tokenIndex817
-							if buffer[position] != rune('R') {
-								goto l813
-							}
-							position++
-						}
-					l817:
-						{
-							position819, tokenIndex819 := position, tokenIndex
-							if buffer[position] != rune('o') {
-								goto l820
-							}
-							position++
-							goto l819
-						l820:
-							position, tokenIndex = position819, tokenIndex819
-							if buffer[position] != rune('O') {
-								goto l813
-							}
-							position++
-						}
-					l819:
-						{
-							position821, tokenIndex821 := position, tokenIndex
-							if buffer[position] != rune('m') {
-								goto l822
-							}
-							position++
-							goto l821
-						l822:
-							position, tokenIndex = position821, tokenIndex821
-							if buffer[position] != rune('M') {
-								goto l813
-							}
-							position++
-						}
-					l821:
-						if !_rules[rulesp]() {
-							goto l813
-						}
-						if !_rules[ruleRelations]() {
-							goto l813
-						}
-						goto l814
-					l813:
-						position, tokenIndex = position813, tokenIndex813
+					position900, tokenIndex900 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l901
+					}
+					position++
+					goto l900
+				l901:
+					position, tokenIndex = position900, tokenIndex900
+					if buffer[position] != rune('E') {
+						goto l858
 					}
-				l814:
-					add(rulePegText, position812)
+					position++
 				}
-				if !_rules[ruleAction33]() {
-					goto l810
+			l900:
+				if !_rules[ruleAction35]() {
+					goto l858
 				}
-				add(ruleWindowedFrom, position811)
+				add(ruleCountBasedSampling, position859)
 			}
 			return true
-		l810:
-			position, tokenIndex = position810, tokenIndex810
+		l858:
+			position, tokenIndex = position858, tokenIndex858
 			return false
 		},
-		/* 44 Interval <- <(TimeInterval / TuplesInterval)> */
+		/* 47 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action36)> */
 		func() bool {
-			position823, tokenIndex823 := position, tokenIndex
+			position902, tokenIndex902 := position, tokenIndex
 			{
-				position824 := position
+				position903 := position
 				{
-					position825, tokenIndex825 := position, tokenIndex
-					if !_rules[ruleTimeInterval]() {
-						goto l826
+					position904, tokenIndex904 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l905
 					}
-					goto l825
-				l826:
-					position, tokenIndex = position825, tokenIndex825
-					if !_rules[ruleTuplesInterval]() {
-						goto l823
+					position++
+					goto l904
+				l905:
+					position, tokenIndex = position904, tokenIndex904
+					if buffer[position] != rune('S') {
+						goto l902
 					}
+					position++
 				}
-			l825:
-				add(ruleInterval, position824)
-			}
-			return true
-		l823:
-			position, tokenIndex = position823, tokenIndex823
-			return false
-		},
-		/* 45 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action34)> */
-		func() bool {
-			position827, tokenIndex827 := position, tokenIndex
-			{
-				position828 := position
+			l904:
 				{
-					position829, tokenIndex829 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l830
+					position906, tokenIndex906 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l907
 					}
-					goto l829
-				l830:
-					position, tokenIndex = position829, tokenIndex829
-					if !_rules[ruleNumericLiteral]() {
-						goto l827
+					position++
+					goto l906
+				l907:
+					position, tokenIndex = position906, tokenIndex906
+					if buffer[position] != rune('A') {
+						goto l902
 					}
+					position++
 				}
-			l829:
-				if !_rules[rulesp]() {
-					goto l827
-				}
+			l906:
 				{
-					position831, tokenIndex831 := position, tokenIndex
+					position908, tokenIndex908 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l909
+					}
+					position++
+					goto l908
+				l909:
+					position, tokenIndex = position908, tokenIndex908
+					if buffer[position] != rune('M') {
+						goto l902
+					}
+					position++
+				}
+			l908:
+				{
+					position910, tokenIndex910 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l911
+					}
+					position++
+					goto l910
+				l911:
+					position, tokenIndex = position910, tokenIndex910
+					if buffer[position] != rune('P') {
+						goto l902
+					}
+					position++
+				}
+			l910:
+				{
+					position912, tokenIndex912 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l913
+					}
+					position++
+					goto l912
+				l913:
+					position, tokenIndex = position912, tokenIndex912
+					if buffer[position] != rune('L') {
+						goto l902
+					}
+					position++
+				}
+			l912:
+				{
+					position914, tokenIndex914 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l915
+					}
+					position++
+					goto l914
+				l915:
+					position, tokenIndex = position914, tokenIndex914
+					if buffer[position] != rune('E') {
+						goto l902
+					}
+					position++
+				}
+			l914:
+				if !_rules[rulesp]() {
+					goto l902
+				}
+				{
+					position916, tokenIndex916 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l917
+					}
+					goto l916
+				l917:
+					position, tokenIndex = position916, tokenIndex916
+					if !_rules[ruleNumericLiteral]() {
+						goto l902
+					}
+				}
+			l916:
+				if !_rules[rulespOpt]() {
+					goto l902
+				}
+				if buffer[position] != rune('%') {
+					goto l902
+				}
+				position++
+				if !_rules[ruleAction36]() {
+					goto l902
+				}
+				add(ruleRandomizedSampling, position903)
+			}
+			return true
+		l902:
+			position, tokenIndex = position902, tokenIndex902
+			return false
+		},
+		/* 48 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
+		func() bool {
+			position918, tokenIndex918 := position, tokenIndex
+			{
+				position919 := position
+				{
+					position920, tokenIndex920 := position, tokenIndex
+					if !_rules[ruleTimeBasedSamplingSeconds]() {
+						goto l921
+					}
+					goto l920
+				l921:
+					position, tokenIndex = position920, tokenIndex920
+					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
+						goto l918
+					}
+				}
+			l920:
+				add(ruleTimeBasedSampling, position919)
+			}
+			return true
+		l918:
+			position, tokenIndex = position918, tokenIndex918
+			return false
+		},
+		/* 49 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action37)> */
+		func() bool {
+			position922, tokenIndex922 := position, tokenIndex
+			{
+				position923 := position
+				{
+					position924, tokenIndex924 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l925
+					}
+					position++
+					goto l924
+				l925:
+					position, tokenIndex = position924, tokenIndex924
+					if buffer[position] != rune('E') {
+						goto l922
+					}
+					position++
+				}
+			l924:
+				{
+					position926, tokenIndex926 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l927
+					}
+					position++
+					goto l926
+				l927:
+					position, tokenIndex = position926, tokenIndex926
+					if buffer[position] != rune('V') {
+						goto l922
+					}
+					position++
+				}
+			l926:
+				{
+					position928, tokenIndex928 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l929
+					}
+					position++
+					goto l928
+				l929:
+					position, tokenIndex = position928, tokenIndex928
+					if buffer[position] != rune('E') {
+						goto l922
+					}
+					position++
+				}
+			l928:
+				{
+					position930, tokenIndex930 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l931
+					}
+					position++
+					goto l930
+				l931:
+					position, tokenIndex = position930, tokenIndex930
+					if buffer[position] != rune('R') {
+						goto l922
+					}
+					position++
+				}
+			l930:
+				{
+					position932, tokenIndex932 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l933
+					}
+					position++
+					goto l932
+				l933:
+					position, tokenIndex = position932, tokenIndex932
+					if buffer[position] != rune('Y') {
+						goto l922
+					}
+					position++
+				}
+			l932:
+				if !_rules[rulesp]() {
+					goto l922
+				}
+				{
+					position934, tokenIndex934 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l935
+					}
+					goto l934
+				l935:
+					position, tokenIndex = position934, tokenIndex934
+					if !_rules[ruleNumericLiteral]() {
+						goto l922
+					}
+				}
+			l934:
+				if !_rules[rulesp]() {
+					goto l922
+				}
+				{
+					position936, tokenIndex936 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l937
+					}
+					position++
+					goto l936
+				l937:
+					position, tokenIndex = position936, tokenIndex936
+					if buffer[position] != rune('S') {
+						goto l922
+					}
+					position++
+				}
+			l936:
+				{
+					position938, tokenIndex938 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l939
+					}
+					position++
+					goto l938
+				l939:
+					position, tokenIndex = position938, tokenIndex938
+					if buffer[position] != rune('E') {
+						goto l922
+					}
+					position++
+				}
+			l938:
+				{
+					position940, tokenIndex940 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l941
+					}
+					position++
+					goto l940
+				l941:
+					position, tokenIndex = position940, tokenIndex940
+					if buffer[position] != rune('C') {
+						goto l922
+					}
+					position++
+				}
+			l940:
+				{
+					position942, tokenIndex942 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l943
+					}
+					position++
+					goto l942
+				l943:
+					position, tokenIndex = position942, tokenIndex942
+					if buffer[position] != rune('O') {
+						goto l922
+					}
+					position++
+				}
+			l942:
+				{
+					position944, tokenIndex944 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l945
+					}
+					position++
+					goto l944
+				l945:
+					position, tokenIndex = position944, tokenIndex944
+					if buffer[position] != rune('N') {
+						goto l922
+					}
+					position++
+				}
+			l944:
+				{
+					position946, tokenIndex946 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l947
+					}
+					position++
+					goto l946
+				l947:
+					position, tokenIndex = position946, tokenIndex946
+					if buffer[position] != rune('D') {
+						goto l922
+					}
+					position++
+				}
+			l946:
+				{
+					position948, tokenIndex948 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l949
+					}
+					position++
+					goto l948
+				l949:
+					position, tokenIndex = position948, tokenIndex948
+					if buffer[position] != rune('S') {
+						goto l922
+					}
+					position++
+				}
+			l948:
+				if !_rules[ruleAction37]() {
+					goto l922
+				}
+				add(ruleTimeBasedSamplingSeconds, position923)
+			}
+			return true
+		l922:
+			position, tokenIndex = position922, tokenIndex922
+			return false
+		},
+		/* 50 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action38)> */
+		func() bool {
+			position950, tokenIndex950 := position, tokenIndex
+			{
+				position951 := position
+				{
+					position952, tokenIndex952 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l953
+					}
+					position++
+					goto l952
+				l953:
+					position, tokenIndex = position952, tokenIndex952
+					if buffer[position] != rune('E') {
+						goto l950
+					}
+					position++
+				}
+			l952:
+				{
+					position954, tokenIndex954 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l955
+					}
+					position++
+					goto l954
+				l955:
+					position, tokenIndex = position954, tokenIndex954
+					if buffer[position] != rune('V') {
+						goto l950
+					}
+					position++
+				}
+			l954:
+				{
+					position956, tokenIndex956 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l957
+					}
+					position++
+					goto l956
+				l957:
+					position, tokenIndex = position956, tokenIndex956
+					if buffer[position] != rune('E') {
+						goto l950
+					}
+					position++
+				}
+			l956:
+				{
+					position958, tokenIndex958 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l959
+					}
+					position++
+					goto l958
+				l959:
+					position, tokenIndex = position958, tokenIndex958
+					if buffer[position] != rune('R') {
+						goto l950
+					}
+					position++
+				}
+			l958:
+				{
+					position960, tokenIndex960 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l961
+					}
+					position++
+					goto l960
+				l961:
+					position, tokenIndex = position960, tokenIndex960
+					if buffer[position] != rune('Y') {
+						goto l950
+					}
+					position++
+				}
+			l960:
+				if !_rules[rulesp]() {
+					goto l950
+				}
+				{
+					position962, tokenIndex962 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l963
+					}
+					goto l962
+				l963:
+					position, tokenIndex = position962, tokenIndex962
+					if !_rules[ruleNumericLiteral]() {
+						goto l950
+					}
+				}
+			l962:
+				if !_rules[rulesp]() {
+					goto l950
+				}
+				{
+					position964, tokenIndex964 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l965
+					}
+					position++
+					goto l964
+				l965:
+					position, tokenIndex = position964, tokenIndex964
+					if buffer[position] != rune('M') {
+						goto l950
+					}
+					position++
+				}
+			l964:
+				{
+					position966, tokenIndex966 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l967
+					}
+					position++
+					goto l966
+				l967:
+					position, tokenIndex = position966, tokenIndex966
+					if buffer[position] != rune('I') {
+						goto l950
+					}
+					position++
+				}
+			l966:
+				{
+					position968, tokenIndex968 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l969
+					}
+					position++
+					goto l968
+				l969:
+					position, tokenIndex = position968, tokenIndex968
+					if buffer[position] != rune('L') {
+						goto l950
+					}
+					position++
+				}
+			l968:
+				{
+					position970, tokenIndex970 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l971
+					}
+					position++
+					goto l970
+				l971:
+					position, tokenIndex = position970, tokenIndex970
+					if buffer[position] != rune('L') {
+						goto l950
+					}
+					position++
+				}
+			l970:
+				{
+					position972, tokenIndex972 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l973
+					}
+					position++
+					goto l972
+				l973:
+					position, tokenIndex = position972, tokenIndex972
+					if buffer[position] != rune('I') {
+						goto l950
+					}
+					position++
+				}
+			l972:
+				{
+					position974, tokenIndex974 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l975
+					}
+					position++
+					goto l974
+				l975:
+					position, tokenIndex = position974, tokenIndex974
+					if buffer[position] != rune('S') {
+						goto l950
+					}
+					position++
+				}
+			l974:
+				{
+					position976, tokenIndex976 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l977
+					}
+					position++
+					goto l976
+				l977:
+					position, tokenIndex = position976, tokenIndex976
+					if buffer[position] != rune('E') {
+						goto l950
+					}
+					position++
+				}
+			l976:
+				{
+					position978, tokenIndex978 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l979
+					}
+					position++
+					goto l978
+				l979:
+					position, tokenIndex = position978, tokenIndex978
+					if buffer[position] != rune('C') {
+						goto l950
+					}
+					position++
+				}
+			l978:
+				{
+					position980, tokenIndex980 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l981
+					}
+					position++
+					goto l980
+				l981:
+					position, tokenIndex = position980, tokenIndex980
+					if buffer[position] != rune('O') {
+						goto l950
+					}
+					position++
+				}
+			l980:
+				{
+					position982, tokenIndex982 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l983
+					}
+					position++
+					goto l982
+				l983:
+					position, tokenIndex = position982, tokenIndex982
+					if buffer[position] != rune('N') {
+						goto l950
+					}
+					position++
+				}
+			l982:
+				{
+					position984, tokenIndex984 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l985
+					}
+					position++
+					goto l984
+				l985:
+					position, tokenIndex = position984, tokenIndex984
+					if buffer[position] != rune('D') {
+						goto l950
+					}
+					position++
+				}
+			l984:
+				{
+					position986, tokenIndex986 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l987
+					}
+					position++
+					goto l986
+				l987:
+					position, tokenIndex = position986, tokenIndex986
+					if buffer[position] != rune('S') {
+						goto l950
+					}
+					position++
+				}
+			l986:
+				if !_rules[ruleAction38]() {
+					goto l950
+				}
+				add(ruleTimeBasedSamplingMilliseconds, position951)
+			}
+			return true
+		l950:
+			position, tokenIndex = position950, tokenIndex950
+			return false
+		},
+		/* 51 Distinct <- <(<(sp (('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T')) &sp)?> Action39)> */
+		func() bool {
+			position988, tokenIndex988 := position, tokenIndex
+			{
+				position989 := position
+				{
+					position990 := position
+					{
+						position991, tokenIndex991 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l991
+						}
+						{
+							position993, tokenIndex993 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l994
+							}
+							position++
+							goto l993
+						l994:
+							position, tokenIndex = position993, tokenIndex993
+							if buffer[position] != rune('D') {
+								goto l991
+							}
+							position++
+						}
+					l993:
+						{
+							position995, tokenIndex995 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l996
+							}
+							position++
+							goto l995
+						l996:
+							position, tokenIndex = position995, tokenIndex995
+							if buffer[position] != rune('I') {
+								goto l991
+							}
+							position++
+						}
+					l995:
+						{
+							position997, tokenIndex997 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l998
+							}
+							position++
+							goto l997
+						l998:
+							position, tokenIndex = position997, tokenIndex997
+							if buffer[position] != rune('S') {
+								goto l991
+							}
+							position++
+						}
+					l997:
+						{
+							position999, tokenIndex999 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1000
+							}
+							position++
+							goto l999
+						l1000:
+							position, tokenIndex = position999, tokenIndex999
+							if buffer[position] != rune('T') {
+								goto l991
+							}
+							position++
+						}
+					l999:
+						{
+							position1001, tokenIndex1001 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1002
+							}
+							position++
+							goto l1001
+						l1002:
+							position, tokenIndex = position1001, tokenIndex1001
+							if buffer[position] != rune('I') {
+								goto l991
+							}
+							position++
+						}
+					l1001:
+						{
+							position1003, tokenIndex1003 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1004
+							}
+							position++
+							goto l1003
+						l1004:
+							position, tokenIndex = position1003, tokenIndex1003
+							if buffer[position] != rune('N') {
+								goto l991
+							}
+							position++
+						}
+					l1003:
+						{
+							position1005, tokenIndex1005 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l1006
+							}
+							position++
+							goto l1005
+						l1006:
+							position, tokenIndex = position1005, tokenIndex1005
+							if buffer[position] != rune('C') {
+								goto l991
+							}
+							position++
+						}
+					l1005:
+						{
+							position1007, tokenIndex1007 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1008
+							}
+							position++
+							goto l1007
+						l1008:
+							position, tokenIndex = position1007, tokenIndex1007
+							if buffer[position] != rune('T') {
+								goto l991
+							}
+							position++
+						}
+					l1007:
+						{
+							position1009, tokenIndex1009 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l991
+							}
+							position, tokenIndex = position1009, tokenIndex1009
+						}
+						goto l992
+					l991:
+						position, tokenIndex = position991, tokenIndex991
+					}
+				l992:
+					add(rulePegText, position990)
+				}
+				if !_rules[ruleAction39]() {
+					goto l988
+				}
+				add(ruleDistinct, position989)
+			}
+			return true
+		l988:
+			position, tokenIndex = position988, tokenIndex988
+			return false
+		},
+		/* 52 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action40)> */
+		func() bool {
+			position1010, tokenIndex1010 := position, tokenIndex
+			{
+				position1011 := position
+				{
+					position1012 := position
+					if !_rules[rulesp]() {
+						goto l1010
+					}
+					if !_rules[ruleProjection]() {
+						goto l1010
+					}
+				l1013:
+					{
+						position1014, tokenIndex1014 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1014
+						}
+						if buffer[position] != rune(',') {
+							goto l1014
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1014
+						}
+						if !_rules[ruleProjection]() {
+							goto l1014
+						}
+						goto l1013
+					l1014:
+						position, tokenIndex = position1014, tokenIndex1014
+					}
+					add(rulePegText, position1012)
+				}
+				if !_rules[ruleAction40]() {
+					goto l1010
+				}
+				add(ruleProjections, position1011)
+			}
+			return true
+		l1010:
+			position, tokenIndex = position1010, tokenIndex1010
+			return false
+		},
+		/* 53 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
+		func() bool {
+			position1015, tokenIndex1015 := position, tokenIndex
+			{
+				position1016 := position
+				{
+					position1017, tokenIndex1017 := position, tokenIndex
+					if !_rules[ruleAliasExpression]() {
+						goto l1018
+					}
+					goto l1017
+				l1018:
+					position, tokenIndex = position1017, tokenIndex1017
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1015
+					}
+				}
+			l1017:
+				add(ruleProjection, position1016)
+			}
+			return true
+		l1015:
+			position, tokenIndex = position1015, tokenIndex1015
+			return false
+		},
+		/* 54 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action41)> */
+		func() bool {
+			position1019, tokenIndex1019 := position, tokenIndex
+			{
+				position1020 := position
+				if !_rules[ruleExpressionOrWildcard]() {
+					goto l1019
+				}
+				if !_rules[rulesp]() {
+					goto l1019
+				}
+				{
+					position1021, tokenIndex1021 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1022
+					}
+					position++
+					goto l1021
+				l1022:
+					position, tokenIndex = position1021, tokenIndex1021
+					if buffer[position] != rune('A') {
+						goto l1019
+					}
+					position++
+				}
+			l1021:
+				{
+					position1023, tokenIndex1023 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1024
+					}
+					position++
+					goto l1023
+				l1024:
+					position, tokenIndex = position1023, tokenIndex1023
+					if buffer[position] != rune('S') {
+						goto l1019
+					}
+					position++
+				}
+			l1023:
+				if !_rules[rulesp]() {
+					goto l1019
+				}
+				if !_rules[ruleTargetIdentifier]() {
+					goto l1019
+				}
+				if !_rules[ruleAction41]() {
+					goto l1019
+				}
+				add(ruleAliasExpression, position1020)
+			}
+			return true
+		l1019:
+			position, tokenIndex = position1019, tokenIndex1019
+			return false
+		},
+		/* 55 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp (JoinedRelations / Relations) (sp Lookup)*)?> Action42)> */
+		func() bool {
+			position1025, tokenIndex1025 := position, tokenIndex
+			{
+				position1026 := position
+				{
+					position1027 := position
+					{
+						position1028, tokenIndex1028 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1028
+						}
+						{
+							position1030, tokenIndex1030 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1031
+							}
+							position++
+							goto l1030
+						l1031:
+							position, tokenIndex = position1030, tokenIndex1030
+							if buffer[position] != rune('F') {
+								goto l1028
+							}
+							position++
+						}
+					l1030:
+						{
+							position1032, tokenIndex1032 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1033
+							}
+							position++
+							goto l1032
+						l1033:
+							position, tokenIndex = position1032, tokenIndex1032
+							if buffer[position] != rune('R') {
+								goto l1028
+							}
+							position++
+						}
+					l1032:
+						{
+							position1034, tokenIndex1034 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1035
+							}
+							position++
+							goto l1034
+						l1035:
+							position, tokenIndex = position1034, tokenIndex1034
+							if buffer[position] != rune('O') {
+								goto l1028
+							}
+							position++
+						}
+					l1034:
+						{
+							position1036, tokenIndex1036 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1037
+							}
+							position++
+							goto l1036
+						l1037:
+							position, tokenIndex = position1036, tokenIndex1036
+							if buffer[position] != rune('M') {
+								goto l1028
+							}
+							position++
+						}
+					l1036:
+						if !_rules[rulesp]() {
+							goto l1028
+						}
+						{
+							position1038, tokenIndex1038 := position, tokenIndex
+							if !_rules[ruleJoinedRelations]() {
+								goto l1039
+							}
+							goto l1038
+						l1039:
+							position, tokenIndex = position1038, tokenIndex1038
+							if !_rules[ruleRelations]() {
+								goto l1028
+							}
+						}
+					l1038:
+					l1040:
+						{
+							position1041, tokenIndex1041 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l1041
+							}
+							if !_rules[ruleLookup]() {
+								goto l1041
+							}
+							goto l1040
+						l1041:
+							position, tokenIndex = position1041, tokenIndex1041
+						}
+						goto l1029
+					l1028:
+						position, tokenIndex = position1028, tokenIndex1028
+					}
+				l1029:
+					add(rulePegText, position1027)
+				}
+				if !_rules[ruleAction42]() {
+					goto l1025
+				}
+				add(ruleWindowedFrom, position1026)
+			}
+			return true
+		l1025:
+			position, tokenIndex = position1025, tokenIndex1025
+			return false
+		},
+		/* 56 Interval <- <(TimeInterval / TuplesInterval)> */
+		func() bool {
+			position1042, tokenIndex1042 := position, tokenIndex
+			{
+				position1043 := position
+				{
+					position1044, tokenIndex1044 := position, tokenIndex
+					if !_rules[ruleTimeInterval]() {
+						goto l1045
+					}
+					goto l1044
+				l1045:
+					position, tokenIndex = position1044, tokenIndex1044
+					if !_rules[ruleTuplesInterval]() {
+						goto l1042
+					}
+				}
+			l1044:
+				add(ruleInterval, position1043)
+			}
+			return true
+		l1042:
+			position, tokenIndex = position1042, tokenIndex1042
+			return false
+		},
+		/* 57 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (MINUTES / SECONDS / MILLISECONDS) Action43)> */
+		func() bool {
+			position1046, tokenIndex1046 := position, tokenIndex
+			{
+				position1047 := position
+				{
+					position1048, tokenIndex1048 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l1049
+					}
+					goto l1048
+				l1049:
+					position, tokenIndex = position1048, tokenIndex1048
+					if !_rules[ruleNumericLiteral]() {
+						goto l1046
+					}
+				}
+			l1048:
+				if !_rules[rulesp]() {
+					goto l1046
+				}
+				{
+					position1050, tokenIndex1050 := position, tokenIndex
+					if !_rules[ruleMINUTES]() {
+						goto l1051
+					}
+					goto l1050
+				l1051:
+					position, tokenIndex = position1050, tokenIndex1050
 					if !_rules[ruleSECONDS]() {
-						goto l832
+						goto l1052
+					}
+					goto l1050
+				l1052:
+					position, tokenIndex = position1050, tokenIndex1050
+					if !_rules[ruleMILLISECONDS]() {
+						goto l1046
+					}
+				}
+			l1050:
+				if !_rules[ruleAction43]() {
+					goto l1046
+				}
+				add(ruleTimeInterval, position1047)
+			}
+			return true
+		l1046:
+			position, tokenIndex = position1046, tokenIndex1046
+			return false
+		},
+		/* 58 TuplesInterval <- <(NumericLiteral sp TUPLES Action44)> */
+		func() bool {
+			position1053, tokenIndex1053 := position, tokenIndex
+			{
+				position1054 := position
+				if !_rules[ruleNumericLiteral]() {
+					goto l1053
+				}
+				if !_rules[rulesp]() {
+					goto l1053
+				}
+				if !_rules[ruleTUPLES]() {
+					goto l1053
+				}
+				if !_rules[ruleAction44]() {
+					goto l1053
+				}
+				add(ruleTuplesInterval, position1054)
+			}
+			return true
+		l1053:
+			position, tokenIndex = position1053, tokenIndex1053
+			return false
+		},
+		/* 59 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
+		func() bool {
+			position1055, tokenIndex1055 := position, tokenIndex
+			{
+				position1056 := position
+				if !_rules[ruleRelationLike]() {
+					goto l1055
+				}
+			l1057:
+				{
+					position1058, tokenIndex1058 := position, tokenIndex
+					if !_rules[rulespOpt]() {
+						goto l1058
+					}
+					if buffer[position] != rune(',') {
+						goto l1058
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1058
+					}
+					if !_rules[ruleRelationLike]() {
+						goto l1058
+					}
+					goto l1057
+				l1058:
+					position, tokenIndex = position1058, tokenIndex1058
+				}
+				add(ruleRelations, position1056)
+			}
+			return true
+		l1055:
+			position, tokenIndex = position1055, tokenIndex1055
+			return false
+		},
+		/* 60 JoinedRelations <- <(RelationLike (sp JoinClause)+)> */
+		func() bool {
+			position1059, tokenIndex1059 := position, tokenIndex
+			{
+				position1060 := position
+				if !_rules[ruleRelationLike]() {
+					goto l1059
+				}
+				if !_rules[rulesp]() {
+					goto l1059
+				}
+				if !_rules[ruleJoinClause]() {
+					goto l1059
+				}
+			l1061:
+				{
+					position1062, tokenIndex1062 := position, tokenIndex
+					if !_rules[rulesp]() {
+						goto l1062
+					}
+					if !_rules[ruleJoinClause]() {
+						goto l1062
+					}
+					goto l1061
+				l1062:
+					position, tokenIndex = position1062, tokenIndex1062
+				}
+				add(ruleJoinedRelations, position1060)
+			}
+			return true
+		l1059:
+			position, tokenIndex = position1059, tokenIndex1059
+			return false
+		},
+		/* 61 JoinClause <- <(JoinType sp RelationLike sp (('o' / 'O') ('n' / 'N')) sp Expression Action45)> */
+		func() bool {
+			position1063, tokenIndex1063 := position, tokenIndex
+			{
+				position1064 := position
+				if !_rules[ruleJoinType]() {
+					goto l1063
+				}
+				if !_rules[rulesp]() {
+					goto l1063
+				}
+				if !_rules[ruleRelationLike]() {
+					goto l1063
+				}
+				if !_rules[rulesp]() {
+					goto l1063
+				}
+				{
+					position1065, tokenIndex1065 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1066
+					}
+					position++
+					goto l1065
+				l1066:
+					position, tokenIndex = position1065, tokenIndex1065
+					if buffer[position] != rune('O') {
+						goto l1063
+					}
+					position++
+				}
+			l1065:
+				{
+					position1067, tokenIndex1067 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1068
+					}
+					position++
+					goto l1067
+				l1068:
+					position, tokenIndex = position1067, tokenIndex1067
+					if buffer[position] != rune('N') {
+						goto l1063
+					}
+					position++
+				}
+			l1067:
+				if !_rules[rulesp]() {
+					goto l1063
+				}
+				if !_rules[ruleExpression]() {
+					goto l1063
+				}
+				if !_rules[ruleAction45]() {
+					goto l1063
+				}
+				add(ruleJoinClause, position1064)
+			}
+			return true
+		l1063:
+			position, tokenIndex = position1063, tokenIndex1063
+			return false
+		},
+		/* 62 JoinType <- <(LeftOuterJoin / InnerJoin)> */
+		func() bool {
+			position1069, tokenIndex1069 := position, tokenIndex
+			{
+				position1070 := position
+				{
+					position1071, tokenIndex1071 := position, tokenIndex
+					if !_rules[ruleLeftOuterJoin]() {
+						goto l1072
+					}
+					goto l1071
+				l1072:
+					position, tokenIndex = position1071, tokenIndex1071
+					if !_rules[ruleInnerJoin]() {
+						goto l1069
+					}
+				}
+			l1071:
+				add(ruleJoinType, position1070)
+			}
+			return true
+		l1069:
+			position, tokenIndex = position1069, tokenIndex1069
+			return false
+		},
+		/* 63 Lookup <- <(('l' / 'L') ('o' / 'O') ('o' / 'O') ('k' / 'K') ('u' / 'U') ('p' / 'P') sp StreamIdentifier LookupAliasOpt sp (('o' / 'O') ('n' / 'N')) sp Expression Action46)> */
+		func() bool {
+			position1073, tokenIndex1073 := position, tokenIndex
+			{
+				position1074 := position
+				{
+					position1075, tokenIndex1075 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1076
+					}
+					position++
+					goto l1075
+				l1076:
+					position, tokenIndex = position1075, tokenIndex1075
+					if buffer[position] != rune('L') {
+						goto l1073
+					}
+					position++
+				}
+			l1075:
+				{
+					position1077, tokenIndex1077 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1078
+					}
+					position++
+					goto l1077
+				l1078:
+					position, tokenIndex = position1077, tokenIndex1077
+					if buffer[position] != rune('O') {
+						goto l1073
+					}
+					position++
+				}
+			l1077:
+				{
+					position1079, tokenIndex1079 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1080
+					}
+					position++
+					goto l1079
+				l1080:
+					position, tokenIndex = position1079, tokenIndex1079
+					if buffer[position] != rune('O') {
+						goto l1073
+					}
+					position++
+				}
+			l1079:
+				{
+					position1081, tokenIndex1081 := position, tokenIndex
+					if buffer[position] != rune('k') {
+						goto l1082
+					}
+					position++
+					goto l1081
+				l1082:
+					position, tokenIndex = position1081, tokenIndex1081
+					if buffer[position] != rune('K') {
+						goto l1073
+					}
+					position++
+				}
+			l1081:
+				{
+					position1083, tokenIndex1083 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l1084
+					}
+					position++
+					goto l1083
+				l1084:
+					position, tokenIndex = position1083, tokenIndex1083
+					if buffer[position] != rune('U') {
+						goto l1073
+					}
+					position++
+				}
+			l1083:
+				{
+					position1085, tokenIndex1085 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1086
+					}
+					position++
+					goto l1085
+				l1086:
+					position, tokenIndex = position1085, tokenIndex1085
+					if buffer[position] != rune('P') {
+						goto l1073
+					}
+					position++
+				}
+			l1085:
+				if !_rules[rulesp]() {
+					goto l1073
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l1073
+				}
+				if !_rules[ruleLookupAliasOpt]() {
+					goto l1073
+				}
+				if !_rules[rulesp]() {
+					goto l1073
+				}
+				{
+					position1087, tokenIndex1087 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1088
+					}
+					position++
+					goto l1087
+				l1088:
+					position, tokenIndex = position1087, tokenIndex1087
+					if buffer[position] != rune('O') {
+						goto l1073
+					}
+					position++
+				}
+			l1087:
+				{
+					position1089, tokenIndex1089 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1090
+					}
+					position++
+					goto l1089
+				l1090:
+					position, tokenIndex = position1089, tokenIndex1089
+					if buffer[position] != rune('N') {
+						goto l1073
+					}
+					position++
+				}
+			l1089:
+				if !_rules[rulesp]() {
+					goto l1073
+				}
+				if !_rules[ruleExpression]() {
+					goto l1073
+				}
+				if !_rules[ruleAction46]() {
+					goto l1073
+				}
+				add(ruleLookup, position1074)
+			}
+			return true
+		l1073:
+			position, tokenIndex = position1073, tokenIndex1073
+			return false
+		},
+		/* 64 LookupAliasOpt <- <(<(sp (('a' / 'A') ('s' / 'S')) sp Identifier)?> Action47)> */
+		func() bool {
+			position1091, tokenIndex1091 := position, tokenIndex
+			{
+				position1092 := position
+				{
+					position1093 := position
+					{
+						position1094, tokenIndex1094 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1094
+						}
+						{
+							position1096, tokenIndex1096 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1097
+							}
+							position++
+							goto l1096
+						l1097:
+							position, tokenIndex = position1096, tokenIndex1096
+							if buffer[position] != rune('A') {
+								goto l1094
+							}
+							position++
+						}
+					l1096:
+						{
+							position1098, tokenIndex1098 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1099
+							}
+							position++
+							goto l1098
+						l1099:
+							position, tokenIndex = position1098, tokenIndex1098
+							if buffer[position] != rune('S') {
+								goto l1094
+							}
+							position++
+						}
+					l1098:
+						if !_rules[rulesp]() {
+							goto l1094
+						}
+						if !_rules[ruleIdentifier]() {
+							goto l1094
+						}
+						goto l1095
+					l1094:
+						position, tokenIndex = position1094, tokenIndex1094
+					}
+				l1095:
+					add(rulePegText, position1093)
+				}
+				if !_rules[ruleAction47]() {
+					goto l1091
+				}
+				add(ruleLookupAliasOpt, position1092)
+			}
+			return true
+		l1091:
+			position, tokenIndex = position1091, tokenIndex1091
+			return false
+		},
+		/* 65 InnerJoin <- <(<((('i' / 'I') ('n' / 'N') ('n' / 'N') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action48)> */
+		func() bool {
+			position1100, tokenIndex1100 := position, tokenIndex
+			{
+				position1101 := position
+				{
+					position1102 := position
+					{
+						position1103, tokenIndex1103 := position, tokenIndex
+						{
+							position1105, tokenIndex1105 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1106
+							}
+							position++
+							goto l1105
+						l1106:
+							position, tokenIndex = position1105, tokenIndex1105
+							if buffer[position] != rune('I') {
+								goto l1103
+							}
+							position++
+						}
+					l1105:
+						{
+							position1107, tokenIndex1107 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1108
+							}
+							position++
+							goto l1107
+						l1108:
+							position, tokenIndex = position1107, tokenIndex1107
+							if buffer[position] != rune('N') {
+								goto l1103
+							}
+							position++
+						}
+					l1107:
+						{
+							position1109, tokenIndex1109 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1110
+							}
+							position++
+							goto l1109
+						l1110:
+							position, tokenIndex = position1109, tokenIndex1109
+							if buffer[position] != rune('N') {
+								goto l1103
+							}
+							position++
+						}
+					l1109:
+						{
+							position1111, tokenIndex1111 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1112
+							}
+							position++
+							goto l1111
+						l1112:
+							position, tokenIndex = position1111, tokenIndex1111
+							if buffer[position] != rune('E') {
+								goto l1103
+							}
+							position++
+						}
+					l1111:
+						{
+							position1113, tokenIndex1113 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1114
+							}
+							position++
+							goto l1113
+						l1114:
+							position, tokenIndex = position1113, tokenIndex1113
+							if buffer[position] != rune('R') {
+								goto l1103
+							}
+							position++
+						}
+					l1113:
+						if !_rules[rulesp]() {
+							goto l1103
+						}
+						goto l1104
+					l1103:
+						position, tokenIndex = position1103, tokenIndex1103
+					}
+				l1104:
+					{
+						position1115, tokenIndex1115 := position, tokenIndex
+						if buffer[position] != rune('j') {
+							goto l1116
+						}
+						position++
+						goto l1115
+					l1116:
+						position, tokenIndex = position1115, tokenIndex1115
+						if buffer[position] != rune('J') {
+							goto l1100
+						}
+						position++
+					}
+				l1115:
+					{
+						position1117, tokenIndex1117 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1118
+						}
+						position++
+						goto l1117
+					l1118:
+						position, tokenIndex = position1117, tokenIndex1117
+						if buffer[position] != rune('O') {
+							goto l1100
+						}
+						position++
+					}
+				l1117:
+					{
+						position1119, tokenIndex1119 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1120
+						}
+						position++
+						goto l1119
+					l1120:
+						position, tokenIndex = position1119, tokenIndex1119
+						if buffer[position] != rune('I') {
+							goto l1100
+						}
+						position++
+					}
+				l1119:
+					{
+						position1121, tokenIndex1121 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1122
+						}
+						position++
+						goto l1121
+					l1122:
+						position, tokenIndex = position1121, tokenIndex1121
+						if buffer[position] != rune('N') {
+							goto l1100
+						}
+						position++
+					}
+				l1121:
+					add(rulePegText, position1102)
+				}
+				if !_rules[ruleAction48]() {
+					goto l1100
+				}
+				add(ruleInnerJoin, position1101)
+			}
+			return true
+		l1100:
+			position, tokenIndex = position1100, tokenIndex1100
+			return false
+		},
+		/* 66 LeftOuterJoin <- <(<(('l' / 'L') ('e' / 'E') ('f' / 'F') ('t' / 'T') sp (('o' / 'O') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action49)> */
+		func() bool {
+			position1123, tokenIndex1123 := position, tokenIndex
+			{
+				position1124 := position
+				{
+					position1125 := position
+					{
+						position1126, tokenIndex1126 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1127
+						}
+						position++
+						goto l1126
+					l1127:
+						position, tokenIndex = position1126, tokenIndex1126
+						if buffer[position] != rune('L') {
+							goto l1123
+						}
+						position++
+					}
+				l1126:
+					{
+						position1128, tokenIndex1128 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1129
+						}
+						position++
+						goto l1128
+					l1129:
+						position, tokenIndex = position1128, tokenIndex1128
+						if buffer[position] != rune('E') {
+							goto l1123
+						}
+						position++
+					}
+				l1128:
+					{
+						position1130, tokenIndex1130 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l1131
+						}
+						position++
+						goto l1130
+					l1131:
+						position, tokenIndex = position1130, tokenIndex1130
+						if buffer[position] != rune('F') {
+							goto l1123
+						}
+						position++
+					}
+				l1130:
+					{
+						position1132, tokenIndex1132 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1133
+						}
+						position++
+						goto l1132
+					l1133:
+						position, tokenIndex = position1132, tokenIndex1132
+						if buffer[position] != rune('T') {
+							goto l1123
+						}
+						position++
+					}
+				l1132:
+					if !_rules[rulesp]() {
+						goto l1123
+					}
+					{
+						position1134, tokenIndex1134 := position, tokenIndex
+						{
+							position1136, tokenIndex1136 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1137
+							}
+							position++
+							goto l1136
+						l1137:
+							position, tokenIndex = position1136, tokenIndex1136
+							if buffer[position] != rune('O') {
+								goto l1134
+							}
+							position++
+						}
+					l1136:
+						{
+							position1138, tokenIndex1138 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1139
+							}
+							position++
+							goto l1138
+						l1139:
+							position, tokenIndex = position1138, tokenIndex1138
+							if buffer[position] != rune('U') {
+								goto l1134
+							}
+							position++
+						}
+					l1138:
+						{
+							position1140, tokenIndex1140 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1141
+							}
+							position++
+							goto l1140
+						l1141:
+							position, tokenIndex = position1140, tokenIndex1140
+							if buffer[position] != rune('T') {
+								goto l1134
+							}
+							position++
+						}
+					l1140:
+						{
+							position1142, tokenIndex1142 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1143
+							}
+							position++
+							goto l1142
+						l1143:
+							position, tokenIndex = position1142, tokenIndex1142
+							if buffer[position] != rune('E') {
+								goto l1134
+							}
+							position++
+						}
+					l1142:
+						{
+							position1144, tokenIndex1144 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1145
+							}
+							position++
+							goto l1144
+						l1145:
+							position, tokenIndex = position1144, tokenIndex1144
+							if buffer[position] != rune('R') {
+								goto l1134
+							}
+							position++
+						}
+					l1144:
+						if !_rules[rulesp]() {
+							goto l1134
+						}
+						goto l1135
+					l1134:
+						position, tokenIndex = position1134, tokenIndex1134
+					}
+				l1135:
+					{
+						position1146, tokenIndex1146 := position, tokenIndex
+						if buffer[position] != rune('j') {
+							goto l1147
+						}
+						position++
+						goto l1146
+					l1147:
+						position, tokenIndex = position1146, tokenIndex1146
+						if buffer[position] != rune('J') {
+							goto l1123
+						}
+						position++
+					}
+				l1146:
+					{
+						position1148, tokenIndex1148 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1149
+						}
+						position++
+						goto l1148
+					l1149:
+						position, tokenIndex = position1148, tokenIndex1148
+						if buffer[position] != rune('O') {
+							goto l1123
+						}
+						position++
+					}
+				l1148:
+					{
+						position1150, tokenIndex1150 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1151
+						}
+						position++
+						goto l1150
+					l1151:
+						position, tokenIndex = position1150, tokenIndex1150
+						if buffer[position] != rune('I') {
+							goto l1123
+						}
+						position++
+					}
+				l1150:
+					{
+						position1152, tokenIndex1152 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1153
+						}
+						position++
+						goto l1152
+					l1153:
+						position, tokenIndex = position1152, tokenIndex1152
+						if buffer[position] != rune('N') {
+							goto l1123
+						}
+						position++
+					}
+				l1152:
+					add(rulePegText, position1125)
+				}
+				if !_rules[ruleAction49]() {
+					goto l1123
+				}
+				add(ruleLeftOuterJoin, position1124)
+			}
+			return true
+		l1123:
+			position, tokenIndex = position1123, tokenIndex1123
+			return false
+		},
+		/* 67 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action50)> */
+		func() bool {
+			position1154, tokenIndex1154 := position, tokenIndex
+			{
+				position1155 := position
+				{
+					position1156 := position
+					{
+						position1157, tokenIndex1157 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1157
+						}
+						{
+							position1159, tokenIndex1159 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1160
+							}
+							position++
+							goto l1159
+						l1160:
+							position, tokenIndex = position1159, tokenIndex1159
+							if buffer[position] != rune('W') {
+								goto l1157
+							}
+							position++
+						}
+					l1159:
+						{
+							position1161, tokenIndex1161 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1162
+							}
+							position++
+							goto l1161
+						l1162:
+							position, tokenIndex = position1161, tokenIndex1161
+							if buffer[position] != rune('H') {
+								goto l1157
+							}
+							position++
+						}
+					l1161:
+						{
+							position1163, tokenIndex1163 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1164
+							}
+							position++
+							goto l1163
+						l1164:
+							position, tokenIndex = position1163, tokenIndex1163
+							if buffer[position] != rune('E') {
+								goto l1157
+							}
+							position++
+						}
+					l1163:
+						{
+							position1165, tokenIndex1165 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1166
+							}
+							position++
+							goto l1165
+						l1166:
+							position, tokenIndex = position1165, tokenIndex1165
+							if buffer[position] != rune('R') {
+								goto l1157
+							}
+							position++
+						}
+					l1165:
+						{
+							position1167, tokenIndex1167 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1168
+							}
+							position++
+							goto l1167
+						l1168:
+							position, tokenIndex = position1167, tokenIndex1167
+							if buffer[position] != rune('E') {
+								goto l1157
+							}
+							position++
+						}
+					l1167:
+						if !_rules[rulesp]() {
+							goto l1157
+						}
+						if !_rules[ruleExpression]() {
+							goto l1157
+						}
+						goto l1158
+					l1157:
+						position, tokenIndex = position1157, tokenIndex1157
+					}
+				l1158:
+					add(rulePegText, position1156)
+				}
+				if !_rules[ruleAction50]() {
+					goto l1154
+				}
+				add(ruleFilter, position1155)
+			}
+			return true
+		l1154:
+			position, tokenIndex = position1154, tokenIndex1154
+			return false
+		},
+		/* 68 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action51)> */
+		func() bool {
+			position1169, tokenIndex1169 := position, tokenIndex
+			{
+				position1170 := position
+				{
+					position1171 := position
+					{
+						position1172, tokenIndex1172 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1172
+						}
+						{
+							position1174, tokenIndex1174 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1175
+							}
+							position++
+							goto l1174
+						l1175:
+							position, tokenIndex = position1174, tokenIndex1174
+							if buffer[position] != rune('G') {
+								goto l1172
+							}
+							position++
+						}
+					l1174:
+						{
+							position1176, tokenIndex1176 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1177
+							}
+							position++
+							goto l1176
+						l1177:
+							position, tokenIndex = position1176, tokenIndex1176
+							if buffer[position] != rune('R') {
+								goto l1172
+							}
+							position++
+						}
+					l1176:
+						{
+							position1178, tokenIndex1178 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1179
+							}
+							position++
+							goto l1178
+						l1179:
+							position, tokenIndex = position1178, tokenIndex1178
+							if buffer[position] != rune('O') {
+								goto l1172
+							}
+							position++
+						}
+					l1178:
+						{
+							position1180, tokenIndex1180 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1181
+							}
+							position++
+							goto l1180
+						l1181:
+							position, tokenIndex = position1180, tokenIndex1180
+							if buffer[position] != rune('U') {
+								goto l1172
+							}
+							position++
+						}
+					l1180:
+						{
+							position1182, tokenIndex1182 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1183
+							}
+							position++
+							goto l1182
+						l1183:
+							position, tokenIndex = position1182, tokenIndex1182
+							if buffer[position] != rune('P') {
+								goto l1172
+							}
+							position++
+						}
+					l1182:
+						if !_rules[rulesp]() {
+							goto l1172
+						}
+						{
+							position1184, tokenIndex1184 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1185
+							}
+							position++
+							goto l1184
+						l1185:
+							position, tokenIndex = position1184, tokenIndex1184
+							if buffer[position] != rune('B') {
+								goto l1172
+							}
+							position++
+						}
+					l1184:
+						{
+							position1186, tokenIndex1186 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1187
+							}
+							position++
+							goto l1186
+						l1187:
+							position, tokenIndex = position1186, tokenIndex1186
+							if buffer[position] != rune('Y') {
+								goto l1172
+							}
+							position++
+						}
+					l1186:
+						if !_rules[rulesp]() {
+							goto l1172
+						}
+						if !_rules[ruleGroupList]() {
+							goto l1172
+						}
+						goto l1173
+					l1172:
+						position, tokenIndex = position1172, tokenIndex1172
+					}
+				l1173:
+					add(rulePegText, position1171)
+				}
+				if !_rules[ruleAction51]() {
+					goto l1169
+				}
+				add(ruleGrouping, position1170)
+			}
+			return true
+		l1169:
+			position, tokenIndex = position1169, tokenIndex1169
+			return false
+		},
+		/* 69 GroupList <- <(GroupingElement (spOpt ',' spOpt GroupingElement)*)> */
+		func() bool {
+			position1188, tokenIndex1188 := position, tokenIndex
+			{
+				position1189 := position
+				if !_rules[ruleGroupingElement]() {
+					goto l1188
+				}
+			l1190:
+				{
+					position1191, tokenIndex1191 := position, tokenIndex
+					if !_rules[rulespOpt]() {
+						goto l1191
+					}
+					if buffer[position] != rune(',') {
+						goto l1191
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1191
+					}
+					if !_rules[ruleGroupingElement]() {
+						goto l1191
+					}
+					goto l1190
+				l1191:
+					position, tokenIndex = position1191, tokenIndex1191
+				}
+				add(ruleGroupList, position1189)
+			}
+			return true
+		l1188:
+			position, tokenIndex = position1188, tokenIndex1188
+			return false
+		},
+		/* 70 GroupingElement <- <(GroupingSets / Rollup / Cube / Expression)> */
+		func() bool {
+			position1192, tokenIndex1192 := position, tokenIndex
+			{
+				position1193 := position
+				{
+					position1194, tokenIndex1194 := position, tokenIndex
+					if !_rules[ruleGroupingSets]() {
+						goto l1195
+					}
+					goto l1194
+				l1195:
+					position, tokenIndex = position1194, tokenIndex1194
+					if !_rules[ruleRollup]() {
+						goto l1196
+					}
+					goto l1194
+				l1196:
+					position, tokenIndex = position1194, tokenIndex1194
+					if !_rules[ruleCube]() {
+						goto l1197
+					}
+					goto l1194
+				l1197:
+					position, tokenIndex = position1194, tokenIndex1194
+					if !_rules[ruleExpression]() {
+						goto l1192
+					}
+				}
+			l1194:
+				add(ruleGroupingElement, position1193)
+			}
+			return true
+		l1192:
+			position, tokenIndex = position1192, tokenIndex1192
+			return false
+		},
+		/* 71 GroupingSets <- <(<(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') ('i' / 'I') ('n' / 'N') ('g' / 'G') sp (('s' / 'S') ('e' / 'E') ('t' / 'T') ('s' / 'S')) spOpt '(' spOpt GroupingSet (spOpt ',' spOpt GroupingSet)* spOpt ')')> Action52)> */
+		func() bool {
+			position1198, tokenIndex1198 := position, tokenIndex
+			{
+				position1199 := position
+				{
+					position1200 := position
+					{
+						position1201, tokenIndex1201 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1202
+						}
+						position++
+						goto l1201
+					l1202:
+						position, tokenIndex = position1201, tokenIndex1201
+						if buffer[position] != rune('G') {
+							goto l1198
+						}
+						position++
+					}
+				l1201:
+					{
+						position1203, tokenIndex1203 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1204
+						}
+						position++
+						goto l1203
+					l1204:
+						position, tokenIndex = position1203, tokenIndex1203
+						if buffer[position] != rune('R') {
+							goto l1198
+						}
+						position++
+					}
+				l1203:
+					{
+						position1205, tokenIndex1205 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1206
+						}
+						position++
+						goto l1205
+					l1206:
+						position, tokenIndex = position1205, tokenIndex1205
+						if buffer[position] != rune('O') {
+							goto l1198
+						}
+						position++
+					}
+				l1205:
+					{
+						position1207, tokenIndex1207 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1208
+						}
+						position++
+						goto l1207
+					l1208:
+						position, tokenIndex = position1207, tokenIndex1207
+						if buffer[position] != rune('U') {
+							goto l1198
+						}
+						position++
+					}
+				l1207:
+					{
+						position1209, tokenIndex1209 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1210
+						}
+						position++
+						goto l1209
+					l1210:
+						position, tokenIndex = position1209, tokenIndex1209
+						if buffer[position] != rune('P') {
+							goto l1198
+						}
+						position++
+					}
+				l1209:
+					{
+						position1211, tokenIndex1211 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1212
+						}
+						position++
+						goto l1211
+					l1212:
+						position, tokenIndex = position1211, tokenIndex1211
+						if buffer[position] != rune('I') {
+							goto l1198
+						}
+						position++
+					}
+				l1211:
+					{
+						position1213, tokenIndex1213 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1214
+						}
+						position++
+						goto l1213
+					l1214:
+						position, tokenIndex = position1213, tokenIndex1213
+						if buffer[position] != rune('N') {
+							goto l1198
+						}
+						position++
+					}
+				l1213:
+					{
+						position1215, tokenIndex1215 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1216
+						}
+						position++
+						goto l1215
+					l1216:
+						position, tokenIndex = position1215, tokenIndex1215
+						if buffer[position] != rune('G') {
+							goto l1198
+						}
+						position++
+					}
+				l1215:
+					if !_rules[rulesp]() {
+						goto l1198
+					}
+					{
+						position1217, tokenIndex1217 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1218
+						}
+						position++
+						goto l1217
+					l1218:
+						position, tokenIndex = position1217, tokenIndex1217
+						if buffer[position] != rune('S') {
+							goto l1198
+						}
+						position++
+					}
+				l1217:
+					{
+						position1219, tokenIndex1219 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1220
+						}
+						position++
+						goto l1219
+					l1220:
+						position, tokenIndex = position1219, tokenIndex1219
+						if buffer[position] != rune('E') {
+							goto l1198
+						}
+						position++
+					}
+				l1219:
+					{
+						position1221, tokenIndex1221 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1222
+						}
+						position++
+						goto l1221
+					l1222:
+						position, tokenIndex = position1221, tokenIndex1221
+						if buffer[position] != rune('T') {
+							goto l1198
+						}
+						position++
+					}
+				l1221:
+					{
+						position1223, tokenIndex1223 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1224
+						}
+						position++
+						goto l1223
+					l1224:
+						position, tokenIndex = position1223, tokenIndex1223
+						if buffer[position] != rune('S') {
+							goto l1198
+						}
+						position++
+					}
+				l1223:
+					if !_rules[rulespOpt]() {
+						goto l1198
+					}
+					if buffer[position] != rune('(') {
+						goto l1198
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1198
+					}
+					if !_rules[ruleGroupingSet]() {
+						goto l1198
+					}
+				l1225:
+					{
+						position1226, tokenIndex1226 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1226
+						}
+						if buffer[position] != rune(',') {
+							goto l1226
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1226
+						}
+						if !_rules[ruleGroupingSet]() {
+							goto l1226
+						}
+						goto l1225
+					l1226:
+						position, tokenIndex = position1226, tokenIndex1226
+					}
+					if !_rules[rulespOpt]() {
+						goto l1198
+					}
+					if buffer[position] != rune(')') {
+						goto l1198
+					}
+					position++
+					add(rulePegText, position1200)
+				}
+				if !_rules[ruleAction52]() {
+					goto l1198
+				}
+				add(ruleGroupingSets, position1199)
+			}
+			return true
+		l1198:
+			position, tokenIndex = position1198, tokenIndex1198
+			return false
+		},
+		/* 72 GroupingSet <- <(<(('(' spOpt (Expression (spOpt ',' spOpt Expression)*)? spOpt ')') / Expression)> Action53)> */
+		func() bool {
+			position1227, tokenIndex1227 := position, tokenIndex
+			{
+				position1228 := position
+				{
+					position1229 := position
+					{
+						position1230, tokenIndex1230 := position, tokenIndex
+						if buffer[position] != rune('(') {
+							goto l1231
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1231
+						}
+						{
+							position1232, tokenIndex1232 := position, tokenIndex
+							if !_rules[ruleExpression]() {
+								goto l1232
+							}
+						l1234:
+							{
+								position1235, tokenIndex1235 := position, tokenIndex
+								if !_rules[rulespOpt]() {
+									goto l1235
+								}
+								if buffer[position] != rune(',') {
+									goto l1235
+								}
+								position++
+								if !_rules[rulespOpt]() {
+									goto l1235
+								}
+								if !_rules[ruleExpression]() {
+									goto l1235
+								}
+								goto l1234
+							l1235:
+								position, tokenIndex = position1235, tokenIndex1235
+							}
+							goto l1233
+						l1232:
+							position, tokenIndex = position1232, tokenIndex1232
+						}
+					l1233:
+						if !_rules[rulespOpt]() {
+							goto l1231
+						}
+						if buffer[position] != rune(')') {
+							goto l1231
+						}
+						position++
+						goto l1230
+					l1231:
+						position, tokenIndex = position1230, tokenIndex1230
+						if !_rules[ruleExpression]() {
+							goto l1227
+						}
+					}
+				l1230:
+					add(rulePegText, position1229)
+				}
+				if !_rules[ruleAction53]() {
+					goto l1227
+				}
+				add(ruleGroupingSet, position1228)
+			}
+			return true
+		l1227:
+			position, tokenIndex = position1227, tokenIndex1227
+			return false
+		},
+		/* 73 Rollup <- <(<(('r' / 'R') ('o' / 'O') ('l' / 'L') ('l' / 'L') ('u' / 'U') ('p' / 'P') spOpt '(' spOpt Expression (spOpt ',' spOpt Expression)* spOpt ')')> Action54)> */
+		func() bool {
+			position1236, tokenIndex1236 := position, tokenIndex
+			{
+				position1237 := position
+				{
+					position1238 := position
+					{
+						position1239, tokenIndex1239 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1240
+						}
+						position++
+						goto l1239
+					l1240:
+						position, tokenIndex = position1239, tokenIndex1239
+						if buffer[position] != rune('R') {
+							goto l1236
+						}
+						position++
+					}
+				l1239:
+					{
+						position1241, tokenIndex1241 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1242
+						}
+						position++
+						goto l1241
+					l1242:
+						position, tokenIndex = position1241, tokenIndex1241
+						if buffer[position] != rune('O') {
+							goto l1236
+						}
+						position++
+					}
+				l1241:
+					{
+						position1243, tokenIndex1243 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1244
+						}
+						position++
+						goto l1243
+					l1244:
+						position, tokenIndex = position1243, tokenIndex1243
+						if buffer[position] != rune('L') {
+							goto l1236
+						}
+						position++
+					}
+				l1243:
+					{
+						position1245, tokenIndex1245 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1246
+						}
+						position++
+						goto l1245
+					l1246:
+						position, tokenIndex = position1245, tokenIndex1245
+						if buffer[position] != rune('L') {
+							goto l1236
+						}
+						position++
+					}
+				l1245:
+					{
+						position1247, tokenIndex1247 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1248
+						}
+						position++
+						goto l1247
+					l1248:
+						position, tokenIndex = position1247, tokenIndex1247
+						if buffer[position] != rune('U') {
+							goto l1236
+						}
+						position++
+					}
+				l1247:
+					{
+						position1249, tokenIndex1249 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1250
+						}
+						position++
+						goto l1249
+					l1250:
+						position, tokenIndex = position1249, tokenIndex1249
+						if buffer[position] != rune('P') {
+							goto l1236
+						}
+						position++
+					}
+				l1249:
+					if !_rules[rulespOpt]() {
+						goto l1236
+					}
+					if buffer[position] != rune('(') {
+						goto l1236
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1236
+					}
+					if !_rules[ruleExpression]() {
+						goto l1236
+					}
+				l1251:
+					{
+						position1252, tokenIndex1252 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1252
+						}
+						if buffer[position] != rune(',') {
+							goto l1252
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1252
+						}
+						if !_rules[ruleExpression]() {
+							goto l1252
+						}
+						goto l1251
+					l1252:
+						position, tokenIndex = position1252, tokenIndex1252
+					}
+					if !_rules[rulespOpt]() {
+						goto l1236
+					}
+					if buffer[position] != rune(')') {
+						goto l1236
+					}
+					position++
+					add(rulePegText, position1238)
+				}
+				if !_rules[ruleAction54]() {
+					goto l1236
+				}
+				add(ruleRollup, position1237)
+			}
+			return true
+		l1236:
+			position, tokenIndex = position1236, tokenIndex1236
+			return false
+		},
+		/* 74 Cube <- <(<(('c' / 'C') ('u' / 'U') ('b' / 'B') ('e' / 'E') spOpt '(' spOpt Expression (spOpt ',' spOpt Expression)* spOpt ')')> Action55)> */
+		func() bool {
+			position1253, tokenIndex1253 := position, tokenIndex
+			{
+				position1254 := position
+				{
+					position1255 := position
+					{
+						position1256, tokenIndex1256 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1257
+						}
+						position++
+						goto l1256
+					l1257:
+						position, tokenIndex = position1256, tokenIndex1256
+						if buffer[position] != rune('C') {
+							goto l1253
+						}
+						position++
+					}
+				l1256:
+					{
+						position1258, tokenIndex1258 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1259
+						}
+						position++
+						goto l1258
+					l1259:
+						position, tokenIndex = position1258, tokenIndex1258
+						if buffer[position] != rune('U') {
+							goto l1253
+						}
+						position++
+					}
+				l1258:
+					{
+						position1260, tokenIndex1260 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1261
+						}
+						position++
+						goto l1260
+					l1261:
+						position, tokenIndex = position1260, tokenIndex1260
+						if buffer[position] != rune('B') {
+							goto l1253
+						}
+						position++
+					}
+				l1260:
+					{
+						position1262, tokenIndex1262 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1263
+						}
+						position++
+						goto l1262
+					l1263:
+						position, tokenIndex = position1262, tokenIndex1262
+						if buffer[position] != rune('E') {
+							goto l1253
+						}
+						position++
+					}
+				l1262:
+					if !_rules[rulespOpt]() {
+						goto l1253
+					}
+					if buffer[position] != rune('(') {
+						goto l1253
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1253
+					}
+					if !_rules[ruleExpression]() {
+						goto l1253
+					}
+				l1264:
+					{
+						position1265, tokenIndex1265 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1265
+						}
+						if buffer[position] != rune(',') {
+							goto l1265
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1265
+						}
+						if !_rules[ruleExpression]() {
+							goto l1265
+						}
+						goto l1264
+					l1265:
+						position, tokenIndex = position1265, tokenIndex1265
+					}
+					if !_rules[rulespOpt]() {
+						goto l1253
+					}
+					if buffer[position] != rune(')') {
+						goto l1253
+					}
+					position++
+					add(rulePegText, position1255)
+				}
+				if !_rules[ruleAction55]() {
+					goto l1253
+				}
+				add(ruleCube, position1254)
+			}
+			return true
+		l1253:
+			position, tokenIndex = position1253, tokenIndex1253
+			return false
+		},
+		/* 75 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action56)> */
+		func() bool {
+			position1266, tokenIndex1266 := position, tokenIndex
+			{
+				position1267 := position
+				{
+					position1268 := position
+					{
+						position1269, tokenIndex1269 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1269
+						}
+						{
+							position1271, tokenIndex1271 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1272
+							}
+							position++
+							goto l1271
+						l1272:
+							position, tokenIndex = position1271, tokenIndex1271
+							if buffer[position] != rune('H') {
+								goto l1269
+							}
+							position++
+						}
+					l1271:
+						{
+							position1273, tokenIndex1273 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1274
+							}
+							position++
+							goto l1273
+						l1274:
+							position, tokenIndex = position1273, tokenIndex1273
+							if buffer[position] != rune('A') {
+								goto l1269
+							}
+							position++
+						}
+					l1273:
+						{
+							position1275, tokenIndex1275 := position, tokenIndex
+							if buffer[position] != rune('v') {
+								goto l1276
+							}
+							position++
+							goto l1275
+						l1276:
+							position, tokenIndex = position1275, tokenIndex1275
+							if buffer[position] != rune('V') {
+								goto l1269
+							}
+							position++
+						}
+					l1275:
+						{
+							position1277, tokenIndex1277 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1278
+							}
+							position++
+							goto l1277
+						l1278:
+							position, tokenIndex = position1277, tokenIndex1277
+							if buffer[position] != rune('I') {
+								goto l1269
+							}
+							position++
+						}
+					l1277:
+						{
+							position1279, tokenIndex1279 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1280
+							}
+							position++
+							goto l1279
+						l1280:
+							position, tokenIndex = position1279, tokenIndex1279
+							if buffer[position] != rune('N') {
+								goto l1269
+							}
+							position++
+						}
+					l1279:
+						{
+							position1281, tokenIndex1281 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1282
+							}
+							position++
+							goto l1281
+						l1282:
+							position, tokenIndex = position1281, tokenIndex1281
+							if buffer[position] != rune('G') {
+								goto l1269
+							}
+							position++
+						}
+					l1281:
+						if !_rules[rulesp]() {
+							goto l1269
+						}
+						if !_rules[ruleExpression]() {
+							goto l1269
+						}
+						goto l1270
+					l1269:
+						position, tokenIndex = position1269, tokenIndex1269
+					}
+				l1270:
+					add(rulePegText, position1268)
+				}
+				if !_rules[ruleAction56]() {
+					goto l1266
+				}
+				add(ruleHaving, position1267)
+			}
+			return true
+		l1266:
+			position, tokenIndex = position1266, tokenIndex1266
+			return false
+		},
+		/* 76 Ordering <- <(<(sp (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action57)> */
+		func() bool {
+			position1283, tokenIndex1283 := position, tokenIndex
+			{
+				position1284 := position
+				{
+					position1285 := position
+					{
+						position1286, tokenIndex1286 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1286
+						}
+						{
+							position1288, tokenIndex1288 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1289
+							}
+							position++
+							goto l1288
+						l1289:
+							position, tokenIndex = position1288, tokenIndex1288
+							if buffer[position] != rune('O') {
+								goto l1286
+							}
+							position++
+						}
+					l1288:
+						{
+							position1290, tokenIndex1290 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1291
+							}
+							position++
+							goto l1290
+						l1291:
+							position, tokenIndex = position1290, tokenIndex1290
+							if buffer[position] != rune('R') {
+								goto l1286
+							}
+							position++
+						}
+					l1290:
+						{
+							position1292, tokenIndex1292 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1293
+							}
+							position++
+							goto l1292
+						l1293:
+							position, tokenIndex = position1292, tokenIndex1292
+							if buffer[position] != rune('D') {
+								goto l1286
+							}
+							position++
+						}
+					l1292:
+						{
+							position1294, tokenIndex1294 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1295
+							}
+							position++
+							goto l1294
+						l1295:
+							position, tokenIndex = position1294, tokenIndex1294
+							if buffer[position] != rune('E') {
+								goto l1286
+							}
+							position++
+						}
+					l1294:
+						{
+							position1296, tokenIndex1296 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1297
+							}
+							position++
+							goto l1296
+						l1297:
+							position, tokenIndex = position1296, tokenIndex1296
+							if buffer[position] != rune('R') {
+								goto l1286
+							}
+							position++
+						}
+					l1296:
+						if !_rules[rulesp]() {
+							goto l1286
+						}
+						{
+							position1298, tokenIndex1298 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1299
+							}
+							position++
+							goto l1298
+						l1299:
+							position, tokenIndex = position1298, tokenIndex1298
+							if buffer[position] != rune('B') {
+								goto l1286
+							}
+							position++
+						}
+					l1298:
+						{
+							position1300, tokenIndex1300 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1301
+							}
+							position++
+							goto l1300
+						l1301:
+							position, tokenIndex = position1300, tokenIndex1300
+							if buffer[position] != rune('Y') {
+								goto l1286
+							}
+							position++
+						}
+					l1300:
+						if !_rules[rulesp]() {
+							goto l1286
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1286
+						}
+					l1302:
+						{
+							position1303, tokenIndex1303 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1303
+							}
+							if buffer[position] != rune(',') {
+								goto l1303
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1303
+							}
+							if !_rules[ruleSortedExpression]() {
+								goto l1303
+							}
+							goto l1302
+						l1303:
+							position, tokenIndex = position1303, tokenIndex1303
+						}
+						goto l1287
+					l1286:
+						position, tokenIndex = position1286, tokenIndex1286
+					}
+				l1287:
+					add(rulePegText, position1285)
+				}
+				if !_rules[ruleAction57]() {
+					goto l1283
+				}
+				add(ruleOrdering, position1284)
+			}
+			return true
+		l1283:
+			position, tokenIndex = position1283, tokenIndex1283
+			return false
+		},
+		/* 77 Limit <- <(<(sp (('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T')) sp NonNegativeNumericLiteral)?> Action58)> */
+		func() bool {
+			position1304, tokenIndex1304 := position, tokenIndex
+			{
+				position1305 := position
+				{
+					position1306 := position
+					{
+						position1307, tokenIndex1307 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1307
+						}
+						{
+							position1309, tokenIndex1309 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1310
+							}
+							position++
+							goto l1309
+						l1310:
+							position, tokenIndex = position1309, tokenIndex1309
+							if buffer[position] != rune('L') {
+								goto l1307
+							}
+							position++
+						}
+					l1309:
+						{
+							position1311, tokenIndex1311 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1312
+							}
+							position++
+							goto l1311
+						l1312:
+							position, tokenIndex = position1311, tokenIndex1311
+							if buffer[position] != rune('I') {
+								goto l1307
+							}
+							position++
+						}
+					l1311:
+						{
+							position1313, tokenIndex1313 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1314
+							}
+							position++
+							goto l1313
+						l1314:
+							position, tokenIndex = position1313, tokenIndex1313
+							if buffer[position] != rune('M') {
+								goto l1307
+							}
+							position++
+						}
+					l1313:
+						{
+							position1315, tokenIndex1315 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1316
+							}
+							position++
+							goto l1315
+						l1316:
+							position, tokenIndex = position1315, tokenIndex1315
+							if buffer[position] != rune('I') {
+								goto l1307
+							}
+							position++
+						}
+					l1315:
+						{
+							position1317, tokenIndex1317 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1318
+							}
+							position++
+							goto l1317
+						l1318:
+							position, tokenIndex = position1317, tokenIndex1317
+							if buffer[position] != rune('T') {
+								goto l1307
+							}
+							position++
+						}
+					l1317:
+						if !_rules[rulesp]() {
+							goto l1307
+						}
+						if !_rules[ruleNonNegativeNumericLiteral]() {
+							goto l1307
+						}
+						goto l1308
+					l1307:
+						position, tokenIndex = position1307, tokenIndex1307
+					}
+				l1308:
+					add(rulePegText, position1306)
+				}
+				if !_rules[ruleAction58]() {
+					goto l1304
+				}
+				add(ruleLimit, position1305)
+			}
+			return true
+		l1304:
+			position, tokenIndex = position1304, tokenIndex1304
+			return false
+		},
+		/* 78 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action59))> */
+		func() bool {
+			position1319, tokenIndex1319 := position, tokenIndex
+			{
+				position1320 := position
+				{
+					position1321, tokenIndex1321 := position, tokenIndex
+					if !_rules[ruleAliasedStreamWindow]() {
+						goto l1322
+					}
+					goto l1321
+				l1322:
+					position, tokenIndex = position1321, tokenIndex1321
+					if !_rules[ruleStreamWindow]() {
+						goto l1319
+					}
+					if !_rules[ruleAction59]() {
+						goto l1319
+					}
+				}
+			l1321:
+				add(ruleRelationLike, position1320)
+			}
+			return true
+		l1319:
+			position, tokenIndex = position1319, tokenIndex1319
+			return false
+		},
+		/* 79 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action60)> */
+		func() bool {
+			position1323, tokenIndex1323 := position, tokenIndex
+			{
+				position1324 := position
+				if !_rules[ruleStreamWindow]() {
+					goto l1323
+				}
+				if !_rules[rulesp]() {
+					goto l1323
+				}
+				{
+					position1325, tokenIndex1325 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1326
+					}
+					position++
+					goto l1325
+				l1326:
+					position, tokenIndex = position1325, tokenIndex1325
+					if buffer[position] != rune('A') {
+						goto l1323
+					}
+					position++
+				}
+			l1325:
+				{
+					position1327, tokenIndex1327 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1328
+					}
+					position++
+					goto l1327
+				l1328:
+					position, tokenIndex = position1327, tokenIndex1327
+					if buffer[position] != rune('S') {
+						goto l1323
+					}
+					position++
+				}
+			l1327:
+				if !_rules[rulesp]() {
+					goto l1323
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l1323
+				}
+				if !_rules[ruleAction60]() {
+					goto l1323
+				}
+				add(ruleAliasedStreamWindow, position1324)
+			}
+			return true
+		l1323:
+			position, tokenIndex = position1323, tokenIndex1323
+			return false
+		},
+		/* 80 StreamWindow <- <(StreamLike spOpt '[' spOpt (RangeWindowSpec / SessionWindowSpec) CapacitySpecOpt SheddingSpecOpt spOpt ']' Action61)> */
+		func() bool {
+			position1329, tokenIndex1329 := position, tokenIndex
+			{
+				position1330 := position
+				if !_rules[ruleStreamLike]() {
+					goto l1329
+				}
+				if !_rules[rulespOpt]() {
+					goto l1329
+				}
+				if buffer[position] != rune('[') {
+					goto l1329
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1329
+				}
+				{
+					position1331, tokenIndex1331 := position, tokenIndex
+					if !_rules[ruleRangeWindowSpec]() {
+						goto l1332
+					}
+					goto l1331
+				l1332:
+					position, tokenIndex = position1331, tokenIndex1331
+					if !_rules[ruleSessionWindowSpec]() {
+						goto l1329
+					}
+				}
+			l1331:
+				if !_rules[ruleCapacitySpecOpt]() {
+					goto l1329
+				}
+				if !_rules[ruleSheddingSpecOpt]() {
+					goto l1329
+				}
+				if !_rules[rulespOpt]() {
+					goto l1329
+				}
+				if buffer[position] != rune(']') {
+					goto l1329
+				}
+				position++
+				if !_rules[ruleAction61]() {
+					goto l1329
+				}
+				add(ruleStreamWindow, position1330)
+			}
+			return true
+		l1329:
+			position, tokenIndex = position1329, tokenIndex1329
+			return false
+		},
+		/* 81 RangeWindowSpec <- <(('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E') sp Interval SlideSpecOpt WindowTypeOpt LatenessSpecOpt)> */
+		func() bool {
+			position1333, tokenIndex1333 := position, tokenIndex
+			{
+				position1334 := position
+				{
+					position1335, tokenIndex1335 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1336
+					}
+					position++
+					goto l1335
+				l1336:
+					position, tokenIndex = position1335, tokenIndex1335
+					if buffer[position] != rune('R') {
+						goto l1333
+					}
+					position++
+				}
+			l1335:
+				{
+					position1337, tokenIndex1337 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1338
+					}
+					position++
+					goto l1337
+				l1338:
+					position, tokenIndex = position1337, tokenIndex1337
+					if buffer[position] != rune('A') {
+						goto l1333
+					}
+					position++
+				}
+			l1337:
+				{
+					position1339, tokenIndex1339 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1340
+					}
+					position++
+					goto l1339
+				l1340:
+					position, tokenIndex = position1339, tokenIndex1339
+					if buffer[position] != rune('N') {
+						goto l1333
+					}
+					position++
+				}
+			l1339:
+				{
+					position1341, tokenIndex1341 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l1342
+					}
+					position++
+					goto l1341
+				l1342:
+					position, tokenIndex = position1341, tokenIndex1341
+					if buffer[position] != rune('G') {
+						goto l1333
+					}
+					position++
+				}
+			l1341:
+				{
+					position1343, tokenIndex1343 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1344
+					}
+					position++
+					goto l1343
+				l1344:
+					position, tokenIndex = position1343, tokenIndex1343
+					if buffer[position] != rune('E') {
+						goto l1333
+					}
+					position++
+				}
+			l1343:
+				if !_rules[rulesp]() {
+					goto l1333
+				}
+				if !_rules[ruleInterval]() {
+					goto l1333
+				}
+				if !_rules[ruleSlideSpecOpt]() {
+					goto l1333
+				}
+				if !_rules[ruleWindowTypeOpt]() {
+					goto l1333
+				}
+				if !_rules[ruleLatenessSpecOpt]() {
+					goto l1333
+				}
+				add(ruleRangeWindowSpec, position1334)
+			}
+			return true
+		l1333:
+			position, tokenIndex = position1333, tokenIndex1333
+			return false
+		},
+		/* 82 SessionWindowSpec <- <(('s' / 'S') ('e' / 'E') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('g' / 'G') ('a' / 'A') ('p' / 'P')) sp TimeInterval Action62)> */
+		func() bool {
+			position1345, tokenIndex1345 := position, tokenIndex
+			{
+				position1346 := position
+				{
+					position1347, tokenIndex1347 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1348
+					}
+					position++
+					goto l1347
+				l1348:
+					position, tokenIndex = position1347, tokenIndex1347
+					if buffer[position] != rune('S') {
+						goto l1345
+					}
+					position++
+				}
+			l1347:
+				{
+					position1349, tokenIndex1349 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1350
+					}
+					position++
+					goto l1349
+				l1350:
+					position, tokenIndex = position1349, tokenIndex1349
+					if buffer[position] != rune('E') {
+						goto l1345
+					}
+					position++
+				}
+			l1349:
+				{
+					position1351, tokenIndex1351 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1352
+					}
+					position++
+					goto l1351
+				l1352:
+					position, tokenIndex = position1351, tokenIndex1351
+					if buffer[position] != rune('S') {
+						goto l1345
+					}
+					position++
+				}
+			l1351:
+				{
+					position1353, tokenIndex1353 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1354
+					}
+					position++
+					goto l1353
+				l1354:
+					position, tokenIndex = position1353, tokenIndex1353
+					if buffer[position] != rune('S') {
+						goto l1345
+					}
+					position++
+				}
+			l1353:
+				{
+					position1355, tokenIndex1355 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1356
+					}
+					position++
+					goto l1355
+				l1356:
+					position, tokenIndex = position1355, tokenIndex1355
+					if buffer[position] != rune('I') {
+						goto l1345
+					}
+					position++
+				}
+			l1355:
+				{
+					position1357, tokenIndex1357 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1358
+					}
+					position++
+					goto l1357
+				l1358:
+					position, tokenIndex = position1357, tokenIndex1357
+					if buffer[position] != rune('O') {
+						goto l1345
+					}
+					position++
+				}
+			l1357:
+				{
+					position1359, tokenIndex1359 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1360
+					}
+					position++
+					goto l1359
+				l1360:
+					position, tokenIndex = position1359, tokenIndex1359
+					if buffer[position] != rune('N') {
+						goto l1345
+					}
+					position++
+				}
+			l1359:
+				if !_rules[rulesp]() {
+					goto l1345
+				}
+				{
+					position1361, tokenIndex1361 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l1362
+					}
+					position++
+					goto l1361
+				l1362:
+					position, tokenIndex = position1361, tokenIndex1361
+					if buffer[position] != rune('G') {
+						goto l1345
+					}
+					position++
+				}
+			l1361:
+				{
+					position1363, tokenIndex1363 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1364
+					}
+					position++
+					goto l1363
+				l1364:
+					position, tokenIndex = position1363, tokenIndex1363
+					if buffer[position] != rune('A') {
+						goto l1345
+					}
+					position++
+				}
+			l1363:
+				{
+					position1365, tokenIndex1365 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1366
+					}
+					position++
+					goto l1365
+				l1366:
+					position, tokenIndex = position1365, tokenIndex1365
+					if buffer[position] != rune('P') {
+						goto l1345
+					}
+					position++
+				}
+			l1365:
+				if !_rules[rulesp]() {
+					goto l1345
+				}
+				if !_rules[ruleTimeInterval]() {
+					goto l1345
+				}
+				if !_rules[ruleAction62]() {
+					goto l1345
+				}
+				add(ruleSessionWindowSpec, position1346)
+			}
+			return true
+		l1345:
+			position, tokenIndex = position1345, tokenIndex1345
+			return false
+		},
+		/* 83 StreamLike <- <(Subquery / UDSFFuncApp / Stream)> */
+		func() bool {
+			position1367, tokenIndex1367 := position, tokenIndex
+			{
+				position1368 := position
+				{
+					position1369, tokenIndex1369 := position, tokenIndex
+					if !_rules[ruleSubquery]() {
+						goto l1370
+					}
+					goto l1369
+				l1370:
+					position, tokenIndex = position1369, tokenIndex1369
+					if !_rules[ruleUDSFFuncApp]() {
+						goto l1371
+					}
+					goto l1369
+				l1371:
+					position, tokenIndex = position1369, tokenIndex1369
+					if !_rules[ruleStream]() {
+						goto l1367
+					}
+				}
+			l1369:
+				add(ruleStreamLike, position1368)
+			}
+			return true
+		l1367:
+			position, tokenIndex = position1367, tokenIndex1367
+			return false
+		},
+		/* 84 Subquery <- <(<('(' spOpt SelectStmt spOpt ')')> Action63)> */
+		func() bool {
+			position1372, tokenIndex1372 := position, tokenIndex
+			{
+				position1373 := position
+				{
+					position1374 := position
+					if buffer[position] != rune('(') {
+						goto l1372
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1372
+					}
+					if !_rules[ruleSelectStmt]() {
+						goto l1372
+					}
+					if !_rules[rulespOpt]() {
+						goto l1372
+					}
+					if buffer[position] != rune(')') {
+						goto l1372
+					}
+					position++
+					add(rulePegText, position1374)
+				}
+				if !_rules[ruleAction63]() {
+					goto l1372
+				}
+				add(ruleSubquery, position1373)
+			}
+			return true
+		l1372:
+			position, tokenIndex = position1372, tokenIndex1372
+			return false
+		},
+		/* 85 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action64)> */
+		func() bool {
+			position1375, tokenIndex1375 := position, tokenIndex
+			{
+				position1376 := position
+				if !_rules[ruleFuncAppWithoutOrderBy]() {
+					goto l1375
+				}
+				if !_rules[ruleAction64]() {
+					goto l1375
+				}
+				add(ruleUDSFFuncApp, position1376)
+			}
+			return true
+		l1375:
+			position, tokenIndex = position1375, tokenIndex1375
+			return false
+		},
+		/* 86 SlideSpecOpt <- <(<(sp (('s' / 'S') ('l' / 'L') ('i' / 'I') ('d' / 'D') ('e' / 'E')) sp Interval)?> Action65)> */
+		func() bool {
+			position1377, tokenIndex1377 := position, tokenIndex
+			{
+				position1378 := position
+				{
+					position1379 := position
+					{
+						position1380, tokenIndex1380 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1380
+						}
+						{
+							position1382, tokenIndex1382 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1383
+							}
+							position++
+							goto l1382
+						l1383:
+							position, tokenIndex = position1382, tokenIndex1382
+							if buffer[position] != rune('S') {
+								goto l1380
+							}
+							position++
+						}
+					l1382:
+						{
+							position1384, tokenIndex1384 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1385
+							}
+							position++
+							goto l1384
+						l1385:
+							position, tokenIndex = position1384, tokenIndex1384
+							if buffer[position] != rune('L') {
+								goto l1380
+							}
+							position++
+						}
+					l1384:
+						{
+							position1386, tokenIndex1386 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1387
+							}
+							position++
+							goto l1386
+						l1387:
+							position, tokenIndex = position1386, tokenIndex1386
+							if buffer[position] != rune('I') {
+								goto l1380
+							}
+							position++
+						}
+					l1386:
+						{
+							position1388, tokenIndex1388 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1389
+							}
+							position++
+							goto l1388
+						l1389:
+							position, tokenIndex = position1388, tokenIndex1388
+							if buffer[position] != rune('D') {
+								goto l1380
+							}
+							position++
+						}
+					l1388:
+						{
+							position1390, tokenIndex1390 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1391
+							}
+							position++
+							goto l1390
+						l1391:
+							position, tokenIndex = position1390, tokenIndex1390
+							if buffer[position] != rune('E') {
+								goto l1380
+							}
+							position++
+						}
+					l1390:
+						if !_rules[rulesp]() {
+							goto l1380
+						}
+						if !_rules[ruleInterval]() {
+							goto l1380
+						}
+						goto l1381
+					l1380:
+						position, tokenIndex = position1380, tokenIndex1380
+					}
+				l1381:
+					add(rulePegText, position1379)
+				}
+				if !_rules[ruleAction65]() {
+					goto l1377
+				}
+				add(ruleSlideSpecOpt, position1378)
+			}
+			return true
+		l1377:
+			position, tokenIndex = position1377, tokenIndex1377
+			return false
+		},
+		/* 87 WindowTypeOpt <- <(<(sp TUMBLING)?> Action66)> */
+		func() bool {
+			position1392, tokenIndex1392 := position, tokenIndex
+			{
+				position1393 := position
+				{
+					position1394 := position
+					{
+						position1395, tokenIndex1395 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1395
+						}
+						if !_rules[ruleTUMBLING]() {
+							goto l1395
+						}
+						goto l1396
+					l1395:
+						position, tokenIndex = position1395, tokenIndex1395
+					}
+				l1396:
+					add(rulePegText, position1394)
+				}
+				if !_rules[ruleAction66]() {
+					goto l1392
+				}
+				add(ruleWindowTypeOpt, position1393)
+			}
+			return true
+		l1392:
+			position, tokenIndex = position1392, tokenIndex1392
+			return false
+		},
+		/* 88 LatenessSpecOpt <- <(<(spOpt ',' spOpt (('a' / 'A') ('l' / 'L') ('l' / 'L') ('o' / 'O') ('w' / 'W') ('e' / 'E') ('d' / 'D')) sp (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E') ('n' / 'N') ('e' / 'E') ('s' / 'S') ('s' / 'S')) sp TimeInterval)?> Action67)> */
+		func() bool {
+			position1397, tokenIndex1397 := position, tokenIndex
+			{
+				position1398 := position
+				{
+					position1399 := position
+					{
+						position1400, tokenIndex1400 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1400
+						}
+						if buffer[position] != rune(',') {
+							goto l1400
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1400
+						}
+						{
+							position1402, tokenIndex1402 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1403
+							}
+							position++
+							goto l1402
+						l1403:
+							position, tokenIndex = position1402, tokenIndex1402
+							if buffer[position] != rune('A') {
+								goto l1400
+							}
+							position++
+						}
+					l1402:
+						{
+							position1404, tokenIndex1404 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1405
+							}
+							position++
+							goto l1404
+						l1405:
+							position, tokenIndex = position1404, tokenIndex1404
+							if buffer[position] != rune('L') {
+								goto l1400
+							}
+							position++
+						}
+					l1404:
+						{
+							position1406, tokenIndex1406 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1407
+							}
+							position++
+							goto l1406
+						l1407:
+							position, tokenIndex = position1406, tokenIndex1406
+							if buffer[position] != rune('L') {
+								goto l1400
+							}
+							position++
+						}
+					l1406:
+						{
+							position1408, tokenIndex1408 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1409
+							}
+							position++
+							goto l1408
+						l1409:
+							position, tokenIndex = position1408, tokenIndex1408
+							if buffer[position] != rune('O') {
+								goto l1400
+							}
+							position++
+						}
+					l1408:
+						{
+							position1410, tokenIndex1410 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1411
+							}
+							position++
+							goto l1410
+						l1411:
+							position, tokenIndex = position1410, tokenIndex1410
+							if buffer[position] != rune('W') {
+								goto l1400
+							}
+							position++
+						}
+					l1410:
+						{
+							position1412, tokenIndex1412 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1413
+							}
+							position++
+							goto l1412
+						l1413:
+							position, tokenIndex = position1412, tokenIndex1412
+							if buffer[position] != rune('E') {
+								goto l1400
+							}
+							position++
+						}
+					l1412:
+						{
+							position1414, tokenIndex1414 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1415
+							}
+							position++
+							goto l1414
+						l1415:
+							position, tokenIndex = position1414, tokenIndex1414
+							if buffer[position] != rune('D') {
+								goto l1400
+							}
+							position++
+						}
+					l1414:
+						if !_rules[rulesp]() {
+							goto l1400
+						}
+						{
+							position1416, tokenIndex1416 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1417
+							}
+							position++
+							goto l1416
+						l1417:
+							position, tokenIndex = position1416, tokenIndex1416
+							if buffer[position] != rune('L') {
+								goto l1400
+							}
+							position++
+						}
+					l1416:
+						{
+							position1418, tokenIndex1418 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1419
+							}
+							position++
+							goto l1418
+						l1419:
+							position, tokenIndex = position1418, tokenIndex1418
+							if buffer[position] != rune('A') {
+								goto l1400
+							}
+							position++
+						}
+					l1418:
+						{
+							position1420, tokenIndex1420 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1421
+							}
+							position++
+							goto l1420
+						l1421:
+							position, tokenIndex = position1420, tokenIndex1420
+							if buffer[position] != rune('T') {
+								goto l1400
+							}
+							position++
+						}
+					l1420:
+						{
+							position1422, tokenIndex1422 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1423
+							}
+							position++
+							goto l1422
+						l1423:
+							position, tokenIndex = position1422, tokenIndex1422
+							if buffer[position] != rune('E') {
+								goto l1400
+							}
+							position++
+						}
+					l1422:
+						{
+							position1424, tokenIndex1424 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1425
+							}
+							position++
+							goto l1424
+						l1425:
+							position, tokenIndex = position1424, tokenIndex1424
+							if buffer[position] != rune('N') {
+								goto l1400
+							}
+							position++
+						}
+					l1424:
+						{
+							position1426, tokenIndex1426 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1427
+							}
+							position++
+							goto l1426
+						l1427:
+							position, tokenIndex = position1426, tokenIndex1426
+							if buffer[position] != rune('E') {
+								goto l1400
+							}
+							position++
+						}
+					l1426:
+						{
+							position1428, tokenIndex1428 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1429
+							}
+							position++
+							goto l1428
+						l1429:
+							position, tokenIndex = position1428, tokenIndex1428
+							if buffer[position] != rune('S') {
+								goto l1400
+							}
+							position++
+						}
+					l1428:
+						{
+							position1430, tokenIndex1430 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1431
+							}
+							position++
+							goto l1430
+						l1431:
+							position, tokenIndex = position1430, tokenIndex1430
+							if buffer[position] != rune('S') {
+								goto l1400
+							}
+							position++
+						}
+					l1430:
+						if !_rules[rulesp]() {
+							goto l1400
+						}
+						if !_rules[ruleTimeInterval]() {
+							goto l1400
+						}
+						goto l1401
+					l1400:
+						position, tokenIndex = position1400, tokenIndex1400
+					}
+				l1401:
+					add(rulePegText, position1399)
+				}
+				if !_rules[ruleAction67]() {
+					goto l1397
+				}
+				add(ruleLatenessSpecOpt, position1398)
+			}
+			return true
+		l1397:
+			position, tokenIndex = position1397, tokenIndex1397
+			return false
+		},
+		/* 89 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action68)> */
+		func() bool {
+			position1432, tokenIndex1432 := position, tokenIndex
+			{
+				position1433 := position
+				{
+					position1434 := position
+					{
+						position1435, tokenIndex1435 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1435
+						}
+						if buffer[position] != rune(',') {
+							goto l1435
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1435
+						}
+						{
+							position1437, tokenIndex1437 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1438
+							}
+							position++
+							goto l1437
+						l1438:
+							position, tokenIndex = position1437, tokenIndex1437
+							if buffer[position] != rune('B') {
+								goto l1435
+							}
+							position++
+						}
+					l1437:
+						{
+							position1439, tokenIndex1439 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1440
+							}
+							position++
+							goto l1439
+						l1440:
+							position, tokenIndex = position1439, tokenIndex1439
+							if buffer[position] != rune('U') {
+								goto l1435
+							}
+							position++
+						}
+					l1439:
+						{
+							position1441, tokenIndex1441 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1442
+							}
+							position++
+							goto l1441
+						l1442:
+							position, tokenIndex = position1441, tokenIndex1441
+							if buffer[position] != rune('F') {
+								goto l1435
+							}
+							position++
+						}
+					l1441:
+						{
+							position1443, tokenIndex1443 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1444
+							}
+							position++
+							goto l1443
+						l1444:
+							position, tokenIndex = position1443, tokenIndex1443
+							if buffer[position] != rune('F') {
+								goto l1435
+							}
+							position++
+						}
+					l1443:
+						{
+							position1445, tokenIndex1445 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1446
+							}
+							position++
+							goto l1445
+						l1446:
+							position, tokenIndex = position1445, tokenIndex1445
+							if buffer[position] != rune('E') {
+								goto l1435
+							}
+							position++
+						}
+					l1445:
+						{
+							position1447, tokenIndex1447 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1448
+							}
+							position++
+							goto l1447
+						l1448:
+							position, tokenIndex = position1447, tokenIndex1447
+							if buffer[position] != rune('R') {
+								goto l1435
+							}
+							position++
+						}
+					l1447:
+						if !_rules[rulesp]() {
+							goto l1435
+						}
+						{
+							position1449, tokenIndex1449 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1450
+							}
+							position++
+							goto l1449
+						l1450:
+							position, tokenIndex = position1449, tokenIndex1449
+							if buffer[position] != rune('S') {
+								goto l1435
+							}
+							position++
+						}
+					l1449:
+						{
+							position1451, tokenIndex1451 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1452
+							}
+							position++
+							goto l1451
+						l1452:
+							position, tokenIndex = position1451, tokenIndex1451
+							if buffer[position] != rune('I') {
+								goto l1435
+							}
+							position++
+						}
+					l1451:
+						{
+							position1453, tokenIndex1453 := position, tokenIndex
+							if buffer[position] != rune('z') {
+								goto l1454
+							}
+							position++
+							goto l1453
+						l1454:
+							position, tokenIndex = position1453, tokenIndex1453
+							if buffer[position] != rune('Z') {
+								goto l1435
+							}
+							position++
+						}
+					l1453:
+						{
+							position1455, tokenIndex1455 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1456
+							}
+							position++
+							goto l1455
+						l1456:
+							position, tokenIndex = position1455, tokenIndex1455
+							if buffer[position] != rune('E') {
+								goto l1435
+							}
+							position++
+						}
+					l1455:
+						if !_rules[rulesp]() {
+							goto l1435
+						}
+						if !_rules[ruleNonNegativeNumericLiteral]() {
+							goto l1435
+						}
+						goto l1436
+					l1435:
+						position, tokenIndex = position1435, tokenIndex1435
+					}
+				l1436:
+					add(rulePegText, position1434)
+				}
+				if !_rules[ruleAction68]() {
+					goto l1432
+				}
+				add(ruleCapacitySpecOpt, position1433)
+			}
+			return true
+		l1432:
+			position, tokenIndex = position1432, tokenIndex1432
+			return false
+		},
+		/* 90 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action69)> */
+		func() bool {
+			position1457, tokenIndex1457 := position, tokenIndex
+			{
+				position1458 := position
+				{
+					position1459 := position
+					{
+						position1460, tokenIndex1460 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1460
+						}
+						if buffer[position] != rune(',') {
+							goto l1460
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1460
+						}
+						if !_rules[ruleSheddingOption]() {
+							goto l1460
+						}
+						if !_rules[rulesp]() {
+							goto l1460
+						}
+						{
+							position1462, tokenIndex1462 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1463
+							}
+							position++
+							goto l1462
+						l1463:
+							position, tokenIndex = position1462, tokenIndex1462
+							if buffer[position] != rune('I') {
+								goto l1460
+							}
+							position++
+						}
+					l1462:
+						{
+							position1464, tokenIndex1464 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1465
+							}
+							position++
+							goto l1464
+						l1465:
+							position, tokenIndex = position1464, tokenIndex1464
+							if buffer[position] != rune('F') {
+								goto l1460
+							}
+							position++
+						}
+					l1464:
+						if !_rules[rulesp]() {
+							goto l1460
+						}
+						{
+							position1466, tokenIndex1466 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1467
+							}
+							position++
+							goto l1466
+						l1467:
+							position, tokenIndex = position1466, tokenIndex1466
+							if buffer[position] != rune('F') {
+								goto l1460
+							}
+							position++
+						}
+					l1466:
+						{
+							position1468, tokenIndex1468 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1469
+							}
+							position++
+							goto l1468
+						l1469:
+							position, tokenIndex = position1468, tokenIndex1468
+							if buffer[position] != rune('U') {
+								goto l1460
+							}
+							position++
+						}
+					l1468:
+						{
+							position1470, tokenIndex1470 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1471
+							}
+							position++
+							goto l1470
+						l1471:
+							position, tokenIndex = position1470, tokenIndex1470
+							if buffer[position] != rune('L') {
+								goto l1460
+							}
+							position++
+						}
+					l1470:
+						{
+							position1472, tokenIndex1472 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1473
+							}
+							position++
+							goto l1472
+						l1473:
+							position, tokenIndex = position1472, tokenIndex1472
+							if buffer[position] != rune('L') {
+								goto l1460
+							}
+							position++
+						}
+					l1472:
+						goto l1461
+					l1460:
+						position, tokenIndex = position1460, tokenIndex1460
+					}
+				l1461:
+					add(rulePegText, position1459)
+				}
+				if !_rules[ruleAction69]() {
+					goto l1457
+				}
+				add(ruleSheddingSpecOpt, position1458)
+			}
+			return true
+		l1457:
+			position, tokenIndex = position1457, tokenIndex1457
+			return false
+		},
+		/* 91 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
+		func() bool {
+			position1474, tokenIndex1474 := position, tokenIndex
+			{
+				position1475 := position
+				{
+					position1476, tokenIndex1476 := position, tokenIndex
+					if !_rules[ruleWait]() {
+						goto l1477
+					}
+					goto l1476
+				l1477:
+					position, tokenIndex = position1476, tokenIndex1476
+					if !_rules[ruleDropOldest]() {
+						goto l1478
+					}
+					goto l1476
+				l1478:
+					position, tokenIndex = position1476, tokenIndex1476
+					if !_rules[ruleDropNewest]() {
+						goto l1474
+					}
+				}
+			l1476:
+				add(ruleSheddingOption, position1475)
+			}
+			return true
+		l1474:
+			position, tokenIndex = position1474, tokenIndex1474
+			return false
+		},
+		/* 92 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action70)> */
+		func() bool {
+			position1479, tokenIndex1479 := position, tokenIndex
+			{
+				position1480 := position
+				{
+					position1481 := position
+					{
+						position1482, tokenIndex1482 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1482
+						}
+						{
+							position1484, tokenIndex1484 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1485
+							}
+							position++
+							goto l1484
+						l1485:
+							position, tokenIndex = position1484, tokenIndex1484
+							if buffer[position] != rune('W') {
+								goto l1482
+							}
+							position++
+						}
+					l1484:
+						{
+							position1486, tokenIndex1486 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1487
+							}
+							position++
+							goto l1486
+						l1487:
+							position, tokenIndex = position1486, tokenIndex1486
+							if buffer[position] != rune('I') {
+								goto l1482
+							}
+							position++
+						}
+					l1486:
+						{
+							position1488, tokenIndex1488 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1489
+							}
+							position++
+							goto l1488
+						l1489:
+							position, tokenIndex = position1488, tokenIndex1488
+							if buffer[position] != rune('T') {
+								goto l1482
+							}
+							position++
+						}
+					l1488:
+						{
+							position1490, tokenIndex1490 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1491
+							}
+							position++
+							goto l1490
+						l1491:
+							position, tokenIndex = position1490, tokenIndex1490
+							if buffer[position] != rune('H') {
+								goto l1482
+							}
+							position++
+						}
+					l1490:
+						if !_rules[rulesp]() {
+							goto l1482
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1482
+						}
+					l1492:
+						{
+							position1493, tokenIndex1493 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1493
+							}
+							if buffer[position] != rune(',') {
+								goto l1493
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1493
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1493
+							}
+							goto l1492
+						l1493:
+							position, tokenIndex = position1493, tokenIndex1493
+						}
+						goto l1483
+					l1482:
+						position, tokenIndex = position1482, tokenIndex1482
+					}
+				l1483:
+					add(rulePegText, position1481)
+				}
+				if !_rules[ruleAction70]() {
+					goto l1479
+				}
+				add(ruleSourceSinkSpecs, position1480)
+			}
+			return true
+		l1479:
+			position, tokenIndex = position1479, tokenIndex1479
+			return false
+		},
+		/* 93 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action71)> */
+		func() bool {
+			position1494, tokenIndex1494 := position, tokenIndex
+			{
+				position1495 := position
+				{
+					position1496 := position
+					if !_rules[rulesp]() {
+						goto l1494
+					}
+					{
+						position1497, tokenIndex1497 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1498
+						}
+						position++
+						goto l1497
+					l1498:
+						position, tokenIndex = position1497, tokenIndex1497
+						if buffer[position] != rune('S') {
+							goto l1494
+						}
+						position++
+					}
+				l1497:
+					{
+						position1499, tokenIndex1499 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1500
+						}
+						position++
+						goto l1499
+					l1500:
+						position, tokenIndex = position1499, tokenIndex1499
+						if buffer[position] != rune('E') {
+							goto l1494
+						}
+						position++
+					}
+				l1499:
+					{
+						position1501, tokenIndex1501 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1502
+						}
+						position++
+						goto l1501
+					l1502:
+						position, tokenIndex = position1501, tokenIndex1501
+						if buffer[position] != rune('T') {
+							goto l1494
+						}
+						position++
+					}
+				l1501:
+					if !_rules[rulesp]() {
+						goto l1494
+					}
+					if !_rules[ruleSourceSinkParam]() {
+						goto l1494
+					}
+				l1503:
+					{
+						position1504, tokenIndex1504 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1504
+						}
+						if buffer[position] != rune(',') {
+							goto l1504
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1504
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1504
+						}
+						goto l1503
+					l1504:
+						position, tokenIndex = position1504, tokenIndex1504
+					}
+					add(rulePegText, position1496)
+				}
+				if !_rules[ruleAction71]() {
+					goto l1494
+				}
+				add(ruleUpdateSourceSinkSpecs, position1495)
+			}
+			return true
+		l1494:
+			position, tokenIndex = position1494, tokenIndex1494
+			return false
+		},
+		/* 94 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action72)> */
+		func() bool {
+			position1505, tokenIndex1505 := position, tokenIndex
+			{
+				position1506 := position
+				{
+					position1507 := position
+					{
+						position1508, tokenIndex1508 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1508
+						}
+						{
+							position1510, tokenIndex1510 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1511
+							}
+							position++
+							goto l1510
+						l1511:
+							position, tokenIndex = position1510, tokenIndex1510
+							if buffer[position] != rune('S') {
+								goto l1508
+							}
+							position++
+						}
+					l1510:
+						{
+							position1512, tokenIndex1512 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1513
+							}
+							position++
+							goto l1512
+						l1513:
+							position, tokenIndex = position1512, tokenIndex1512
+							if buffer[position] != rune('E') {
+								goto l1508
+							}
+							position++
+						}
+					l1512:
+						{
+							position1514, tokenIndex1514 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1515
+							}
+							position++
+							goto l1514
+						l1515:
+							position, tokenIndex = position1514, tokenIndex1514
+							if buffer[position] != rune('T') {
+								goto l1508
+							}
+							position++
+						}
+					l1514:
+						if !_rules[rulesp]() {
+							goto l1508
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1508
+						}
+					l1516:
+						{
+							position1517, tokenIndex1517 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1517
+							}
+							if buffer[position] != rune(',') {
+								goto l1517
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1517
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1517
+							}
+							goto l1516
+						l1517:
+							position, tokenIndex = position1517, tokenIndex1517
+						}
+						goto l1509
+					l1508:
+						position, tokenIndex = position1508, tokenIndex1508
+					}
+				l1509:
+					add(rulePegText, position1507)
+				}
+				if !_rules[ruleAction72]() {
+					goto l1505
+				}
+				add(ruleSetOptSpecs, position1506)
+			}
+			return true
+		l1505:
+			position, tokenIndex = position1505, tokenIndex1505
+			return false
+		},
+		/* 95 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action73)> */
+		func() bool {
+			position1518, tokenIndex1518 := position, tokenIndex
+			{
+				position1519 := position
+				{
+					position1520 := position
+					{
+						position1521, tokenIndex1521 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1521
+						}
+						{
+							position1523, tokenIndex1523 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1524
+							}
+							position++
+							goto l1523
+						l1524:
+							position, tokenIndex = position1523, tokenIndex1523
+							if buffer[position] != rune('T') {
+								goto l1521
+							}
+							position++
+						}
+					l1523:
+						{
+							position1525, tokenIndex1525 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1526
+							}
+							position++
+							goto l1525
+						l1526:
+							position, tokenIndex = position1525, tokenIndex1525
+							if buffer[position] != rune('A') {
+								goto l1521
+							}
+							position++
+						}
+					l1525:
+						{
+							position1527, tokenIndex1527 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1528
+							}
+							position++
+							goto l1527
+						l1528:
+							position, tokenIndex = position1527, tokenIndex1527
+							if buffer[position] != rune('G') {
+								goto l1521
+							}
+							position++
+						}
+					l1527:
+						if !_rules[rulesp]() {
+							goto l1521
+						}
+						if !_rules[ruleIdentifier]() {
+							goto l1521
+						}
+						goto l1522
+					l1521:
+						position, tokenIndex = position1521, tokenIndex1521
+					}
+				l1522:
+					add(rulePegText, position1520)
+				}
+				if !_rules[ruleAction73]() {
+					goto l1518
+				}
+				add(ruleStateTagOpt, position1519)
+			}
+			return true
+		l1518:
+			position, tokenIndex = position1518, tokenIndex1518
+			return false
+		},
+		/* 96 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action74)> */
+		func() bool {
+			position1529, tokenIndex1529 := position, tokenIndex
+			{
+				position1530 := position
+				if !_rules[ruleSourceSinkParamKey]() {
+					goto l1529
+				}
+				if !_rules[rulespOpt]() {
+					goto l1529
+				}
+				if buffer[position] != rune('=') {
+					goto l1529
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1529
+				}
+				if !_rules[ruleSourceSinkParamVal]() {
+					goto l1529
+				}
+				if !_rules[ruleAction74]() {
+					goto l1529
+				}
+				add(ruleSourceSinkParam, position1530)
+			}
+			return true
+		l1529:
+			position, tokenIndex = position1529, tokenIndex1529
+			return false
+		},
+		/* 97 SourceSinkParamVal <- <ParamLiteral> */
+		func() bool {
+			position1531, tokenIndex1531 := position, tokenIndex
+			{
+				position1532 := position
+				if !_rules[ruleParamLiteral]() {
+					goto l1531
+				}
+				add(ruleSourceSinkParamVal, position1532)
+			}
+			return true
+		l1531:
+			position, tokenIndex = position1531, tokenIndex1531
+			return false
+		},
+		/* 98 ParamLiteral <- <(BooleanLiteral / Literal / ParamArrayExpr / ParamMapExpr)> */
+		func() bool {
+			position1533, tokenIndex1533 := position, tokenIndex
+			{
+				position1534 := position
+				{
+					position1535, tokenIndex1535 := position, tokenIndex
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1536
+					}
+					goto l1535
+				l1536:
+					position, tokenIndex = position1535, tokenIndex1535
+					if !_rules[ruleLiteral]() {
+						goto l1537
+					}
+					goto l1535
+				l1537:
+					position, tokenIndex = position1535, tokenIndex1535
+					if !_rules[ruleParamArrayExpr]() {
+						goto l1538
+					}
+					goto l1535
+				l1538:
+					position, tokenIndex = position1535, tokenIndex1535
+					if !_rules[ruleParamMapExpr]() {
+						goto l1533
+					}
+				}
+			l1535:
+				add(ruleParamLiteral, position1534)
+			}
+			return true
+		l1533:
+			position, tokenIndex = position1533, tokenIndex1533
+			return false
+		},
+		/* 99 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action75)> */
+		func() bool {
+			position1539, tokenIndex1539 := position, tokenIndex
+			{
+				position1540 := position
+				{
+					position1541 := position
+					if buffer[position] != rune('[') {
+						goto l1539
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1539
+					}
+					{
+						position1542, tokenIndex1542 := position, tokenIndex
+						if !_rules[ruleParamLiteral]() {
+							goto l1542
+						}
+					l1544:
+						{
+							position1545, tokenIndex1545 := position, tokenIndex
+							if buffer[position] != rune(',') {
+								goto l1545
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1545
+							}
+							if !_rules[ruleParamLiteral]() {
+								goto l1545
+							}
+							goto l1544
+						l1545:
+							position, tokenIndex = position1545, tokenIndex1545
+						}
+						goto l1543
+					l1542:
+						position, tokenIndex = position1542, tokenIndex1542
+					}
+				l1543:
+					if !_rules[rulespOpt]() {
+						goto l1539
+					}
+					{
+						position1546, tokenIndex1546 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l1546
+						}
+						position++
+						goto l1547
+					l1546:
+						position, tokenIndex = position1546, tokenIndex1546
+					}
+				l1547:
+					if !_rules[rulespOpt]() {
+						goto l1539
+					}
+					if buffer[position] != rune(']') {
+						goto l1539
+					}
+					position++
+					add(rulePegText, position1541)
+				}
+				if !_rules[ruleAction75]() {
+					goto l1539
+				}
+				add(ruleParamArrayExpr, position1540)
+			}
+			return true
+		l1539:
+			position, tokenIndex = position1539, tokenIndex1539
+			return false
+		},
+		/* 100 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action76)> */
+		func() bool {
+			position1548, tokenIndex1548 := position, tokenIndex
+			{
+				position1549 := position
+				{
+					position1550 := position
+					if buffer[position] != rune('{') {
+						goto l1548
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1548
+					}
+					{
+						position1551, tokenIndex1551 := position, tokenIndex
+						if !_rules[ruleParamKeyValuePair]() {
+							goto l1551
+						}
+					l1553:
+						{
+							position1554, tokenIndex1554 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1554
+							}
+							if buffer[position] != rune(',') {
+								goto l1554
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1554
+							}
+							if !_rules[ruleParamKeyValuePair]() {
+								goto l1554
+							}
+							goto l1553
+						l1554:
+							position, tokenIndex = position1554, tokenIndex1554
+						}
+						goto l1552
+					l1551:
+						position, tokenIndex = position1551, tokenIndex1551
+					}
+				l1552:
+					if !_rules[rulespOpt]() {
+						goto l1548
+					}
+					if buffer[position] != rune('}') {
+						goto l1548
+					}
+					position++
+					add(rulePegText, position1550)
+				}
+				if !_rules[ruleAction76]() {
+					goto l1548
+				}
+				add(ruleParamMapExpr, position1549)
+			}
+			return true
+		l1548:
+			position, tokenIndex = position1548, tokenIndex1548
+			return false
+		},
+		/* 101 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action77)> */
+		func() bool {
+			position1555, tokenIndex1555 := position, tokenIndex
+			{
+				position1556 := position
+				{
+					position1557 := position
+					if !_rules[ruleStringLiteral]() {
+						goto l1555
+					}
+					if !_rules[rulespOpt]() {
+						goto l1555
+					}
+					if buffer[position] != rune(':') {
+						goto l1555
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1555
+					}
+					if !_rules[ruleParamLiteral]() {
+						goto l1555
+					}
+					add(rulePegText, position1557)
+				}
+				if !_rules[ruleAction77]() {
+					goto l1555
+				}
+				add(ruleParamKeyValuePair, position1556)
+			}
+			return true
+		l1555:
+			position, tokenIndex = position1555, tokenIndex1555
+			return false
+		},
+		/* 102 StreamSchemaOpt <- <(<(spOpt SchemaFields)?> Action78)> */
+		func() bool {
+			position1558, tokenIndex1558 := position, tokenIndex
+			{
+				position1559 := position
+				{
+					position1560 := position
+					{
+						position1561, tokenIndex1561 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1561
+						}
+						if !_rules[ruleSchemaFields]() {
+							goto l1561
+						}
+						goto l1562
+					l1561:
+						position, tokenIndex = position1561, tokenIndex1561
+					}
+				l1562:
+					add(rulePegText, position1560)
+				}
+				if !_rules[ruleAction78]() {
+					goto l1558
+				}
+				add(ruleStreamSchemaOpt, position1559)
+			}
+			return true
+		l1558:
+			position, tokenIndex = position1558, tokenIndex1558
+			return false
+		},
+		/* 103 SourceSchemaOpt <- <(<(sp (('s' / 'S') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('m' / 'M') ('a' / 'A')) spOpt SchemaFields)?> Action79)> */
+		func() bool {
+			position1563, tokenIndex1563 := position, tokenIndex
+			{
+				position1564 := position
+				{
+					position1565 := position
+					{
+						position1566, tokenIndex1566 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1566
+						}
+						{
+							position1568, tokenIndex1568 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1569
+							}
+							position++
+							goto l1568
+						l1569:
+							position, tokenIndex = position1568, tokenIndex1568
+							if buffer[position] != rune('S') {
+								goto l1566
+							}
+							position++
+						}
+					l1568:
+						{
+							position1570, tokenIndex1570 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l1571
+							}
+							position++
+							goto l1570
+						l1571:
+							position, tokenIndex = position1570, tokenIndex1570
+							if buffer[position] != rune('C') {
+								goto l1566
+							}
+							position++
+						}
+					l1570:
+						{
+							position1572, tokenIndex1572 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1573
+							}
+							position++
+							goto l1572
+						l1573:
+							position, tokenIndex = position1572, tokenIndex1572
+							if buffer[position] != rune('H') {
+								goto l1566
+							}
+							position++
+						}
+					l1572:
+						{
+							position1574, tokenIndex1574 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1575
+							}
+							position++
+							goto l1574
+						l1575:
+							position, tokenIndex = position1574, tokenIndex1574
+							if buffer[position] != rune('E') {
+								goto l1566
+							}
+							position++
+						}
+					l1574:
+						{
+							position1576, tokenIndex1576 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1577
+							}
+							position++
+							goto l1576
+						l1577:
+							position, tokenIndex = position1576, tokenIndex1576
+							if buffer[position] != rune('M') {
+								goto l1566
+							}
+							position++
+						}
+					l1576:
+						{
+							position1578, tokenIndex1578 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1579
+							}
+							position++
+							goto l1578
+						l1579:
+							position, tokenIndex = position1578, tokenIndex1578
+							if buffer[position] != rune('A') {
+								goto l1566
+							}
+							position++
+						}
+					l1578:
+						if !_rules[rulespOpt]() {
+							goto l1566
+						}
+						if !_rules[ruleSchemaFields]() {
+							goto l1566
+						}
+						goto l1567
+					l1566:
+						position, tokenIndex = position1566, tokenIndex1566
+					}
+				l1567:
+					add(rulePegText, position1565)
+				}
+				if !_rules[ruleAction79]() {
+					goto l1563
+				}
+				add(ruleSourceSchemaOpt, position1564)
+			}
+			return true
+		l1563:
+			position, tokenIndex = position1563, tokenIndex1563
+			return false
+		},
+		/* 104 SchemaFields <- <('(' spOpt SchemaField (spOpt ',' spOpt SchemaField)* spOpt ')')> */
+		func() bool {
+			position1580, tokenIndex1580 := position, tokenIndex
+			{
+				position1581 := position
+				if buffer[position] != rune('(') {
+					goto l1580
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1580
+				}
+				if !_rules[ruleSchemaField]() {
+					goto l1580
+				}
+			l1582:
+				{
+					position1583, tokenIndex1583 := position, tokenIndex
+					if !_rules[rulespOpt]() {
+						goto l1583
+					}
+					if buffer[position] != rune(',') {
+						goto l1583
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1583
+					}
+					if !_rules[ruleSchemaField]() {
+						goto l1583
+					}
+					goto l1582
+				l1583:
+					position, tokenIndex = position1583, tokenIndex1583
+				}
+				if !_rules[rulespOpt]() {
+					goto l1580
+				}
+				if buffer[position] != rune(')') {
+					goto l1580
+				}
+				position++
+				add(ruleSchemaFields, position1581)
+			}
+			return true
+		l1580:
+			position, tokenIndex = position1580, tokenIndex1580
+			return false
+		},
+		/* 105 SchemaField <- <(Identifier sp Type NotNullOpt Action80)> */
+		func() bool {
+			position1584, tokenIndex1584 := position, tokenIndex
+			{
+				position1585 := position
+				if !_rules[ruleIdentifier]() {
+					goto l1584
+				}
+				if !_rules[rulesp]() {
+					goto l1584
+				}
+				if !_rules[ruleType]() {
+					goto l1584
+				}
+				if !_rules[ruleNotNullOpt]() {
+					goto l1584
+				}
+				if !_rules[ruleAction80]() {
+					goto l1584
+				}
+				add(ruleSchemaField, position1585)
+			}
+			return true
+		l1584:
+			position, tokenIndex = position1584, tokenIndex1584
+			return false
+		},
+		/* 106 NotNullOpt <- <(<(sp NotNull)?> Action81)> */
+		func() bool {
+			position1586, tokenIndex1586 := position, tokenIndex
+			{
+				position1587 := position
+				{
+					position1588 := position
+					{
+						position1589, tokenIndex1589 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1589
+						}
+						if !_rules[ruleNotNull]() {
+							goto l1589
+						}
+						goto l1590
+					l1589:
+						position, tokenIndex = position1589, tokenIndex1589
+					}
+				l1590:
+					add(rulePegText, position1588)
+				}
+				if !_rules[ruleAction81]() {
+					goto l1586
+				}
+				add(ruleNotNullOpt, position1587)
+			}
+			return true
+		l1586:
+			position, tokenIndex = position1586, tokenIndex1586
+			return false
+		},
+		/* 107 NotNull <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L')))> Action82)> */
+		func() bool {
+			position1591, tokenIndex1591 := position, tokenIndex
+			{
+				position1592 := position
+				{
+					position1593 := position
+					{
+						position1594, tokenIndex1594 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1595
+						}
+						position++
+						goto l1594
+					l1595:
+						position, tokenIndex = position1594, tokenIndex1594
+						if buffer[position] != rune('N') {
+							goto l1591
+						}
+						position++
+					}
+				l1594:
+					{
+						position1596, tokenIndex1596 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1597
+						}
+						position++
+						goto l1596
+					l1597:
+						position, tokenIndex = position1596, tokenIndex1596
+						if buffer[position] != rune('O') {
+							goto l1591
+						}
+						position++
+					}
+				l1596:
+					{
+						position1598, tokenIndex1598 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1599
+						}
+						position++
+						goto l1598
+					l1599:
+						position, tokenIndex = position1598, tokenIndex1598
+						if buffer[position] != rune('T') {
+							goto l1591
+						}
+						position++
+					}
+				l1598:
+					if !_rules[rulesp]() {
+						goto l1591
+					}
+					{
+						position1600, tokenIndex1600 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1601
+						}
+						position++
+						goto l1600
+					l1601:
+						position, tokenIndex = position1600, tokenIndex1600
+						if buffer[position] != rune('N') {
+							goto l1591
+						}
+						position++
+					}
+				l1600:
+					{
+						position1602, tokenIndex1602 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1603
+						}
+						position++
+						goto l1602
+					l1603:
+						position, tokenIndex = position1602, tokenIndex1602
+						if buffer[position] != rune('U') {
+							goto l1591
+						}
+						position++
+					}
+				l1602:
+					{
+						position1604, tokenIndex1604 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1605
+						}
+						position++
+						goto l1604
+					l1605:
+						position, tokenIndex = position1604, tokenIndex1604
+						if buffer[position] != rune('L') {
+							goto l1591
+						}
+						position++
+					}
+				l1604:
+					{
+						position1606, tokenIndex1606 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1607
+						}
+						position++
+						goto l1606
+					l1607:
+						position, tokenIndex = position1606, tokenIndex1606
+						if buffer[position] != rune('L') {
+							goto l1591
+						}
+						position++
+					}
+				l1606:
+					add(rulePegText, position1593)
+				}
+				if !_rules[ruleAction82]() {
+					goto l1591
+				}
+				add(ruleNotNull, position1592)
+			}
+			return true
+		l1591:
+			position, tokenIndex = position1591, tokenIndex1591
+			return false
+		},
+		/* 108 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action83)> */
+		func() bool {
+			position1608, tokenIndex1608 := position, tokenIndex
+			{
+				position1609 := position
+				{
+					position1610 := position
+					{
+						position1611, tokenIndex1611 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1611
+						}
+						{
+							position1613, tokenIndex1613 := position, tokenIndex
+							if !_rules[rulePaused]() {
+								goto l1614
+							}
+							goto l1613
+						l1614:
+							position, tokenIndex = position1613, tokenIndex1613
+							if !_rules[ruleUnpaused]() {
+								goto l1611
+							}
+						}
+					l1613:
+						goto l1612
+					l1611:
+						position, tokenIndex = position1611, tokenIndex1611
+					}
+				l1612:
+					add(rulePegText, position1610)
+				}
+				if !_rules[ruleAction83]() {
+					goto l1608
+				}
+				add(rulePausedOpt, position1609)
+			}
+			return true
+		l1608:
+			position, tokenIndex = position1608, tokenIndex1608
+			return false
+		},
+		/* 109 ExpressionOrWildcard <- <(Wildcard / Expression)> */
+		func() bool {
+			position1615, tokenIndex1615 := position, tokenIndex
+			{
+				position1616 := position
+				{
+					position1617, tokenIndex1617 := position, tokenIndex
+					if !_rules[ruleWildcard]() {
+						goto l1618
+					}
+					goto l1617
+				l1618:
+					position, tokenIndex = position1617, tokenIndex1617
+					if !_rules[ruleExpression]() {
+						goto l1615
+					}
+				}
+			l1617:
+				add(ruleExpressionOrWildcard, position1616)
+			}
+			return true
+		l1615:
+			position, tokenIndex = position1615, tokenIndex1615
+			return false
+		},
+		/* 110 Expression <- <orExpr> */
+		func() bool {
+			position1619, tokenIndex1619 := position, tokenIndex
+			{
+				position1620 := position
+				if !_rules[ruleorExpr]() {
+					goto l1619
+				}
+				add(ruleExpression, position1620)
+			}
+			return true
+		l1619:
+			position, tokenIndex = position1619, tokenIndex1619
+			return false
+		},
+		/* 111 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action84)> */
+		func() bool {
+			position1621, tokenIndex1621 := position, tokenIndex
+			{
+				position1622 := position
+				{
+					position1623 := position
+					if !_rules[ruleandExpr]() {
+						goto l1621
+					}
+				l1624:
+					{
+						position1625, tokenIndex1625 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1625
+						}
+						if !_rules[ruleOr]() {
+							goto l1625
+						}
+						if !_rules[rulesp]() {
+							goto l1625
+						}
+						if !_rules[ruleandExpr]() {
+							goto l1625
+						}
+						goto l1624
+					l1625:
+						position, tokenIndex = position1625, tokenIndex1625
+					}
+					add(rulePegText, position1623)
+				}
+				if !_rules[ruleAction84]() {
+					goto l1621
+				}
+				add(ruleorExpr, position1622)
+			}
+			return true
+		l1621:
+			position, tokenIndex = position1621, tokenIndex1621
+			return false
+		},
+		/* 112 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action85)> */
+		func() bool {
+			position1626, tokenIndex1626 := position, tokenIndex
+			{
+				position1627 := position
+				{
+					position1628 := position
+					if !_rules[rulenotExpr]() {
+						goto l1626
+					}
+				l1629:
+					{
+						position1630, tokenIndex1630 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1630
+						}
+						if !_rules[ruleAnd]() {
+							goto l1630
+						}
+						if !_rules[rulesp]() {
+							goto l1630
+						}
+						if !_rules[rulenotExpr]() {
+							goto l1630
+						}
+						goto l1629
+					l1630:
+						position, tokenIndex = position1630, tokenIndex1630
+					}
+					add(rulePegText, position1628)
+				}
+				if !_rules[ruleAction85]() {
+					goto l1626
+				}
+				add(ruleandExpr, position1627)
+			}
+			return true
+		l1626:
+			position, tokenIndex = position1626, tokenIndex1626
+			return false
+		},
+		/* 113 notExpr <- <(<((Not sp)? comparisonExpr)> Action86)> */
+		func() bool {
+			position1631, tokenIndex1631 := position, tokenIndex
+			{
+				position1632 := position
+				{
+					position1633 := position
+					{
+						position1634, tokenIndex1634 := position, tokenIndex
+						if !_rules[ruleNot]() {
+							goto l1634
+						}
+						if !_rules[rulesp]() {
+							goto l1634
+						}
+						goto l1635
+					l1634:
+						position, tokenIndex = position1634, tokenIndex1634
+					}
+				l1635:
+					if !_rules[rulecomparisonExpr]() {
+						goto l1631
+					}
+					add(rulePegText, position1633)
+				}
+				if !_rules[ruleAction86]() {
+					goto l1631
+				}
+				add(rulenotExpr, position1632)
+			}
+			return true
+		l1631:
+			position, tokenIndex = position1631, tokenIndex1631
+			return false
+		},
+		/* 114 comparisonExpr <- <(<(otherOpExpr ((spOpt ComparisonOp spOpt otherOpExpr) / (sp PatternMatchOp sp otherOpExpr) / (sp InOp spOpt InList) / (sp InOp sp otherOpExpr) / (sp BetweenOp sp otherOpExpr sp (('a' / 'A') ('n' / 'N') ('d' / 'D')) sp otherOpExpr))?)> Action87)> */
+		func() bool {
+			position1636, tokenIndex1636 := position, tokenIndex
+			{
+				position1637 := position
+				{
+					position1638 := position
+					if !_rules[ruleotherOpExpr]() {
+						goto l1636
+					}
+					{
+						position1639, tokenIndex1639 := position, tokenIndex
+						{
+							position1641, tokenIndex1641 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1642
+							}
+							if !_rules[ruleComparisonOp]() {
+								goto l1642
+							}
+							if !_rules[rulespOpt]() {
+								goto l1642
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1642
+							}
+							goto l1641
+						l1642:
+							position, tokenIndex = position1641, tokenIndex1641
+							if !_rules[rulesp]() {
+								goto l1643
+							}
+							if !_rules[rulePatternMatchOp]() {
+								goto l1643
+							}
+							if !_rules[rulesp]() {
+								goto l1643
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1643
+							}
+							goto l1641
+						l1643:
+							position, tokenIndex = position1641, tokenIndex1641
+							if !_rules[rulesp]() {
+								goto l1644
+							}
+							if !_rules[ruleInOp]() {
+								goto l1644
+							}
+							if !_rules[rulespOpt]() {
+								goto l1644
+							}
+							if !_rules[ruleInList]() {
+								goto l1644
+							}
+							goto l1641
+						l1644:
+							position, tokenIndex = position1641, tokenIndex1641
+							if !_rules[rulesp]() {
+								goto l1645
+							}
+							if !_rules[ruleInOp]() {
+								goto l1645
+							}
+							if !_rules[rulesp]() {
+								goto l1645
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1645
+							}
+							goto l1641
+						l1645:
+							position, tokenIndex = position1641, tokenIndex1641
+							if !_rules[rulesp]() {
+								goto l1639
+							}
+							if !_rules[ruleBetweenOp]() {
+								goto l1639
+							}
+							if !_rules[rulesp]() {
+								goto l1639
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1639
+							}
+							if !_rules[rulesp]() {
+								goto l1639
+							}
+							{
+								position1646, tokenIndex1646 := position, tokenIndex
+								if buffer[position] != rune('a') {
+									goto l1647
+								}
+								position++
+								goto l1646
+							l1647:
+								position, tokenIndex = position1646, tokenIndex1646
+								if buffer[position] != rune('A') {
+									goto l1639
+								}
+								position++
+							}
+						l1646:
+							{
+								position1648, tokenIndex1648 := position, tokenIndex
+								if buffer[position] != rune('n') {
+									goto l1649
+								}
+								position++
+								goto l1648
+							l1649:
+								position, tokenIndex = position1648, tokenIndex1648
+								if buffer[position] != rune('N') {
+									goto l1639
+								}
+								position++
+							}
+						l1648:
+							{
+								position1650, tokenIndex1650 := position, tokenIndex
+								if buffer[position] != rune('d') {
+									goto l1651
+								}
+								position++
+								goto l1650
+							l1651:
+								position, tokenIndex = position1650, tokenIndex1650
+								if buffer[position] != rune('D') {
+									goto l1639
+								}
+								position++
+							}
+						l1650:
+							if !_rules[rulesp]() {
+								goto l1639
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1639
+							}
+						}
+					l1641:
+						goto l1640
+					l1639:
+						position, tokenIndex = position1639, tokenIndex1639
+					}
+				l1640:
+					add(rulePegText, position1638)
+				}
+				if !_rules[ruleAction87]() {
+					goto l1636
+				}
+				add(rulecomparisonExpr, position1637)
+			}
+			return true
+		l1636:
+			position, tokenIndex = position1636, tokenIndex1636
+			return false
+		},
+		/* 115 InList <- <(<('(' spOpt Expression (spOpt ',' spOpt Expression)* spOpt ')')> Action88)> */
+		func() bool {
+			position1652, tokenIndex1652 := position, tokenIndex
+			{
+				position1653 := position
+				{
+					position1654 := position
+					if buffer[position] != rune('(') {
+						goto l1652
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1652
+					}
+					if !_rules[ruleExpression]() {
+						goto l1652
+					}
+				l1655:
+					{
+						position1656, tokenIndex1656 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1656
+						}
+						if buffer[position] != rune(',') {
+							goto l1656
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1656
+						}
+						if !_rules[ruleExpression]() {
+							goto l1656
+						}
+						goto l1655
+					l1656:
+						position, tokenIndex = position1656, tokenIndex1656
+					}
+					if !_rules[rulespOpt]() {
+						goto l1652
+					}
+					if buffer[position] != rune(')') {
+						goto l1652
+					}
+					position++
+					add(rulePegText, position1654)
+				}
+				if !_rules[ruleAction88]() {
+					goto l1652
+				}
+				add(ruleInList, position1653)
+			}
+			return true
+		l1652:
+			position, tokenIndex = position1652, tokenIndex1652
+			return false
+		},
+		/* 116 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action89)> */
+		func() bool {
+			position1657, tokenIndex1657 := position, tokenIndex
+			{
+				position1658 := position
+				{
+					position1659 := position
+					if !_rules[ruleisExpr]() {
+						goto l1657
+					}
+				l1660:
+					{
+						position1661, tokenIndex1661 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1661
+						}
+						if !_rules[ruleOtherOp]() {
+							goto l1661
+						}
+						if !_rules[rulespOpt]() {
+							goto l1661
+						}
+						if !_rules[ruleisExpr]() {
+							goto l1661
+						}
+						goto l1660
+					l1661:
+						position, tokenIndex = position1661, tokenIndex1661
+					}
+					add(rulePegText, position1659)
+				}
+				if !_rules[ruleAction89]() {
+					goto l1657
+				}
+				add(ruleotherOpExpr, position1658)
+			}
+			return true
+		l1657:
+			position, tokenIndex = position1657, tokenIndex1657
+			return false
+		},
+		/* 117 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action90)> */
+		func() bool {
+			position1662, tokenIndex1662 := position, tokenIndex
+			{
+				position1663 := position
+				{
+					position1664 := position
+					{
+						position1665, tokenIndex1665 := position, tokenIndex
+						if !_rules[ruleRowValue]() {
+							goto l1666
+						}
+						if !_rules[rulesp]() {
+							goto l1666
+						}
+						if !_rules[ruleIsOp]() {
+							goto l1666
+						}
+						if !_rules[rulesp]() {
+							goto l1666
+						}
+						if !_rules[ruleMissing]() {
+							goto l1666
+						}
+						goto l1665
+					l1666:
+						position, tokenIndex = position1665, tokenIndex1665
+						if !_rules[ruletermExpr]() {
+							goto l1662
+						}
+						{
+							position1667, tokenIndex1667 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l1667
+							}
+							if !_rules[ruleIsOp]() {
+								goto l1667
+							}
+							if !_rules[rulesp]() {
+								goto l1667
+							}
+							if !_rules[ruleNullLiteral]() {
+								goto l1667
+							}
+							goto l1668
+						l1667:
+							position, tokenIndex = position1667, tokenIndex1667
+						}
+					l1668:
+					}
+				l1665:
+					add(rulePegText, position1664)
+				}
+				if !_rules[ruleAction90]() {
+					goto l1662
+				}
+				add(ruleisExpr, position1663)
+			}
+			return true
+		l1662:
+			position, tokenIndex = position1662, tokenIndex1662
+			return false
+		},
+		/* 118 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action91)> */
+		func() bool {
+			position1669, tokenIndex1669 := position, tokenIndex
+			{
+				position1670 := position
+				{
+					position1671 := position
+					if !_rules[ruleproductExpr]() {
+						goto l1669
+					}
+				l1672:
+					{
+						position1673, tokenIndex1673 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1673
+						}
+						if !_rules[rulePlusMinusOp]() {
+							goto l1673
+						}
+						if !_rules[rulespOpt]() {
+							goto l1673
+						}
+						if !_rules[ruleproductExpr]() {
+							goto l1673
+						}
+						goto l1672
+					l1673:
+						position, tokenIndex = position1673, tokenIndex1673
+					}
+					add(rulePegText, position1671)
+				}
+				if !_rules[ruleAction91]() {
+					goto l1669
+				}
+				add(ruletermExpr, position1670)
+			}
+			return true
+		l1669:
+			position, tokenIndex = position1669, tokenIndex1669
+			return false
+		},
+		/* 119 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action92)> */
+		func() bool {
+			position1674, tokenIndex1674 := position, tokenIndex
+			{
+				position1675 := position
+				{
+					position1676 := position
+					if !_rules[ruleminusExpr]() {
+						goto l1674
+					}
+				l1677:
+					{
+						position1678, tokenIndex1678 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1678
+						}
+						if !_rules[ruleMultDivOp]() {
+							goto l1678
+						}
+						if !_rules[rulespOpt]() {
+							goto l1678
+						}
+						if !_rules[ruleminusExpr]() {
+							goto l1678
+						}
+						goto l1677
+					l1678:
+						position, tokenIndex = position1678, tokenIndex1678
+					}
+					add(rulePegText, position1676)
+				}
+				if !_rules[ruleAction92]() {
+					goto l1674
+				}
+				add(ruleproductExpr, position1675)
+			}
+			return true
+		l1674:
+			position, tokenIndex = position1674, tokenIndex1674
+			return false
+		},
+		/* 120 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action93)> */
+		func() bool {
+			position1679, tokenIndex1679 := position, tokenIndex
+			{
+				position1680 := position
+				{
+					position1681 := position
+					{
+						position1682, tokenIndex1682 := position, tokenIndex
+						if !_rules[ruleUnaryMinus]() {
+							goto l1682
+						}
+						if !_rules[rulespOpt]() {
+							goto l1682
+						}
+						goto l1683
+					l1682:
+						position, tokenIndex = position1682, tokenIndex1682
+					}
+				l1683:
+					if !_rules[rulecastExpr]() {
+						goto l1679
+					}
+					add(rulePegText, position1681)
+				}
+				if !_rules[ruleAction93]() {
+					goto l1679
+				}
+				add(ruleminusExpr, position1680)
+			}
+			return true
+		l1679:
+			position, tokenIndex = position1679, tokenIndex1679
+			return false
+		},
+		/* 121 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action94)> */
+		func() bool {
+			position1684, tokenIndex1684 := position, tokenIndex
+			{
+				position1685 := position
+				{
+					position1686 := position
+					if !_rules[rulebaseExpr]() {
+						goto l1684
+					}
+					{
+						position1687, tokenIndex1687 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1687
+						}
+						if buffer[position] != rune(':') {
+							goto l1687
+						}
+						position++
+						if buffer[position] != rune(':') {
+							goto l1687
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1687
+						}
+						if !_rules[ruleType]() {
+							goto l1687
+						}
+						goto l1688
+					l1687:
+						position, tokenIndex = position1687, tokenIndex1687
+					}
+				l1688:
+					add(rulePegText, position1686)
+				}
+				if !_rules[ruleAction94]() {
+					goto l1684
+				}
+				add(rulecastExpr, position1685)
+			}
+			return true
+		l1684:
+			position, tokenIndex = position1684, tokenIndex1684
+			return false
+		},
+		/* 122 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / AnalyticFuncApp / FuncAppSelector / FuncApp / RowValue / ArrayExpr / Literal)> */
+		func() bool {
+			position1689, tokenIndex1689 := position, tokenIndex
+			{
+				position1690 := position
+				{
+					position1691, tokenIndex1691 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l1692
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1692
+					}
+					if !_rules[ruleExpression]() {
+						goto l1692
+					}
+					if !_rules[rulespOpt]() {
+						goto l1692
+					}
+					if buffer[position] != rune(')') {
+						goto l1692
+					}
+					position++
+					goto l1691
+				l1692:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleMapExpr]() {
+						goto l1693
+					}
+					goto l1691
+				l1693:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1694
+					}
+					goto l1691
+				l1694:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleNullLiteral]() {
+						goto l1695
+					}
+					goto l1691
+				l1695:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleCase]() {
+						goto l1696
+					}
+					goto l1691
+				l1696:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleRowMeta]() {
+						goto l1697
+					}
+					goto l1691
+				l1697:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleFuncTypeCast]() {
+						goto l1698
+					}
+					goto l1691
+				l1698:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleAnalyticFuncApp]() {
+						goto l1699
+					}
+					goto l1691
+				l1699:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleFuncAppSelector]() {
+						goto l1700
+					}
+					goto l1691
+				l1700:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleFuncApp]() {
+						goto l1701
+					}
+					goto l1691
+				l1701:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleRowValue]() {
+						goto l1702
+					}
+					goto l1691
+				l1702:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleArrayExpr]() {
+						goto l1703
+					}
+					goto l1691
+				l1703:
+					position, tokenIndex = position1691, tokenIndex1691
+					if !_rules[ruleLiteral]() {
+						goto l1689
+					}
+				}
+			l1691:
+				add(rulebaseExpr, position1690)
+			}
+			return true
+		l1689:
+			position, tokenIndex = position1689, tokenIndex1689
+			return false
+		},
+		/* 123 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action95)> */
+		func() bool {
+			position1704, tokenIndex1704 := position, tokenIndex
+			{
+				position1705 := position
+				{
+					position1706 := position
+					{
+						position1707, tokenIndex1707 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1708
+						}
+						position++
+						goto l1707
+					l1708:
+						position, tokenIndex = position1707, tokenIndex1707
+						if buffer[position] != rune('C') {
+							goto l1704
+						}
+						position++
+					}
+				l1707:
+					{
+						position1709, tokenIndex1709 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1710
+						}
+						position++
+						goto l1709
+					l1710:
+						position, tokenIndex = position1709, tokenIndex1709
+						if buffer[position] != rune('A') {
+							goto l1704
+						}
+						position++
+					}
+				l1709:
+					{
+						position1711, tokenIndex1711 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1712
+						}
+						position++
+						goto l1711
+					l1712:
+						position, tokenIndex = position1711, tokenIndex1711
+						if buffer[position] != rune('S') {
+							goto l1704
+						}
+						position++
+					}
+				l1711:
+					{
+						position1713, tokenIndex1713 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1714
+						}
+						position++
+						goto l1713
+					l1714:
+						position, tokenIndex = position1713, tokenIndex1713
+						if buffer[position] != rune('T') {
+							goto l1704
+						}
+						position++
+					}
+				l1713:
+					if !_rules[rulespOpt]() {
+						goto l1704
+					}
+					if buffer[position] != rune('(') {
+						goto l1704
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1704
+					}
+					if !_rules[ruleExpression]() {
+						goto l1704
+					}
+					if !_rules[rulesp]() {
+						goto l1704
+					}
+					{
+						position1715, tokenIndex1715 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1716
+						}
+						position++
+						goto l1715
+					l1716:
+						position, tokenIndex = position1715, tokenIndex1715
+						if buffer[position] != rune('A') {
+							goto l1704
+						}
+						position++
+					}
+				l1715:
+					{
+						position1717, tokenIndex1717 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1718
+						}
+						position++
+						goto l1717
+					l1718:
+						position, tokenIndex = position1717, tokenIndex1717
+						if buffer[position] != rune('S') {
+							goto l1704
+						}
+						position++
+					}
+				l1717:
+					if !_rules[rulesp]() {
+						goto l1704
+					}
+					if !_rules[ruleType]() {
+						goto l1704
+					}
+					if !_rules[rulespOpt]() {
+						goto l1704
+					}
+					if buffer[position] != rune(')') {
+						goto l1704
+					}
+					position++
+					add(rulePegText, position1706)
+				}
+				if !_rules[ruleAction95]() {
+					goto l1704
+				}
+				add(ruleFuncTypeCast, position1705)
+			}
+			return true
+		l1704:
+			position, tokenIndex = position1704, tokenIndex1704
+			return false
+		},
+		/* 124 FuncApp <- <((FuncAppWithOrderBy / FuncAppWithoutOrderBy) FuncFilter Action96)> */
+		func() bool {
+			position1719, tokenIndex1719 := position, tokenIndex
+			{
+				position1720 := position
+				{
+					position1721, tokenIndex1721 := position, tokenIndex
+					if !_rules[ruleFuncAppWithOrderBy]() {
+						goto l1722
+					}
+					goto l1721
+				l1722:
+					position, tokenIndex = position1721, tokenIndex1721
+					if !_rules[ruleFuncAppWithoutOrderBy]() {
+						goto l1719
+					}
+				}
+			l1721:
+				if !_rules[ruleFuncFilter]() {
+					goto l1719
+				}
+				if !_rules[ruleAction96]() {
+					goto l1719
+				}
+				add(ruleFuncApp, position1720)
+			}
+			return true
+		l1719:
+			position, tokenIndex = position1719, tokenIndex1719
+			return false
+		},
+		/* 125 FuncFilter <- <(<(spOpt (('f' / 'F') ('i' / 'I') ('l' / 'L') ('t' / 'T') ('e' / 'E') ('r' / 'R')) spOpt '(' spOpt (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression spOpt ')')?> Action97)> */
+		func() bool {
+			position1723, tokenIndex1723 := position, tokenIndex
+			{
+				position1724 := position
+				{
+					position1725 := position
+					{
+						position1726, tokenIndex1726 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1726
+						}
+						{
+							position1728, tokenIndex1728 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1729
+							}
+							position++
+							goto l1728
+						l1729:
+							position, tokenIndex = position1728, tokenIndex1728
+							if buffer[position] != rune('F') {
+								goto l1726
+							}
+							position++
+						}
+					l1728:
+						{
+							position1730, tokenIndex1730 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1731
+							}
+							position++
+							goto l1730
+						l1731:
+							position, tokenIndex = position1730, tokenIndex1730
+							if buffer[position] != rune('I') {
+								goto l1726
+							}
+							position++
+						}
+					l1730:
+						{
+							position1732, tokenIndex1732 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1733
+							}
+							position++
+							goto l1732
+						l1733:
+							position, tokenIndex = position1732, tokenIndex1732
+							if buffer[position] != rune('L') {
+								goto l1726
+							}
+							position++
+						}
+					l1732:
+						{
+							position1734, tokenIndex1734 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1735
+							}
+							position++
+							goto l1734
+						l1735:
+							position, tokenIndex = position1734, tokenIndex1734
+							if buffer[position] != rune('T') {
+								goto l1726
+							}
+							position++
+						}
+					l1734:
+						{
+							position1736, tokenIndex1736 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1737
+							}
+							position++
+							goto l1736
+						l1737:
+							position, tokenIndex = position1736, tokenIndex1736
+							if buffer[position] != rune('E') {
+								goto l1726
+							}
+							position++
+						}
+					l1736:
+						{
+							position1738, tokenIndex1738 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1739
+							}
+							position++
+							goto l1738
+						l1739:
+							position, tokenIndex = position1738, tokenIndex1738
+							if buffer[position] != rune('R') {
+								goto l1726
+							}
+							position++
+						}
+					l1738:
+						if !_rules[rulespOpt]() {
+							goto l1726
+						}
+						if buffer[position] != rune('(') {
+							goto l1726
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1726
+						}
+						{
+							position1740, tokenIndex1740 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1741
+							}
+							position++
+							goto l1740
+						l1741:
+							position, tokenIndex = position1740, tokenIndex1740
+							if buffer[position] != rune('W') {
+								goto l1726
+							}
+							position++
+						}
+					l1740:
+						{
+							position1742, tokenIndex1742 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1743
+							}
+							position++
+							goto l1742
+						l1743:
+							position, tokenIndex = position1742, tokenIndex1742
+							if buffer[position] != rune('H') {
+								goto l1726
+							}
+							position++
+						}
+					l1742:
+						{
+							position1744, tokenIndex1744 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1745
+							}
+							position++
+							goto l1744
+						l1745:
+							position, tokenIndex = position1744, tokenIndex1744
+							if buffer[position] != rune('E') {
+								goto l1726
+							}
+							position++
+						}
+					l1744:
+						{
+							position1746, tokenIndex1746 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1747
+							}
+							position++
+							goto l1746
+						l1747:
+							position, tokenIndex = position1746, tokenIndex1746
+							if buffer[position] != rune('R') {
+								goto l1726
+							}
+							position++
+						}
+					l1746:
+						{
+							position1748, tokenIndex1748 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1749
+							}
+							position++
+							goto l1748
+						l1749:
+							position, tokenIndex = position1748, tokenIndex1748
+							if buffer[position] != rune('E') {
+								goto l1726
+							}
+							position++
+						}
+					l1748:
+						if !_rules[rulesp]() {
+							goto l1726
+						}
+						if !_rules[ruleExpression]() {
+							goto l1726
+						}
+						if !_rules[rulespOpt]() {
+							goto l1726
+						}
+						if buffer[position] != rune(')') {
+							goto l1726
+						}
+						position++
+						goto l1727
+					l1726:
+						position, tokenIndex = position1726, tokenIndex1726
+					}
+				l1727:
+					add(rulePegText, position1725)
+				}
+				if !_rules[ruleAction97]() {
+					goto l1723
+				}
+				add(ruleFuncFilter, position1724)
+			}
+			return true
+		l1723:
+			position, tokenIndex = position1723, tokenIndex1723
+			return false
+		},
+		/* 126 FuncAppSelector <- <(FuncApp FuncElemAccessor Action98)> */
+		func() bool {
+			position1750, tokenIndex1750 := position, tokenIndex
+			{
+				position1751 := position
+				if !_rules[ruleFuncApp]() {
+					goto l1750
+				}
+				if !_rules[ruleFuncElemAccessor]() {
+					goto l1750
+				}
+				if !_rules[ruleAction98]() {
+					goto l1750
+				}
+				add(ruleFuncAppSelector, position1751)
+			}
+			return true
+		l1750:
+			position, tokenIndex = position1750, tokenIndex1750
+			return false
+		},
+		/* 127 FuncElemAccessor <- <(<jsonGetPathNonHead+> Action99)> */
+		func() bool {
+			position1752, tokenIndex1752 := position, tokenIndex
+			{
+				position1753 := position
+				{
+					position1754 := position
+					if !_rules[rulejsonGetPathNonHead]() {
+						goto l1752
+					}
+				l1755:
+					{
+						position1756, tokenIndex1756 := position, tokenIndex
+						if !_rules[rulejsonGetPathNonHead]() {
+							goto l1756
+						}
+						goto l1755
+					l1756:
+						position, tokenIndex = position1756, tokenIndex1756
+					}
+					add(rulePegText, position1754)
+				}
+				if !_rules[ruleAction99]() {
+					goto l1752
+				}
+				add(ruleFuncElemAccessor, position1753)
+			}
+			return true
+		l1752:
+			position, tokenIndex = position1752, tokenIndex1752
+			return false
+		},
+		/* 128 AnalyticFuncApp <- <(FuncApp sp (('o' / 'O') ('v' / 'V') ('e' / 'E') ('r' / 'R')) spOpt '(' spOpt PartitionBy OverOrdering spOpt ')' Action100)> */
+		func() bool {
+			position1757, tokenIndex1757 := position, tokenIndex
+			{
+				position1758 := position
+				if !_rules[ruleFuncApp]() {
+					goto l1757
+				}
+				if !_rules[rulesp]() {
+					goto l1757
+				}
+				{
+					position1759, tokenIndex1759 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1760
+					}
+					position++
+					goto l1759
+				l1760:
+					position, tokenIndex = position1759, tokenIndex1759
+					if buffer[position] != rune('O') {
+						goto l1757
+					}
+					position++
+				}
+			l1759:
+				{
+					position1761, tokenIndex1761 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l1762
+					}
+					position++
+					goto l1761
+				l1762:
+					position, tokenIndex = position1761, tokenIndex1761
+					if buffer[position] != rune('V') {
+						goto l1757
+					}
+					position++
+				}
+			l1761:
+				{
+					position1763, tokenIndex1763 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1764
+					}
+					position++
+					goto l1763
+				l1764:
+					position, tokenIndex = position1763, tokenIndex1763
+					if buffer[position] != rune('E') {
+						goto l1757
+					}
+					position++
+				}
+			l1763:
+				{
+					position1765, tokenIndex1765 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1766
 					}
-					goto l831
-				l832:
-					position, tokenIndex = position831, tokenIndex831
-					if !_rules[ruleMILLISECONDS]() {
-						goto l827
+					position++
+					goto l1765
+				l1766:
+					position, tokenIndex = position1765, tokenIndex1765
+					if buffer[position] != rune('R') {
+						goto l1757
+					}
+					position++
+				}
+			l1765:
+				if !_rules[rulespOpt]() {
+					goto l1757
+				}
+				if buffer[position] != rune('(') {
+					goto l1757
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1757
+				}
+				if !_rules[rulePartitionBy]() {
+					goto l1757
+				}
+				if !_rules[ruleOverOrdering]() {
+					goto l1757
+				}
+				if !_rules[rulespOpt]() {
+					goto l1757
+				}
+				if buffer[position] != rune(')') {
+					goto l1757
+				}
+				position++
+				if !_rules[ruleAction100]() {
+					goto l1757
+				}
+				add(ruleAnalyticFuncApp, position1758)
+			}
+			return true
+		l1757:
+			position, tokenIndex = position1757, tokenIndex1757
+			return false
+		},
+		/* 129 PartitionBy <- <(<(('p' / 'P') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('i' / 'I') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('b' / 'B') ('y' / 'Y')) sp Expression (spOpt ',' spOpt Expression)*)?> Action101)> */
+		func() bool {
+			position1767, tokenIndex1767 := position, tokenIndex
+			{
+				position1768 := position
+				{
+					position1769 := position
+					{
+						position1770, tokenIndex1770 := position, tokenIndex
+						{
+							position1772, tokenIndex1772 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1773
+							}
+							position++
+							goto l1772
+						l1773:
+							position, tokenIndex = position1772, tokenIndex1772
+							if buffer[position] != rune('P') {
+								goto l1770
+							}
+							position++
+						}
+					l1772:
+						{
+							position1774, tokenIndex1774 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1775
+							}
+							position++
+							goto l1774
+						l1775:
+							position, tokenIndex = position1774, tokenIndex1774
+							if buffer[position] != rune('A') {
+								goto l1770
+							}
+							position++
+						}
+					l1774:
+						{
+							position1776, tokenIndex1776 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1777
+							}
+							position++
+							goto l1776
+						l1777:
+							position, tokenIndex = position1776, tokenIndex1776
+							if buffer[position] != rune('R') {
+								goto l1770
+							}
+							position++
+						}
+					l1776:
+						{
+							position1778, tokenIndex1778 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1779
+							}
+							position++
+							goto l1778
+						l1779:
+							position, tokenIndex = position1778, tokenIndex1778
+							if buffer[position] != rune('T') {
+								goto l1770
+							}
+							position++
+						}
+					l1778:
+						{
+							position1780, tokenIndex1780 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1781
+							}
+							position++
+							goto l1780
+						l1781:
+							position, tokenIndex = position1780, tokenIndex1780
+							if buffer[position] != rune('I') {
+								goto l1770
+							}
+							position++
+						}
+					l1780:
+						{
+							position1782, tokenIndex1782 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1783
+							}
+							position++
+							goto l1782
+						l1783:
+							position, tokenIndex = position1782, tokenIndex1782
+							if buffer[position] != rune('T') {
+								goto l1770
+							}
+							position++
+						}
+					l1782:
+						{
+							position1784, tokenIndex1784 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1785
+							}
+							position++
+							goto l1784
+						l1785:
+							position, tokenIndex = position1784, tokenIndex1784
+							if buffer[position] != rune('I') {
+								goto l1770
+							}
+							position++
+						}
+					l1784:
+						{
+							position1786, tokenIndex1786 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1787
+							}
+							position++
+							goto l1786
+						l1787:
+							position, tokenIndex = position1786, tokenIndex1786
+							if buffer[position] != rune('O') {
+								goto l1770
+							}
+							position++
+						}
+					l1786:
+						{
+							position1788, tokenIndex1788 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1789
+							}
+							position++
+							goto l1788
+						l1789:
+							position, tokenIndex = position1788, tokenIndex1788
+							if buffer[position] != rune('N') {
+								goto l1770
+							}
+							position++
+						}
+					l1788:
+						if !_rules[rulesp]() {
+							goto l1770
+						}
+						{
+							position1790, tokenIndex1790 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1791
+							}
+							position++
+							goto l1790
+						l1791:
+							position, tokenIndex = position1790, tokenIndex1790
+							if buffer[position] != rune('B') {
+								goto l1770
+							}
+							position++
+						}
+					l1790:
+						{
+							position1792, tokenIndex1792 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1793
+							}
+							position++
+							goto l1792
+						l1793:
+							position, tokenIndex = position1792, tokenIndex1792
+							if buffer[position] != rune('Y') {
+								goto l1770
+							}
+							position++
+						}
+					l1792:
+						if !_rules[rulesp]() {
+							goto l1770
+						}
+						if !_rules[ruleExpression]() {
+							goto l1770
+						}
+					l1794:
+						{
+							position1795, tokenIndex1795 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1795
+							}
+							if buffer[position] != rune(',') {
+								goto l1795
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1795
+							}
+							if !_rules[ruleExpression]() {
+								goto l1795
+							}
+							goto l1794
+						l1795:
+							position, tokenIndex = position1795, tokenIndex1795
+						}
+						goto l1771
+					l1770:
+						position, tokenIndex = position1770, tokenIndex1770
+					}
+				l1771:
+					add(rulePegText, position1769)
+				}
+				if !_rules[ruleAction101]() {
+					goto l1767
+				}
+				add(rulePartitionBy, position1768)
+			}
+			return true
+		l1767:
+			position, tokenIndex = position1767, tokenIndex1767
+			return false
+		},
+		/* 130 OverOrdering <- <(<(spOpt (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action102)> */
+		func() bool {
+			position1796, tokenIndex1796 := position, tokenIndex
+			{
+				position1797 := position
+				{
+					position1798 := position
+					{
+						position1799, tokenIndex1799 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1799
+						}
+						{
+							position1801, tokenIndex1801 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1802
+							}
+							position++
+							goto l1801
+						l1802:
+							position, tokenIndex = position1801, tokenIndex1801
+							if buffer[position] != rune('O') {
+								goto l1799
+							}
+							position++
+						}
+					l1801:
+						{
+							position1803, tokenIndex1803 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1804
+							}
+							position++
+							goto l1803
+						l1804:
+							position, tokenIndex = position1803, tokenIndex1803
+							if buffer[position] != rune('R') {
+								goto l1799
+							}
+							position++
+						}
+					l1803:
+						{
+							position1805, tokenIndex1805 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1806
+							}
+							position++
+							goto l1805
+						l1806:
+							position, tokenIndex = position1805, tokenIndex1805
+							if buffer[position] != rune('D') {
+								goto l1799
+							}
+							position++
+						}
+					l1805:
+						{
+							position1807, tokenIndex1807 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1808
+							}
+							position++
+							goto l1807
+						l1808:
+							position, tokenIndex = position1807, tokenIndex1807
+							if buffer[position] != rune('E') {
+								goto l1799
+							}
+							position++
+						}
+					l1807:
+						{
+							position1809, tokenIndex1809 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1810
+							}
+							position++
+							goto l1809
+						l1810:
+							position, tokenIndex = position1809, tokenIndex1809
+							if buffer[position] != rune('R') {
+								goto l1799
+							}
+							position++
+						}
+					l1809:
+						if !_rules[rulesp]() {
+							goto l1799
+						}
+						{
+							position1811, tokenIndex1811 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1812
+							}
+							position++
+							goto l1811
+						l1812:
+							position, tokenIndex = position1811, tokenIndex1811
+							if buffer[position] != rune('B') {
+								goto l1799
+							}
+							position++
+						}
+					l1811:
+						{
+							position1813, tokenIndex1813 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1814
+							}
+							position++
+							goto l1813
+						l1814:
+							position, tokenIndex = position1813, tokenIndex1813
+							if buffer[position] != rune('Y') {
+								goto l1799
+							}
+							position++
+						}
+					l1813:
+						if !_rules[rulesp]() {
+							goto l1799
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1799
+						}
+					l1815:
+						{
+							position1816, tokenIndex1816 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1816
+							}
+							if buffer[position] != rune(',') {
+								goto l1816
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1816
+							}
+							if !_rules[ruleSortedExpression]() {
+								goto l1816
+							}
+							goto l1815
+						l1816:
+							position, tokenIndex = position1816, tokenIndex1816
+						}
+						goto l1800
+					l1799:
+						position, tokenIndex = position1799, tokenIndex1799
 					}
+				l1800:
+					add(rulePegText, position1798)
+				}
+				if !_rules[ruleAction102]() {
+					goto l1796
+				}
+				add(ruleOverOrdering, position1797)
+			}
+			return true
+		l1796:
+			position, tokenIndex = position1796, tokenIndex1796
+			return false
+		},
+		/* 131 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action103)> */
+		func() bool {
+			position1817, tokenIndex1817 := position, tokenIndex
+			{
+				position1818 := position
+				if !_rules[ruleFunction]() {
+					goto l1817
+				}
+				if !_rules[rulespOpt]() {
+					goto l1817
+				}
+				if buffer[position] != rune('(') {
+					goto l1817
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1817
 				}
-			l831:
-				if !_rules[ruleAction34]() {
-					goto l827
+				if !_rules[ruleFuncParams]() {
+					goto l1817
+				}
+				if !_rules[rulesp]() {
+					goto l1817
+				}
+				if !_rules[ruleParamsOrder]() {
+					goto l1817
+				}
+				if !_rules[rulespOpt]() {
+					goto l1817
 				}
-				add(ruleTimeInterval, position828)
+				if buffer[position] != rune(')') {
+					goto l1817
+				}
+				position++
+				if !_rules[ruleAction103]() {
+					goto l1817
+				}
+				add(ruleFuncAppWithOrderBy, position1818)
 			}
 			return true
-		l827:
-			position, tokenIndex = position827, tokenIndex827
+		l1817:
+			position, tokenIndex = position1817, tokenIndex1817
 			return false
 		},
-		/* 46 TuplesInterval <- <(NumericLiteral sp TUPLES Action35)> */
+		/* 132 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action104)> */
 		func() bool {
-			position833, tokenIndex833 := position, tokenIndex
+			position1819, tokenIndex1819 := position, tokenIndex
 			{
-				position834 := position
-				if !_rules[ruleNumericLiteral]() {
-					goto l833
+				position1820 := position
+				if !_rules[ruleFunction]() {
+					goto l1819
 				}
-				if !_rules[rulesp]() {
-					goto l833
+				if !_rules[rulespOpt]() {
+					goto l1819
 				}
-				if !_rules[ruleTUPLES]() {
-					goto l833
+				if buffer[position] != rune('(') {
+					goto l1819
 				}
-				if !_rules[ruleAction35]() {
-					goto l833
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1819
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1819
+				}
+				{
+					position1821 := position
+					if !_rules[rulespOpt]() {
+						goto l1819
+					}
+					add(rulePegText, position1821)
+				}
+				if buffer[position] != rune(')') {
+					goto l1819
+				}
+				position++
+				if !_rules[ruleAction104]() {
+					goto l1819
 				}
-				add(ruleTuplesInterval, position834)
+				add(ruleFuncAppWithoutOrderBy, position1820)
 			}
 			return true
-		l833:
-			position, tokenIndex = position833, tokenIndex833
+		l1819:
+			position, tokenIndex = position1819, tokenIndex1819
 			return false
 		},
-		/* 47 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
+		/* 133 FuncParams <- <(<(FuncParam (spOpt ',' spOpt FuncParam)*)?> Action105)> */
 		func() bool {
-			position835, tokenIndex835 := position, tokenIndex
+			position1822, tokenIndex1822 := position, tokenIndex
 			{
-				position836 := position
-				if !_rules[ruleRelationLike]() {
-					goto l835
+				position1823 := position
+				{
+					position1824 := position
+					{
+						position1825, tokenIndex1825 := position, tokenIndex
+						if !_rules[ruleFuncParam]() {
+							goto l1825
+						}
+					l1827:
+						{
+							position1828, tokenIndex1828 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1828
+							}
+							if buffer[position] != rune(',') {
+								goto l1828
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1828
+							}
+							if !_rules[ruleFuncParam]() {
+								goto l1828
+							}
+							goto l1827
+						l1828:
+							position, tokenIndex = position1828, tokenIndex1828
+						}
+						goto l1826
+					l1825:
+						position, tokenIndex = position1825, tokenIndex1825
+					}
+				l1826:
+					add(rulePegText, position1824)
+				}
+				if !_rules[ruleAction105]() {
+					goto l1822
 				}
-			l837:
+				add(ruleFuncParams, position1823)
+			}
+			return true
+		l1822:
+			position, tokenIndex = position1822, tokenIndex1822
+			return false
+		},
+		/* 134 FuncParam <- <(DistinctExpression / ExpressionOrWildcard)> */
+		func() bool {
+			position1829, tokenIndex1829 := position, tokenIndex
+			{
+				position1830 := position
 				{
-					position838, tokenIndex838 := position, tokenIndex
-					if !_rules[rulespOpt]() {
-						goto l838
+					position1831, tokenIndex1831 := position, tokenIndex
+					if !_rules[ruleDistinctExpression]() {
+						goto l1832
 					}
-					if buffer[position] != rune(',') {
-						goto l838
+					goto l1831
+				l1832:
+					position, tokenIndex = position1831, tokenIndex1831
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1829
 					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l838
+				}
+			l1831:
+				add(ruleFuncParam, position1830)
+			}
+			return true
+		l1829:
+			position, tokenIndex = position1829, tokenIndex1829
+			return false
+		},
+		/* 135 DistinctExpression <- <(<(('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T') sp Expression)> Action106)> */
+		func() bool {
+			position1833, tokenIndex1833 := position, tokenIndex
+			{
+				position1834 := position
+				{
+					position1835 := position
+					{
+						position1836, tokenIndex1836 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1837
+						}
+						position++
+						goto l1836
+					l1837:
+						position, tokenIndex = position1836, tokenIndex1836
+						if buffer[position] != rune('D') {
+							goto l1833
+						}
+						position++
 					}
-					if !_rules[ruleRelationLike]() {
-						goto l838
+				l1836:
+					{
+						position1838, tokenIndex1838 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1839
+						}
+						position++
+						goto l1838
+					l1839:
+						position, tokenIndex = position1838, tokenIndex1838
+						if buffer[position] != rune('I') {
+							goto l1833
+						}
+						position++
 					}
-					goto l837
-				l838:
-					position, tokenIndex = position838, tokenIndex838
+				l1838:
+					{
+						position1840, tokenIndex1840 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1841
+						}
+						position++
+						goto l1840
+					l1841:
+						position, tokenIndex = position1840, tokenIndex1840
+						if buffer[position] != rune('S') {
+							goto l1833
+						}
+						position++
+					}
+				l1840:
+					{
+						position1842, tokenIndex1842 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1843
+						}
+						position++
+						goto l1842
+					l1843:
+						position, tokenIndex = position1842, tokenIndex1842
+						if buffer[position] != rune('T') {
+							goto l1833
+						}
+						position++
+					}
+				l1842:
+					{
+						position1844, tokenIndex1844 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1845
+						}
+						position++
+						goto l1844
+					l1845:
+						position, tokenIndex = position1844, tokenIndex1844
+						if buffer[position] != rune('I') {
+							goto l1833
+						}
+						position++
+					}
+				l1844:
+					{
+						position1846, tokenIndex1846 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1847
+						}
+						position++
+						goto l1846
+					l1847:
+						position, tokenIndex = position1846, tokenIndex1846
+						if buffer[position] != rune('N') {
+							goto l1833
+						}
+						position++
+					}
+				l1846:
+					{
+						position1848, tokenIndex1848 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1849
+						}
+						position++
+						goto l1848
+					l1849:
+						position, tokenIndex = position1848, tokenIndex1848
+						if buffer[position] != rune('C') {
+							goto l1833
+						}
+						position++
+					}
+				l1848:
+					{
+						position1850, tokenIndex1850 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1851
+						}
+						position++
+						goto l1850
+					l1851:
+						position, tokenIndex = position1850, tokenIndex1850
+						if buffer[position] != rune('T') {
+							goto l1833
+						}
+						position++
+					}
+				l1850:
+					if !_rules[rulesp]() {
+						goto l1833
+					}
+					if !_rules[ruleExpression]() {
+						goto l1833
+					}
+					add(rulePegText, position1835)
 				}
-				add(ruleRelations, position836)
+				if !_rules[ruleAction106]() {
+					goto l1833
+				}
+				add(ruleDistinctExpression, position1834)
 			}
 			return true
-		l835:
-			position, tokenIndex = position835, tokenIndex835
+		l1833:
+			position, tokenIndex = position1833, tokenIndex1833
 			return false
 		},
-		/* 48 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action36)> */
+		/* 136 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action107)> */
 		func() bool {
-			position839, tokenIndex839 := position, tokenIndex
+			position1852, tokenIndex1852 := position, tokenIndex
 			{
-				position840 := position
+				position1853 := position
 				{
-					position841 := position
+					position1854 := position
 					{
-						position842, tokenIndex842 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l842
+						position1855, tokenIndex1855 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1856
 						}
-						{
-							position844, tokenIndex844 := position, tokenIndex
-							if buffer[position] != rune('w') {
-								goto l845
-							}
-							position++
-							goto l844
-						l845:
-							position, tokenIndex = position844, tokenIndex844
-							if buffer[position] != rune('W') {
-								goto l842
-							}
-							position++
+						position++
+						goto l1855
+					l1856:
+						position, tokenIndex = position1855, tokenIndex1855
+						if buffer[position] != rune('O') {
+							goto l1852
 						}
-					l844:
-						{
-							position846, tokenIndex846 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l847
-							}
-							position++
-							goto l846
-						l847:
-							position, tokenIndex = position846, tokenIndex846
-							if buffer[position] != rune('H') {
-								goto l842
-							}
-							position++
+						position++
+					}
+				l1855:
+					{
+						position1857, tokenIndex1857 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1858
 						}
-					l846:
-						{
-							position848, tokenIndex848 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l849
-							}
-							position++
-							goto l848
-						l849:
-							position, tokenIndex = position848, tokenIndex848
-							if buffer[position] != rune('E') {
-								goto l842
-							}
-							position++
+						position++
+						goto l1857
+					l1858:
+						position, tokenIndex = position1857, tokenIndex1857
+						if buffer[position] != rune('R') {
+							goto l1852
 						}
-					l848:
-						{
-							position850, tokenIndex850 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l851
-							}
-							position++
-							goto l850
-						l851:
-							position, tokenIndex = position850, tokenIndex850
-							if buffer[position] != rune('R') {
-								goto l842
-							}
-							position++
+						position++
+					}
+				l1857:
+					{
+						position1859, tokenIndex1859 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1860
 						}
-					l850:
-						{
-							position852, tokenIndex852 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l853
-							}
-							position++
-							goto l852
-						l853:
-							position, tokenIndex = position852, tokenIndex852
-							if buffer[position] != rune('E') {
-								goto l842
-							}
-							position++
+						position++
+						goto l1859
+					l1860:
+						position, tokenIndex = position1859, tokenIndex1859
+						if buffer[position] != rune('D') {
+							goto l1852
 						}
-					l852:
-						if !_rules[rulesp]() {
-							goto l842
+						position++
+					}
+				l1859:
+					{
+						position1861, tokenIndex1861 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1862
+						}
+						position++
+						goto l1861
+					l1862:
+						position, tokenIndex = position1861, tokenIndex1861
+						if buffer[position] != rune('E') {
+							goto l1852
+						}
+						position++
+					}
+				l1861:
+					{
+						position1863, tokenIndex1863 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1864
+						}
+						position++
+						goto l1863
+					l1864:
+						position, tokenIndex = position1863, tokenIndex1863
+						if buffer[position] != rune('R') {
+							goto l1852
+						}
+						position++
+					}
+				l1863:
+					if !_rules[rulesp]() {
+						goto l1852
+					}
+					{
+						position1865, tokenIndex1865 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1866
+						}
+						position++
+						goto l1865
+					l1866:
+						position, tokenIndex = position1865, tokenIndex1865
+						if buffer[position] != rune('B') {
+							goto l1852
+						}
+						position++
+					}
+				l1865:
+					{
+						position1867, tokenIndex1867 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l1868
+						}
+						position++
+						goto l1867
+					l1868:
+						position, tokenIndex = position1867, tokenIndex1867
+						if buffer[position] != rune('Y') {
+							goto l1852
+						}
+						position++
+					}
+				l1867:
+					if !_rules[rulesp]() {
+						goto l1852
+					}
+					if !_rules[ruleSortedExpression]() {
+						goto l1852
+					}
+				l1869:
+					{
+						position1870, tokenIndex1870 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1870
+						}
+						if buffer[position] != rune(',') {
+							goto l1870
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1870
 						}
-						if !_rules[ruleExpression]() {
-							goto l842
+						if !_rules[ruleSortedExpression]() {
+							goto l1870
 						}
-						goto l843
-					l842:
-						position, tokenIndex = position842, tokenIndex842
+						goto l1869
+					l1870:
+						position, tokenIndex = position1870, tokenIndex1870
 					}
-				l843:
-					add(rulePegText, position841)
+					add(rulePegText, position1854)
 				}
-				if !_rules[ruleAction36]() {
-					goto l839
+				if !_rules[ruleAction107]() {
+					goto l1852
+				}
+				add(ruleParamsOrder, position1853)
+			}
+			return true
+		l1852:
+			position, tokenIndex = position1852, tokenIndex1852
+			return false
+		},
+		/* 137 SortedExpression <- <(Expression OrderDirectionOpt Action108)> */
+		func() bool {
+			position1871, tokenIndex1871 := position, tokenIndex
+			{
+				position1872 := position
+				if !_rules[ruleExpression]() {
+					goto l1871
+				}
+				if !_rules[ruleOrderDirectionOpt]() {
+					goto l1871
+				}
+				if !_rules[ruleAction108]() {
+					goto l1871
 				}
-				add(ruleFilter, position840)
+				add(ruleSortedExpression, position1872)
 			}
 			return true
-		l839:
-			position, tokenIndex = position839, tokenIndex839
+		l1871:
+			position, tokenIndex = position1871, tokenIndex1871
 			return false
 		},
-		/* 49 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action37)> */
+		/* 138 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action109)> */
 		func() bool {
-			position854, tokenIndex854 := position, tokenIndex
+			position1873, tokenIndex1873 := position, tokenIndex
 			{
-				position855 := position
+				position1874 := position
 				{
-					position856 := position
+					position1875 := position
 					{
-						position857, tokenIndex857 := position, tokenIndex
+						position1876, tokenIndex1876 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l857
+							goto l1876
 						}
 						{
-							position859, tokenIndex859 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l860
+							position1878, tokenIndex1878 := position, tokenIndex
+							if !_rules[ruleAscending]() {
+								goto l1879
 							}
-							position++
-							goto l859
-						l860:
-							position, tokenIndex = position859, tokenIndex859
-							if buffer[position] != rune('G') {
-								goto l857
+							goto l1878
+						l1879:
+							position, tokenIndex = position1878, tokenIndex1878
+							if !_rules[ruleDescending]() {
+								goto l1876
 							}
-							position++
 						}
-					l859:
-						{
-							position861, tokenIndex861 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l862
-							}
-							position++
-							goto l861
-						l862:
-							position, tokenIndex = position861, tokenIndex861
-							if buffer[position] != rune('R') {
-								goto l857
-							}
-							position++
+					l1878:
+						goto l1877
+					l1876:
+						position, tokenIndex = position1876, tokenIndex1876
+					}
+				l1877:
+					add(rulePegText, position1875)
+				}
+				if !_rules[ruleAction109]() {
+					goto l1873
+				}
+				add(ruleOrderDirectionOpt, position1874)
+			}
+			return true
+		l1873:
+			position, tokenIndex = position1873, tokenIndex1873
+			return false
+		},
+		/* 139 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action110)> */
+		func() bool {
+			position1880, tokenIndex1880 := position, tokenIndex
+			{
+				position1881 := position
+				{
+					position1882 := position
+					if buffer[position] != rune('[') {
+						goto l1880
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1880
+					}
+					{
+						position1883, tokenIndex1883 := position, tokenIndex
+						if !_rules[ruleExpressionOrWildcard]() {
+							goto l1883
 						}
-					l861:
+					l1885:
 						{
-							position863, tokenIndex863 := position, tokenIndex
-							if buffer[position] != rune('o') {
-								goto l864
+							position1886, tokenIndex1886 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1886
 							}
-							position++
-							goto l863
-						l864:
-							position, tokenIndex = position863, tokenIndex863
-							if buffer[position] != rune('O') {
-								goto l857
+							if buffer[position] != rune(',') {
+								goto l1886
 							}
 							position++
-						}
-					l863:
-						{
-							position865, tokenIndex865 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l866
+							if !_rules[rulespOpt]() {
+								goto l1886
 							}
-							position++
-							goto l865
-						l866:
-							position, tokenIndex = position865, tokenIndex865
-							if buffer[position] != rune('U') {
-								goto l857
+							if !_rules[ruleExpressionOrWildcard]() {
+								goto l1886
 							}
-							position++
+							goto l1885
+						l1886:
+							position, tokenIndex = position1886, tokenIndex1886
 						}
-					l865:
-						{
-							position867, tokenIndex867 := position, tokenIndex
-							if buffer[position] != rune('p') {
-								goto l868
-							}
-							position++
-							goto l867
-						l868:
-							position, tokenIndex = position867, tokenIndex867
-							if buffer[position] != rune('P') {
-								goto l857
-							}
-							position++
+						goto l1884
+					l1883:
+						position, tokenIndex = position1883, tokenIndex1883
+					}
+				l1884:
+					if !_rules[rulespOpt]() {
+						goto l1880
+					}
+					{
+						position1887, tokenIndex1887 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l1887
 						}
-					l867:
-						if !_rules[rulesp]() {
-							goto l857
+						position++
+						goto l1888
+					l1887:
+						position, tokenIndex = position1887, tokenIndex1887
+					}
+				l1888:
+					if !_rules[rulespOpt]() {
+						goto l1880
+					}
+					if buffer[position] != rune(']') {
+						goto l1880
+					}
+					position++
+					add(rulePegText, position1882)
+				}
+				if !_rules[ruleAction110]() {
+					goto l1880
+				}
+				add(ruleArrayExpr, position1881)
+			}
+			return true
+		l1880:
+			position, tokenIndex = position1880, tokenIndex1880
+			return false
+		},
+		/* 140 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action111)> */
+		func() bool {
+			position1889, tokenIndex1889 := position, tokenIndex
+			{
+				position1890 := position
+				{
+					position1891 := position
+					if buffer[position] != rune('{') {
+						goto l1889
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1889
+					}
+					{
+						position1892, tokenIndex1892 := position, tokenIndex
+						if !_rules[ruleKeyValuePair]() {
+							goto l1892
 						}
+					l1894:
 						{
-							position869, tokenIndex869 := position, tokenIndex
-							if buffer[position] != rune('b') {
-								goto l870
+							position1895, tokenIndex1895 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1895
 							}
-							position++
-							goto l869
-						l870:
-							position, tokenIndex = position869, tokenIndex869
-							if buffer[position] != rune('B') {
-								goto l857
+							if buffer[position] != rune(',') {
+								goto l1895
 							}
 							position++
-						}
-					l869:
-						{
-							position871, tokenIndex871 := position, tokenIndex
-							if buffer[position] != rune('y') {
-								goto l872
+							if !_rules[rulespOpt]() {
+								goto l1895
 							}
-							position++
-							goto l871
-						l872:
-							position, tokenIndex = position871, tokenIndex871
-							if buffer[position] != rune('Y') {
-								goto l857
+							if !_rules[ruleKeyValuePair]() {
+								goto l1895
 							}
-							position++
-						}
-					l871:
-						if !_rules[rulesp]() {
-							goto l857
+							goto l1894
+						l1895:
+							position, tokenIndex = position1895, tokenIndex1895
 						}
-						if !_rules[ruleGroupList]() {
-							goto l857
-						}
-						goto l858
-					l857:
-						position, tokenIndex = position857, tokenIndex857
+						goto l1893
+					l1892:
+						position, tokenIndex = position1892, tokenIndex1892
 					}
-				l858:
-					add(rulePegText, position856)
+				l1893:
+					if !_rules[rulespOpt]() {
+						goto l1889
+					}
+					if buffer[position] != rune('}') {
+						goto l1889
+					}
+					position++
+					add(rulePegText, position1891)
 				}
-				if !_rules[ruleAction37]() {
-					goto l854
+				if !_rules[ruleAction111]() {
+					goto l1889
 				}
-				add(ruleGrouping, position855)
+				add(ruleMapExpr, position1890)
 			}
 			return true
-		l854:
-			position, tokenIndex = position854, tokenIndex854
+		l1889:
+			position, tokenIndex = position1889, tokenIndex1889
 			return false
 		},
-		/* 50 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
+		/* 141 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action112)> */
 		func() bool {
-			position873, tokenIndex873 := position, tokenIndex
+			position1896, tokenIndex1896 := position, tokenIndex
 			{
-				position874 := position
-				if !_rules[ruleExpression]() {
-					goto l873
-				}
-			l875:
+				position1897 := position
 				{
-					position876, tokenIndex876 := position, tokenIndex
+					position1898 := position
+					if !_rules[ruleStringLiteral]() {
+						goto l1896
+					}
 					if !_rules[rulespOpt]() {
-						goto l876
+						goto l1896
 					}
-					if buffer[position] != rune(',') {
-						goto l876
+					if buffer[position] != rune(':') {
+						goto l1896
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l876
+						goto l1896
 					}
-					if !_rules[ruleExpression]() {
-						goto l876
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1896
+					}
+					add(rulePegText, position1898)
+				}
+				if !_rules[ruleAction112]() {
+					goto l1896
+				}
+				add(ruleKeyValuePair, position1897)
+			}
+			return true
+		l1896:
+			position, tokenIndex = position1896, tokenIndex1896
+			return false
+		},
+		/* 142 Case <- <(ConditionCase / ExpressionCase)> */
+		func() bool {
+			position1899, tokenIndex1899 := position, tokenIndex
+			{
+				position1900 := position
+				{
+					position1901, tokenIndex1901 := position, tokenIndex
+					if !_rules[ruleConditionCase]() {
+						goto l1902
+					}
+					goto l1901
+				l1902:
+					position, tokenIndex = position1901, tokenIndex1901
+					if !_rules[ruleExpressionCase]() {
+						goto l1899
 					}
-					goto l875
-				l876:
-					position, tokenIndex = position876, tokenIndex876
 				}
-				add(ruleGroupList, position874)
+			l1901:
+				add(ruleCase, position1900)
 			}
 			return true
-		l873:
-			position, tokenIndex = position873, tokenIndex873
+		l1899:
+			position, tokenIndex = position1899, tokenIndex1899
 			return false
 		},
-		/* 51 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action38)> */
+		/* 143 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action113)> */
 		func() bool {
-			position877, tokenIndex877 := position, tokenIndex
+			position1903, tokenIndex1903 := position, tokenIndex
 			{
-				position878 := position
+				position1904 := position
+				{
+					position1905, tokenIndex1905 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1906
+					}
+					position++
+					goto l1905
+				l1906:
+					position, tokenIndex = position1905, tokenIndex1905
+					if buffer[position] != rune('C') {
+						goto l1903
+					}
+					position++
+				}
+			l1905:
+				{
+					position1907, tokenIndex1907 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1908
+					}
+					position++
+					goto l1907
+				l1908:
+					position, tokenIndex = position1907, tokenIndex1907
+					if buffer[position] != rune('A') {
+						goto l1903
+					}
+					position++
+				}
+			l1907:
+				{
+					position1909, tokenIndex1909 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1910
+					}
+					position++
+					goto l1909
+				l1910:
+					position, tokenIndex = position1909, tokenIndex1909
+					if buffer[position] != rune('S') {
+						goto l1903
+					}
+					position++
+				}
+			l1909:
+				{
+					position1911, tokenIndex1911 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1912
+					}
+					position++
+					goto l1911
+				l1912:
+					position, tokenIndex = position1911, tokenIndex1911
+					if buffer[position] != rune('E') {
+						goto l1903
+					}
+					position++
+				}
+			l1911:
 				{
-					position879 := position
+					position1913 := position
+					if !_rules[rulesp]() {
+						goto l1903
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1903
+					}
+				l1914:
 					{
-						position880, tokenIndex880 := position, tokenIndex
+						position1915, tokenIndex1915 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l880
+							goto l1915
 						}
-						{
-							position882, tokenIndex882 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l883
-							}
-							position++
-							goto l882
-						l883:
-							position, tokenIndex = position882, tokenIndex882
-							if buffer[position] != rune('H') {
-								goto l880
-							}
-							position++
+						if !_rules[ruleWhenThenPair]() {
+							goto l1915
 						}
-					l882:
-						{
-							position884, tokenIndex884 := position, tokenIndex
-							if buffer[position] != rune('a') {
-								goto l885
-							}
-							position++
-							goto l884
-						l885:
-							position, tokenIndex = position884, tokenIndex884
-							if buffer[position] != rune('A') {
-								goto l880
-							}
-							position++
+						goto l1914
+					l1915:
+						position, tokenIndex = position1915, tokenIndex1915
+					}
+					{
+						position1916, tokenIndex1916 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1916
 						}
-					l884:
 						{
-							position886, tokenIndex886 := position, tokenIndex
-							if buffer[position] != rune('v') {
-								goto l887
+							position1918, tokenIndex1918 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1919
 							}
 							position++
-							goto l886
-						l887:
-							position, tokenIndex = position886, tokenIndex886
-							if buffer[position] != rune('V') {
-								goto l880
+							goto l1918
+						l1919:
+							position, tokenIndex = position1918, tokenIndex1918
+							if buffer[position] != rune('E') {
+								goto l1916
 							}
 							position++
 						}
-					l886:
+					l1918:
 						{
-							position888, tokenIndex888 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l889
+							position1920, tokenIndex1920 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1921
 							}
 							position++
-							goto l888
-						l889:
-							position, tokenIndex = position888, tokenIndex888
-							if buffer[position] != rune('I') {
-								goto l880
+							goto l1920
+						l1921:
+							position, tokenIndex = position1920, tokenIndex1920
+							if buffer[position] != rune('L') {
+								goto l1916
 							}
 							position++
 						}
-					l888:
+					l1920:
 						{
-							position890, tokenIndex890 := position, tokenIndex
-							if buffer[position] != rune('n') {
-								goto l891
+							position1922, tokenIndex1922 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1923
 							}
 							position++
-							goto l890
-						l891:
-							position, tokenIndex = position890, tokenIndex890
-							if buffer[position] != rune('N') {
-								goto l880
+							goto l1922
+						l1923:
+							position, tokenIndex = position1922, tokenIndex1922
+							if buffer[position] != rune('S') {
+								goto l1916
 							}
 							position++
 						}
-					l890:
+					l1922:
 						{
-							position892, tokenIndex892 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l893
+							position1924, tokenIndex1924 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1925
 							}
 							position++
-							goto l892
-						l893:
-							position, tokenIndex = position892, tokenIndex892
-							if buffer[position] != rune('G') {
-								goto l880
+							goto l1924
+						l1925:
+							position, tokenIndex = position1924, tokenIndex1924
+							if buffer[position] != rune('E') {
+								goto l1916
 							}
 							position++
 						}
-					l892:
+					l1924:
 						if !_rules[rulesp]() {
-							goto l880
+							goto l1916
 						}
 						if !_rules[ruleExpression]() {
-							goto l880
+							goto l1916
 						}
-						goto l881
-					l880:
-						position, tokenIndex = position880, tokenIndex880
-					}
-				l881:
-					add(rulePegText, position879)
-				}
-				if !_rules[ruleAction38]() {
-					goto l877
-				}
-				add(ruleHaving, position878)
-			}
-			return true
-		l877:
-			position, tokenIndex = position877, tokenIndex877
-			return false
-		},
-		/* 52 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action39))> */
-		func() bool {
-			position894, tokenIndex894 := position, tokenIndex
-			{
-				position895 := position
-				{
-					position896, tokenIndex896 := position, tokenIndex
-					if !_rules[ruleAliasedStreamWindow]() {
-						goto l897
-					}
-					goto l896
-				l897:
-					position, tokenIndex = position896, tokenIndex896
-					if !_rules[ruleStreamWindow]() {
-						goto l894
-					}
-					if !_rules[ruleAction39]() {
-						goto l894
+						goto l1917
+					l1916:
+						position, tokenIndex = position1916, tokenIndex1916
 					}
-				}
-			l896:
-				add(ruleRelationLike, position895)
-			}
-			return true
-		l894:
-			position, tokenIndex = position894, tokenIndex894
-			return false
-		},
-		/* 53 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action40)> */
-		func() bool {
-			position898, tokenIndex898 := position, tokenIndex
-			{
-				position899 := position
-				if !_rules[ruleStreamWindow]() {
-					goto l898
-				}
-				if !_rules[rulesp]() {
-					goto l898
-				}
-				{
-					position900, tokenIndex900 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l901
+				l1917:
+					if !_rules[rulesp]() {
+						goto l1903
 					}
-					position++
-					goto l900
-				l901:
-					position, tokenIndex = position900, tokenIndex900
-					if buffer[position] != rune('A') {
-						goto l898
+					{
+						position1926, tokenIndex1926 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1927
+						}
+						position++
+						goto l1926
+					l1927:
+						position, tokenIndex = position1926, tokenIndex1926
+						if buffer[position] != rune('E') {
+							goto l1903
+						}
+						position++
 					}
-					position++
-				}
-			l900:
-				{
-					position902, tokenIndex902 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l903
+				l1926:
+					{
+						position1928, tokenIndex1928 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1929
+						}
+						position++
+						goto l1928
+					l1929:
+						position, tokenIndex = position1928, tokenIndex1928
+						if buffer[position] != rune('N') {
+							goto l1903
+						}
+						position++
 					}
-					position++
-					goto l902
-				l903:
-					position, tokenIndex = position902, tokenIndex902
-					if buffer[position] != rune('S') {
-						goto l898
+				l1928:
+					{
+						position1930, tokenIndex1930 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1931
+						}
+						position++
+						goto l1930
+					l1931:
+						position, tokenIndex = position1930, tokenIndex1930
+						if buffer[position] != rune('D') {
+							goto l1903
+						}
+						position++
 					}
-					position++
-				}
-			l902:
-				if !_rules[rulesp]() {
-					goto l898
+				l1930:
+					add(rulePegText, position1913)
 				}
-				if !_rules[ruleIdentifier]() {
-					goto l898
-				}
-				if !_rules[ruleAction40]() {
-					goto l898
+				if !_rules[ruleAction113]() {
+					goto l1903
 				}
-				add(ruleAliasedStreamWindow, position899)
+				add(ruleConditionCase, position1904)
 			}
 			return true
-		l898:
-			position, tokenIndex = position898, tokenIndex898
+		l1903:
+			position, tokenIndex = position1903, tokenIndex1903
 			return false
 		},
-		/* 54 StreamWindow <- <(StreamLike spOpt '[' spOpt (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) sp Interval CapacitySpecOpt SheddingSpecOpt spOpt ']' Action41)> */
+		/* 144 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action114)> */
 		func() bool {
-			position904, tokenIndex904 := position, tokenIndex
+			position1932, tokenIndex1932 := position, tokenIndex
 			{
-				position905 := position
-				if !_rules[ruleStreamLike]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune('[') {
-					goto l904
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
+				position1933 := position
 				{
-					position906, tokenIndex906 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l907
+					position1934, tokenIndex1934 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1935
 					}
 					position++
-					goto l906
-				l907:
-					position, tokenIndex = position906, tokenIndex906
-					if buffer[position] != rune('R') {
-						goto l904
+					goto l1934
+				l1935:
+					position, tokenIndex = position1934, tokenIndex1934
+					if buffer[position] != rune('C') {
+						goto l1932
 					}
 					position++
 				}
-			l906:
+			l1934:
 				{
-					position908, tokenIndex908 := position, tokenIndex
+					position1936, tokenIndex1936 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l909
+						goto l1937
 					}
 					position++
-					goto l908
-				l909:
-					position, tokenIndex = position908, tokenIndex908
+					goto l1936
+				l1937:
+					position, tokenIndex = position1936, tokenIndex1936
 					if buffer[position] != rune('A') {
-						goto l904
-					}
-					position++
-				}
-			l908:
-				{
-					position910, tokenIndex910 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l911
-					}
-					position++
-					goto l910
-				l911:
-					position, tokenIndex = position910, tokenIndex910
-					if buffer[position] != rune('N') {
-						goto l904
+						goto l1932
 					}
 					position++
 				}
-			l910:
+			l1936:
 				{
-					position912, tokenIndex912 := position, tokenIndex
-					if buffer[position] != rune('g') {
-						goto l913
+					position1938, tokenIndex1938 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1939
 					}
 					position++
-					goto l912
-				l913:
-					position, tokenIndex = position912, tokenIndex912
-					if buffer[position] != rune('G') {
-						goto l904
+					goto l1938
+				l1939:
+					position, tokenIndex = position1938, tokenIndex1938
+					if buffer[position] != rune('S') {
+						goto l1932
 					}
 					position++
 				}
-			l912:
+			l1938:
 				{
-					position914, tokenIndex914 := position, tokenIndex
+					position1940, tokenIndex1940 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l915
+						goto l1941
 					}
 					position++
-					goto l914
-				l915:
-					position, tokenIndex = position914, tokenIndex914
+					goto l1940
+				l1941:
+					position, tokenIndex = position1940, tokenIndex1940
 					if buffer[position] != rune('E') {
-						goto l904
+						goto l1932
 					}
 					position++
 				}
-			l914:
+			l1940:
 				if !_rules[rulesp]() {
-					goto l904
-				}
-				if !_rules[ruleInterval]() {
-					goto l904
-				}
-				if !_rules[ruleCapacitySpecOpt]() {
-					goto l904
-				}
-				if !_rules[ruleSheddingSpecOpt]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune(']') {
-					goto l904
+					goto l1932
 				}
-				position++
-				if !_rules[ruleAction41]() {
-					goto l904
+				if !_rules[ruleExpression]() {
+					goto l1932
 				}
-				add(ruleStreamWindow, position905)
-			}
-			return true
-		l904:
-			position, tokenIndex = position904, tokenIndex904
-			return false
-		},
-		/* 55 StreamLike <- <(UDSFFuncApp / Stream)> */
-		func() bool {
-			position916, tokenIndex916 := position, tokenIndex
-			{
-				position917 := position
 				{
-					position918, tokenIndex918 := position, tokenIndex
-					if !_rules[ruleUDSFFuncApp]() {
-						goto l919
+					position1942 := position
+					if !_rules[rulesp]() {
+						goto l1932
 					}
-					goto l918
-				l919:
-					position, tokenIndex = position918, tokenIndex918
-					if !_rules[ruleStream]() {
-						goto l916
+					if !_rules[ruleWhenThenPair]() {
+						goto l1932
 					}
-				}
-			l918:
-				add(ruleStreamLike, position917)
-			}
-			return true
-		l916:
-			position, tokenIndex = position916, tokenIndex916
-			return false
-		},
-		/* 56 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action42)> */
-		func() bool {
-			position920, tokenIndex920 := position, tokenIndex
-			{
-				position921 := position
-				if !_rules[ruleFuncAppWithoutOrderBy]() {
-					goto l920
-				}
-				if !_rules[ruleAction42]() {
-					goto l920
-				}
-				add(ruleUDSFFuncApp, position921)
-			}
-			return true
-		l920:
-			position, tokenIndex = position920, tokenIndex920
-			return false
-		},
-		/* 57 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action43)> */
-		func() bool {
-			position922, tokenIndex922 := position, tokenIndex
-			{
-				position923 := position
-				{
-					position924 := position
-					{
-						position925, tokenIndex925 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l925
-						}
-						if buffer[position] != rune(',') {
-							goto l925
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l925
-						}
-						{
-							position927, tokenIndex927 := position, tokenIndex
-							if buffer[position] != rune('b') {
-								goto l928
-							}
-							position++
-							goto l927
-						l928:
-							position, tokenIndex = position927, tokenIndex927
-							if buffer[position] != rune('B') {
-								goto l925
-							}
-							position++
-						}
-					l927:
-						{
-							position929, tokenIndex929 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l930
-							}
-							position++
-							goto l929
-						l930:
-							position, tokenIndex = position929, tokenIndex929
-							if buffer[position] != rune('U') {
-								goto l925
-							}
-							position++
-						}
-					l929:
-						{
-							position931, tokenIndex931 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l932
-							}
-							position++
-							goto l931
-						l932:
-							position, tokenIndex = position931, tokenIndex931
-							if buffer[position] != rune('F') {
-								goto l925
-							}
-							position++
+				l1943:
+					{
+						position1944, tokenIndex1944 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1944
 						}
-					l931:
-						{
-							position933, tokenIndex933 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l934
-							}
-							position++
-							goto l933
-						l934:
-							position, tokenIndex = position933, tokenIndex933
-							if buffer[position] != rune('F') {
-								goto l925
-							}
-							position++
+						if !_rules[ruleWhenThenPair]() {
+							goto l1944
+						}
+						goto l1943
+					l1944:
+						position, tokenIndex = position1944, tokenIndex1944
+					}
+					{
+						position1945, tokenIndex1945 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1945
 						}
-					l933:
 						{
-							position935, tokenIndex935 := position, tokenIndex
+							position1947, tokenIndex1947 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l936
+								goto l1948
 							}
 							position++
-							goto l935
-						l936:
-							position, tokenIndex = position935, tokenIndex935
+							goto l1947
+						l1948:
+							position, tokenIndex = position1947, tokenIndex1947
 							if buffer[position] != rune('E') {
-								goto l925
+								goto l1945
 							}
 							position++
 						}
-					l935:
+					l1947:
 						{
-							position937, tokenIndex937 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l938
+							position1949, tokenIndex1949 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1950
 							}
 							position++
-							goto l937
-						l938:
-							position, tokenIndex = position937, tokenIndex937
-							if buffer[position] != rune('R') {
-								goto l925
+							goto l1949
+						l1950:
+							position, tokenIndex = position1949, tokenIndex1949
+							if buffer[position] != rune('L') {
+								goto l1945
 							}
 							position++
 						}
-					l937:
-						if !_rules[rulesp]() {
-							goto l925
-						}
+					l1949:
 						{
-							position939, tokenIndex939 := position, tokenIndex
+							position1951, tokenIndex1951 := position, tokenIndex
 							if buffer[position] != rune('s') {
-								goto l940
+								goto l1952
 							}
 							position++
-							goto l939
-						l940:
-							position, tokenIndex = position939, tokenIndex939
+							goto l1951
+						l1952:
+							position, tokenIndex = position1951, tokenIndex1951
 							if buffer[position] != rune('S') {
-								goto l925
-							}
-							position++
-						}
-					l939:
-						{
-							position941, tokenIndex941 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l942
-							}
-							position++
-							goto l941
-						l942:
-							position, tokenIndex = position941, tokenIndex941
-							if buffer[position] != rune('I') {
-								goto l925
+								goto l1945
 							}
 							position++
 						}
-					l941:
+					l1951:
 						{
-							position943, tokenIndex943 := position, tokenIndex
-							if buffer[position] != rune('z') {
-								goto l944
-							}
-							position++
-							goto l943
-						l944:
-							position, tokenIndex = position943, tokenIndex943
-							if buffer[position] != rune('Z') {
-								goto l925
-							}
-							position++
-						}
-					l943:
-						{
-							position945, tokenIndex945 := position, tokenIndex
+							position1953, tokenIndex1953 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l946
+								goto l1954
 							}
 							position++
-							goto l945
-						l946:
-							position, tokenIndex = position945, tokenIndex945
+							goto l1953
+						l1954:
+							position, tokenIndex = position1953, tokenIndex1953
 							if buffer[position] != rune('E') {
-								goto l925
+								goto l1945
 							}
 							position++
 						}
-					l945:
+					l1953:
 						if !_rules[rulesp]() {
-							goto l925
+							goto l1945
 						}
-						if !_rules[ruleNonNegativeNumericLiteral]() {
-							goto l925
+						if !_rules[ruleExpression]() {
+							goto l1945
+						}
+						goto l1946
+					l1945:
+						position, tokenIndex = position1945, tokenIndex1945
+					}
+				l1946:
+					if !_rules[rulesp]() {
+						goto l1932
+					}
+					{
+						position1955, tokenIndex1955 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1956
+						}
+						position++
+						goto l1955
+					l1956:
+						position, tokenIndex = position1955, tokenIndex1955
+						if buffer[position] != rune('E') {
+							goto l1932
+						}
+						position++
+					}
+				l1955:
+					{
+						position1957, tokenIndex1957 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1958
+						}
+						position++
+						goto l1957
+					l1958:
+						position, tokenIndex = position1957, tokenIndex1957
+						if buffer[position] != rune('N') {
+							goto l1932
+						}
+						position++
+					}
+				l1957:
+					{
+						position1959, tokenIndex1959 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1960
+						}
+						position++
+						goto l1959
+					l1960:
+						position, tokenIndex = position1959, tokenIndex1959
+						if buffer[position] != rune('D') {
+							goto l1932
 						}
-						goto l926
-					l925:
-						position, tokenIndex = position925, tokenIndex925
+						position++
 					}
-				l926:
-					add(rulePegText, position924)
+				l1959:
+					add(rulePegText, position1942)
 				}
-				if !_rules[ruleAction43]() {
-					goto l922
+				if !_rules[ruleAction114]() {
+					goto l1932
 				}
-				add(ruleCapacitySpecOpt, position923)
+				add(ruleExpressionCase, position1933)
 			}
 			return true
-		l922:
-			position, tokenIndex = position922, tokenIndex922
+		l1932:
+			position, tokenIndex = position1932, tokenIndex1932
 			return false
 		},
-		/* 58 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action44)> */
+		/* 145 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action115)> */
 		func() bool {
-			position947, tokenIndex947 := position, tokenIndex
+			position1961, tokenIndex1961 := position, tokenIndex
 			{
-				position948 := position
+				position1962 := position
 				{
-					position949 := position
-					{
-						position950, tokenIndex950 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if buffer[position] != rune(',') {
-							goto l950
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if !_rules[ruleSheddingOption]() {
-							goto l950
-						}
-						if !_rules[rulesp]() {
-							goto l950
-						}
-						{
-							position952, tokenIndex952 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l953
-							}
-							position++
-							goto l952
-						l953:
-							position, tokenIndex = position952, tokenIndex952
-							if buffer[position] != rune('I') {
-								goto l950
-							}
-							position++
-						}
-					l952:
-						{
-							position954, tokenIndex954 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l955
-							}
-							position++
-							goto l954
-						l955:
-							position, tokenIndex = position954, tokenIndex954
-							if buffer[position] != rune('F') {
-								goto l950
-							}
-							position++
-						}
-					l954:
-						if !_rules[rulesp]() {
-							goto l950
-						}
-						{
-							position956, tokenIndex956 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l957
-							}
-							position++
-							goto l956
-						l957:
-							position, tokenIndex = position956, tokenIndex956
-							if buffer[position] != rune('F') {
-								goto l950
-							}
-							position++
-						}
-					l956:
-						{
-							position958, tokenIndex958 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l959
-							}
-							position++
-							goto l958
-						l959:
-							position, tokenIndex = position958, tokenIndex958
-							if buffer[position] != rune('U') {
-								goto l950
-							}
-							position++
-						}
-					l958:
-						{
-							position960, tokenIndex960 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l961
-							}
-							position++
-							goto l960
-						l961:
-							position, tokenIndex = position960, tokenIndex960
-							if buffer[position] != rune('L') {
-								goto l950
-							}
-							position++
-						}
-					l960:
-						{
-							position962, tokenIndex962 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l963
-							}
-							position++
-							goto l962
-						l963:
-							position, tokenIndex = position962, tokenIndex962
-							if buffer[position] != rune('L') {
-								goto l950
-							}
-							position++
-						}
-					l962:
-						goto l951
-					l950:
-						position, tokenIndex = position950, tokenIndex950
+					position1963, tokenIndex1963 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l1964
+					}
+					position++
+					goto l1963
+				l1964:
+					position, tokenIndex = position1963, tokenIndex1963
+					if buffer[position] != rune('W') {
+						goto l1961
+					}
+					position++
+				}
+			l1963:
+				{
+					position1965, tokenIndex1965 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l1966
+					}
+					position++
+					goto l1965
+				l1966:
+					position, tokenIndex = position1965, tokenIndex1965
+					if buffer[position] != rune('H') {
+						goto l1961
+					}
+					position++
+				}
+			l1965:
+				{
+					position1967, tokenIndex1967 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1968
+					}
+					position++
+					goto l1967
+				l1968:
+					position, tokenIndex = position1967, tokenIndex1967
+					if buffer[position] != rune('E') {
+						goto l1961
+					}
+					position++
+				}
+			l1967:
+				{
+					position1969, tokenIndex1969 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1970
+					}
+					position++
+					goto l1969
+				l1970:
+					position, tokenIndex = position1969, tokenIndex1969
+					if buffer[position] != rune('N') {
+						goto l1961
+					}
+					position++
+				}
+			l1969:
+				if !_rules[rulesp]() {
+					goto l1961
+				}
+				if !_rules[ruleExpression]() {
+					goto l1961
+				}
+				if !_rules[rulesp]() {
+					goto l1961
+				}
+				{
+					position1971, tokenIndex1971 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1972
+					}
+					position++
+					goto l1971
+				l1972:
+					position, tokenIndex = position1971, tokenIndex1971
+					if buffer[position] != rune('T') {
+						goto l1961
+					}
+					position++
+				}
+			l1971:
+				{
+					position1973, tokenIndex1973 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l1974
+					}
+					position++
+					goto l1973
+				l1974:
+					position, tokenIndex = position1973, tokenIndex1973
+					if buffer[position] != rune('H') {
+						goto l1961
+					}
+					position++
+				}
+			l1973:
+				{
+					position1975, tokenIndex1975 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1976
+					}
+					position++
+					goto l1975
+				l1976:
+					position, tokenIndex = position1975, tokenIndex1975
+					if buffer[position] != rune('E') {
+						goto l1961
+					}
+					position++
+				}
+			l1975:
+				{
+					position1977, tokenIndex1977 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1978
+					}
+					position++
+					goto l1977
+				l1978:
+					position, tokenIndex = position1977, tokenIndex1977
+					if buffer[position] != rune('N') {
+						goto l1961
+					}
+					position++
+				}
+			l1977:
+				if !_rules[rulesp]() {
+					goto l1961
+				}
+				if !_rules[ruleExpressionOrWildcard]() {
+					goto l1961
+				}
+				if !_rules[ruleAction115]() {
+					goto l1961
+				}
+				add(ruleWhenThenPair, position1962)
+			}
+			return true
+		l1961:
+			position, tokenIndex = position1961, tokenIndex1961
+			return false
+		},
+		/* 146 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral / Placeholder)> */
+		func() bool {
+			position1979, tokenIndex1979 := position, tokenIndex
+			{
+				position1980 := position
+				{
+					position1981, tokenIndex1981 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l1982
+					}
+					goto l1981
+				l1982:
+					position, tokenIndex = position1981, tokenIndex1981
+					if !_rules[ruleNumericLiteral]() {
+						goto l1983
+					}
+					goto l1981
+				l1983:
+					position, tokenIndex = position1981, tokenIndex1981
+					if !_rules[ruleStringLiteral]() {
+						goto l1984
+					}
+					goto l1981
+				l1984:
+					position, tokenIndex = position1981, tokenIndex1981
+					if !_rules[rulePlaceholder]() {
+						goto l1979
+					}
+				}
+			l1981:
+				add(ruleLiteral, position1980)
+			}
+			return true
+		l1979:
+			position, tokenIndex = position1979, tokenIndex1979
+			return false
+		},
+		/* 147 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual / RegexpMatch / NotRegexpMatch)> */
+		func() bool {
+			position1985, tokenIndex1985 := position, tokenIndex
+			{
+				position1986 := position
+				{
+					position1987, tokenIndex1987 := position, tokenIndex
+					if !_rules[ruleEqual]() {
+						goto l1988
+					}
+					goto l1987
+				l1988:
+					position, tokenIndex = position1987, tokenIndex1987
+					if !_rules[ruleNotEqual]() {
+						goto l1989
+					}
+					goto l1987
+				l1989:
+					position, tokenIndex = position1987, tokenIndex1987
+					if !_rules[ruleLessOrEqual]() {
+						goto l1990
+					}
+					goto l1987
+				l1990:
+					position, tokenIndex = position1987, tokenIndex1987
+					if !_rules[ruleLess]() {
+						goto l1991
+					}
+					goto l1987
+				l1991:
+					position, tokenIndex = position1987, tokenIndex1987
+					if !_rules[ruleGreaterOrEqual]() {
+						goto l1992
+					}
+					goto l1987
+				l1992:
+					position, tokenIndex = position1987, tokenIndex1987
+					if !_rules[ruleGreater]() {
+						goto l1993
+					}
+					goto l1987
+				l1993:
+					position, tokenIndex = position1987, tokenIndex1987
+					if !_rules[ruleNotEqual]() {
+						goto l1994
+					}
+					goto l1987
+				l1994:
+					position, tokenIndex = position1987, tokenIndex1987
+					if !_rules[ruleRegexpMatch]() {
+						goto l1995
+					}
+					goto l1987
+				l1995:
+					position, tokenIndex = position1987, tokenIndex1987
+					if !_rules[ruleNotRegexpMatch]() {
+						goto l1985
 					}
-				l951:
-					add(rulePegText, position949)
-				}
-				if !_rules[ruleAction44]() {
-					goto l947
 				}
-				add(ruleSheddingSpecOpt, position948)
+			l1987:
+				add(ruleComparisonOp, position1986)
 			}
 			return true
-		l947:
-			position, tokenIndex = position947, tokenIndex947
+		l1985:
+			position, tokenIndex = position1985, tokenIndex1985
 			return false
 		},
-		/* 59 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
+		/* 148 PatternMatchOp <- <(Like / NotLike / ILike / NotILike / SimilarTo / NotSimilarTo)> */
 		func() bool {
-			position964, tokenIndex964 := position, tokenIndex
+			position1996, tokenIndex1996 := position, tokenIndex
 			{
-				position965 := position
+				position1997 := position
 				{
-					position966, tokenIndex966 := position, tokenIndex
-					if !_rules[ruleWait]() {
-						goto l967
+					position1998, tokenIndex1998 := position, tokenIndex
+					if !_rules[ruleLike]() {
+						goto l1999
 					}
-					goto l966
-				l967:
-					position, tokenIndex = position966, tokenIndex966
-					if !_rules[ruleDropOldest]() {
-						goto l968
+					goto l1998
+				l1999:
+					position, tokenIndex = position1998, tokenIndex1998
+					if !_rules[ruleNotLike]() {
+						goto l2000
 					}
-					goto l966
-				l968:
-					position, tokenIndex = position966, tokenIndex966
-					if !_rules[ruleDropNewest]() {
-						goto l964
+					goto l1998
+				l2000:
+					position, tokenIndex = position1998, tokenIndex1998
+					if !_rules[ruleILike]() {
+						goto l2001
+					}
+					goto l1998
+				l2001:
+					position, tokenIndex = position1998, tokenIndex1998
+					if !_rules[ruleNotILike]() {
+						goto l2002
+					}
+					goto l1998
+				l2002:
+					position, tokenIndex = position1998, tokenIndex1998
+					if !_rules[ruleSimilarTo]() {
+						goto l2003
+					}
+					goto l1998
+				l2003:
+					position, tokenIndex = position1998, tokenIndex1998
+					if !_rules[ruleNotSimilarTo]() {
+						goto l1996
 					}
 				}
-			l966:
-				add(ruleSheddingOption, position965)
+			l1998:
+				add(rulePatternMatchOp, position1997)
 			}
 			return true
-		l964:
-			position, tokenIndex = position964, tokenIndex964
+		l1996:
+			position, tokenIndex = position1996, tokenIndex1996
 			return false
 		},
-		/* 60 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action45)> */
+		/* 149 InOp <- <(In / NotIn)> */
 		func() bool {
-			position969, tokenIndex969 := position, tokenIndex
+			position2004, tokenIndex2004 := position, tokenIndex
 			{
-				position970 := position
+				position2005 := position
 				{
-					position971 := position
-					{
-						position972, tokenIndex972 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l972
-						}
-						{
-							position974, tokenIndex974 := position, tokenIndex
-							if buffer[position] != rune('w') {
-								goto l975
-							}
-							position++
-							goto l974
-						l975:
-							position, tokenIndex = position974, tokenIndex974
-							if buffer[position] != rune('W') {
-								goto l972
-							}
-							position++
-						}
-					l974:
-						{
-							position976, tokenIndex976 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l977
-							}
-							position++
-							goto l976
-						l977:
-							position, tokenIndex = position976, tokenIndex976
-							if buffer[position] != rune('I') {
-								goto l972
-							}
-							position++
-						}
-					l976:
-						{
-							position978, tokenIndex978 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l979
-							}
-							position++
-							goto l978
-						l979:
-							position, tokenIndex = position978, tokenIndex978
-							if buffer[position] != rune('T') {
-								goto l972
-							}
-							position++
-						}
-					l978:
-						{
-							position980, tokenIndex980 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l981
-							}
-							position++
-							goto l980
-						l981:
-							position, tokenIndex = position980, tokenIndex980
-							if buffer[position] != rune('H') {
-								goto l972
-							}
-							position++
-						}
-					l980:
-						if !_rules[rulesp]() {
-							goto l972
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l972
-						}
-					l982:
-						{
-							position983, tokenIndex983 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l983
-							}
-							if buffer[position] != rune(',') {
-								goto l983
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l983
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l983
-							}
-							goto l982
-						l983:
-							position, tokenIndex = position983, tokenIndex983
-						}
-						goto l973
-					l972:
-						position, tokenIndex = position972, tokenIndex972
+					position2006, tokenIndex2006 := position, tokenIndex
+					if !_rules[ruleIn]() {
+						goto l2007
+					}
+					goto l2006
+				l2007:
+					position, tokenIndex = position2006, tokenIndex2006
+					if !_rules[ruleNotIn]() {
+						goto l2004
 					}
-				l973:
-					add(rulePegText, position971)
-				}
-				if !_rules[ruleAction45]() {
-					goto l969
 				}
-				add(ruleSourceSinkSpecs, position970)
+			l2006:
+				add(ruleInOp, position2005)
 			}
 			return true
-		l969:
-			position, tokenIndex = position969, tokenIndex969
+		l2004:
+			position, tokenIndex = position2004, tokenIndex2004
 			return false
 		},
-		/* 61 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action46)> */
+		/* 150 BetweenOp <- <(Between / NotBetween)> */
 		func() bool {
-			position984, tokenIndex984 := position, tokenIndex
+			position2008, tokenIndex2008 := position, tokenIndex
 			{
-				position985 := position
+				position2009 := position
 				{
-					position986 := position
-					if !_rules[rulesp]() {
-						goto l984
-					}
-					{
-						position987, tokenIndex987 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l988
-						}
-						position++
-						goto l987
-					l988:
-						position, tokenIndex = position987, tokenIndex987
-						if buffer[position] != rune('S') {
-							goto l984
-						}
-						position++
-					}
-				l987:
-					{
-						position989, tokenIndex989 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l990
-						}
-						position++
-						goto l989
-					l990:
-						position, tokenIndex = position989, tokenIndex989
-						if buffer[position] != rune('E') {
-							goto l984
-						}
-						position++
-					}
-				l989:
-					{
-						position991, tokenIndex991 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l992
-						}
-						position++
-						goto l991
-					l992:
-						position, tokenIndex = position991, tokenIndex991
-						if buffer[position] != rune('T') {
-							goto l984
-						}
-						position++
-					}
-				l991:
-					if !_rules[rulesp]() {
-						goto l984
-					}
-					if !_rules[ruleSourceSinkParam]() {
-						goto l984
+					position2010, tokenIndex2010 := position, tokenIndex
+					if !_rules[ruleBetween]() {
+						goto l2011
 					}
-				l993:
-					{
-						position994, tokenIndex994 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l994
-						}
-						if buffer[position] != rune(',') {
-							goto l994
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l994
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l994
-						}
-						goto l993
-					l994:
-						position, tokenIndex = position994, tokenIndex994
+					goto l2010
+				l2011:
+					position, tokenIndex = position2010, tokenIndex2010
+					if !_rules[ruleNotBetween]() {
+						goto l2008
 					}
-					add(rulePegText, position986)
 				}
-				if !_rules[ruleAction46]() {
-					goto l984
+			l2010:
+				add(ruleBetweenOp, position2009)
+			}
+			return true
+		l2008:
+			position, tokenIndex = position2008, tokenIndex2008
+			return false
+		},
+		/* 151 OtherOp <- <Concat> */
+		func() bool {
+			position2012, tokenIndex2012 := position, tokenIndex
+			{
+				position2013 := position
+				if !_rules[ruleConcat]() {
+					goto l2012
 				}
-				add(ruleUpdateSourceSinkSpecs, position985)
+				add(ruleOtherOp, position2013)
 			}
 			return true
-		l984:
-			position, tokenIndex = position984, tokenIndex984
+		l2012:
+			position, tokenIndex = position2012, tokenIndex2012
 			return false
 		},
-		/* 62 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action47)> */
+		/* 152 IsOp <- <(IsNot / Is)> */
 		func() bool {
-			position995, tokenIndex995 := position, tokenIndex
+			position2014, tokenIndex2014 := position, tokenIndex
 			{
-				position996 := position
+				position2015 := position
 				{
-					position997 := position
-					{
-						position998, tokenIndex998 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						{
-							position1000, tokenIndex1000 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1001
-							}
-							position++
-							goto l1000
-						l1001:
-							position, tokenIndex = position1000, tokenIndex1000
-							if buffer[position] != rune('S') {
-								goto l998
-							}
-							position++
-						}
-					l1000:
-						{
-							position1002, tokenIndex1002 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1003
-							}
-							position++
-							goto l1002
-						l1003:
-							position, tokenIndex = position1002, tokenIndex1002
-							if buffer[position] != rune('E') {
-								goto l998
-							}
-							position++
-						}
-					l1002:
-						{
-							position1004, tokenIndex1004 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l1005
-							}
-							position++
-							goto l1004
-						l1005:
-							position, tokenIndex = position1004, tokenIndex1004
-							if buffer[position] != rune('T') {
-								goto l998
-							}
-							position++
-						}
-					l1004:
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l998
-						}
-					l1006:
-						{
-							position1007, tokenIndex1007 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if buffer[position] != rune(',') {
-								goto l1007
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l1007
-							}
-							goto l1006
-						l1007:
-							position, tokenIndex = position1007, tokenIndex1007
-						}
-						goto l999
-					l998:
-						position, tokenIndex = position998, tokenIndex998
+					position2016, tokenIndex2016 := position, tokenIndex
+					if !_rules[ruleIsNot]() {
+						goto l2017
+					}
+					goto l2016
+				l2017:
+					position, tokenIndex = position2016, tokenIndex2016
+					if !_rules[ruleIs]() {
+						goto l2014
 					}
-				l999:
-					add(rulePegText, position997)
-				}
-				if !_rules[ruleAction47]() {
-					goto l995
 				}
-				add(ruleSetOptSpecs, position996)
+			l2016:
+				add(ruleIsOp, position2015)
 			}
 			return true
-		l995:
-			position, tokenIndex = position995, tokenIndex995
+		l2014:
+			position, tokenIndex = position2014, tokenIndex2014
 			return false
 		},
-		/* 63 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action48)> */
+		/* 153 PlusMinusOp <- <(Plus / Minus)> */
 		func() bool {
-			position1008, tokenIndex1008 := position, tokenIndex
+			position2018, tokenIndex2018 := position, tokenIndex
 			{
-				position1009 := position
+				position2019 := position
 				{
-					position1010 := position
-					{
-						position1011, tokenIndex1011 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1011
-						}
-						{
-							position1013, tokenIndex1013 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l1014
-							}
-							position++
-							goto l1013
-						l1014:
-							position, tokenIndex = position1013, tokenIndex1013
-							if buffer[position] != rune('T') {
-								goto l1011
-							}
-							position++
-						}
-					l1013:
-						{
-							position1015, tokenIndex1015 := position, tokenIndex
-							if buffer[position] != rune('a') {
-								goto l1016
-							}
-							position++
-							goto l1015
-						l1016:
-							position, tokenIndex = position1015, tokenIndex1015
-							if buffer[position] != rune('A') {
-								goto l1011
-							}
-							position++
-						}
-					l1015:
-						{
-							position1017, tokenIndex1017 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l1018
-							}
-							position++
-							goto l1017
-						l1018:
-							position, tokenIndex = position1017, tokenIndex1017
-							if buffer[position] != rune('G') {
-								goto l1011
-							}
-							position++
-						}
-					l1017:
-						if !_rules[rulesp]() {
-							goto l1011
-						}
-						if !_rules[ruleIdentifier]() {
-							goto l1011
-						}
-						goto l1012
-					l1011:
-						position, tokenIndex = position1011, tokenIndex1011
+					position2020, tokenIndex2020 := position, tokenIndex
+					if !_rules[rulePlus]() {
+						goto l2021
+					}
+					goto l2020
+				l2021:
+					position, tokenIndex = position2020, tokenIndex2020
+					if !_rules[ruleMinus]() {
+						goto l2018
 					}
-				l1012:
-					add(rulePegText, position1010)
-				}
-				if !_rules[ruleAction48]() {
-					goto l1008
 				}
-				add(ruleStateTagOpt, position1009)
+			l2020:
+				add(rulePlusMinusOp, position2019)
 			}
 			return true
-		l1008:
-			position, tokenIndex = position1008, tokenIndex1008
+		l2018:
+			position, tokenIndex = position2018, tokenIndex2018
 			return false
 		},
-		/* 64 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action49)> */
+		/* 154 MultDivOp <- <(Multiply / Divide / Modulo)> */
 		func() bool {
-			position1019, tokenIndex1019 := position, tokenIndex
+			position2022, tokenIndex2022 := position, tokenIndex
 			{
-				position1020 := position
-				if !_rules[ruleSourceSinkParamKey]() {
-					goto l1019
-				}
-				if !_rules[rulespOpt]() {
-					goto l1019
-				}
-				if buffer[position] != rune('=') {
-					goto l1019
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1019
+				position2023 := position
+				{
+					position2024, tokenIndex2024 := position, tokenIndex
+					if !_rules[ruleMultiply]() {
+						goto l2025
+					}
+					goto l2024
+				l2025:
+					position, tokenIndex = position2024, tokenIndex2024
+					if !_rules[ruleDivide]() {
+						goto l2026
+					}
+					goto l2024
+				l2026:
+					position, tokenIndex = position2024, tokenIndex2024
+					if !_rules[ruleModulo]() {
+						goto l2022
+					}
 				}
-				if !_rules[ruleSourceSinkParamVal]() {
-					goto l1019
+			l2024:
+				add(ruleMultDivOp, position2023)
+			}
+			return true
+		l2022:
+			position, tokenIndex = position2022, tokenIndex2022
+			return false
+		},
+		/* 155 Stream <- <(<ident> Action116)> */
+		func() bool {
+			position2027, tokenIndex2027 := position, tokenIndex
+			{
+				position2028 := position
+				{
+					position2029 := position
+					if !_rules[ruleident]() {
+						goto l2027
+					}
+					add(rulePegText, position2029)
 				}
-				if !_rules[ruleAction49]() {
-					goto l1019
+				if !_rules[ruleAction116]() {
+					goto l2027
 				}
-				add(ruleSourceSinkParam, position1020)
+				add(ruleStream, position2028)
 			}
 			return true
-		l1019:
-			position, tokenIndex = position1019, tokenIndex1019
+		l2027:
+			position, tokenIndex = position2027, tokenIndex2027
 			return false
 		},
-		/* 65 SourceSinkParamVal <- <ParamLiteral> */
+		/* 156 RowMeta <- <RowTimestamp> */
 		func() bool {
-			position1021, tokenIndex1021 := position, tokenIndex
+			position2030, tokenIndex2030 := position, tokenIndex
 			{
-				position1022 := position
-				if !_rules[ruleParamLiteral]() {
-					goto l1021
+				position2031 := position
+				if !_rules[ruleRowTimestamp]() {
+					goto l2030
 				}
-				add(ruleSourceSinkParamVal, position1022)
+				add(ruleRowMeta, position2031)
 			}
 			return true
-		l1021:
-			position, tokenIndex = position1021, tokenIndex1021
+		l2030:
+			position, tokenIndex = position2030, tokenIndex2030
 			return false
 		},
-		/* 66 ParamLiteral <- <(BooleanLiteral / Literal / ParamArrayExpr / ParamMapExpr)> */
+		/* 157 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action117)> */
 		func() bool {
-			position1023, tokenIndex1023 := position, tokenIndex
+			position2032, tokenIndex2032 := position, tokenIndex
 			{
-				position1024 := position
+				position2033 := position
 				{
-					position1025, tokenIndex1025 := position, tokenIndex
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1026
+					position2034 := position
+					{
+						position2035, tokenIndex2035 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l2035
+						}
+						if buffer[position] != rune(':') {
+							goto l2035
+						}
+						position++
+						goto l2036
+					l2035:
+						position, tokenIndex = position2035, tokenIndex2035
 					}
-					goto l1025
-				l1026:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleLiteral]() {
-						goto l1027
+				l2036:
+					if buffer[position] != rune('t') {
+						goto l2032
 					}
-					goto l1025
-				l1027:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleParamArrayExpr]() {
-						goto l1028
+					position++
+					if buffer[position] != rune('s') {
+						goto l2032
 					}
-					goto l1025
-				l1028:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleParamMapExpr]() {
-						goto l1023
+					position++
+					if buffer[position] != rune('(') {
+						goto l2032
+					}
+					position++
+					if buffer[position] != rune(')') {
+						goto l2032
 					}
+					position++
+					add(rulePegText, position2034)
+				}
+				if !_rules[ruleAction117]() {
+					goto l2032
 				}
-			l1025:
-				add(ruleParamLiteral, position1024)
+				add(ruleRowTimestamp, position2033)
 			}
 			return true
-		l1023:
-			position, tokenIndex = position1023, tokenIndex1023
+		l2032:
+			position, tokenIndex = position2032, tokenIndex2032
 			return false
 		},
-		/* 67 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action50)> */
+		/* 158 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action118)> */
 		func() bool {
-			position1029, tokenIndex1029 := position, tokenIndex
+			position2037, tokenIndex2037 := position, tokenIndex
 			{
-				position1030 := position
+				position2038 := position
 				{
-					position1031 := position
-					if buffer[position] != rune('[') {
-						goto l1029
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1029
-					}
+					position2039 := position
 					{
-						position1032, tokenIndex1032 := position, tokenIndex
-						if !_rules[ruleParamLiteral]() {
-							goto l1032
+						position2040, tokenIndex2040 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l2040
 						}
-					l1034:
+						if buffer[position] != rune(':') {
+							goto l2040
+						}
+						position++
 						{
-							position1035, tokenIndex1035 := position, tokenIndex
-							if buffer[position] != rune(',') {
-								goto l1035
+							position2042, tokenIndex2042 := position, tokenIndex
+							if buffer[position] != rune(':') {
+								goto l2042
 							}
 							position++
-							if !_rules[rulespOpt]() {
-								goto l1035
-							}
-							if !_rules[ruleParamLiteral]() {
-								goto l1035
-							}
-							goto l1034
-						l1035:
-							position, tokenIndex = position1035, tokenIndex1035
+							goto l2040
+						l2042:
+							position, tokenIndex = position2042, tokenIndex2042
 						}
-						goto l1033
-					l1032:
-						position, tokenIndex = position1032, tokenIndex1032
+						goto l2041
+					l2040:
+						position, tokenIndex = position2040, tokenIndex2040
 					}
-				l1033:
-					if !_rules[rulespOpt]() {
-						goto l1029
+				l2041:
+					if !_rules[rulejsonGetPath]() {
+						goto l2037
 					}
+					add(rulePegText, position2039)
+				}
+				if !_rules[ruleAction118]() {
+					goto l2037
+				}
+				add(ruleRowValue, position2038)
+			}
+			return true
+		l2037:
+			position, tokenIndex = position2037, tokenIndex2037
+			return false
+		},
+		/* 159 NumericLiteral <- <(<('-'? [0-9]+)> Action119)> */
+		func() bool {
+			position2043, tokenIndex2043 := position, tokenIndex
+			{
+				position2044 := position
+				{
+					position2045 := position
 					{
-						position1036, tokenIndex1036 := position, tokenIndex
-						if buffer[position] != rune(',') {
-							goto l1036
+						position2046, tokenIndex2046 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l2046
 						}
 						position++
-						goto l1037
-					l1036:
-						position, tokenIndex = position1036, tokenIndex1036
-					}
-				l1037:
-					if !_rules[rulespOpt]() {
-						goto l1029
+						goto l2047
+					l2046:
+						position, tokenIndex = position2046, tokenIndex2046
 					}
-					if buffer[position] != rune(']') {
-						goto l1029
+				l2047:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2043
 					}
 					position++
-					add(rulePegText, position1031)
+				l2048:
+					{
+						position2049, tokenIndex2049 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2049
+						}
+						position++
+						goto l2048
+					l2049:
+						position, tokenIndex = position2049, tokenIndex2049
+					}
+					add(rulePegText, position2045)
 				}
-				if !_rules[ruleAction50]() {
-					goto l1029
+				if !_rules[ruleAction119]() {
+					goto l2043
 				}
-				add(ruleParamArrayExpr, position1030)
+				add(ruleNumericLiteral, position2044)
 			}
 			return true
-		l1029:
-			position, tokenIndex = position1029, tokenIndex1029
+		l2043:
+			position, tokenIndex = position2043, tokenIndex2043
 			return false
 		},
-		/* 68 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action51)> */
+		/* 160 NonNegativeNumericLiteral <- <(<[0-9]+> Action120)> */
 		func() bool {
-			position1038, tokenIndex1038 := position, tokenIndex
+			position2050, tokenIndex2050 := position, tokenIndex
 			{
-				position1039 := position
+				position2051 := position
 				{
-					position1040 := position
-					if buffer[position] != rune('{') {
-						goto l1038
+					position2052 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2050
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1038
-					}
+				l2053:
 					{
-						position1041, tokenIndex1041 := position, tokenIndex
-						if !_rules[ruleParamKeyValuePair]() {
-							goto l1041
-						}
-					l1043:
-						{
-							position1044, tokenIndex1044 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1044
-							}
-							if buffer[position] != rune(',') {
-								goto l1044
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1044
-							}
-							if !_rules[ruleParamKeyValuePair]() {
-								goto l1044
-							}
-							goto l1043
-						l1044:
-							position, tokenIndex = position1044, tokenIndex1044
+						position2054, tokenIndex2054 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2054
 						}
-						goto l1042
-					l1041:
-						position, tokenIndex = position1041, tokenIndex1041
-					}
-				l1042:
-					if !_rules[rulespOpt]() {
-						goto l1038
-					}
-					if buffer[position] != rune('}') {
-						goto l1038
+						position++
+						goto l2053
+					l2054:
+						position, tokenIndex = position2054, tokenIndex2054
 					}
-					position++
-					add(rulePegText, position1040)
+					add(rulePegText, position2052)
 				}
-				if !_rules[ruleAction51]() {
-					goto l1038
+				if !_rules[ruleAction120]() {
+					goto l2050
 				}
-				add(ruleParamMapExpr, position1039)
+				add(ruleNonNegativeNumericLiteral, position2051)
 			}
 			return true
-		l1038:
-			position, tokenIndex = position1038, tokenIndex1038
+		l2050:
+			position, tokenIndex = position2050, tokenIndex2050
 			return false
 		},
-		/* 69 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action52)> */
+		/* 161 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action121)> */
 		func() bool {
-			position1045, tokenIndex1045 := position, tokenIndex
+			position2055, tokenIndex2055 := position, tokenIndex
 			{
-				position1046 := position
+				position2056 := position
 				{
-					position1047 := position
-					if !_rules[ruleStringLiteral]() {
-						goto l1045
+					position2057 := position
+					{
+						position2058, tokenIndex2058 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l2058
+						}
+						position++
+						goto l2059
+					l2058:
+						position, tokenIndex = position2058, tokenIndex2058
 					}
-					if !_rules[rulespOpt]() {
-						goto l1045
+				l2059:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2055
 					}
-					if buffer[position] != rune(':') {
-						goto l1045
+					position++
+				l2060:
+					{
+						position2061, tokenIndex2061 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2061
+						}
+						position++
+						goto l2060
+					l2061:
+						position, tokenIndex = position2061, tokenIndex2061
+					}
+					if buffer[position] != rune('.') {
+						goto l2055
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1045
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2055
 					}
-					if !_rules[ruleParamLiteral]() {
-						goto l1045
+					position++
+				l2062:
+					{
+						position2063, tokenIndex2063 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2063
+						}
+						position++
+						goto l2062
+					l2063:
+						position, tokenIndex = position2063, tokenIndex2063
 					}
-					add(rulePegText, position1047)
+					add(rulePegText, position2057)
 				}
-				if !_rules[ruleAction52]() {
-					goto l1045
+				if !_rules[ruleAction121]() {
+					goto l2055
 				}
-				add(ruleParamKeyValuePair, position1046)
+				add(ruleFloatLiteral, position2056)
 			}
 			return true
-		l1045:
-			position, tokenIndex = position1045, tokenIndex1045
+		l2055:
+			position, tokenIndex = position2055, tokenIndex2055
 			return false
 		},
-		/* 70 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action53)> */
+		/* 162 Function <- <(<ident> Action122)> */
 		func() bool {
-			position1048, tokenIndex1048 := position, tokenIndex
+			position2064, tokenIndex2064 := position, tokenIndex
 			{
-				position1049 := position
+				position2065 := position
 				{
-					position1050 := position
-					{
-						position1051, tokenIndex1051 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1051
-						}
-						{
-							position1053, tokenIndex1053 := position, tokenIndex
-							if !_rules[rulePaused]() {
-								goto l1054
-							}
-							goto l1053
-						l1054:
-							position, tokenIndex = position1053, tokenIndex1053
-							if !_rules[ruleUnpaused]() {
-								goto l1051
-							}
-						}
-					l1053:
-						goto l1052
-					l1051:
-						position, tokenIndex = position1051, tokenIndex1051
+					position2066 := position
+					if !_rules[ruleident]() {
+						goto l2064
 					}
-				l1052:
-					add(rulePegText, position1050)
+					add(rulePegText, position2066)
 				}
-				if !_rules[ruleAction53]() {
-					goto l1048
+				if !_rules[ruleAction122]() {
+					goto l2064
 				}
-				add(rulePausedOpt, position1049)
+				add(ruleFunction, position2065)
 			}
 			return true
-		l1048:
-			position, tokenIndex = position1048, tokenIndex1048
+		l2064:
+			position, tokenIndex = position2064, tokenIndex2064
 			return false
 		},
-		/* 71 ExpressionOrWildcard <- <(Wildcard / Expression)> */
+		/* 163 Placeholder <- <(<('$' ident)> Action123)> */
 		func() bool {
-			position1055, tokenIndex1055 := position, tokenIndex
+			position2067, tokenIndex2067 := position, tokenIndex
 			{
-				position1056 := position
+				position2068 := position
 				{
-					position1057, tokenIndex1057 := position, tokenIndex
-					if !_rules[ruleWildcard]() {
-						goto l1058
+					position2069 := position
+					if buffer[position] != rune('$') {
+						goto l2067
 					}
-					goto l1057
-				l1058:
-					position, tokenIndex = position1057, tokenIndex1057
-					if !_rules[ruleExpression]() {
-						goto l1055
+					position++
+					if !_rules[ruleident]() {
+						goto l2067
 					}
+					add(rulePegText, position2069)
 				}
-			l1057:
-				add(ruleExpressionOrWildcard, position1056)
-			}
-			return true
-		l1055:
-			position, tokenIndex = position1055, tokenIndex1055
-			return false
-		},
-		/* 72 Expression <- <orExpr> */
-		func() bool {
-			position1059, tokenIndex1059 := position, tokenIndex
-			{
-				position1060 := position
-				if !_rules[ruleorExpr]() {
-					goto l1059
+				if !_rules[ruleAction123]() {
+					goto l2067
 				}
-				add(ruleExpression, position1060)
+				add(rulePlaceholder, position2068)
 			}
 			return true
-		l1059:
-			position, tokenIndex = position1059, tokenIndex1059
+		l2067:
+			position, tokenIndex = position2067, tokenIndex2067
 			return false
 		},
-		/* 73 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action54)> */
+		/* 164 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action124)> */
 		func() bool {
-			position1061, tokenIndex1061 := position, tokenIndex
+			position2070, tokenIndex2070 := position, tokenIndex
 			{
-				position1062 := position
+				position2071 := position
 				{
-					position1063 := position
-					if !_rules[ruleandExpr]() {
-						goto l1061
+					position2072 := position
+					{
+						position2073, tokenIndex2073 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2074
+						}
+						position++
+						goto l2073
+					l2074:
+						position, tokenIndex = position2073, tokenIndex2073
+						if buffer[position] != rune('N') {
+							goto l2070
+						}
+						position++
 					}
-				l1064:
+				l2073:
 					{
-						position1065, tokenIndex1065 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1065
+						position2075, tokenIndex2075 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2076
 						}
-						if !_rules[ruleOr]() {
-							goto l1065
+						position++
+						goto l2075
+					l2076:
+						position, tokenIndex = position2075, tokenIndex2075
+						if buffer[position] != rune('U') {
+							goto l2070
 						}
-						if !_rules[rulesp]() {
-							goto l1065
+						position++
+					}
+				l2075:
+					{
+						position2077, tokenIndex2077 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2078
 						}
-						if !_rules[ruleandExpr]() {
-							goto l1065
+						position++
+						goto l2077
+					l2078:
+						position, tokenIndex = position2077, tokenIndex2077
+						if buffer[position] != rune('L') {
+							goto l2070
+						}
+						position++
+					}
+				l2077:
+					{
+						position2079, tokenIndex2079 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2080
+						}
+						position++
+						goto l2079
+					l2080:
+						position, tokenIndex = position2079, tokenIndex2079
+						if buffer[position] != rune('L') {
+							goto l2070
 						}
-						goto l1064
-					l1065:
-						position, tokenIndex = position1065, tokenIndex1065
+						position++
 					}
-					add(rulePegText, position1063)
+				l2079:
+					add(rulePegText, position2072)
 				}
-				if !_rules[ruleAction54]() {
-					goto l1061
+				if !_rules[ruleAction124]() {
+					goto l2070
 				}
-				add(ruleorExpr, position1062)
+				add(ruleNullLiteral, position2071)
 			}
 			return true
-		l1061:
-			position, tokenIndex = position1061, tokenIndex1061
+		l2070:
+			position, tokenIndex = position2070, tokenIndex2070
 			return false
 		},
-		/* 74 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action55)> */
+		/* 165 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action125)> */
 		func() bool {
-			position1066, tokenIndex1066 := position, tokenIndex
+			position2081, tokenIndex2081 := position, tokenIndex
 			{
-				position1067 := position
+				position2082 := position
 				{
-					position1068 := position
-					if !_rules[rulenotExpr]() {
-						goto l1066
+					position2083 := position
+					{
+						position2084, tokenIndex2084 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2085
+						}
+						position++
+						goto l2084
+					l2085:
+						position, tokenIndex = position2084, tokenIndex2084
+						if buffer[position] != rune('M') {
+							goto l2081
+						}
+						position++
+					}
+				l2084:
+					{
+						position2086, tokenIndex2086 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2087
+						}
+						position++
+						goto l2086
+					l2087:
+						position, tokenIndex = position2086, tokenIndex2086
+						if buffer[position] != rune('I') {
+							goto l2081
+						}
+						position++
+					}
+				l2086:
+					{
+						position2088, tokenIndex2088 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2089
+						}
+						position++
+						goto l2088
+					l2089:
+						position, tokenIndex = position2088, tokenIndex2088
+						if buffer[position] != rune('S') {
+							goto l2081
+						}
+						position++
+					}
+				l2088:
+					{
+						position2090, tokenIndex2090 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2091
+						}
+						position++
+						goto l2090
+					l2091:
+						position, tokenIndex = position2090, tokenIndex2090
+						if buffer[position] != rune('S') {
+							goto l2081
+						}
+						position++
+					}
+				l2090:
+					{
+						position2092, tokenIndex2092 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2093
+						}
+						position++
+						goto l2092
+					l2093:
+						position, tokenIndex = position2092, tokenIndex2092
+						if buffer[position] != rune('I') {
+							goto l2081
+						}
+						position++
 					}
-				l1069:
+				l2092:
 					{
-						position1070, tokenIndex1070 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1070
+						position2094, tokenIndex2094 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2095
 						}
-						if !_rules[ruleAnd]() {
-							goto l1070
+						position++
+						goto l2094
+					l2095:
+						position, tokenIndex = position2094, tokenIndex2094
+						if buffer[position] != rune('N') {
+							goto l2081
 						}
-						if !_rules[rulesp]() {
-							goto l1070
+						position++
+					}
+				l2094:
+					{
+						position2096, tokenIndex2096 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2097
 						}
-						if !_rules[rulenotExpr]() {
-							goto l1070
+						position++
+						goto l2096
+					l2097:
+						position, tokenIndex = position2096, tokenIndex2096
+						if buffer[position] != rune('G') {
+							goto l2081
 						}
-						goto l1069
-					l1070:
-						position, tokenIndex = position1070, tokenIndex1070
+						position++
 					}
-					add(rulePegText, position1068)
+				l2096:
+					add(rulePegText, position2083)
 				}
-				if !_rules[ruleAction55]() {
-					goto l1066
+				if !_rules[ruleAction125]() {
+					goto l2081
 				}
-				add(ruleandExpr, position1067)
+				add(ruleMissing, position2082)
 			}
 			return true
-		l1066:
-			position, tokenIndex = position1066, tokenIndex1066
+		l2081:
+			position, tokenIndex = position2081, tokenIndex2081
 			return false
 		},
-		/* 75 notExpr <- <(<((Not sp)? comparisonExpr)> Action56)> */
+		/* 166 BooleanLiteral <- <(TRUE / FALSE)> */
 		func() bool {
-			position1071, tokenIndex1071 := position, tokenIndex
+			position2098, tokenIndex2098 := position, tokenIndex
 			{
-				position1072 := position
+				position2099 := position
 				{
-					position1073 := position
-					{
-						position1074, tokenIndex1074 := position, tokenIndex
-						if !_rules[ruleNot]() {
-							goto l1074
-						}
-						if !_rules[rulesp]() {
-							goto l1074
-						}
-						goto l1075
-					l1074:
-						position, tokenIndex = position1074, tokenIndex1074
+					position2100, tokenIndex2100 := position, tokenIndex
+					if !_rules[ruleTRUE]() {
+						goto l2101
 					}
-				l1075:
-					if !_rules[rulecomparisonExpr]() {
-						goto l1071
+					goto l2100
+				l2101:
+					position, tokenIndex = position2100, tokenIndex2100
+					if !_rules[ruleFALSE]() {
+						goto l2098
 					}
-					add(rulePegText, position1073)
-				}
-				if !_rules[ruleAction56]() {
-					goto l1071
 				}
-				add(rulenotExpr, position1072)
+			l2100:
+				add(ruleBooleanLiteral, position2099)
 			}
 			return true
-		l1071:
-			position, tokenIndex = position1071, tokenIndex1071
+		l2098:
+			position, tokenIndex = position2098, tokenIndex2098
 			return false
 		},
-		/* 76 comparisonExpr <- <(<(otherOpExpr (spOpt ComparisonOp spOpt otherOpExpr)?)> Action57)> */
+		/* 167 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action126)> */
 		func() bool {
-			position1076, tokenIndex1076 := position, tokenIndex
+			position2102, tokenIndex2102 := position, tokenIndex
 			{
-				position1077 := position
+				position2103 := position
 				{
-					position1078 := position
-					if !_rules[ruleotherOpExpr]() {
-						goto l1076
-					}
+					position2104 := position
 					{
-						position1079, tokenIndex1079 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1079
+						position2105, tokenIndex2105 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2106
 						}
-						if !_rules[ruleComparisonOp]() {
-							goto l1079
+						position++
+						goto l2105
+					l2106:
+						position, tokenIndex = position2105, tokenIndex2105
+						if buffer[position] != rune('T') {
+							goto l2102
 						}
-						if !_rules[rulespOpt]() {
-							goto l1079
+						position++
+					}
+				l2105:
+					{
+						position2107, tokenIndex2107 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2108
 						}
-						if !_rules[ruleotherOpExpr]() {
-							goto l1079
+						position++
+						goto l2107
+					l2108:
+						position, tokenIndex = position2107, tokenIndex2107
+						if buffer[position] != rune('R') {
+							goto l2102
 						}
-						goto l1080
-					l1079:
-						position, tokenIndex = position1079, tokenIndex1079
-					}
-				l1080:
-					add(rulePegText, position1078)
-				}
-				if !_rules[ruleAction57]() {
-					goto l1076
-				}
-				add(rulecomparisonExpr, position1077)
-			}
-			return true
-		l1076:
-			position, tokenIndex = position1076, tokenIndex1076
-			return false
-		},
-		/* 77 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action58)> */
-		func() bool {
-			position1081, tokenIndex1081 := position, tokenIndex
-			{
-				position1082 := position
-				{
-					position1083 := position
-					if !_rules[ruleisExpr]() {
-						goto l1081
+						position++
 					}
-				l1084:
+				l2107:
 					{
-						position1085, tokenIndex1085 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1085
+						position2109, tokenIndex2109 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2110
 						}
-						if !_rules[ruleOtherOp]() {
-							goto l1085
+						position++
+						goto l2109
+					l2110:
+						position, tokenIndex = position2109, tokenIndex2109
+						if buffer[position] != rune('U') {
+							goto l2102
 						}
-						if !_rules[rulespOpt]() {
-							goto l1085
+						position++
+					}
+				l2109:
+					{
+						position2111, tokenIndex2111 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2112
 						}
-						if !_rules[ruleisExpr]() {
-							goto l1085
+						position++
+						goto l2111
+					l2112:
+						position, tokenIndex = position2111, tokenIndex2111
+						if buffer[position] != rune('E') {
+							goto l2102
 						}
-						goto l1084
-					l1085:
-						position, tokenIndex = position1085, tokenIndex1085
+						position++
 					}
-					add(rulePegText, position1083)
+				l2111:
+					add(rulePegText, position2104)
 				}
-				if !_rules[ruleAction58]() {
-					goto l1081
+				if !_rules[ruleAction126]() {
+					goto l2102
 				}
-				add(ruleotherOpExpr, position1082)
+				add(ruleTRUE, position2103)
 			}
 			return true
-		l1081:
-			position, tokenIndex = position1081, tokenIndex1081
+		l2102:
+			position, tokenIndex = position2102, tokenIndex2102
 			return false
 		},
-		/* 78 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action59)> */
+		/* 168 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action127)> */
 		func() bool {
-			position1086, tokenIndex1086 := position, tokenIndex
+			position2113, tokenIndex2113 := position, tokenIndex
 			{
-				position1087 := position
+				position2114 := position
 				{
-					position1088 := position
+					position2115 := position
 					{
-						position1089, tokenIndex1089 := position, tokenIndex
-						if !_rules[ruleRowValue]() {
-							goto l1090
-						}
-						if !_rules[rulesp]() {
-							goto l1090
+						position2116, tokenIndex2116 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2117
 						}
-						if !_rules[ruleIsOp]() {
-							goto l1090
+						position++
+						goto l2116
+					l2117:
+						position, tokenIndex = position2116, tokenIndex2116
+						if buffer[position] != rune('F') {
+							goto l2113
 						}
-						if !_rules[rulesp]() {
-							goto l1090
+						position++
+					}
+				l2116:
+					{
+						position2118, tokenIndex2118 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2119
 						}
-						if !_rules[ruleMissing]() {
-							goto l1090
+						position++
+						goto l2118
+					l2119:
+						position, tokenIndex = position2118, tokenIndex2118
+						if buffer[position] != rune('A') {
+							goto l2113
 						}
-						goto l1089
-					l1090:
-						position, tokenIndex = position1089, tokenIndex1089
-						if !_rules[ruletermExpr]() {
-							goto l1086
+						position++
+					}
+				l2118:
+					{
+						position2120, tokenIndex2120 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2121
 						}
-						{
-							position1091, tokenIndex1091 := position, tokenIndex
-							if !_rules[rulesp]() {
-								goto l1091
-							}
-							if !_rules[ruleIsOp]() {
-								goto l1091
-							}
-							if !_rules[rulesp]() {
-								goto l1091
-							}
-							if !_rules[ruleNullLiteral]() {
-								goto l1091
-							}
-							goto l1092
-						l1091:
-							position, tokenIndex = position1091, tokenIndex1091
+						position++
+						goto l2120
+					l2121:
+						position, tokenIndex = position2120, tokenIndex2120
+						if buffer[position] != rune('L') {
+							goto l2113
 						}
-					l1092:
-					}
-				l1089:
-					add(rulePegText, position1088)
-				}
-				if !_rules[ruleAction59]() {
-					goto l1086
-				}
-				add(ruleisExpr, position1087)
-			}
-			return true
-		l1086:
-			position, tokenIndex = position1086, tokenIndex1086
-			return false
-		},
-		/* 79 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action60)> */
-		func() bool {
-			position1093, tokenIndex1093 := position, tokenIndex
-			{
-				position1094 := position
-				{
-					position1095 := position
-					if !_rules[ruleproductExpr]() {
-						goto l1093
+						position++
 					}
-				l1096:
+				l2120:
 					{
-						position1097, tokenIndex1097 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1097
+						position2122, tokenIndex2122 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2123
 						}
-						if !_rules[rulePlusMinusOp]() {
-							goto l1097
+						position++
+						goto l2122
+					l2123:
+						position, tokenIndex = position2122, tokenIndex2122
+						if buffer[position] != rune('S') {
+							goto l2113
 						}
-						if !_rules[rulespOpt]() {
-							goto l1097
+						position++
+					}
+				l2122:
+					{
+						position2124, tokenIndex2124 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2125
 						}
-						if !_rules[ruleproductExpr]() {
-							goto l1097
+						position++
+						goto l2124
+					l2125:
+						position, tokenIndex = position2124, tokenIndex2124
+						if buffer[position] != rune('E') {
+							goto l2113
 						}
-						goto l1096
-					l1097:
-						position, tokenIndex = position1097, tokenIndex1097
+						position++
 					}
-					add(rulePegText, position1095)
+				l2124:
+					add(rulePegText, position2115)
 				}
-				if !_rules[ruleAction60]() {
-					goto l1093
+				if !_rules[ruleAction127]() {
+					goto l2113
 				}
-				add(ruletermExpr, position1094)
+				add(ruleFALSE, position2114)
 			}
 			return true
-		l1093:
-			position, tokenIndex = position1093, tokenIndex1093
+		l2113:
+			position, tokenIndex = position2113, tokenIndex2113
 			return false
 		},
-		/* 80 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action61)> */
+		/* 169 Wildcard <- <(<((ident ':' !':')? '*')> Action128)> */
 		func() bool {
-			position1098, tokenIndex1098 := position, tokenIndex
+			position2126, tokenIndex2126 := position, tokenIndex
 			{
-				position1099 := position
+				position2127 := position
 				{
-					position1100 := position
-					if !_rules[ruleminusExpr]() {
-						goto l1098
-					}
-				l1101:
+					position2128 := position
 					{
-						position1102, tokenIndex1102 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1102
-						}
-						if !_rules[ruleMultDivOp]() {
-							goto l1102
+						position2129, tokenIndex2129 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l2129
 						}
-						if !_rules[rulespOpt]() {
-							goto l1102
+						if buffer[position] != rune(':') {
+							goto l2129
 						}
-						if !_rules[ruleminusExpr]() {
-							goto l1102
+						position++
+						{
+							position2131, tokenIndex2131 := position, tokenIndex
+							if buffer[position] != rune(':') {
+								goto l2131
+							}
+							position++
+							goto l2129
+						l2131:
+							position, tokenIndex = position2131, tokenIndex2131
 						}
-						goto l1101
-					l1102:
-						position, tokenIndex = position1102, tokenIndex1102
+						goto l2130
+					l2129:
+						position, tokenIndex = position2129, tokenIndex2129
+					}
+				l2130:
+					if buffer[position] != rune('*') {
+						goto l2126
 					}
-					add(rulePegText, position1100)
+					position++
+					add(rulePegText, position2128)
 				}
-				if !_rules[ruleAction61]() {
-					goto l1098
+				if !_rules[ruleAction128]() {
+					goto l2126
 				}
-				add(ruleproductExpr, position1099)
+				add(ruleWildcard, position2127)
 			}
 			return true
-		l1098:
-			position, tokenIndex = position1098, tokenIndex1098
+		l2126:
+			position, tokenIndex = position2126, tokenIndex2126
 			return false
 		},
-		/* 81 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action62)> */
+		/* 170 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action129)> */
 		func() bool {
-			position1103, tokenIndex1103 := position, tokenIndex
+			position2132, tokenIndex2132 := position, tokenIndex
 			{
-				position1104 := position
+				position2133 := position
 				{
-					position1105 := position
+					position2134 := position
+					if buffer[position] != rune('"') {
+						goto l2132
+					}
+					position++
+				l2135:
 					{
-						position1106, tokenIndex1106 := position, tokenIndex
-						if !_rules[ruleUnaryMinus]() {
-							goto l1106
-						}
-						if !_rules[rulespOpt]() {
-							goto l1106
+						position2136, tokenIndex2136 := position, tokenIndex
+						{
+							position2137, tokenIndex2137 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l2138
+							}
+							position++
+							if buffer[position] != rune('"') {
+								goto l2138
+							}
+							position++
+							goto l2137
+						l2138:
+							position, tokenIndex = position2137, tokenIndex2137
+							{
+								position2139, tokenIndex2139 := position, tokenIndex
+								if buffer[position] != rune('"') {
+									goto l2139
+								}
+								position++
+								goto l2136
+							l2139:
+								position, tokenIndex = position2139, tokenIndex2139
+							}
+							if !matchDot() {
+								goto l2136
+							}
 						}
-						goto l1107
-					l1106:
-						position, tokenIndex = position1106, tokenIndex1106
+					l2137:
+						goto l2135
+					l2136:
+						position, tokenIndex = position2136, tokenIndex2136
 					}
-				l1107:
-					if !_rules[rulecastExpr]() {
-						goto l1103
+					if buffer[position] != rune('"') {
+						goto l2132
 					}
-					add(rulePegText, position1105)
+					position++
+					add(rulePegText, position2134)
 				}
-				if !_rules[ruleAction62]() {
-					goto l1103
+				if !_rules[ruleAction129]() {
+					goto l2132
 				}
-				add(ruleminusExpr, position1104)
+				add(ruleStringLiteral, position2133)
 			}
 			return true
-		l1103:
-			position, tokenIndex = position1103, tokenIndex1103
+		l2132:
+			position, tokenIndex = position2132, tokenIndex2132
 			return false
 		},
-		/* 82 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action63)> */
+		/* 171 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action130)> */
 		func() bool {
-			position1108, tokenIndex1108 := position, tokenIndex
+			position2140, tokenIndex2140 := position, tokenIndex
 			{
-				position1109 := position
+				position2141 := position
 				{
-					position1110 := position
-					if !_rules[rulebaseExpr]() {
-						goto l1108
-					}
+					position2142 := position
 					{
-						position1111, tokenIndex1111 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1111
-						}
-						if buffer[position] != rune(':') {
-							goto l1111
+						position2143, tokenIndex2143 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2144
 						}
 						position++
-						if buffer[position] != rune(':') {
-							goto l1111
+						goto l2143
+					l2144:
+						position, tokenIndex = position2143, tokenIndex2143
+						if buffer[position] != rune('I') {
+							goto l2140
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l1111
+					}
+				l2143:
+					{
+						position2145, tokenIndex2145 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2146
 						}
-						if !_rules[ruleType]() {
-							goto l1111
+						position++
+						goto l2145
+					l2146:
+						position, tokenIndex = position2145, tokenIndex2145
+						if buffer[position] != rune('S') {
+							goto l2140
 						}
-						goto l1112
-					l1111:
-						position, tokenIndex = position1111, tokenIndex1111
-					}
-				l1112:
-					add(rulePegText, position1110)
-				}
-				if !_rules[ruleAction63]() {
-					goto l1108
-				}
-				add(rulecastExpr, position1109)
-			}
-			return true
-		l1108:
-			position, tokenIndex = position1108, tokenIndex1108
-			return false
-		},
-		/* 83 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncAppSelector / FuncApp / RowValue / ArrayExpr / Literal)> */
-		func() bool {
-			position1113, tokenIndex1113 := position, tokenIndex
-			{
-				position1114 := position
-				{
-					position1115, tokenIndex1115 := position, tokenIndex
-					if buffer[position] != rune('(') {
-						goto l1116
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1116
-					}
-					if !_rules[ruleExpression]() {
-						goto l1116
-					}
-					if !_rules[rulespOpt]() {
-						goto l1116
-					}
-					if buffer[position] != rune(')') {
-						goto l1116
-					}
-					position++
-					goto l1115
-				l1116:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleMapExpr]() {
-						goto l1117
-					}
-					goto l1115
-				l1117:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1118
-					}
-					goto l1115
-				l1118:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleNullLiteral]() {
-						goto l1119
-					}
-					goto l1115
-				l1119:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleCase]() {
-						goto l1120
-					}
-					goto l1115
-				l1120:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleRowMeta]() {
-						goto l1121
-					}
-					goto l1115
-				l1121:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncTypeCast]() {
-						goto l1122
+						position++
 					}
-					goto l1115
-				l1122:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncAppSelector]() {
-						goto l1123
+				l2145:
+					{
+						position2147, tokenIndex2147 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2148
+						}
+						position++
+						goto l2147
+					l2148:
+						position, tokenIndex = position2147, tokenIndex2147
+						if buffer[position] != rune('T') {
+							goto l2140
+						}
+						position++
 					}
-					goto l1115
-				l1123:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncApp]() {
-						goto l1124
+				l2147:
+					{
+						position2149, tokenIndex2149 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2150
+						}
+						position++
+						goto l2149
+					l2150:
+						position, tokenIndex = position2149, tokenIndex2149
+						if buffer[position] != rune('R') {
+							goto l2140
+						}
+						position++
 					}
-					goto l1115
-				l1124:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleRowValue]() {
-						goto l1125
+				l2149:
+					{
+						position2151, tokenIndex2151 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2152
+						}
+						position++
+						goto l2151
+					l2152:
+						position, tokenIndex = position2151, tokenIndex2151
+						if buffer[position] != rune('E') {
+							goto l2140
+						}
+						position++
 					}
-					goto l1115
-				l1125:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleArrayExpr]() {
-						goto l1126
+				l2151:
+					{
+						position2153, tokenIndex2153 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2154
+						}
+						position++
+						goto l2153
+					l2154:
+						position, tokenIndex = position2153, tokenIndex2153
+						if buffer[position] != rune('A') {
+							goto l2140
+						}
+						position++
 					}
-					goto l1115
-				l1126:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleLiteral]() {
-						goto l1113
+				l2153:
+					{
+						position2155, tokenIndex2155 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2156
+						}
+						position++
+						goto l2155
+					l2156:
+						position, tokenIndex = position2155, tokenIndex2155
+						if buffer[position] != rune('M') {
+							goto l2140
+						}
+						position++
 					}
+				l2155:
+					add(rulePegText, position2142)
+				}
+				if !_rules[ruleAction130]() {
+					goto l2140
 				}
-			l1115:
-				add(rulebaseExpr, position1114)
+				add(ruleISTREAM, position2141)
 			}
 			return true
-		l1113:
-			position, tokenIndex = position1113, tokenIndex1113
+		l2140:
+			position, tokenIndex = position2140, tokenIndex2140
 			return false
 		},
-		/* 84 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action64)> */
+		/* 172 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action131)> */
 		func() bool {
-			position1127, tokenIndex1127 := position, tokenIndex
+			position2157, tokenIndex2157 := position, tokenIndex
 			{
-				position1128 := position
+				position2158 := position
 				{
-					position1129 := position
+					position2159 := position
 					{
-						position1130, tokenIndex1130 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1131
+						position2160, tokenIndex2160 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2161
 						}
 						position++
-						goto l1130
-					l1131:
-						position, tokenIndex = position1130, tokenIndex1130
-						if buffer[position] != rune('C') {
-							goto l1127
+						goto l2160
+					l2161:
+						position, tokenIndex = position2160, tokenIndex2160
+						if buffer[position] != rune('D') {
+							goto l2157
 						}
 						position++
 					}
-				l1130:
+				l2160:
 					{
-						position1132, tokenIndex1132 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1133
+						position2162, tokenIndex2162 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2163
 						}
 						position++
-						goto l1132
-					l1133:
-						position, tokenIndex = position1132, tokenIndex1132
-						if buffer[position] != rune('A') {
-							goto l1127
+						goto l2162
+					l2163:
+						position, tokenIndex = position2162, tokenIndex2162
+						if buffer[position] != rune('S') {
+							goto l2157
 						}
 						position++
 					}
-				l1132:
+				l2162:
 					{
-						position1134, tokenIndex1134 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1135
+						position2164, tokenIndex2164 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2165
 						}
 						position++
-						goto l1134
-					l1135:
-						position, tokenIndex = position1134, tokenIndex1134
-						if buffer[position] != rune('S') {
-							goto l1127
+						goto l2164
+					l2165:
+						position, tokenIndex = position2164, tokenIndex2164
+						if buffer[position] != rune('T') {
+							goto l2157
 						}
 						position++
 					}
-				l1134:
+				l2164:
 					{
-						position1136, tokenIndex1136 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1137
+						position2166, tokenIndex2166 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2167
 						}
 						position++
-						goto l1136
-					l1137:
-						position, tokenIndex = position1136, tokenIndex1136
-						if buffer[position] != rune('T') {
-							goto l1127
+						goto l2166
+					l2167:
+						position, tokenIndex = position2166, tokenIndex2166
+						if buffer[position] != rune('R') {
+							goto l2157
 						}
 						position++
 					}
-				l1136:
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if buffer[position] != rune('(') {
-						goto l1127
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if !_rules[ruleExpression]() {
-						goto l1127
-					}
-					if !_rules[rulesp]() {
-						goto l1127
+				l2166:
+					{
+						position2168, tokenIndex2168 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2169
+						}
+						position++
+						goto l2168
+					l2169:
+						position, tokenIndex = position2168, tokenIndex2168
+						if buffer[position] != rune('E') {
+							goto l2157
+						}
+						position++
 					}
+				l2168:
 					{
-						position1138, tokenIndex1138 := position, tokenIndex
+						position2170, tokenIndex2170 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1139
+							goto l2171
 						}
 						position++
-						goto l1138
-					l1139:
-						position, tokenIndex = position1138, tokenIndex1138
+						goto l2170
+					l2171:
+						position, tokenIndex = position2170, tokenIndex2170
 						if buffer[position] != rune('A') {
-							goto l1127
+							goto l2157
 						}
 						position++
 					}
-				l1138:
+				l2170:
 					{
-						position1140, tokenIndex1140 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1141
+						position2172, tokenIndex2172 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2173
 						}
 						position++
-						goto l1140
-					l1141:
-						position, tokenIndex = position1140, tokenIndex1140
-						if buffer[position] != rune('S') {
-							goto l1127
+						goto l2172
+					l2173:
+						position, tokenIndex = position2172, tokenIndex2172
+						if buffer[position] != rune('M') {
+							goto l2157
 						}
 						position++
 					}
-				l1140:
-					if !_rules[rulesp]() {
-						goto l1127
-					}
-					if !_rules[ruleType]() {
-						goto l1127
-					}
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if buffer[position] != rune(')') {
-						goto l1127
-					}
-					position++
-					add(rulePegText, position1129)
+				l2172:
+					add(rulePegText, position2159)
 				}
-				if !_rules[ruleAction64]() {
-					goto l1127
+				if !_rules[ruleAction131]() {
+					goto l2157
 				}
-				add(ruleFuncTypeCast, position1128)
+				add(ruleDSTREAM, position2158)
 			}
 			return true
-		l1127:
-			position, tokenIndex = position1127, tokenIndex1127
+		l2157:
+			position, tokenIndex = position2157, tokenIndex2157
 			return false
 		},
-		/* 85 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		/* 173 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action132)> */
 		func() bool {
-			position1142, tokenIndex1142 := position, tokenIndex
+			position2174, tokenIndex2174 := position, tokenIndex
 			{
-				position1143 := position
+				position2175 := position
 				{
-					position1144, tokenIndex1144 := position, tokenIndex
-					if !_rules[ruleFuncAppWithOrderBy]() {
-						goto l1145
+					position2176 := position
+					{
+						position2177, tokenIndex2177 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2178
+						}
+						position++
+						goto l2177
+					l2178:
+						position, tokenIndex = position2177, tokenIndex2177
+						if buffer[position] != rune('R') {
+							goto l2174
+						}
+						position++
 					}
-					goto l1144
-				l1145:
-					position, tokenIndex = position1144, tokenIndex1144
-					if !_rules[ruleFuncAppWithoutOrderBy]() {
-						goto l1142
+				l2177:
+					{
+						position2179, tokenIndex2179 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2180
+						}
+						position++
+						goto l2179
+					l2180:
+						position, tokenIndex = position2179, tokenIndex2179
+						if buffer[position] != rune('S') {
+							goto l2174
+						}
+						position++
 					}
-				}
-			l1144:
-				add(ruleFuncApp, position1143)
-			}
-			return true
-		l1142:
-			position, tokenIndex = position1142, tokenIndex1142
-			return false
-		},
-		/* 86 FuncAppSelector <- <(FuncApp FuncElemAccessor Action65)> */
-		func() bool {
-			position1146, tokenIndex1146 := position, tokenIndex
-			{
-				position1147 := position
-				if !_rules[ruleFuncApp]() {
-					goto l1146
-				}
-				if !_rules[ruleFuncElemAccessor]() {
-					goto l1146
-				}
-				if !_rules[ruleAction65]() {
-					goto l1146
-				}
-				add(ruleFuncAppSelector, position1147)
-			}
-			return true
-		l1146:
-			position, tokenIndex = position1146, tokenIndex1146
-			return false
-		},
-		/* 87 FuncElemAccessor <- <(<jsonGetPathNonHead+> Action66)> */
-		func() bool {
-			position1148, tokenIndex1148 := position, tokenIndex
-			{
-				position1149 := position
-				{
-					position1150 := position
-					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1148
+				l2179:
+					{
+						position2181, tokenIndex2181 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2182
+						}
+						position++
+						goto l2181
+					l2182:
+						position, tokenIndex = position2181, tokenIndex2181
+						if buffer[position] != rune('T') {
+							goto l2174
+						}
+						position++
 					}
-				l1151:
+				l2181:
 					{
-						position1152, tokenIndex1152 := position, tokenIndex
-						if !_rules[rulejsonGetPathNonHead]() {
-							goto l1152
+						position2183, tokenIndex2183 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2184
 						}
-						goto l1151
-					l1152:
-						position, tokenIndex = position1152, tokenIndex1152
+						position++
+						goto l2183
+					l2184:
+						position, tokenIndex = position2183, tokenIndex2183
+						if buffer[position] != rune('R') {
+							goto l2174
+						}
+						position++
 					}
-					add(rulePegText, position1150)
-				}
-				if !_rules[ruleAction66]() {
-					goto l1148
-				}
-				add(ruleFuncElemAccessor, position1149)
-			}
-			return true
-		l1148:
-			position, tokenIndex = position1148, tokenIndex1148
-			return false
-		},
-		/* 88 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action67)> */
-		func() bool {
-			position1153, tokenIndex1153 := position, tokenIndex
-			{
-				position1154 := position
-				if !_rules[ruleFunction]() {
-					goto l1153
-				}
-				if !_rules[rulespOpt]() {
-					goto l1153
-				}
-				if buffer[position] != rune('(') {
-					goto l1153
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1153
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1153
-				}
-				if !_rules[rulesp]() {
-					goto l1153
-				}
-				if !_rules[ruleParamsOrder]() {
-					goto l1153
-				}
-				if !_rules[rulespOpt]() {
-					goto l1153
-				}
-				if buffer[position] != rune(')') {
-					goto l1153
-				}
-				position++
-				if !_rules[ruleAction67]() {
-					goto l1153
-				}
-				add(ruleFuncAppWithOrderBy, position1154)
-			}
-			return true
-		l1153:
-			position, tokenIndex = position1153, tokenIndex1153
-			return false
-		},
-		/* 89 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action68)> */
-		func() bool {
-			position1155, tokenIndex1155 := position, tokenIndex
-			{
-				position1156 := position
-				if !_rules[ruleFunction]() {
-					goto l1155
-				}
-				if !_rules[rulespOpt]() {
-					goto l1155
-				}
-				if buffer[position] != rune('(') {
-					goto l1155
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1155
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1155
-				}
-				{
-					position1157 := position
-					if !_rules[rulespOpt]() {
-						goto l1155
+				l2183:
+					{
+						position2185, tokenIndex2185 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2186
+						}
+						position++
+						goto l2185
+					l2186:
+						position, tokenIndex = position2185, tokenIndex2185
+						if buffer[position] != rune('E') {
+							goto l2174
+						}
+						position++
 					}
-					add(rulePegText, position1157)
-				}
-				if buffer[position] != rune(')') {
-					goto l1155
-				}
-				position++
-				if !_rules[ruleAction68]() {
-					goto l1155
-				}
-				add(ruleFuncAppWithoutOrderBy, position1156)
-			}
-			return true
-		l1155:
-			position, tokenIndex = position1155, tokenIndex1155
-			return false
-		},
-		/* 90 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action69)> */
-		func() bool {
-			position1158, tokenIndex1158 := position, tokenIndex
-			{
-				position1159 := position
-				{
-					position1160 := position
+				l2185:
 					{
-						position1161, tokenIndex1161 := position, tokenIndex
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1161
+						position2187, tokenIndex2187 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2188
 						}
-					l1163:
-						{
-							position1164, tokenIndex1164 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1164
-							}
-							if buffer[position] != rune(',') {
-								goto l1164
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1164
-							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1164
-							}
-							goto l1163
-						l1164:
-							position, tokenIndex = position1164, tokenIndex1164
+						position++
+						goto l2187
+					l2188:
+						position, tokenIndex = position2187, tokenIndex2187
+						if buffer[position] != rune('A') {
+							goto l2174
 						}
-						goto l1162
-					l1161:
-						position, tokenIndex = position1161, tokenIndex1161
+						position++
+					}
+				l2187:
+					{
+						position2189, tokenIndex2189 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2190
+						}
+						position++
+						goto l2189
+					l2190:
+						position, tokenIndex = position2189, tokenIndex2189
+						if buffer[position] != rune('M') {
+							goto l2174
+						}
+						position++
 					}
-				l1162:
-					add(rulePegText, position1160)
+				l2189:
+					add(rulePegText, position2176)
 				}
-				if !_rules[ruleAction69]() {
-					goto l1158
+				if !_rules[ruleAction132]() {
+					goto l2174
 				}
-				add(ruleFuncParams, position1159)
+				add(ruleRSTREAM, position2175)
 			}
 			return true
-		l1158:
-			position, tokenIndex = position1158, tokenIndex1158
+		l2174:
+			position, tokenIndex = position2174, tokenIndex2174
 			return false
 		},
-		/* 91 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action70)> */
+		/* 174 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action133)> */
 		func() bool {
-			position1165, tokenIndex1165 := position, tokenIndex
+			position2191, tokenIndex2191 := position, tokenIndex
 			{
-				position1166 := position
+				position2192 := position
 				{
-					position1167 := position
+					position2193 := position
 					{
-						position1168, tokenIndex1168 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1169
+						position2194, tokenIndex2194 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2195
 						}
 						position++
-						goto l1168
-					l1169:
-						position, tokenIndex = position1168, tokenIndex1168
-						if buffer[position] != rune('O') {
-							goto l1165
+						goto l2194
+					l2195:
+						position, tokenIndex = position2194, tokenIndex2194
+						if buffer[position] != rune('T') {
+							goto l2191
 						}
 						position++
 					}
-				l1168:
+				l2194:
 					{
-						position1170, tokenIndex1170 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1171
+						position2196, tokenIndex2196 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2197
 						}
 						position++
-						goto l1170
-					l1171:
-						position, tokenIndex = position1170, tokenIndex1170
-						if buffer[position] != rune('R') {
-							goto l1165
+						goto l2196
+					l2197:
+						position, tokenIndex = position2196, tokenIndex2196
+						if buffer[position] != rune('U') {
+							goto l2191
 						}
 						position++
 					}
-				l1170:
+				l2196:
 					{
-						position1172, tokenIndex1172 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1173
+						position2198, tokenIndex2198 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2199
 						}
 						position++
-						goto l1172
-					l1173:
-						position, tokenIndex = position1172, tokenIndex1172
-						if buffer[position] != rune('D') {
-							goto l1165
+						goto l2198
+					l2199:
+						position, tokenIndex = position2198, tokenIndex2198
+						if buffer[position] != rune('P') {
+							goto l2191
+						}
+						position++
+					}
+				l2198:
+					{
+						position2200, tokenIndex2200 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2201
+						}
+						position++
+						goto l2200
+					l2201:
+						position, tokenIndex = position2200, tokenIndex2200
+						if buffer[position] != rune('L') {
+							goto l2191
 						}
 						position++
 					}
-				l1172:
+				l2200:
 					{
-						position1174, tokenIndex1174 := position, tokenIndex
+						position2202, tokenIndex2202 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1175
+							goto l2203
 						}
 						position++
-						goto l1174
-					l1175:
-						position, tokenIndex = position1174, tokenIndex1174
+						goto l2202
+					l2203:
+						position, tokenIndex = position2202, tokenIndex2202
 						if buffer[position] != rune('E') {
-							goto l1165
+							goto l2191
 						}
 						position++
 					}
-				l1174:
+				l2202:
 					{
-						position1176, tokenIndex1176 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1177
+						position2204, tokenIndex2204 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2205
 						}
 						position++
-						goto l1176
-					l1177:
-						position, tokenIndex = position1176, tokenIndex1176
-						if buffer[position] != rune('R') {
-							goto l1165
+						goto l2204
+					l2205:
+						position, tokenIndex = position2204, tokenIndex2204
+						if buffer[position] != rune('S') {
+							goto l2191
 						}
 						position++
 					}
-				l1176:
-					if !_rules[rulesp]() {
-						goto l1165
-					}
+				l2204:
+					add(rulePegText, position2193)
+				}
+				if !_rules[ruleAction133]() {
+					goto l2191
+				}
+				add(ruleTUPLES, position2192)
+			}
+			return true
+		l2191:
+			position, tokenIndex = position2191, tokenIndex2191
+			return false
+		},
+		/* 175 MINUTES <- <(<(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('s' / 'S'))> Action134)> */
+		func() bool {
+			position2206, tokenIndex2206 := position, tokenIndex
+			{
+				position2207 := position
+				{
+					position2208 := position
 					{
-						position1178, tokenIndex1178 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1179
+						position2209, tokenIndex2209 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2210
 						}
 						position++
-						goto l1178
-					l1179:
-						position, tokenIndex = position1178, tokenIndex1178
-						if buffer[position] != rune('B') {
-							goto l1165
+						goto l2209
+					l2210:
+						position, tokenIndex = position2209, tokenIndex2209
+						if buffer[position] != rune('M') {
+							goto l2206
 						}
 						position++
 					}
-				l1178:
+				l2209:
 					{
-						position1180, tokenIndex1180 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l1181
+						position2211, tokenIndex2211 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2212
 						}
 						position++
-						goto l1180
-					l1181:
-						position, tokenIndex = position1180, tokenIndex1180
-						if buffer[position] != rune('Y') {
-							goto l1165
+						goto l2211
+					l2212:
+						position, tokenIndex = position2211, tokenIndex2211
+						if buffer[position] != rune('I') {
+							goto l2206
 						}
 						position++
 					}
-				l1180:
-					if !_rules[rulesp]() {
-						goto l1165
-					}
-					if !_rules[ruleSortedExpression]() {
-						goto l1165
-					}
-				l1182:
+				l2211:
 					{
-						position1183, tokenIndex1183 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1183
+						position2213, tokenIndex2213 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2214
 						}
-						if buffer[position] != rune(',') {
-							goto l1183
+						position++
+						goto l2213
+					l2214:
+						position, tokenIndex = position2213, tokenIndex2213
+						if buffer[position] != rune('N') {
+							goto l2206
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l1183
+					}
+				l2213:
+					{
+						position2215, tokenIndex2215 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2216
 						}
-						if !_rules[ruleSortedExpression]() {
-							goto l1183
+						position++
+						goto l2215
+					l2216:
+						position, tokenIndex = position2215, tokenIndex2215
+						if buffer[position] != rune('U') {
+							goto l2206
 						}
-						goto l1182
-					l1183:
-						position, tokenIndex = position1183, tokenIndex1183
+						position++
 					}
-					add(rulePegText, position1167)
-				}
-				if !_rules[ruleAction70]() {
-					goto l1165
-				}
-				add(ruleParamsOrder, position1166)
-			}
-			return true
-		l1165:
-			position, tokenIndex = position1165, tokenIndex1165
-			return false
-		},
-		/* 92 SortedExpression <- <(Expression OrderDirectionOpt Action71)> */
-		func() bool {
-			position1184, tokenIndex1184 := position, tokenIndex
-			{
-				position1185 := position
-				if !_rules[ruleExpression]() {
-					goto l1184
-				}
-				if !_rules[ruleOrderDirectionOpt]() {
-					goto l1184
-				}
-				if !_rules[ruleAction71]() {
-					goto l1184
-				}
-				add(ruleSortedExpression, position1185)
-			}
-			return true
-		l1184:
-			position, tokenIndex = position1184, tokenIndex1184
-			return false
-		},
-		/* 93 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action72)> */
-		func() bool {
-			position1186, tokenIndex1186 := position, tokenIndex
-			{
-				position1187 := position
-				{
-					position1188 := position
+				l2215:
 					{
-						position1189, tokenIndex1189 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1189
+						position2217, tokenIndex2217 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2218
 						}
-						{
-							position1191, tokenIndex1191 := position, tokenIndex
-							if !_rules[ruleAscending]() {
-								goto l1192
-							}
-							goto l1191
-						l1192:
-							position, tokenIndex = position1191, tokenIndex1191
-							if !_rules[ruleDescending]() {
-								goto l1189
-							}
+						position++
+						goto l2217
+					l2218:
+						position, tokenIndex = position2217, tokenIndex2217
+						if buffer[position] != rune('T') {
+							goto l2206
 						}
-					l1191:
-						goto l1190
-					l1189:
-						position, tokenIndex = position1189, tokenIndex1189
-					}
-				l1190:
-					add(rulePegText, position1188)
-				}
-				if !_rules[ruleAction72]() {
-					goto l1186
-				}
-				add(ruleOrderDirectionOpt, position1187)
-			}
-			return true
-		l1186:
-			position, tokenIndex = position1186, tokenIndex1186
-			return false
-		},
-		/* 94 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action73)> */
-		func() bool {
-			position1193, tokenIndex1193 := position, tokenIndex
-			{
-				position1194 := position
-				{
-					position1195 := position
-					if buffer[position] != rune('[') {
-						goto l1193
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1193
+						position++
 					}
+				l2217:
 					{
-						position1196, tokenIndex1196 := position, tokenIndex
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1196
+						position2219, tokenIndex2219 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2220
 						}
-					l1198:
-						{
-							position1199, tokenIndex1199 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1199
-							}
-							if buffer[position] != rune(',') {
-								goto l1199
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1199
-							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1199
-							}
-							goto l1198
-						l1199:
-							position, tokenIndex = position1199, tokenIndex1199
+						position++
+						goto l2219
+					l2220:
+						position, tokenIndex = position2219, tokenIndex2219
+						if buffer[position] != rune('E') {
+							goto l2206
 						}
-						goto l1197
-					l1196:
-						position, tokenIndex = position1196, tokenIndex1196
-					}
-				l1197:
-					if !_rules[rulespOpt]() {
-						goto l1193
+						position++
 					}
+				l2219:
 					{
-						position1200, tokenIndex1200 := position, tokenIndex
-						if buffer[position] != rune(',') {
-							goto l1200
+						position2221, tokenIndex2221 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2222
+						}
+						position++
+						goto l2221
+					l2222:
+						position, tokenIndex = position2221, tokenIndex2221
+						if buffer[position] != rune('S') {
+							goto l2206
 						}
 						position++
-						goto l1201
-					l1200:
-						position, tokenIndex = position1200, tokenIndex1200
-					}
-				l1201:
-					if !_rules[rulespOpt]() {
-						goto l1193
-					}
-					if buffer[position] != rune(']') {
-						goto l1193
 					}
-					position++
-					add(rulePegText, position1195)
+				l2221:
+					add(rulePegText, position2208)
 				}
-				if !_rules[ruleAction73]() {
-					goto l1193
+				if !_rules[ruleAction134]() {
+					goto l2206
 				}
-				add(ruleArrayExpr, position1194)
+				add(ruleMINUTES, position2207)
 			}
 			return true
-		l1193:
-			position, tokenIndex = position1193, tokenIndex1193
+		l2206:
+			position, tokenIndex = position2206, tokenIndex2206
 			return false
 		},
-		/* 95 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action74)> */
+		/* 176 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action135)> */
 		func() bool {
-			position1202, tokenIndex1202 := position, tokenIndex
+			position2223, tokenIndex2223 := position, tokenIndex
 			{
-				position1203 := position
+				position2224 := position
 				{
-					position1204 := position
-					if buffer[position] != rune('{') {
-						goto l1202
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1202
-					}
+					position2225 := position
 					{
-						position1205, tokenIndex1205 := position, tokenIndex
-						if !_rules[ruleKeyValuePair]() {
-							goto l1205
+						position2226, tokenIndex2226 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2227
 						}
-					l1207:
-						{
-							position1208, tokenIndex1208 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1208
-							}
-							if buffer[position] != rune(',') {
-								goto l1208
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1208
-							}
-							if !_rules[ruleKeyValuePair]() {
-								goto l1208
-							}
-							goto l1207
-						l1208:
-							position, tokenIndex = position1208, tokenIndex1208
+						position++
+						goto l2226
+					l2227:
+						position, tokenIndex = position2226, tokenIndex2226
+						if buffer[position] != rune('S') {
+							goto l2223
 						}
-						goto l1206
-					l1205:
-						position, tokenIndex = position1205, tokenIndex1205
-					}
-				l1206:
-					if !_rules[rulespOpt]() {
-						goto l1202
+						position++
 					}
-					if buffer[position] != rune('}') {
-						goto l1202
+				l2226:
+					{
+						position2228, tokenIndex2228 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2229
+						}
+						position++
+						goto l2228
+					l2229:
+						position, tokenIndex = position2228, tokenIndex2228
+						if buffer[position] != rune('E') {
+							goto l2223
+						}
+						position++
 					}
-					position++
-					add(rulePegText, position1204)
-				}
-				if !_rules[ruleAction74]() {
-					goto l1202
-				}
-				add(ruleMapExpr, position1203)
-			}
-			return true
-		l1202:
-			position, tokenIndex = position1202, tokenIndex1202
-			return false
-		},
-		/* 96 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action75)> */
-		func() bool {
-			position1209, tokenIndex1209 := position, tokenIndex
-			{
-				position1210 := position
-				{
-					position1211 := position
-					if !_rules[ruleStringLiteral]() {
-						goto l1209
+				l2228:
+					{
+						position2230, tokenIndex2230 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2231
+						}
+						position++
+						goto l2230
+					l2231:
+						position, tokenIndex = position2230, tokenIndex2230
+						if buffer[position] != rune('C') {
+							goto l2223
+						}
+						position++
 					}
-					if !_rules[rulespOpt]() {
-						goto l1209
+				l2230:
+					{
+						position2232, tokenIndex2232 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2233
+						}
+						position++
+						goto l2232
+					l2233:
+						position, tokenIndex = position2232, tokenIndex2232
+						if buffer[position] != rune('O') {
+							goto l2223
+						}
+						position++
 					}
-					if buffer[position] != rune(':') {
-						goto l1209
+				l2232:
+					{
+						position2234, tokenIndex2234 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2235
+						}
+						position++
+						goto l2234
+					l2235:
+						position, tokenIndex = position2234, tokenIndex2234
+						if buffer[position] != rune('N') {
+							goto l2223
+						}
+						position++
 					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1209
+				l2234:
+					{
+						position2236, tokenIndex2236 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2237
+						}
+						position++
+						goto l2236
+					l2237:
+						position, tokenIndex = position2236, tokenIndex2236
+						if buffer[position] != rune('D') {
+							goto l2223
+						}
+						position++
 					}
-					if !_rules[ruleExpressionOrWildcard]() {
-						goto l1209
+				l2236:
+					{
+						position2238, tokenIndex2238 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2239
+						}
+						position++
+						goto l2238
+					l2239:
+						position, tokenIndex = position2238, tokenIndex2238
+						if buffer[position] != rune('S') {
+							goto l2223
+						}
+						position++
 					}
-					add(rulePegText, position1211)
-				}
-				if !_rules[ruleAction75]() {
-					goto l1209
+				l2238:
+					add(rulePegText, position2225)
 				}
-				add(ruleKeyValuePair, position1210)
-			}
-			return true
-		l1209:
-			position, tokenIndex = position1209, tokenIndex1209
-			return false
-		},
-		/* 97 Case <- <(ConditionCase / ExpressionCase)> */
-		func() bool {
-			position1212, tokenIndex1212 := position, tokenIndex
-			{
-				position1213 := position
-				{
-					position1214, tokenIndex1214 := position, tokenIndex
-					if !_rules[ruleConditionCase]() {
-						goto l1215
-					}
-					goto l1214
-				l1215:
-					position, tokenIndex = position1214, tokenIndex1214
-					if !_rules[ruleExpressionCase]() {
-						goto l1212
-					}
+				if !_rules[ruleAction135]() {
+					goto l2223
 				}
-			l1214:
-				add(ruleCase, position1213)
+				add(ruleSECONDS, position2224)
 			}
 			return true
-		l1212:
-			position, tokenIndex = position1212, tokenIndex1212
+		l2223:
+			position, tokenIndex = position2223, tokenIndex2223
 			return false
 		},
-		/* 98 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action76)> */
+		/* 177 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action136)> */
 		func() bool {
-			position1216, tokenIndex1216 := position, tokenIndex
+			position2240, tokenIndex2240 := position, tokenIndex
 			{
-				position1217 := position
-				{
-					position1218, tokenIndex1218 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l1219
-					}
-					position++
-					goto l1218
-				l1219:
-					position, tokenIndex = position1218, tokenIndex1218
-					if buffer[position] != rune('C') {
-						goto l1216
-					}
-					position++
-				}
-			l1218:
-				{
-					position1220, tokenIndex1220 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l1221
-					}
-					position++
-					goto l1220
-				l1221:
-					position, tokenIndex = position1220, tokenIndex1220
-					if buffer[position] != rune('A') {
-						goto l1216
-					}
-					position++
-				}
-			l1220:
-				{
-					position1222, tokenIndex1222 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l1223
-					}
-					position++
-					goto l1222
-				l1223:
-					position, tokenIndex = position1222, tokenIndex1222
-					if buffer[position] != rune('S') {
-						goto l1216
-					}
-					position++
-				}
-			l1222:
-				{
-					position1224, tokenIndex1224 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1225
-					}
-					position++
-					goto l1224
-				l1225:
-					position, tokenIndex = position1224, tokenIndex1224
-					if buffer[position] != rune('E') {
-						goto l1216
-					}
-					position++
-				}
-			l1224:
+				position2241 := position
 				{
-					position1226 := position
-					if !_rules[rulesp]() {
-						goto l1216
-					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1216
-					}
-				l1227:
+					position2242 := position
 					{
-						position1228, tokenIndex1228 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1228
+						position2243, tokenIndex2243 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2244
 						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1228
+						position++
+						goto l2243
+					l2244:
+						position, tokenIndex = position2243, tokenIndex2243
+						if buffer[position] != rune('M') {
+							goto l2240
 						}
-						goto l1227
-					l1228:
-						position, tokenIndex = position1228, tokenIndex1228
+						position++
 					}
+				l2243:
 					{
-						position1229, tokenIndex1229 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1229
+						position2245, tokenIndex2245 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2246
 						}
-						{
-							position1231, tokenIndex1231 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1232
-							}
-							position++
-							goto l1231
-						l1232:
-							position, tokenIndex = position1231, tokenIndex1231
-							if buffer[position] != rune('E') {
-								goto l1229
-							}
-							position++
+						position++
+						goto l2245
+					l2246:
+						position, tokenIndex = position2245, tokenIndex2245
+						if buffer[position] != rune('I') {
+							goto l2240
 						}
-					l1231:
-						{
-							position1233, tokenIndex1233 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l1234
-							}
-							position++
-							goto l1233
-						l1234:
-							position, tokenIndex = position1233, tokenIndex1233
-							if buffer[position] != rune('L') {
-								goto l1229
-							}
-							position++
+						position++
+					}
+				l2245:
+					{
+						position2247, tokenIndex2247 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2248
 						}
-					l1233:
-						{
-							position1235, tokenIndex1235 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1236
-							}
-							position++
-							goto l1235
-						l1236:
-							position, tokenIndex = position1235, tokenIndex1235
-							if buffer[position] != rune('S') {
-								goto l1229
-							}
-							position++
+						position++
+						goto l2247
+					l2248:
+						position, tokenIndex = position2247, tokenIndex2247
+						if buffer[position] != rune('L') {
+							goto l2240
 						}
-					l1235:
-						{
-							position1237, tokenIndex1237 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1238
-							}
-							position++
-							goto l1237
-						l1238:
-							position, tokenIndex = position1237, tokenIndex1237
-							if buffer[position] != rune('E') {
-								goto l1229
-							}
-							position++
+						position++
+					}
+				l2247:
+					{
+						position2249, tokenIndex2249 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2250
 						}
-					l1237:
-						if !_rules[rulesp]() {
-							goto l1229
+						position++
+						goto l2249
+					l2250:
+						position, tokenIndex = position2249, tokenIndex2249
+						if buffer[position] != rune('L') {
+							goto l2240
 						}
-						if !_rules[ruleExpression]() {
-							goto l1229
+						position++
+					}
+				l2249:
+					{
+						position2251, tokenIndex2251 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2252
 						}
-						goto l1230
-					l1229:
-						position, tokenIndex = position1229, tokenIndex1229
+						position++
+						goto l2251
+					l2252:
+						position, tokenIndex = position2251, tokenIndex2251
+						if buffer[position] != rune('I') {
+							goto l2240
+						}
+						position++
 					}
-				l1230:
-					if !_rules[rulesp]() {
-						goto l1216
+				l2251:
+					{
+						position2253, tokenIndex2253 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2254
+						}
+						position++
+						goto l2253
+					l2254:
+						position, tokenIndex = position2253, tokenIndex2253
+						if buffer[position] != rune('S') {
+							goto l2240
+						}
+						position++
 					}
+				l2253:
 					{
-						position1239, tokenIndex1239 := position, tokenIndex
+						position2255, tokenIndex2255 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1240
+							goto l2256
 						}
 						position++
-						goto l1239
-					l1240:
-						position, tokenIndex = position1239, tokenIndex1239
+						goto l2255
+					l2256:
+						position, tokenIndex = position2255, tokenIndex2255
 						if buffer[position] != rune('E') {
-							goto l1216
+							goto l2240
 						}
 						position++
 					}
-				l1239:
+				l2255:
 					{
-						position1241, tokenIndex1241 := position, tokenIndex
+						position2257, tokenIndex2257 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2258
+						}
+						position++
+						goto l2257
+					l2258:
+						position, tokenIndex = position2257, tokenIndex2257
+						if buffer[position] != rune('C') {
+							goto l2240
+						}
+						position++
+					}
+				l2257:
+					{
+						position2259, tokenIndex2259 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2260
+						}
+						position++
+						goto l2259
+					l2260:
+						position, tokenIndex = position2259, tokenIndex2259
+						if buffer[position] != rune('O') {
+							goto l2240
+						}
+						position++
+					}
+				l2259:
+					{
+						position2261, tokenIndex2261 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1242
+							goto l2262
 						}
 						position++
-						goto l1241
-					l1242:
-						position, tokenIndex = position1241, tokenIndex1241
+						goto l2261
+					l2262:
+						position, tokenIndex = position2261, tokenIndex2261
 						if buffer[position] != rune('N') {
-							goto l1216
+							goto l2240
 						}
 						position++
 					}
-				l1241:
+				l2261:
 					{
-						position1243, tokenIndex1243 := position, tokenIndex
+						position2263, tokenIndex2263 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l1244
+							goto l2264
 						}
 						position++
-						goto l1243
-					l1244:
-						position, tokenIndex = position1243, tokenIndex1243
+						goto l2263
+					l2264:
+						position, tokenIndex = position2263, tokenIndex2263
 						if buffer[position] != rune('D') {
-							goto l1216
+							goto l2240
 						}
 						position++
 					}
-				l1243:
-					add(rulePegText, position1226)
+				l2263:
+					{
+						position2265, tokenIndex2265 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2266
+						}
+						position++
+						goto l2265
+					l2266:
+						position, tokenIndex = position2265, tokenIndex2265
+						if buffer[position] != rune('S') {
+							goto l2240
+						}
+						position++
+					}
+				l2265:
+					add(rulePegText, position2242)
 				}
-				if !_rules[ruleAction76]() {
-					goto l1216
+				if !_rules[ruleAction136]() {
+					goto l2240
 				}
-				add(ruleConditionCase, position1217)
+				add(ruleMILLISECONDS, position2241)
 			}
 			return true
-		l1216:
-			position, tokenIndex = position1216, tokenIndex1216
+		l2240:
+			position, tokenIndex = position2240, tokenIndex2240
 			return false
 		},
-		/* 99 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action77)> */
+		/* 178 TUMBLING <- <(<(('t' / 'T') ('u' / 'U') ('m' / 'M') ('b' / 'B') ('l' / 'L') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action137)> */
 		func() bool {
-			position1245, tokenIndex1245 := position, tokenIndex
+			position2267, tokenIndex2267 := position, tokenIndex
 			{
-				position1246 := position
-				{
-					position1247, tokenIndex1247 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l1248
-					}
-					position++
-					goto l1247
-				l1248:
-					position, tokenIndex = position1247, tokenIndex1247
-					if buffer[position] != rune('C') {
-						goto l1245
-					}
-					position++
-				}
-			l1247:
-				{
-					position1249, tokenIndex1249 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l1250
-					}
-					position++
-					goto l1249
-				l1250:
-					position, tokenIndex = position1249, tokenIndex1249
-					if buffer[position] != rune('A') {
-						goto l1245
-					}
-					position++
-				}
-			l1249:
-				{
-					position1251, tokenIndex1251 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l1252
-					}
-					position++
-					goto l1251
-				l1252:
-					position, tokenIndex = position1251, tokenIndex1251
-					if buffer[position] != rune('S') {
-						goto l1245
-					}
-					position++
-				}
-			l1251:
-				{
-					position1253, tokenIndex1253 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1254
-					}
-					position++
-					goto l1253
-				l1254:
-					position, tokenIndex = position1253, tokenIndex1253
-					if buffer[position] != rune('E') {
-						goto l1245
-					}
-					position++
-				}
-			l1253:
-				if !_rules[rulesp]() {
-					goto l1245
-				}
-				if !_rules[ruleExpression]() {
-					goto l1245
-				}
+				position2268 := position
 				{
-					position1255 := position
-					if !_rules[rulesp]() {
-						goto l1245
-					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1245
-					}
-				l1256:
+					position2269 := position
 					{
-						position1257, tokenIndex1257 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1257
+						position2270, tokenIndex2270 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2271
 						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1257
+						position++
+						goto l2270
+					l2271:
+						position, tokenIndex = position2270, tokenIndex2270
+						if buffer[position] != rune('T') {
+							goto l2267
 						}
-						goto l1256
-					l1257:
-						position, tokenIndex = position1257, tokenIndex1257
+						position++
 					}
+				l2270:
 					{
-						position1258, tokenIndex1258 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1258
-						}
-						{
-							position1260, tokenIndex1260 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1261
-							}
-							position++
-							goto l1260
-						l1261:
-							position, tokenIndex = position1260, tokenIndex1260
-							if buffer[position] != rune('E') {
-								goto l1258
-							}
-							position++
+						position2272, tokenIndex2272 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2273
 						}
-					l1260:
-						{
-							position1262, tokenIndex1262 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l1263
-							}
-							position++
-							goto l1262
-						l1263:
-							position, tokenIndex = position1262, tokenIndex1262
-							if buffer[position] != rune('L') {
-								goto l1258
-							}
-							position++
+						position++
+						goto l2272
+					l2273:
+						position, tokenIndex = position2272, tokenIndex2272
+						if buffer[position] != rune('U') {
+							goto l2267
 						}
-					l1262:
-						{
-							position1264, tokenIndex1264 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1265
-							}
-							position++
-							goto l1264
-						l1265:
-							position, tokenIndex = position1264, tokenIndex1264
-							if buffer[position] != rune('S') {
-								goto l1258
-							}
-							position++
+						position++
+					}
+				l2272:
+					{
+						position2274, tokenIndex2274 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2275
 						}
-					l1264:
-						{
-							position1266, tokenIndex1266 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1267
-							}
-							position++
-							goto l1266
-						l1267:
-							position, tokenIndex = position1266, tokenIndex1266
-							if buffer[position] != rune('E') {
-								goto l1258
-							}
-							position++
+						position++
+						goto l2274
+					l2275:
+						position, tokenIndex = position2274, tokenIndex2274
+						if buffer[position] != rune('M') {
+							goto l2267
 						}
-					l1266:
-						if !_rules[rulesp]() {
-							goto l1258
+						position++
+					}
+				l2274:
+					{
+						position2276, tokenIndex2276 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2277
 						}
-						if !_rules[ruleExpression]() {
-							goto l1258
+						position++
+						goto l2276
+					l2277:
+						position, tokenIndex = position2276, tokenIndex2276
+						if buffer[position] != rune('B') {
+							goto l2267
 						}
-						goto l1259
-					l1258:
-						position, tokenIndex = position1258, tokenIndex1258
+						position++
 					}
-				l1259:
-					if !_rules[rulesp]() {
-						goto l1245
+				l2276:
+					{
+						position2278, tokenIndex2278 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2279
+						}
+						position++
+						goto l2278
+					l2279:
+						position, tokenIndex = position2278, tokenIndex2278
+						if buffer[position] != rune('L') {
+							goto l2267
+						}
+						position++
 					}
+				l2278:
 					{
-						position1268, tokenIndex1268 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1269
+						position2280, tokenIndex2280 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2281
 						}
 						position++
-						goto l1268
-					l1269:
-						position, tokenIndex = position1268, tokenIndex1268
-						if buffer[position] != rune('E') {
-							goto l1245
+						goto l2280
+					l2281:
+						position, tokenIndex = position2280, tokenIndex2280
+						if buffer[position] != rune('I') {
+							goto l2267
 						}
 						position++
 					}
-				l1268:
+				l2280:
 					{
-						position1270, tokenIndex1270 := position, tokenIndex
+						position2282, tokenIndex2282 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1271
+							goto l2283
 						}
 						position++
-						goto l1270
-					l1271:
-						position, tokenIndex = position1270, tokenIndex1270
+						goto l2282
+					l2283:
+						position, tokenIndex = position2282, tokenIndex2282
 						if buffer[position] != rune('N') {
-							goto l1245
+							goto l2267
 						}
 						position++
 					}
-				l1270:
+				l2282:
 					{
-						position1272, tokenIndex1272 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1273
+						position2284, tokenIndex2284 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2285
 						}
 						position++
-						goto l1272
-					l1273:
-						position, tokenIndex = position1272, tokenIndex1272
-						if buffer[position] != rune('D') {
-							goto l1245
+						goto l2284
+					l2285:
+						position, tokenIndex = position2284, tokenIndex2284
+						if buffer[position] != rune('G') {
+							goto l2267
 						}
 						position++
 					}
-				l1272:
-					add(rulePegText, position1255)
+				l2284:
+					add(rulePegText, position2269)
 				}
-				if !_rules[ruleAction77]() {
-					goto l1245
+				if !_rules[ruleAction137]() {
+					goto l2267
 				}
-				add(ruleExpressionCase, position1246)
+				add(ruleTUMBLING, position2268)
 			}
 			return true
-		l1245:
-			position, tokenIndex = position1245, tokenIndex1245
+		l2267:
+			position, tokenIndex = position2267, tokenIndex2267
 			return false
 		},
-		/* 100 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action78)> */
+		/* 179 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action138)> */
 		func() bool {
-			position1274, tokenIndex1274 := position, tokenIndex
+			position2286, tokenIndex2286 := position, tokenIndex
 			{
-				position1275 := position
+				position2287 := position
 				{
-					position1276, tokenIndex1276 := position, tokenIndex
-					if buffer[position] != rune('w') {
-						goto l1277
+					position2288 := position
+					{
+						position2289, tokenIndex2289 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2290
+						}
+						position++
+						goto l2289
+					l2290:
+						position, tokenIndex = position2289, tokenIndex2289
+						if buffer[position] != rune('W') {
+							goto l2286
+						}
+						position++
 					}
-					position++
-					goto l1276
-				l1277:
-					position, tokenIndex = position1276, tokenIndex1276
-					if buffer[position] != rune('W') {
-						goto l1274
+				l2289:
+					{
+						position2291, tokenIndex2291 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2292
+						}
+						position++
+						goto l2291
+					l2292:
+						position, tokenIndex = position2291, tokenIndex2291
+						if buffer[position] != rune('A') {
+							goto l2286
+						}
+						position++
 					}
-					position++
-				}
-			l1276:
-				{
-					position1278, tokenIndex1278 := position, tokenIndex
-					if buffer[position] != rune('h') {
-						goto l1279
+				l2291:
+					{
+						position2293, tokenIndex2293 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2294
+						}
+						position++
+						goto l2293
+					l2294:
+						position, tokenIndex = position2293, tokenIndex2293
+						if buffer[position] != rune('I') {
+							goto l2286
+						}
+						position++
 					}
-					position++
-					goto l1278
-				l1279:
-					position, tokenIndex = position1278, tokenIndex1278
-					if buffer[position] != rune('H') {
-						goto l1274
+				l2293:
+					{
+						position2295, tokenIndex2295 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2296
+						}
+						position++
+						goto l2295
+					l2296:
+						position, tokenIndex = position2295, tokenIndex2295
+						if buffer[position] != rune('T') {
+							goto l2286
+						}
+						position++
 					}
-					position++
+				l2295:
+					add(rulePegText, position2288)
 				}
-			l1278:
-				{
-					position1280, tokenIndex1280 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1281
-					}
-					position++
-					goto l1280
-				l1281:
-					position, tokenIndex = position1280, tokenIndex1280
-					if buffer[position] != rune('E') {
-						goto l1274
-					}
-					position++
+				if !_rules[ruleAction138]() {
+					goto l2286
 				}
-			l1280:
+				add(ruleWait, position2287)
+			}
+			return true
+		l2286:
+			position, tokenIndex = position2286, tokenIndex2286
+			return false
+		},
+		/* 180 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action139)> */
+		func() bool {
+			position2297, tokenIndex2297 := position, tokenIndex
+			{
+				position2298 := position
 				{
-					position1282, tokenIndex1282 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l1283
+					position2299 := position
+					{
+						position2300, tokenIndex2300 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2301
+						}
+						position++
+						goto l2300
+					l2301:
+						position, tokenIndex = position2300, tokenIndex2300
+						if buffer[position] != rune('D') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-					goto l1282
-				l1283:
-					position, tokenIndex = position1282, tokenIndex1282
-					if buffer[position] != rune('N') {
-						goto l1274
+				l2300:
+					{
+						position2302, tokenIndex2302 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2303
+						}
+						position++
+						goto l2302
+					l2303:
+						position, tokenIndex = position2302, tokenIndex2302
+						if buffer[position] != rune('R') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-				}
-			l1282:
-				if !_rules[rulesp]() {
-					goto l1274
-				}
-				if !_rules[ruleExpression]() {
-					goto l1274
-				}
-				if !_rules[rulesp]() {
-					goto l1274
-				}
-				{
-					position1284, tokenIndex1284 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l1285
+				l2302:
+					{
+						position2304, tokenIndex2304 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2305
+						}
+						position++
+						goto l2304
+					l2305:
+						position, tokenIndex = position2304, tokenIndex2304
+						if buffer[position] != rune('O') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-					goto l1284
-				l1285:
-					position, tokenIndex = position1284, tokenIndex1284
-					if buffer[position] != rune('T') {
-						goto l1274
+				l2304:
+					{
+						position2306, tokenIndex2306 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2307
+						}
+						position++
+						goto l2306
+					l2307:
+						position, tokenIndex = position2306, tokenIndex2306
+						if buffer[position] != rune('P') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-				}
-			l1284:
-				{
-					position1286, tokenIndex1286 := position, tokenIndex
-					if buffer[position] != rune('h') {
-						goto l1287
+				l2306:
+					if !_rules[rulesp]() {
+						goto l2297
 					}
-					position++
-					goto l1286
-				l1287:
-					position, tokenIndex = position1286, tokenIndex1286
-					if buffer[position] != rune('H') {
-						goto l1274
+					{
+						position2308, tokenIndex2308 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2309
+						}
+						position++
+						goto l2308
+					l2309:
+						position, tokenIndex = position2308, tokenIndex2308
+						if buffer[position] != rune('O') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-				}
-			l1286:
-				{
-					position1288, tokenIndex1288 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1289
+				l2308:
+					{
+						position2310, tokenIndex2310 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2311
+						}
+						position++
+						goto l2310
+					l2311:
+						position, tokenIndex = position2310, tokenIndex2310
+						if buffer[position] != rune('L') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-					goto l1288
-				l1289:
-					position, tokenIndex = position1288, tokenIndex1288
-					if buffer[position] != rune('E') {
-						goto l1274
+				l2310:
+					{
+						position2312, tokenIndex2312 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2313
+						}
+						position++
+						goto l2312
+					l2313:
+						position, tokenIndex = position2312, tokenIndex2312
+						if buffer[position] != rune('D') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-				}
-			l1288:
-				{
-					position1290, tokenIndex1290 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l1291
+				l2312:
+					{
+						position2314, tokenIndex2314 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2315
+						}
+						position++
+						goto l2314
+					l2315:
+						position, tokenIndex = position2314, tokenIndex2314
+						if buffer[position] != rune('E') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-					goto l1290
-				l1291:
-					position, tokenIndex = position1290, tokenIndex1290
-					if buffer[position] != rune('N') {
-						goto l1274
+				l2314:
+					{
+						position2316, tokenIndex2316 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2317
+						}
+						position++
+						goto l2316
+					l2317:
+						position, tokenIndex = position2316, tokenIndex2316
+						if buffer[position] != rune('S') {
+							goto l2297
+						}
+						position++
 					}
-					position++
-				}
-			l1290:
-				if !_rules[rulesp]() {
-					goto l1274
-				}
-				if !_rules[ruleExpressionOrWildcard]() {
-					goto l1274
+				l2316:
+					{
+						position2318, tokenIndex2318 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2319
+						}
+						position++
+						goto l2318
+					l2319:
+						position, tokenIndex = position2318, tokenIndex2318
+						if buffer[position] != rune('T') {
+							goto l2297
+						}
+						position++
+					}
+				l2318:
+					add(rulePegText, position2299)
 				}
-				if !_rules[ruleAction78]() {
-					goto l1274
+				if !_rules[ruleAction139]() {
+					goto l2297
 				}
-				add(ruleWhenThenPair, position1275)
+				add(ruleDropOldest, position2298)
 			}
 			return true
-		l1274:
-			position, tokenIndex = position1274, tokenIndex1274
+		l2297:
+			position, tokenIndex = position2297, tokenIndex2297
 			return false
 		},
-		/* 101 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
+		/* 181 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action140)> */
 		func() bool {
-			position1292, tokenIndex1292 := position, tokenIndex
+			position2320, tokenIndex2320 := position, tokenIndex
 			{
-				position1293 := position
+				position2321 := position
 				{
-					position1294, tokenIndex1294 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l1295
+					position2322 := position
+					{
+						position2323, tokenIndex2323 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2324
+						}
+						position++
+						goto l2323
+					l2324:
+						position, tokenIndex = position2323, tokenIndex2323
+						if buffer[position] != rune('D') {
+							goto l2320
+						}
+						position++
 					}
-					goto l1294
-				l1295:
-					position, tokenIndex = position1294, tokenIndex1294
-					if !_rules[ruleNumericLiteral]() {
-						goto l1296
+				l2323:
+					{
+						position2325, tokenIndex2325 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2326
+						}
+						position++
+						goto l2325
+					l2326:
+						position, tokenIndex = position2325, tokenIndex2325
+						if buffer[position] != rune('R') {
+							goto l2320
+						}
+						position++
 					}
-					goto l1294
-				l1296:
-					position, tokenIndex = position1294, tokenIndex1294
-					if !_rules[ruleStringLiteral]() {
-						goto l1292
+				l2325:
+					{
+						position2327, tokenIndex2327 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2328
+						}
+						position++
+						goto l2327
+					l2328:
+						position, tokenIndex = position2327, tokenIndex2327
+						if buffer[position] != rune('O') {
+							goto l2320
+						}
+						position++
 					}
-				}
-			l1294:
-				add(ruleLiteral, position1293)
-			}
-			return true
-		l1292:
-			position, tokenIndex = position1292, tokenIndex1292
-			return false
-		},
-		/* 102 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
-		func() bool {
-			position1297, tokenIndex1297 := position, tokenIndex
-			{
-				position1298 := position
-				{
-					position1299, tokenIndex1299 := position, tokenIndex
-					if !_rules[ruleEqual]() {
-						goto l1300
+				l2327:
+					{
+						position2329, tokenIndex2329 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2330
+						}
+						position++
+						goto l2329
+					l2330:
+						position, tokenIndex = position2329, tokenIndex2329
+						if buffer[position] != rune('P') {
+							goto l2320
+						}
+						position++
 					}
-					goto l1299
-				l1300:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleNotEqual]() {
-						goto l1301
+				l2329:
+					if !_rules[rulesp]() {
+						goto l2320
+					}
+					{
+						position2331, tokenIndex2331 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2332
+						}
+						position++
+						goto l2331
+					l2332:
+						position, tokenIndex = position2331, tokenIndex2331
+						if buffer[position] != rune('N') {
+							goto l2320
+						}
+						position++
 					}
-					goto l1299
-				l1301:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleLessOrEqual]() {
-						goto l1302
+				l2331:
+					{
+						position2333, tokenIndex2333 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2334
+						}
+						position++
+						goto l2333
+					l2334:
+						position, tokenIndex = position2333, tokenIndex2333
+						if buffer[position] != rune('E') {
+							goto l2320
+						}
+						position++
 					}
-					goto l1299
-				l1302:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleLess]() {
-						goto l1303
+				l2333:
+					{
+						position2335, tokenIndex2335 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2336
+						}
+						position++
+						goto l2335
+					l2336:
+						position, tokenIndex = position2335, tokenIndex2335
+						if buffer[position] != rune('W') {
+							goto l2320
+						}
+						position++
 					}
-					goto l1299
-				l1303:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleGreaterOrEqual]() {
-						goto l1304
+				l2335:
+					{
+						position2337, tokenIndex2337 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2338
+						}
+						position++
+						goto l2337
+					l2338:
+						position, tokenIndex = position2337, tokenIndex2337
+						if buffer[position] != rune('E') {
+							goto l2320
+						}
+						position++
 					}
-					goto l1299
-				l1304:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleGreater]() {
-						goto l1305
+				l2337:
+					{
+						position2339, tokenIndex2339 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2340
+						}
+						position++
+						goto l2339
+					l2340:
+						position, tokenIndex = position2339, tokenIndex2339
+						if buffer[position] != rune('S') {
+							goto l2320
+						}
+						position++
 					}
-					goto l1299
-				l1305:
-					position, tokenIndex = position1299, tokenIndex1299
-					if !_rules[ruleNotEqual]() {
-						goto l1297
+				l2339:
+					{
+						position2341, tokenIndex2341 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2342
+						}
+						position++
+						goto l2341
+					l2342:
+						position, tokenIndex = position2341, tokenIndex2341
+						if buffer[position] != rune('T') {
+							goto l2320
+						}
+						position++
 					}
+				l2341:
+					add(rulePegText, position2322)
 				}
-			l1299:
-				add(ruleComparisonOp, position1298)
-			}
-			return true
-		l1297:
-			position, tokenIndex = position1297, tokenIndex1297
-			return false
-		},
-		/* 103 OtherOp <- <Concat> */
-		func() bool {
-			position1306, tokenIndex1306 := position, tokenIndex
-			{
-				position1307 := position
-				if !_rules[ruleConcat]() {
-					goto l1306
+				if !_rules[ruleAction140]() {
+					goto l2320
 				}
-				add(ruleOtherOp, position1307)
+				add(ruleDropNewest, position2321)
 			}
 			return true
-		l1306:
-			position, tokenIndex = position1306, tokenIndex1306
+		l2320:
+			position, tokenIndex = position2320, tokenIndex2320
 			return false
 		},
-		/* 104 IsOp <- <(IsNot / Is)> */
+		/* 182 StreamIdentifier <- <(<ident> Action141)> */
 		func() bool {
-			position1308, tokenIndex1308 := position, tokenIndex
+			position2343, tokenIndex2343 := position, tokenIndex
 			{
-				position1309 := position
+				position2344 := position
 				{
-					position1310, tokenIndex1310 := position, tokenIndex
-					if !_rules[ruleIsNot]() {
-						goto l1311
-					}
-					goto l1310
-				l1311:
-					position, tokenIndex = position1310, tokenIndex1310
-					if !_rules[ruleIs]() {
-						goto l1308
+					position2345 := position
+					if !_rules[ruleident]() {
+						goto l2343
 					}
+					add(rulePegText, position2345)
 				}
-			l1310:
-				add(ruleIsOp, position1309)
-			}
-			return true
-		l1308:
-			position, tokenIndex = position1308, tokenIndex1308
-			return false
-		},
-		/* 105 PlusMinusOp <- <(Plus / Minus)> */
-		func() bool {
-			position1312, tokenIndex1312 := position, tokenIndex
-			{
-				position1313 := position
-				{
-					position1314, tokenIndex1314 := position, tokenIndex
-					if !_rules[rulePlus]() {
-						goto l1315
-					}
-					goto l1314
-				l1315:
-					position, tokenIndex = position1314, tokenIndex1314
-					if !_rules[ruleMinus]() {
-						goto l1312
-					}
+				if !_rules[ruleAction141]() {
+					goto l2343
 				}
-			l1314:
-				add(rulePlusMinusOp, position1313)
+				add(ruleStreamIdentifier, position2344)
 			}
 			return true
-		l1312:
-			position, tokenIndex = position1312, tokenIndex1312
+		l2343:
+			position, tokenIndex = position2343, tokenIndex2343
 			return false
 		},
-		/* 106 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		/* 183 SourceSinkType <- <(<ident> Action142)> */
 		func() bool {
-			position1316, tokenIndex1316 := position, tokenIndex
+			position2346, tokenIndex2346 := position, tokenIndex
 			{
-				position1317 := position
+				position2347 := position
 				{
-					position1318, tokenIndex1318 := position, tokenIndex
-					if !_rules[ruleMultiply]() {
-						goto l1319
-					}
-					goto l1318
-				l1319:
-					position, tokenIndex = position1318, tokenIndex1318
-					if !_rules[ruleDivide]() {
-						goto l1320
-					}
-					goto l1318
-				l1320:
-					position, tokenIndex = position1318, tokenIndex1318
-					if !_rules[ruleModulo]() {
-						goto l1316
+					position2348 := position
+					if !_rules[ruleident]() {
+						goto l2346
 					}
+					add(rulePegText, position2348)
+				}
+				if !_rules[ruleAction142]() {
+					goto l2346
 				}
-			l1318:
-				add(ruleMultDivOp, position1317)
+				add(ruleSourceSinkType, position2347)
 			}
 			return true
-		l1316:
-			position, tokenIndex = position1316, tokenIndex1316
+		l2346:
+			position, tokenIndex = position2346, tokenIndex2346
 			return false
 		},
-		/* 107 Stream <- <(<ident> Action79)> */
+		/* 184 SourceSinkParamKey <- <(<ident> Action143)> */
 		func() bool {
-			position1321, tokenIndex1321 := position, tokenIndex
+			position2349, tokenIndex2349 := position, tokenIndex
 			{
-				position1322 := position
+				position2350 := position
 				{
-					position1323 := position
+					position2351 := position
 					if !_rules[ruleident]() {
-						goto l1321
+						goto l2349
 					}
-					add(rulePegText, position1323)
-				}
-				if !_rules[ruleAction79]() {
-					goto l1321
+					add(rulePegText, position2351)
 				}
-				add(ruleStream, position1322)
-			}
-			return true
-		l1321:
-			position, tokenIndex = position1321, tokenIndex1321
-			return false
-		},
-		/* 108 RowMeta <- <RowTimestamp> */
-		func() bool {
-			position1324, tokenIndex1324 := position, tokenIndex
-			{
-				position1325 := position
-				if !_rules[ruleRowTimestamp]() {
-					goto l1324
+				if !_rules[ruleAction143]() {
+					goto l2349
 				}
-				add(ruleRowMeta, position1325)
+				add(ruleSourceSinkParamKey, position2350)
 			}
 			return true
-		l1324:
-			position, tokenIndex = position1324, tokenIndex1324
+		l2349:
+			position, tokenIndex = position2349, tokenIndex2349
 			return false
 		},
-		/* 109 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action80)> */
+		/* 185 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action144)> */
 		func() bool {
-			position1326, tokenIndex1326 := position, tokenIndex
+			position2352, tokenIndex2352 := position, tokenIndex
 			{
-				position1327 := position
+				position2353 := position
 				{
-					position1328 := position
+					position2354 := position
 					{
-						position1329, tokenIndex1329 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1329
+						position2355, tokenIndex2355 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2356
 						}
-						if buffer[position] != rune(':') {
-							goto l1329
+						position++
+						goto l2355
+					l2356:
+						position, tokenIndex = position2355, tokenIndex2355
+						if buffer[position] != rune('P') {
+							goto l2352
 						}
 						position++
-						goto l1330
-					l1329:
-						position, tokenIndex = position1329, tokenIndex1329
-					}
-				l1330:
-					if buffer[position] != rune('t') {
-						goto l1326
 					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l1326
-					}
-					position++
-					if buffer[position] != rune('(') {
-						goto l1326
-					}
-					position++
-					if buffer[position] != rune(')') {
-						goto l1326
-					}
-					position++
-					add(rulePegText, position1328)
-				}
-				if !_rules[ruleAction80]() {
-					goto l1326
-				}
-				add(ruleRowTimestamp, position1327)
-			}
-			return true
-		l1326:
-			position, tokenIndex = position1326, tokenIndex1326
-			return false
-		},
-		/* 110 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action81)> */
-		func() bool {
-			position1331, tokenIndex1331 := position, tokenIndex
-			{
-				position1332 := position
-				{
-					position1333 := position
+				l2355:
 					{
-						position1334, tokenIndex1334 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1334
-						}
-						if buffer[position] != rune(':') {
-							goto l1334
+						position2357, tokenIndex2357 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2358
 						}
 						position++
-						{
-							position1336, tokenIndex1336 := position, tokenIndex
-							if buffer[position] != rune(':') {
-								goto l1336
-							}
-							position++
-							goto l1334
-						l1336:
-							position, tokenIndex = position1336, tokenIndex1336
+						goto l2357
+					l2358:
+						position, tokenIndex = position2357, tokenIndex2357
+						if buffer[position] != rune('A') {
+							goto l2352
 						}
-						goto l1335
-					l1334:
-						position, tokenIndex = position1334, tokenIndex1334
+						position++
 					}
-				l1335:
-					if !_rules[rulejsonGetPath]() {
-						goto l1331
+				l2357:
+					{
+						position2359, tokenIndex2359 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2360
+						}
+						position++
+						goto l2359
+					l2360:
+						position, tokenIndex = position2359, tokenIndex2359
+						if buffer[position] != rune('U') {
+							goto l2352
+						}
+						position++
 					}
-					add(rulePegText, position1333)
-				}
-				if !_rules[ruleAction81]() {
-					goto l1331
-				}
-				add(ruleRowValue, position1332)
-			}
-			return true
-		l1331:
-			position, tokenIndex = position1331, tokenIndex1331
-			return false
-		},
-		/* 111 NumericLiteral <- <(<('-'? [0-9]+)> Action82)> */
-		func() bool {
-			position1337, tokenIndex1337 := position, tokenIndex
-			{
-				position1338 := position
-				{
-					position1339 := position
+				l2359:
 					{
-						position1340, tokenIndex1340 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1340
+						position2361, tokenIndex2361 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2362
+						}
+						position++
+						goto l2361
+					l2362:
+						position, tokenIndex = position2361, tokenIndex2361
+						if buffer[position] != rune('S') {
+							goto l2352
 						}
 						position++
-						goto l1341
-					l1340:
-						position, tokenIndex = position1340, tokenIndex1340
 					}
-				l1341:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1337
+				l2361:
+					{
+						position2363, tokenIndex2363 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2364
+						}
+						position++
+						goto l2363
+					l2364:
+						position, tokenIndex = position2363, tokenIndex2363
+						if buffer[position] != rune('E') {
+							goto l2352
+						}
+						position++
 					}
-					position++
-				l1342:
+				l2363:
 					{
-						position1343, tokenIndex1343 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1343
+						position2365, tokenIndex2365 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2366
+						}
+						position++
+						goto l2365
+					l2366:
+						position, tokenIndex = position2365, tokenIndex2365
+						if buffer[position] != rune('D') {
+							goto l2352
 						}
 						position++
-						goto l1342
-					l1343:
-						position, tokenIndex = position1343, tokenIndex1343
 					}
-					add(rulePegText, position1339)
+				l2365:
+					add(rulePegText, position2354)
 				}
-				if !_rules[ruleAction82]() {
-					goto l1337
+				if !_rules[ruleAction144]() {
+					goto l2352
 				}
-				add(ruleNumericLiteral, position1338)
+				add(rulePaused, position2353)
 			}
 			return true
-		l1337:
-			position, tokenIndex = position1337, tokenIndex1337
+		l2352:
+			position, tokenIndex = position2352, tokenIndex2352
 			return false
 		},
-		/* 112 NonNegativeNumericLiteral <- <(<[0-9]+> Action83)> */
+		/* 186 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action145)> */
 		func() bool {
-			position1344, tokenIndex1344 := position, tokenIndex
+			position2367, tokenIndex2367 := position, tokenIndex
 			{
-				position1345 := position
+				position2368 := position
 				{
-					position1346 := position
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1344
+					position2369 := position
+					{
+						position2370, tokenIndex2370 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2371
+						}
+						position++
+						goto l2370
+					l2371:
+						position, tokenIndex = position2370, tokenIndex2370
+						if buffer[position] != rune('U') {
+							goto l2367
+						}
+						position++
 					}
-					position++
-				l1347:
+				l2370:
 					{
-						position1348, tokenIndex1348 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1348
+						position2372, tokenIndex2372 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2373
+						}
+						position++
+						goto l2372
+					l2373:
+						position, tokenIndex = position2372, tokenIndex2372
+						if buffer[position] != rune('N') {
+							goto l2367
 						}
 						position++
-						goto l1347
-					l1348:
-						position, tokenIndex = position1348, tokenIndex1348
 					}
-					add(rulePegText, position1346)
-				}
-				if !_rules[ruleAction83]() {
-					goto l1344
-				}
-				add(ruleNonNegativeNumericLiteral, position1345)
-			}
-			return true
-		l1344:
-			position, tokenIndex = position1344, tokenIndex1344
-			return false
-		},
-		/* 113 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action84)> */
-		func() bool {
-			position1349, tokenIndex1349 := position, tokenIndex
-			{
-				position1350 := position
-				{
-					position1351 := position
+				l2372:
 					{
-						position1352, tokenIndex1352 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1352
+						position2374, tokenIndex2374 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2375
+						}
+						position++
+						goto l2374
+					l2375:
+						position, tokenIndex = position2374, tokenIndex2374
+						if buffer[position] != rune('P') {
+							goto l2367
 						}
 						position++
-						goto l1353
-					l1352:
-						position, tokenIndex = position1352, tokenIndex1352
 					}
-				l1353:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1349
+				l2374:
+					{
+						position2376, tokenIndex2376 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2377
+						}
+						position++
+						goto l2376
+					l2377:
+						position, tokenIndex = position2376, tokenIndex2376
+						if buffer[position] != rune('A') {
+							goto l2367
+						}
+						position++
 					}
-					position++
-				l1354:
+				l2376:
 					{
-						position1355, tokenIndex1355 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1355
+						position2378, tokenIndex2378 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2379
+						}
+						position++
+						goto l2378
+					l2379:
+						position, tokenIndex = position2378, tokenIndex2378
+						if buffer[position] != rune('U') {
+							goto l2367
 						}
 						position++
-						goto l1354
-					l1355:
-						position, tokenIndex = position1355, tokenIndex1355
 					}
-					if buffer[position] != rune('.') {
-						goto l1349
+				l2378:
+					{
+						position2380, tokenIndex2380 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2381
+						}
+						position++
+						goto l2380
+					l2381:
+						position, tokenIndex = position2380, tokenIndex2380
+						if buffer[position] != rune('S') {
+							goto l2367
+						}
+						position++
 					}
-					position++
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1349
+				l2380:
+					{
+						position2382, tokenIndex2382 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2383
+						}
+						position++
+						goto l2382
+					l2383:
+						position, tokenIndex = position2382, tokenIndex2382
+						if buffer[position] != rune('E') {
+							goto l2367
+						}
+						position++
 					}
-					position++
-				l1356:
+				l2382:
 					{
-						position1357, tokenIndex1357 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1357
+						position2384, tokenIndex2384 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2385
+						}
+						position++
+						goto l2384
+					l2385:
+						position, tokenIndex = position2384, tokenIndex2384
+						if buffer[position] != rune('D') {
+							goto l2367
 						}
 						position++
-						goto l1356
-					l1357:
-						position, tokenIndex = position1357, tokenIndex1357
 					}
-					add(rulePegText, position1351)
+				l2384:
+					add(rulePegText, position2369)
 				}
-				if !_rules[ruleAction84]() {
-					goto l1349
+				if !_rules[ruleAction145]() {
+					goto l2367
 				}
-				add(ruleFloatLiteral, position1350)
+				add(ruleUnpaused, position2368)
 			}
 			return true
-		l1349:
-			position, tokenIndex = position1349, tokenIndex1349
+		l2367:
+			position, tokenIndex = position2367, tokenIndex2367
 			return false
 		},
-		/* 114 Function <- <(<ident> Action85)> */
+		/* 187 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action146)> */
 		func() bool {
-			position1358, tokenIndex1358 := position, tokenIndex
+			position2386, tokenIndex2386 := position, tokenIndex
 			{
-				position1359 := position
+				position2387 := position
 				{
-					position1360 := position
-					if !_rules[ruleident]() {
-						goto l1358
+					position2388 := position
+					{
+						position2389, tokenIndex2389 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2390
+						}
+						position++
+						goto l2389
+					l2390:
+						position, tokenIndex = position2389, tokenIndex2389
+						if buffer[position] != rune('A') {
+							goto l2386
+						}
+						position++
+					}
+				l2389:
+					{
+						position2391, tokenIndex2391 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2392
+						}
+						position++
+						goto l2391
+					l2392:
+						position, tokenIndex = position2391, tokenIndex2391
+						if buffer[position] != rune('S') {
+							goto l2386
+						}
+						position++
+					}
+				l2391:
+					{
+						position2393, tokenIndex2393 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2394
+						}
+						position++
+						goto l2393
+					l2394:
+						position, tokenIndex = position2393, tokenIndex2393
+						if buffer[position] != rune('C') {
+							goto l2386
+						}
+						position++
 					}
-					add(rulePegText, position1360)
+				l2393:
+					add(rulePegText, position2388)
 				}
-				if !_rules[ruleAction85]() {
-					goto l1358
+				if !_rules[ruleAction146]() {
+					goto l2386
 				}
-				add(ruleFunction, position1359)
+				add(ruleAscending, position2387)
 			}
 			return true
-		l1358:
-			position, tokenIndex = position1358, tokenIndex1358
+		l2386:
+			position, tokenIndex = position2386, tokenIndex2386
 			return false
 		},
-		/* 115 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action86)> */
+		/* 188 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action147)> */
 		func() bool {
-			position1361, tokenIndex1361 := position, tokenIndex
+			position2395, tokenIndex2395 := position, tokenIndex
 			{
-				position1362 := position
+				position2396 := position
 				{
-					position1363 := position
+					position2397 := position
 					{
-						position1364, tokenIndex1364 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1365
+						position2398, tokenIndex2398 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2399
 						}
 						position++
-						goto l1364
-					l1365:
-						position, tokenIndex = position1364, tokenIndex1364
-						if buffer[position] != rune('N') {
-							goto l1361
+						goto l2398
+					l2399:
+						position, tokenIndex = position2398, tokenIndex2398
+						if buffer[position] != rune('D') {
+							goto l2395
 						}
 						position++
 					}
-				l1364:
+				l2398:
 					{
-						position1366, tokenIndex1366 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1367
+						position2400, tokenIndex2400 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2401
 						}
 						position++
-						goto l1366
-					l1367:
-						position, tokenIndex = position1366, tokenIndex1366
-						if buffer[position] != rune('U') {
-							goto l1361
+						goto l2400
+					l2401:
+						position, tokenIndex = position2400, tokenIndex2400
+						if buffer[position] != rune('E') {
+							goto l2395
 						}
 						position++
 					}
-				l1366:
+				l2400:
 					{
-						position1368, tokenIndex1368 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1369
+						position2402, tokenIndex2402 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2403
 						}
 						position++
-						goto l1368
-					l1369:
-						position, tokenIndex = position1368, tokenIndex1368
-						if buffer[position] != rune('L') {
-							goto l1361
+						goto l2402
+					l2403:
+						position, tokenIndex = position2402, tokenIndex2402
+						if buffer[position] != rune('S') {
+							goto l2395
 						}
 						position++
 					}
-				l1368:
+				l2402:
 					{
-						position1370, tokenIndex1370 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1371
+						position2404, tokenIndex2404 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2405
 						}
 						position++
-						goto l1370
-					l1371:
-						position, tokenIndex = position1370, tokenIndex1370
-						if buffer[position] != rune('L') {
-							goto l1361
+						goto l2404
+					l2405:
+						position, tokenIndex = position2404, tokenIndex2404
+						if buffer[position] != rune('C') {
+							goto l2395
 						}
 						position++
 					}
-				l1370:
-					add(rulePegText, position1363)
+				l2404:
+					add(rulePegText, position2397)
 				}
-				if !_rules[ruleAction86]() {
-					goto l1361
+				if !_rules[ruleAction147]() {
+					goto l2395
 				}
-				add(ruleNullLiteral, position1362)
+				add(ruleDescending, position2396)
 			}
 			return true
-		l1361:
-			position, tokenIndex = position1361, tokenIndex1361
+		l2395:
+			position, tokenIndex = position2395, tokenIndex2395
 			return false
 		},
-		/* 116 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action87)> */
+		/* 189 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
 		func() bool {
-			position1372, tokenIndex1372 := position, tokenIndex
+			position2406, tokenIndex2406 := position, tokenIndex
 			{
-				position1373 := position
+				position2407 := position
 				{
-					position1374 := position
+					position2408, tokenIndex2408 := position, tokenIndex
+					if !_rules[ruleBool]() {
+						goto l2409
+					}
+					goto l2408
+				l2409:
+					position, tokenIndex = position2408, tokenIndex2408
+					if !_rules[ruleInt]() {
+						goto l2410
+					}
+					goto l2408
+				l2410:
+					position, tokenIndex = position2408, tokenIndex2408
+					if !_rules[ruleFloat]() {
+						goto l2411
+					}
+					goto l2408
+				l2411:
+					position, tokenIndex = position2408, tokenIndex2408
+					if !_rules[ruleString]() {
+						goto l2412
+					}
+					goto l2408
+				l2412:
+					position, tokenIndex = position2408, tokenIndex2408
+					if !_rules[ruleBlob]() {
+						goto l2413
+					}
+					goto l2408
+				l2413:
+					position, tokenIndex = position2408, tokenIndex2408
+					if !_rules[ruleTimestamp]() {
+						goto l2414
+					}
+					goto l2408
+				l2414:
+					position, tokenIndex = position2408, tokenIndex2408
+					if !_rules[ruleArray]() {
+						goto l2415
+					}
+					goto l2408
+				l2415:
+					position, tokenIndex = position2408, tokenIndex2408
+					if !_rules[ruleMap]() {
+						goto l2406
+					}
+				}
+			l2408:
+				add(ruleType, position2407)
+			}
+			return true
+		l2406:
+			position, tokenIndex = position2406, tokenIndex2406
+			return false
+		},
+		/* 190 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action148)> */
+		func() bool {
+			position2416, tokenIndex2416 := position, tokenIndex
+			{
+				position2417 := position
+				{
+					position2418 := position
 					{
-						position1375, tokenIndex1375 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1376
+						position2419, tokenIndex2419 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2420
 						}
 						position++
-						goto l1375
-					l1376:
-						position, tokenIndex = position1375, tokenIndex1375
-						if buffer[position] != rune('M') {
-							goto l1372
+						goto l2419
+					l2420:
+						position, tokenIndex = position2419, tokenIndex2419
+						if buffer[position] != rune('B') {
+							goto l2416
 						}
 						position++
 					}
-				l1375:
+				l2419:
 					{
-						position1377, tokenIndex1377 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1378
+						position2421, tokenIndex2421 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2422
 						}
 						position++
-						goto l1377
-					l1378:
-						position, tokenIndex = position1377, tokenIndex1377
-						if buffer[position] != rune('I') {
-							goto l1372
+						goto l2421
+					l2422:
+						position, tokenIndex = position2421, tokenIndex2421
+						if buffer[position] != rune('O') {
+							goto l2416
 						}
 						position++
 					}
-				l1377:
+				l2421:
 					{
-						position1379, tokenIndex1379 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1380
+						position2423, tokenIndex2423 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2424
 						}
 						position++
-						goto l1379
-					l1380:
-						position, tokenIndex = position1379, tokenIndex1379
-						if buffer[position] != rune('S') {
-							goto l1372
+						goto l2423
+					l2424:
+						position, tokenIndex = position2423, tokenIndex2423
+						if buffer[position] != rune('O') {
+							goto l2416
 						}
 						position++
 					}
-				l1379:
+				l2423:
 					{
-						position1381, tokenIndex1381 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1382
+						position2425, tokenIndex2425 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2426
 						}
 						position++
-						goto l1381
-					l1382:
-						position, tokenIndex = position1381, tokenIndex1381
-						if buffer[position] != rune('S') {
-							goto l1372
+						goto l2425
+					l2426:
+						position, tokenIndex = position2425, tokenIndex2425
+						if buffer[position] != rune('L') {
+							goto l2416
 						}
 						position++
 					}
-				l1381:
+				l2425:
+					add(rulePegText, position2418)
+				}
+				if !_rules[ruleAction148]() {
+					goto l2416
+				}
+				add(ruleBool, position2417)
+			}
+			return true
+		l2416:
+			position, tokenIndex = position2416, tokenIndex2416
+			return false
+		},
+		/* 191 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action149)> */
+		func() bool {
+			position2427, tokenIndex2427 := position, tokenIndex
+			{
+				position2428 := position
+				{
+					position2429 := position
 					{
-						position1383, tokenIndex1383 := position, tokenIndex
+						position2430, tokenIndex2430 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1384
+							goto l2431
 						}
 						position++
-						goto l1383
-					l1384:
-						position, tokenIndex = position1383, tokenIndex1383
+						goto l2430
+					l2431:
+						position, tokenIndex = position2430, tokenIndex2430
 						if buffer[position] != rune('I') {
-							goto l1372
+							goto l2427
 						}
 						position++
 					}
-				l1383:
+				l2430:
 					{
-						position1385, tokenIndex1385 := position, tokenIndex
+						position2432, tokenIndex2432 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1386
+							goto l2433
 						}
 						position++
-						goto l1385
-					l1386:
-						position, tokenIndex = position1385, tokenIndex1385
+						goto l2432
+					l2433:
+						position, tokenIndex = position2432, tokenIndex2432
 						if buffer[position] != rune('N') {
-							goto l1372
+							goto l2427
 						}
 						position++
 					}
-				l1385:
+				l2432:
 					{
-						position1387, tokenIndex1387 := position, tokenIndex
-						if buffer[position] != rune('g') {
-							goto l1388
+						position2434, tokenIndex2434 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2435
 						}
 						position++
-						goto l1387
-					l1388:
-						position, tokenIndex = position1387, tokenIndex1387
-						if buffer[position] != rune('G') {
-							goto l1372
+						goto l2434
+					l2435:
+						position, tokenIndex = position2434, tokenIndex2434
+						if buffer[position] != rune('T') {
+							goto l2427
 						}
 						position++
 					}
-				l1387:
-					add(rulePegText, position1374)
+				l2434:
+					add(rulePegText, position2429)
 				}
-				if !_rules[ruleAction87]() {
-					goto l1372
+				if !_rules[ruleAction149]() {
+					goto l2427
 				}
-				add(ruleMissing, position1373)
+				add(ruleInt, position2428)
 			}
 			return true
-		l1372:
-			position, tokenIndex = position1372, tokenIndex1372
+		l2427:
+			position, tokenIndex = position2427, tokenIndex2427
 			return false
 		},
-		/* 117 BooleanLiteral <- <(TRUE / FALSE)> */
+		/* 192 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action150)> */
 		func() bool {
-			position1389, tokenIndex1389 := position, tokenIndex
+			position2436, tokenIndex2436 := position, tokenIndex
 			{
-				position1390 := position
+				position2437 := position
 				{
-					position1391, tokenIndex1391 := position, tokenIndex
-					if !_rules[ruleTRUE]() {
-						goto l1392
-					}
-					goto l1391
-				l1392:
-					position, tokenIndex = position1391, tokenIndex1391
-					if !_rules[ruleFALSE]() {
-						goto l1389
+					position2438 := position
+					{
+						position2439, tokenIndex2439 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2440
+						}
+						position++
+						goto l2439
+					l2440:
+						position, tokenIndex = position2439, tokenIndex2439
+						if buffer[position] != rune('F') {
+							goto l2436
+						}
+						position++
 					}
-				}
-			l1391:
-				add(ruleBooleanLiteral, position1390)
-			}
-			return true
-		l1389:
-			position, tokenIndex = position1389, tokenIndex1389
-			return false
-		},
-		/* 118 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action88)> */
-		func() bool {
-			position1393, tokenIndex1393 := position, tokenIndex
-			{
-				position1394 := position
-				{
-					position1395 := position
+				l2439:
 					{
-						position1396, tokenIndex1396 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1397
+						position2441, tokenIndex2441 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2442
 						}
 						position++
-						goto l1396
-					l1397:
-						position, tokenIndex = position1396, tokenIndex1396
-						if buffer[position] != rune('T') {
-							goto l1393
+						goto l2441
+					l2442:
+						position, tokenIndex = position2441, tokenIndex2441
+						if buffer[position] != rune('L') {
+							goto l2436
 						}
 						position++
 					}
-				l1396:
+				l2441:
 					{
-						position1398, tokenIndex1398 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1399
+						position2443, tokenIndex2443 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2444
 						}
 						position++
-						goto l1398
-					l1399:
-						position, tokenIndex = position1398, tokenIndex1398
-						if buffer[position] != rune('R') {
-							goto l1393
+						goto l2443
+					l2444:
+						position, tokenIndex = position2443, tokenIndex2443
+						if buffer[position] != rune('O') {
+							goto l2436
 						}
 						position++
 					}
-				l1398:
+				l2443:
 					{
-						position1400, tokenIndex1400 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1401
+						position2445, tokenIndex2445 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2446
 						}
 						position++
-						goto l1400
-					l1401:
-						position, tokenIndex = position1400, tokenIndex1400
-						if buffer[position] != rune('U') {
-							goto l1393
+						goto l2445
+					l2446:
+						position, tokenIndex = position2445, tokenIndex2445
+						if buffer[position] != rune('A') {
+							goto l2436
 						}
 						position++
 					}
-				l1400:
+				l2445:
 					{
-						position1402, tokenIndex1402 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1403
+						position2447, tokenIndex2447 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2448
 						}
 						position++
-						goto l1402
-					l1403:
-						position, tokenIndex = position1402, tokenIndex1402
-						if buffer[position] != rune('E') {
-							goto l1393
+						goto l2447
+					l2448:
+						position, tokenIndex = position2447, tokenIndex2447
+						if buffer[position] != rune('T') {
+							goto l2436
 						}
 						position++
 					}
-				l1402:
-					add(rulePegText, position1395)
+				l2447:
+					add(rulePegText, position2438)
 				}
-				if !_rules[ruleAction88]() {
-					goto l1393
+				if !_rules[ruleAction150]() {
+					goto l2436
 				}
-				add(ruleTRUE, position1394)
+				add(ruleFloat, position2437)
 			}
 			return true
-		l1393:
-			position, tokenIndex = position1393, tokenIndex1393
+		l2436:
+			position, tokenIndex = position2436, tokenIndex2436
 			return false
 		},
-		/* 119 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action89)> */
+		/* 193 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action151)> */
 		func() bool {
-			position1404, tokenIndex1404 := position, tokenIndex
+			position2449, tokenIndex2449 := position, tokenIndex
 			{
-				position1405 := position
+				position2450 := position
 				{
-					position1406 := position
+					position2451 := position
 					{
-						position1407, tokenIndex1407 := position, tokenIndex
-						if buffer[position] != rune('f') {
-							goto l1408
+						position2452, tokenIndex2452 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2453
 						}
 						position++
-						goto l1407
-					l1408:
-						position, tokenIndex = position1407, tokenIndex1407
-						if buffer[position] != rune('F') {
-							goto l1404
+						goto l2452
+					l2453:
+						position, tokenIndex = position2452, tokenIndex2452
+						if buffer[position] != rune('S') {
+							goto l2449
 						}
 						position++
 					}
-				l1407:
+				l2452:
 					{
-						position1409, tokenIndex1409 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1410
+						position2454, tokenIndex2454 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2455
 						}
 						position++
-						goto l1409
-					l1410:
-						position, tokenIndex = position1409, tokenIndex1409
-						if buffer[position] != rune('A') {
-							goto l1404
+						goto l2454
+					l2455:
+						position, tokenIndex = position2454, tokenIndex2454
+						if buffer[position] != rune('T') {
+							goto l2449
 						}
 						position++
 					}
-				l1409:
+				l2454:
 					{
-						position1411, tokenIndex1411 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1412
+						position2456, tokenIndex2456 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2457
 						}
 						position++
-						goto l1411
-					l1412:
-						position, tokenIndex = position1411, tokenIndex1411
-						if buffer[position] != rune('L') {
-							goto l1404
+						goto l2456
+					l2457:
+						position, tokenIndex = position2456, tokenIndex2456
+						if buffer[position] != rune('R') {
+							goto l2449
 						}
 						position++
 					}
-				l1411:
+				l2456:
 					{
-						position1413, tokenIndex1413 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1414
+						position2458, tokenIndex2458 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2459
 						}
 						position++
-						goto l1413
-					l1414:
-						position, tokenIndex = position1413, tokenIndex1413
-						if buffer[position] != rune('S') {
-							goto l1404
+						goto l2458
+					l2459:
+						position, tokenIndex = position2458, tokenIndex2458
+						if buffer[position] != rune('I') {
+							goto l2449
 						}
 						position++
 					}
-				l1413:
+				l2458:
 					{
-						position1415, tokenIndex1415 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1416
+						position2460, tokenIndex2460 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2461
 						}
 						position++
-						goto l1415
-					l1416:
-						position, tokenIndex = position1415, tokenIndex1415
-						if buffer[position] != rune('E') {
-							goto l1404
+						goto l2460
+					l2461:
+						position, tokenIndex = position2460, tokenIndex2460
+						if buffer[position] != rune('N') {
+							goto l2449
 						}
 						position++
 					}
-				l1415:
-					add(rulePegText, position1406)
-				}
-				if !_rules[ruleAction89]() {
-					goto l1404
-				}
-				add(ruleFALSE, position1405)
-			}
-			return true
-		l1404:
-			position, tokenIndex = position1404, tokenIndex1404
-			return false
-		},
-		/* 120 Wildcard <- <(<((ident ':' !':')? '*')> Action90)> */
-		func() bool {
-			position1417, tokenIndex1417 := position, tokenIndex
-			{
-				position1418 := position
-				{
-					position1419 := position
+				l2460:
 					{
-						position1420, tokenIndex1420 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1420
-						}
-						if buffer[position] != rune(':') {
-							goto l1420
+						position2462, tokenIndex2462 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2463
 						}
 						position++
-						{
-							position1422, tokenIndex1422 := position, tokenIndex
-							if buffer[position] != rune(':') {
-								goto l1422
-							}
-							position++
-							goto l1420
-						l1422:
-							position, tokenIndex = position1422, tokenIndex1422
+						goto l2462
+					l2463:
+						position, tokenIndex = position2462, tokenIndex2462
+						if buffer[position] != rune('G') {
+							goto l2449
 						}
-						goto l1421
-					l1420:
-						position, tokenIndex = position1420, tokenIndex1420
-					}
-				l1421:
-					if buffer[position] != rune('*') {
-						goto l1417
+						position++
 					}
-					position++
-					add(rulePegText, position1419)
+				l2462:
+					add(rulePegText, position2451)
 				}
-				if !_rules[ruleAction90]() {
-					goto l1417
+				if !_rules[ruleAction151]() {
+					goto l2449
 				}
-				add(ruleWildcard, position1418)
+				add(ruleString, position2450)
 			}
 			return true
-		l1417:
-			position, tokenIndex = position1417, tokenIndex1417
+		l2449:
+			position, tokenIndex = position2449, tokenIndex2449
 			return false
 		},
-		/* 121 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action91)> */
+		/* 194 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action152)> */
 		func() bool {
-			position1423, tokenIndex1423 := position, tokenIndex
+			position2464, tokenIndex2464 := position, tokenIndex
 			{
-				position1424 := position
+				position2465 := position
 				{
-					position1425 := position
-					if buffer[position] != rune('"') {
-						goto l1423
+					position2466 := position
+					{
+						position2467, tokenIndex2467 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2468
+						}
+						position++
+						goto l2467
+					l2468:
+						position, tokenIndex = position2467, tokenIndex2467
+						if buffer[position] != rune('B') {
+							goto l2464
+						}
+						position++
 					}
-					position++
-				l1426:
+				l2467:
 					{
-						position1427, tokenIndex1427 := position, tokenIndex
-						{
-							position1428, tokenIndex1428 := position, tokenIndex
-							if buffer[position] != rune('"') {
-								goto l1429
-							}
-							position++
-							if buffer[position] != rune('"') {
-								goto l1429
-							}
-							position++
-							goto l1428
-						l1429:
-							position, tokenIndex = position1428, tokenIndex1428
-							{
-								position1430, tokenIndex1430 := position, tokenIndex
-								if buffer[position] != rune('"') {
-									goto l1430
-								}
-								position++
-								goto l1427
-							l1430:
-								position, tokenIndex = position1430, tokenIndex1430
-							}
-							if !matchDot() {
-								goto l1427
-							}
+						position2469, tokenIndex2469 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2470
+						}
+						position++
+						goto l2469
+					l2470:
+						position, tokenIndex = position2469, tokenIndex2469
+						if buffer[position] != rune('L') {
+							goto l2464
+						}
+						position++
+					}
+				l2469:
+					{
+						position2471, tokenIndex2471 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2472
+						}
+						position++
+						goto l2471
+					l2472:
+						position, tokenIndex = position2471, tokenIndex2471
+						if buffer[position] != rune('O') {
+							goto l2464
 						}
-					l1428:
-						goto l1426
-					l1427:
-						position, tokenIndex = position1427, tokenIndex1427
+						position++
 					}
-					if buffer[position] != rune('"') {
-						goto l1423
+				l2471:
+					{
+						position2473, tokenIndex2473 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2474
+						}
+						position++
+						goto l2473
+					l2474:
+						position, tokenIndex = position2473, tokenIndex2473
+						if buffer[position] != rune('B') {
+							goto l2464
+						}
+						position++
 					}
-					position++
-					add(rulePegText, position1425)
+				l2473:
+					add(rulePegText, position2466)
 				}
-				if !_rules[ruleAction91]() {
-					goto l1423
+				if !_rules[ruleAction152]() {
+					goto l2464
 				}
-				add(ruleStringLiteral, position1424)
+				add(ruleBlob, position2465)
 			}
 			return true
-		l1423:
-			position, tokenIndex = position1423, tokenIndex1423
+		l2464:
+			position, tokenIndex = position2464, tokenIndex2464
 			return false
 		},
-		/* 122 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action92)> */
+		/* 195 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action153)> */
 		func() bool {
-			position1431, tokenIndex1431 := position, tokenIndex
+			position2475, tokenIndex2475 := position, tokenIndex
 			{
-				position1432 := position
+				position2476 := position
 				{
-					position1433 := position
+					position2477 := position
+					{
+						position2478, tokenIndex2478 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2479
+						}
+						position++
+						goto l2478
+					l2479:
+						position, tokenIndex = position2478, tokenIndex2478
+						if buffer[position] != rune('T') {
+							goto l2475
+						}
+						position++
+					}
+				l2478:
 					{
-						position1434, tokenIndex1434 := position, tokenIndex
+						position2480, tokenIndex2480 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1435
+							goto l2481
 						}
 						position++
-						goto l1434
-					l1435:
-						position, tokenIndex = position1434, tokenIndex1434
+						goto l2480
+					l2481:
+						position, tokenIndex = position2480, tokenIndex2480
 						if buffer[position] != rune('I') {
-							goto l1431
+							goto l2475
 						}
 						position++
 					}
-				l1434:
+				l2480:
 					{
-						position1436, tokenIndex1436 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1437
+						position2482, tokenIndex2482 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2483
 						}
 						position++
-						goto l1436
-					l1437:
-						position, tokenIndex = position1436, tokenIndex1436
-						if buffer[position] != rune('S') {
-							goto l1431
+						goto l2482
+					l2483:
+						position, tokenIndex = position2482, tokenIndex2482
+						if buffer[position] != rune('M') {
+							goto l2475
 						}
 						position++
 					}
-				l1436:
+				l2482:
 					{
-						position1438, tokenIndex1438 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1439
+						position2484, tokenIndex2484 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2485
 						}
 						position++
-						goto l1438
-					l1439:
-						position, tokenIndex = position1438, tokenIndex1438
-						if buffer[position] != rune('T') {
-							goto l1431
+						goto l2484
+					l2485:
+						position, tokenIndex = position2484, tokenIndex2484
+						if buffer[position] != rune('E') {
+							goto l2475
 						}
 						position++
 					}
-				l1438:
+				l2484:
 					{
-						position1440, tokenIndex1440 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1441
+						position2486, tokenIndex2486 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2487
 						}
 						position++
-						goto l1440
-					l1441:
-						position, tokenIndex = position1440, tokenIndex1440
-						if buffer[position] != rune('R') {
-							goto l1431
+						goto l2486
+					l2487:
+						position, tokenIndex = position2486, tokenIndex2486
+						if buffer[position] != rune('S') {
+							goto l2475
 						}
 						position++
 					}
-				l1440:
+				l2486:
 					{
-						position1442, tokenIndex1442 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1443
+						position2488, tokenIndex2488 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2489
 						}
 						position++
-						goto l1442
-					l1443:
-						position, tokenIndex = position1442, tokenIndex1442
-						if buffer[position] != rune('E') {
-							goto l1431
+						goto l2488
+					l2489:
+						position, tokenIndex = position2488, tokenIndex2488
+						if buffer[position] != rune('T') {
+							goto l2475
 						}
 						position++
 					}
-				l1442:
+				l2488:
 					{
-						position1444, tokenIndex1444 := position, tokenIndex
+						position2490, tokenIndex2490 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1445
+							goto l2491
 						}
 						position++
-						goto l1444
-					l1445:
-						position, tokenIndex = position1444, tokenIndex1444
+						goto l2490
+					l2491:
+						position, tokenIndex = position2490, tokenIndex2490
 						if buffer[position] != rune('A') {
-							goto l1431
+							goto l2475
 						}
 						position++
 					}
-				l1444:
+				l2490:
 					{
-						position1446, tokenIndex1446 := position, tokenIndex
+						position2492, tokenIndex2492 := position, tokenIndex
 						if buffer[position] != rune('m') {
-							goto l1447
+							goto l2493
 						}
 						position++
-						goto l1446
-					l1447:
-						position, tokenIndex = position1446, tokenIndex1446
+						goto l2492
+					l2493:
+						position, tokenIndex = position2492, tokenIndex2492
 						if buffer[position] != rune('M') {
-							goto l1431
+							goto l2475
+						}
+						position++
+					}
+				l2492:
+					{
+						position2494, tokenIndex2494 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2495
+						}
+						position++
+						goto l2494
+					l2495:
+						position, tokenIndex = position2494, tokenIndex2494
+						if buffer[position] != rune('P') {
+							goto l2475
 						}
 						position++
 					}
-				l1446:
-					add(rulePegText, position1433)
+				l2494:
+					add(rulePegText, position2477)
 				}
-				if !_rules[ruleAction92]() {
-					goto l1431
+				if !_rules[ruleAction153]() {
+					goto l2475
 				}
-				add(ruleISTREAM, position1432)
+				add(ruleTimestamp, position2476)
 			}
 			return true
-		l1431:
-			position, tokenIndex = position1431, tokenIndex1431
+		l2475:
+			position, tokenIndex = position2475, tokenIndex2475
 			return false
 		},
-		/* 123 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action93)> */
+		/* 196 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action154)> */
 		func() bool {
-			position1448, tokenIndex1448 := position, tokenIndex
+			position2496, tokenIndex2496 := position, tokenIndex
 			{
-				position1449 := position
+				position2497 := position
 				{
-					position1450 := position
+					position2498 := position
 					{
-						position1451, tokenIndex1451 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1452
+						position2499, tokenIndex2499 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2500
 						}
 						position++
-						goto l1451
-					l1452:
-						position, tokenIndex = position1451, tokenIndex1451
-						if buffer[position] != rune('D') {
-							goto l1448
+						goto l2499
+					l2500:
+						position, tokenIndex = position2499, tokenIndex2499
+						if buffer[position] != rune('A') {
+							goto l2496
 						}
 						position++
 					}
-				l1451:
+				l2499:
 					{
-						position1453, tokenIndex1453 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1454
+						position2501, tokenIndex2501 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2502
 						}
 						position++
-						goto l1453
-					l1454:
-						position, tokenIndex = position1453, tokenIndex1453
-						if buffer[position] != rune('S') {
-							goto l1448
+						goto l2501
+					l2502:
+						position, tokenIndex = position2501, tokenIndex2501
+						if buffer[position] != rune('R') {
+							goto l2496
 						}
 						position++
 					}
-				l1453:
+				l2501:
 					{
-						position1455, tokenIndex1455 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1456
+						position2503, tokenIndex2503 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2504
 						}
 						position++
-						goto l1455
-					l1456:
-						position, tokenIndex = position1455, tokenIndex1455
-						if buffer[position] != rune('T') {
-							goto l1448
+						goto l2503
+					l2504:
+						position, tokenIndex = position2503, tokenIndex2503
+						if buffer[position] != rune('R') {
+							goto l2496
 						}
 						position++
 					}
-				l1455:
+				l2503:
 					{
-						position1457, tokenIndex1457 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1458
+						position2505, tokenIndex2505 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2506
 						}
 						position++
-						goto l1457
-					l1458:
-						position, tokenIndex = position1457, tokenIndex1457
-						if buffer[position] != rune('R') {
-							goto l1448
+						goto l2505
+					l2506:
+						position, tokenIndex = position2505, tokenIndex2505
+						if buffer[position] != rune('A') {
+							goto l2496
 						}
 						position++
 					}
-				l1457:
+				l2505:
 					{
-						position1459, tokenIndex1459 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1460
+						position2507, tokenIndex2507 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2508
 						}
 						position++
-						goto l1459
-					l1460:
-						position, tokenIndex = position1459, tokenIndex1459
-						if buffer[position] != rune('E') {
-							goto l1448
+						goto l2507
+					l2508:
+						position, tokenIndex = position2507, tokenIndex2507
+						if buffer[position] != rune('Y') {
+							goto l2496
+						}
+						position++
+					}
+				l2507:
+					add(rulePegText, position2498)
+				}
+				if !_rules[ruleAction154]() {
+					goto l2496
+				}
+				add(ruleArray, position2497)
+			}
+			return true
+		l2496:
+			position, tokenIndex = position2496, tokenIndex2496
+			return false
+		},
+		/* 197 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action155)> */
+		func() bool {
+			position2509, tokenIndex2509 := position, tokenIndex
+			{
+				position2510 := position
+				{
+					position2511 := position
+					{
+						position2512, tokenIndex2512 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2513
+						}
+						position++
+						goto l2512
+					l2513:
+						position, tokenIndex = position2512, tokenIndex2512
+						if buffer[position] != rune('M') {
+							goto l2509
 						}
 						position++
 					}
-				l1459:
+				l2512:
 					{
-						position1461, tokenIndex1461 := position, tokenIndex
+						position2514, tokenIndex2514 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1462
+							goto l2515
 						}
 						position++
-						goto l1461
-					l1462:
-						position, tokenIndex = position1461, tokenIndex1461
+						goto l2514
+					l2515:
+						position, tokenIndex = position2514, tokenIndex2514
 						if buffer[position] != rune('A') {
-							goto l1448
+							goto l2509
 						}
 						position++
 					}
-				l1461:
+				l2514:
 					{
-						position1463, tokenIndex1463 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1464
+						position2516, tokenIndex2516 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2517
 						}
 						position++
-						goto l1463
-					l1464:
-						position, tokenIndex = position1463, tokenIndex1463
-						if buffer[position] != rune('M') {
-							goto l1448
+						goto l2516
+					l2517:
+						position, tokenIndex = position2516, tokenIndex2516
+						if buffer[position] != rune('P') {
+							goto l2509
 						}
 						position++
 					}
-				l1463:
-					add(rulePegText, position1450)
+				l2516:
+					add(rulePegText, position2511)
 				}
-				if !_rules[ruleAction93]() {
-					goto l1448
+				if !_rules[ruleAction155]() {
+					goto l2509
 				}
-				add(ruleDSTREAM, position1449)
+				add(ruleMap, position2510)
 			}
 			return true
-		l1448:
-			position, tokenIndex = position1448, tokenIndex1448
+		l2509:
+			position, tokenIndex = position2509, tokenIndex2509
 			return false
 		},
-		/* 124 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action94)> */
+		/* 198 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action156)> */
 		func() bool {
-			position1465, tokenIndex1465 := position, tokenIndex
+			position2518, tokenIndex2518 := position, tokenIndex
 			{
-				position1466 := position
+				position2519 := position
 				{
-					position1467 := position
+					position2520 := position
+					{
+						position2521, tokenIndex2521 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2522
+						}
+						position++
+						goto l2521
+					l2522:
+						position, tokenIndex = position2521, tokenIndex2521
+						if buffer[position] != rune('O') {
+							goto l2518
+						}
+						position++
+					}
+				l2521:
 					{
-						position1468, tokenIndex1468 := position, tokenIndex
+						position2523, tokenIndex2523 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1469
+							goto l2524
 						}
 						position++
-						goto l1468
-					l1469:
-						position, tokenIndex = position1468, tokenIndex1468
+						goto l2523
+					l2524:
+						position, tokenIndex = position2523, tokenIndex2523
 						if buffer[position] != rune('R') {
-							goto l1465
+							goto l2518
+						}
+						position++
+					}
+				l2523:
+					add(rulePegText, position2520)
+				}
+				if !_rules[ruleAction156]() {
+					goto l2518
+				}
+				add(ruleOr, position2519)
+			}
+			return true
+		l2518:
+			position, tokenIndex = position2518, tokenIndex2518
+			return false
+		},
+		/* 199 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action157)> */
+		func() bool {
+			position2525, tokenIndex2525 := position, tokenIndex
+			{
+				position2526 := position
+				{
+					position2527 := position
+					{
+						position2528, tokenIndex2528 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2529
+						}
+						position++
+						goto l2528
+					l2529:
+						position, tokenIndex = position2528, tokenIndex2528
+						if buffer[position] != rune('A') {
+							goto l2525
 						}
 						position++
 					}
-				l1468:
+				l2528:
 					{
-						position1470, tokenIndex1470 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1471
+						position2530, tokenIndex2530 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2531
 						}
 						position++
-						goto l1470
-					l1471:
-						position, tokenIndex = position1470, tokenIndex1470
-						if buffer[position] != rune('S') {
-							goto l1465
+						goto l2530
+					l2531:
+						position, tokenIndex = position2530, tokenIndex2530
+						if buffer[position] != rune('N') {
+							goto l2525
 						}
 						position++
 					}
-				l1470:
+				l2530:
 					{
-						position1472, tokenIndex1472 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1473
+						position2532, tokenIndex2532 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2533
 						}
 						position++
-						goto l1472
-					l1473:
-						position, tokenIndex = position1472, tokenIndex1472
-						if buffer[position] != rune('T') {
-							goto l1465
+						goto l2532
+					l2533:
+						position, tokenIndex = position2532, tokenIndex2532
+						if buffer[position] != rune('D') {
+							goto l2525
 						}
 						position++
 					}
-				l1472:
+				l2532:
+					add(rulePegText, position2527)
+				}
+				if !_rules[ruleAction157]() {
+					goto l2525
+				}
+				add(ruleAnd, position2526)
+			}
+			return true
+		l2525:
+			position, tokenIndex = position2525, tokenIndex2525
+			return false
+		},
+		/* 200 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action158)> */
+		func() bool {
+			position2534, tokenIndex2534 := position, tokenIndex
+			{
+				position2535 := position
+				{
+					position2536 := position
 					{
-						position1474, tokenIndex1474 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1475
+						position2537, tokenIndex2537 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2538
 						}
 						position++
-						goto l1474
-					l1475:
-						position, tokenIndex = position1474, tokenIndex1474
-						if buffer[position] != rune('R') {
-							goto l1465
+						goto l2537
+					l2538:
+						position, tokenIndex = position2537, tokenIndex2537
+						if buffer[position] != rune('N') {
+							goto l2534
 						}
 						position++
 					}
-				l1474:
+				l2537:
 					{
-						position1476, tokenIndex1476 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1477
+						position2539, tokenIndex2539 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2540
 						}
 						position++
-						goto l1476
-					l1477:
-						position, tokenIndex = position1476, tokenIndex1476
-						if buffer[position] != rune('E') {
-							goto l1465
+						goto l2539
+					l2540:
+						position, tokenIndex = position2539, tokenIndex2539
+						if buffer[position] != rune('O') {
+							goto l2534
 						}
 						position++
 					}
-				l1476:
+				l2539:
 					{
-						position1478, tokenIndex1478 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1479
+						position2541, tokenIndex2541 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2542
 						}
 						position++
-						goto l1478
-					l1479:
-						position, tokenIndex = position1478, tokenIndex1478
-						if buffer[position] != rune('A') {
-							goto l1465
+						goto l2541
+					l2542:
+						position, tokenIndex = position2541, tokenIndex2541
+						if buffer[position] != rune('T') {
+							goto l2534
 						}
 						position++
 					}
-				l1478:
+				l2541:
+					add(rulePegText, position2536)
+				}
+				if !_rules[ruleAction158]() {
+					goto l2534
+				}
+				add(ruleNot, position2535)
+			}
+			return true
+		l2534:
+			position, tokenIndex = position2534, tokenIndex2534
+			return false
+		},
+		/* 201 Equal <- <(<'='> Action159)> */
+		func() bool {
+			position2543, tokenIndex2543 := position, tokenIndex
+			{
+				position2544 := position
+				{
+					position2545 := position
+					if buffer[position] != rune('=') {
+						goto l2543
+					}
+					position++
+					add(rulePegText, position2545)
+				}
+				if !_rules[ruleAction159]() {
+					goto l2543
+				}
+				add(ruleEqual, position2544)
+			}
+			return true
+		l2543:
+			position, tokenIndex = position2543, tokenIndex2543
+			return false
+		},
+		/* 202 Less <- <(<'<'> Action160)> */
+		func() bool {
+			position2546, tokenIndex2546 := position, tokenIndex
+			{
+				position2547 := position
+				{
+					position2548 := position
+					if buffer[position] != rune('<') {
+						goto l2546
+					}
+					position++
+					add(rulePegText, position2548)
+				}
+				if !_rules[ruleAction160]() {
+					goto l2546
+				}
+				add(ruleLess, position2547)
+			}
+			return true
+		l2546:
+			position, tokenIndex = position2546, tokenIndex2546
+			return false
+		},
+		/* 203 LessOrEqual <- <(<('<' '=')> Action161)> */
+		func() bool {
+			position2549, tokenIndex2549 := position, tokenIndex
+			{
+				position2550 := position
+				{
+					position2551 := position
+					if buffer[position] != rune('<') {
+						goto l2549
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l2549
+					}
+					position++
+					add(rulePegText, position2551)
+				}
+				if !_rules[ruleAction161]() {
+					goto l2549
+				}
+				add(ruleLessOrEqual, position2550)
+			}
+			return true
+		l2549:
+			position, tokenIndex = position2549, tokenIndex2549
+			return false
+		},
+		/* 204 Greater <- <(<'>'> Action162)> */
+		func() bool {
+			position2552, tokenIndex2552 := position, tokenIndex
+			{
+				position2553 := position
+				{
+					position2554 := position
+					if buffer[position] != rune('>') {
+						goto l2552
+					}
+					position++
+					add(rulePegText, position2554)
+				}
+				if !_rules[ruleAction162]() {
+					goto l2552
+				}
+				add(ruleGreater, position2553)
+			}
+			return true
+		l2552:
+			position, tokenIndex = position2552, tokenIndex2552
+			return false
+		},
+		/* 205 GreaterOrEqual <- <(<('>' '=')> Action163)> */
+		func() bool {
+			position2555, tokenIndex2555 := position, tokenIndex
+			{
+				position2556 := position
+				{
+					position2557 := position
+					if buffer[position] != rune('>') {
+						goto l2555
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l2555
+					}
+					position++
+					add(rulePegText, position2557)
+				}
+				if !_rules[ruleAction163]() {
+					goto l2555
+				}
+				add(ruleGreaterOrEqual, position2556)
+			}
+			return true
+		l2555:
+			position, tokenIndex = position2555, tokenIndex2555
+			return false
+		},
+		/* 206 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action164)> */
+		func() bool {
+			position2558, tokenIndex2558 := position, tokenIndex
+			{
+				position2559 := position
+				{
+					position2560 := position
 					{
-						position1480, tokenIndex1480 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1481
+						position2561, tokenIndex2561 := position, tokenIndex
+						if buffer[position] != rune('!') {
+							goto l2562
 						}
 						position++
-						goto l1480
-					l1481:
-						position, tokenIndex = position1480, tokenIndex1480
-						if buffer[position] != rune('M') {
-							goto l1465
+						if buffer[position] != rune('=') {
+							goto l2562
+						}
+						position++
+						goto l2561
+					l2562:
+						position, tokenIndex = position2561, tokenIndex2561
+						if buffer[position] != rune('<') {
+							goto l2558
+						}
+						position++
+						if buffer[position] != rune('>') {
+							goto l2558
 						}
 						position++
 					}
-				l1480:
-					add(rulePegText, position1467)
+				l2561:
+					add(rulePegText, position2560)
 				}
-				if !_rules[ruleAction94]() {
-					goto l1465
+				if !_rules[ruleAction164]() {
+					goto l2558
 				}
-				add(ruleRSTREAM, position1466)
+				add(ruleNotEqual, position2559)
 			}
 			return true
-		l1465:
-			position, tokenIndex = position1465, tokenIndex1465
+		l2558:
+			position, tokenIndex = position2558, tokenIndex2558
 			return false
 		},
-		/* 125 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action95)> */
+		/* 207 Like <- <(<(('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action165)> */
 		func() bool {
-			position1482, tokenIndex1482 := position, tokenIndex
+			position2563, tokenIndex2563 := position, tokenIndex
 			{
-				position1483 := position
+				position2564 := position
 				{
-					position1484 := position
+					position2565 := position
 					{
-						position1485, tokenIndex1485 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1486
-						}
-						position++
-						goto l1485
-					l1486:
-						position, tokenIndex = position1485, tokenIndex1485
-						if buffer[position] != rune('T') {
-							goto l1482
-						}
-						position++
-					}
-				l1485:
-					{
-						position1487, tokenIndex1487 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1488
+						position2566, tokenIndex2566 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2567
 						}
 						position++
-						goto l1487
-					l1488:
-						position, tokenIndex = position1487, tokenIndex1487
-						if buffer[position] != rune('U') {
-							goto l1482
+						goto l2566
+					l2567:
+						position, tokenIndex = position2566, tokenIndex2566
+						if buffer[position] != rune('L') {
+							goto l2563
 						}
 						position++
 					}
-				l1487:
+				l2566:
 					{
-						position1489, tokenIndex1489 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1490
+						position2568, tokenIndex2568 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2569
 						}
 						position++
-						goto l1489
-					l1490:
-						position, tokenIndex = position1489, tokenIndex1489
-						if buffer[position] != rune('P') {
-							goto l1482
+						goto l2568
+					l2569:
+						position, tokenIndex = position2568, tokenIndex2568
+						if buffer[position] != rune('I') {
+							goto l2563
 						}
 						position++
 					}
-				l1489:
+				l2568:
 					{
-						position1491, tokenIndex1491 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1492
+						position2570, tokenIndex2570 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2571
 						}
 						position++
-						goto l1491
-					l1492:
-						position, tokenIndex = position1491, tokenIndex1491
-						if buffer[position] != rune('L') {
-							goto l1482
+						goto l2570
+					l2571:
+						position, tokenIndex = position2570, tokenIndex2570
+						if buffer[position] != rune('K') {
+							goto l2563
 						}
 						position++
 					}
-				l1491:
+				l2570:
 					{
-						position1493, tokenIndex1493 := position, tokenIndex
+						position2572, tokenIndex2572 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1494
+							goto l2573
 						}
 						position++
-						goto l1493
-					l1494:
-						position, tokenIndex = position1493, tokenIndex1493
+						goto l2572
+					l2573:
+						position, tokenIndex = position2572, tokenIndex2572
 						if buffer[position] != rune('E') {
-							goto l1482
+							goto l2563
 						}
 						position++
 					}
-				l1493:
-					{
-						position1495, tokenIndex1495 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1496
-						}
-						position++
-						goto l1495
-					l1496:
-						position, tokenIndex = position1495, tokenIndex1495
-						if buffer[position] != rune('S') {
-							goto l1482
-						}
-						position++
-					}
-				l1495:
-					add(rulePegText, position1484)
+				l2572:
+					add(rulePegText, position2565)
 				}
-				if !_rules[ruleAction95]() {
-					goto l1482
+				if !_rules[ruleAction165]() {
+					goto l2563
 				}
-				add(ruleTUPLES, position1483)
+				add(ruleLike, position2564)
 			}
 			return true
-		l1482:
-			position, tokenIndex = position1482, tokenIndex1482
+		l2563:
+			position, tokenIndex = position2563, tokenIndex2563
 			return false
 		},
-		/* 126 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action96)> */
+		/* 208 NotLike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action166)> */
 		func() bool {
-			position1497, tokenIndex1497 := position, tokenIndex
+			position2574, tokenIndex2574 := position, tokenIndex
 			{
-				position1498 := position
+				position2575 := position
 				{
-					position1499 := position
+					position2576 := position
 					{
-						position1500, tokenIndex1500 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1501
+						position2577, tokenIndex2577 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2578
 						}
 						position++
-						goto l1500
-					l1501:
-						position, tokenIndex = position1500, tokenIndex1500
-						if buffer[position] != rune('S') {
-							goto l1497
+						goto l2577
+					l2578:
+						position, tokenIndex = position2577, tokenIndex2577
+						if buffer[position] != rune('N') {
+							goto l2574
 						}
 						position++
 					}
-				l1500:
+				l2577:
 					{
-						position1502, tokenIndex1502 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1503
+						position2579, tokenIndex2579 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2580
 						}
 						position++
-						goto l1502
-					l1503:
-						position, tokenIndex = position1502, tokenIndex1502
-						if buffer[position] != rune('E') {
-							goto l1497
+						goto l2579
+					l2580:
+						position, tokenIndex = position2579, tokenIndex2579
+						if buffer[position] != rune('O') {
+							goto l2574
 						}
 						position++
 					}
-				l1502:
+				l2579:
 					{
-						position1504, tokenIndex1504 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1505
+						position2581, tokenIndex2581 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2582
 						}
 						position++
-						goto l1504
-					l1505:
-						position, tokenIndex = position1504, tokenIndex1504
-						if buffer[position] != rune('C') {
-							goto l1497
+						goto l2581
+					l2582:
+						position, tokenIndex = position2581, tokenIndex2581
+						if buffer[position] != rune('T') {
+							goto l2574
 						}
 						position++
 					}
-				l1504:
+				l2581:
+					if !_rules[rulesp]() {
+						goto l2574
+					}
 					{
-						position1506, tokenIndex1506 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1507
+						position2583, tokenIndex2583 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2584
 						}
 						position++
-						goto l1506
-					l1507:
-						position, tokenIndex = position1506, tokenIndex1506
-						if buffer[position] != rune('O') {
-							goto l1497
+						goto l2583
+					l2584:
+						position, tokenIndex = position2583, tokenIndex2583
+						if buffer[position] != rune('L') {
+							goto l2574
 						}
 						position++
 					}
-				l1506:
+				l2583:
 					{
-						position1508, tokenIndex1508 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1509
+						position2585, tokenIndex2585 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2586
 						}
 						position++
-						goto l1508
-					l1509:
-						position, tokenIndex = position1508, tokenIndex1508
-						if buffer[position] != rune('N') {
-							goto l1497
+						goto l2585
+					l2586:
+						position, tokenIndex = position2585, tokenIndex2585
+						if buffer[position] != rune('I') {
+							goto l2574
 						}
 						position++
 					}
-				l1508:
+				l2585:
 					{
-						position1510, tokenIndex1510 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1511
+						position2587, tokenIndex2587 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2588
 						}
 						position++
-						goto l1510
-					l1511:
-						position, tokenIndex = position1510, tokenIndex1510
-						if buffer[position] != rune('D') {
-							goto l1497
+						goto l2587
+					l2588:
+						position, tokenIndex = position2587, tokenIndex2587
+						if buffer[position] != rune('K') {
+							goto l2574
 						}
 						position++
 					}
-				l1510:
+				l2587:
 					{
-						position1512, tokenIndex1512 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1513
+						position2589, tokenIndex2589 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2590
 						}
 						position++
-						goto l1512
-					l1513:
-						position, tokenIndex = position1512, tokenIndex1512
-						if buffer[position] != rune('S') {
-							goto l1497
+						goto l2589
+					l2590:
+						position, tokenIndex = position2589, tokenIndex2589
+						if buffer[position] != rune('E') {
+							goto l2574
 						}
 						position++
 					}
-				l1512:
-					add(rulePegText, position1499)
+				l2589:
+					add(rulePegText, position2576)
 				}
-				if !_rules[ruleAction96]() {
-					goto l1497
+				if !_rules[ruleAction166]() {
+					goto l2574
 				}
-				add(ruleSECONDS, position1498)
+				add(ruleNotLike, position2575)
 			}
 			return true
-		l1497:
-			position, tokenIndex = position1497, tokenIndex1497
+		l2574:
+			position, tokenIndex = position2574, tokenIndex2574
 			return false
 		},
-		/* 127 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action97)> */
+		/* 209 ILike <- <(<(('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action167)> */
 		func() bool {
-			position1514, tokenIndex1514 := position, tokenIndex
+			position2591, tokenIndex2591 := position, tokenIndex
 			{
-				position1515 := position
+				position2592 := position
 				{
-					position1516 := position
-					{
-						position1517, tokenIndex1517 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1518
-						}
-						position++
-						goto l1517
-					l1518:
-						position, tokenIndex = position1517, tokenIndex1517
-						if buffer[position] != rune('M') {
-							goto l1514
-						}
-						position++
-					}
-				l1517:
+					position2593 := position
 					{
-						position1519, tokenIndex1519 := position, tokenIndex
+						position2594, tokenIndex2594 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1520
+							goto l2595
 						}
 						position++
-						goto l1519
-					l1520:
-						position, tokenIndex = position1519, tokenIndex1519
+						goto l2594
+					l2595:
+						position, tokenIndex = position2594, tokenIndex2594
 						if buffer[position] != rune('I') {
-							goto l1514
-						}
-						position++
-					}
-				l1519:
-					{
-						position1521, tokenIndex1521 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1522
-						}
-						position++
-						goto l1521
-					l1522:
-						position, tokenIndex = position1521, tokenIndex1521
-						if buffer[position] != rune('L') {
-							goto l1514
+							goto l2591
 						}
 						position++
 					}
-				l1521:
+				l2594:
 					{
-						position1523, tokenIndex1523 := position, tokenIndex
+						position2596, tokenIndex2596 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1524
+							goto l2597
 						}
 						position++
-						goto l1523
-					l1524:
-						position, tokenIndex = position1523, tokenIndex1523
+						goto l2596
+					l2597:
+						position, tokenIndex = position2596, tokenIndex2596
 						if buffer[position] != rune('L') {
-							goto l1514
+							goto l2591
 						}
 						position++
 					}
-				l1523:
+				l2596:
 					{
-						position1525, tokenIndex1525 := position, tokenIndex
+						position2598, tokenIndex2598 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l1526
+							goto l2599
 						}
 						position++
-						goto l1525
-					l1526:
-						position, tokenIndex = position1525, tokenIndex1525
+						goto l2598
+					l2599:
+						position, tokenIndex = position2598, tokenIndex2598
 						if buffer[position] != rune('I') {
-							goto l1514
+							goto l2591
 						}
 						position++
 					}
-				l1525:
+				l2598:
 					{
-						position1527, tokenIndex1527 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1528
+						position2600, tokenIndex2600 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2601
 						}
 						position++
-						goto l1527
-					l1528:
-						position, tokenIndex = position1527, tokenIndex1527
-						if buffer[position] != rune('S') {
-							goto l1514
+						goto l2600
+					l2601:
+						position, tokenIndex = position2600, tokenIndex2600
+						if buffer[position] != rune('K') {
+							goto l2591
 						}
 						position++
 					}
-				l1527:
+				l2600:
 					{
-						position1529, tokenIndex1529 := position, tokenIndex
+						position2602, tokenIndex2602 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1530
+							goto l2603
 						}
 						position++
-						goto l1529
-					l1530:
-						position, tokenIndex = position1529, tokenIndex1529
+						goto l2602
+					l2603:
+						position, tokenIndex = position2602, tokenIndex2602
 						if buffer[position] != rune('E') {
-							goto l1514
+							goto l2591
 						}
 						position++
 					}
-				l1529:
+				l2602:
+					add(rulePegText, position2593)
+				}
+				if !_rules[ruleAction167]() {
+					goto l2591
+				}
+				add(ruleILike, position2592)
+			}
+			return true
+		l2591:
+			position, tokenIndex = position2591, tokenIndex2591
+			return false
+		},
+		/* 210 NotILike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action168)> */
+		func() bool {
+			position2604, tokenIndex2604 := position, tokenIndex
+			{
+				position2605 := position
+				{
+					position2606 := position
 					{
-						position1531, tokenIndex1531 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1532
+						position2607, tokenIndex2607 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2608
 						}
 						position++
-						goto l1531
-					l1532:
-						position, tokenIndex = position1531, tokenIndex1531
-						if buffer[position] != rune('C') {
-							goto l1514
+						goto l2607
+					l2608:
+						position, tokenIndex = position2607, tokenIndex2607
+						if buffer[position] != rune('N') {
+							goto l2604
 						}
 						position++
 					}
-				l1531:
+				l2607:
 					{
-						position1533, tokenIndex1533 := position, tokenIndex
+						position2609, tokenIndex2609 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1534
+							goto l2610
 						}
 						position++
-						goto l1533
-					l1534:
-						position, tokenIndex = position1533, tokenIndex1533
+						goto l2609
+					l2610:
+						position, tokenIndex = position2609, tokenIndex2609
 						if buffer[position] != rune('O') {
-							goto l1514
+							goto l2604
 						}
 						position++
 					}
-				l1533:
+				l2609:
 					{
-						position1535, tokenIndex1535 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1536
+						position2611, tokenIndex2611 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2612
 						}
 						position++
-						goto l1535
-					l1536:
-						position, tokenIndex = position1535, tokenIndex1535
-						if buffer[position] != rune('N') {
-							goto l1514
+						goto l2611
+					l2612:
+						position, tokenIndex = position2611, tokenIndex2611
+						if buffer[position] != rune('T') {
+							goto l2604
 						}
 						position++
 					}
-				l1535:
-					{
-						position1537, tokenIndex1537 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1538
-						}
-						position++
-						goto l1537
-					l1538:
-						position, tokenIndex = position1537, tokenIndex1537
-						if buffer[position] != rune('D') {
-							goto l1514
-						}
-						position++
+				l2611:
+					if !_rules[rulesp]() {
+						goto l2604
 					}
-				l1537:
 					{
-						position1539, tokenIndex1539 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1540
+						position2613, tokenIndex2613 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2614
 						}
 						position++
-						goto l1539
-					l1540:
-						position, tokenIndex = position1539, tokenIndex1539
-						if buffer[position] != rune('S') {
-							goto l1514
+						goto l2613
+					l2614:
+						position, tokenIndex = position2613, tokenIndex2613
+						if buffer[position] != rune('I') {
+							goto l2604
 						}
 						position++
 					}
-				l1539:
-					add(rulePegText, position1516)
-				}
-				if !_rules[ruleAction97]() {
-					goto l1514
-				}
-				add(ruleMILLISECONDS, position1515)
-			}
-			return true
-		l1514:
-			position, tokenIndex = position1514, tokenIndex1514
-			return false
-		},
-		/* 128 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action98)> */
-		func() bool {
-			position1541, tokenIndex1541 := position, tokenIndex
-			{
-				position1542 := position
-				{
-					position1543 := position
+				l2613:
 					{
-						position1544, tokenIndex1544 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l1545
+						position2615, tokenIndex2615 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2616
 						}
 						position++
-						goto l1544
-					l1545:
-						position, tokenIndex = position1544, tokenIndex1544
-						if buffer[position] != rune('W') {
-							goto l1541
+						goto l2615
+					l2616:
+						position, tokenIndex = position2615, tokenIndex2615
+						if buffer[position] != rune('L') {
+							goto l2604
 						}
 						position++
 					}
-				l1544:
+				l2615:
 					{
-						position1546, tokenIndex1546 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1547
+						position2617, tokenIndex2617 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2618
 						}
 						position++
-						goto l1546
-					l1547:
-						position, tokenIndex = position1546, tokenIndex1546
-						if buffer[position] != rune('A') {
-							goto l1541
+						goto l2617
+					l2618:
+						position, tokenIndex = position2617, tokenIndex2617
+						if buffer[position] != rune('I') {
+							goto l2604
 						}
 						position++
 					}
-				l1546:
+				l2617:
 					{
-						position1548, tokenIndex1548 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1549
+						position2619, tokenIndex2619 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2620
 						}
 						position++
-						goto l1548
-					l1549:
-						position, tokenIndex = position1548, tokenIndex1548
-						if buffer[position] != rune('I') {
-							goto l1541
+						goto l2619
+					l2620:
+						position, tokenIndex = position2619, tokenIndex2619
+						if buffer[position] != rune('K') {
+							goto l2604
 						}
 						position++
 					}
-				l1548:
+				l2619:
 					{
-						position1550, tokenIndex1550 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1551
+						position2621, tokenIndex2621 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2622
 						}
 						position++
-						goto l1550
-					l1551:
-						position, tokenIndex = position1550, tokenIndex1550
-						if buffer[position] != rune('T') {
-							goto l1541
+						goto l2621
+					l2622:
+						position, tokenIndex = position2621, tokenIndex2621
+						if buffer[position] != rune('E') {
+							goto l2604
 						}
 						position++
 					}
-				l1550:
-					add(rulePegText, position1543)
+				l2621:
+					add(rulePegText, position2606)
 				}
-				if !_rules[ruleAction98]() {
-					goto l1541
+				if !_rules[ruleAction168]() {
+					goto l2604
 				}
-				add(ruleWait, position1542)
+				add(ruleNotILike, position2605)
 			}
 			return true
-		l1541:
-			position, tokenIndex = position1541, tokenIndex1541
+		l2604:
+			position, tokenIndex = position2604, tokenIndex2604
 			return false
 		},
-		/* 129 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action99)> */
+		/* 211 SimilarTo <- <(<(('s' / 'S') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('l' / 'L') ('a' / 'A') ('r' / 'R') sp (('t' / 'T') ('o' / 'O')))> Action169)> */
 		func() bool {
-			position1552, tokenIndex1552 := position, tokenIndex
+			position2623, tokenIndex2623 := position, tokenIndex
 			{
-				position1553 := position
+				position2624 := position
 				{
-					position1554 := position
-					{
-						position1555, tokenIndex1555 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1556
-						}
-						position++
-						goto l1555
-					l1556:
-						position, tokenIndex = position1555, tokenIndex1555
-						if buffer[position] != rune('D') {
-							goto l1552
-						}
-						position++
-					}
-				l1555:
+					position2625 := position
 					{
-						position1557, tokenIndex1557 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1558
+						position2626, tokenIndex2626 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2627
 						}
 						position++
-						goto l1557
-					l1558:
-						position, tokenIndex = position1557, tokenIndex1557
-						if buffer[position] != rune('R') {
-							goto l1552
+						goto l2626
+					l2627:
+						position, tokenIndex = position2626, tokenIndex2626
+						if buffer[position] != rune('S') {
+							goto l2623
 						}
 						position++
 					}
-				l1557:
+				l2626:
 					{
-						position1559, tokenIndex1559 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1560
+						position2628, tokenIndex2628 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2629
 						}
 						position++
-						goto l1559
-					l1560:
-						position, tokenIndex = position1559, tokenIndex1559
-						if buffer[position] != rune('O') {
-							goto l1552
+						goto l2628
+					l2629:
+						position, tokenIndex = position2628, tokenIndex2628
+						if buffer[position] != rune('I') {
+							goto l2623
 						}
 						position++
 					}
-				l1559:
+				l2628:
 					{
-						position1561, tokenIndex1561 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1562
+						position2630, tokenIndex2630 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2631
 						}
 						position++
-						goto l1561
-					l1562:
-						position, tokenIndex = position1561, tokenIndex1561
-						if buffer[position] != rune('P') {
-							goto l1552
+						goto l2630
+					l2631:
+						position, tokenIndex = position2630, tokenIndex2630
+						if buffer[position] != rune('M') {
+							goto l2623
 						}
 						position++
 					}
-				l1561:
-					if !_rules[rulesp]() {
-						goto l1552
-					}
+				l2630:
 					{
-						position1563, tokenIndex1563 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1564
+						position2632, tokenIndex2632 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2633
 						}
 						position++
-						goto l1563
-					l1564:
-						position, tokenIndex = position1563, tokenIndex1563
-						if buffer[position] != rune('O') {
-							goto l1552
+						goto l2632
+					l2633:
+						position, tokenIndex = position2632, tokenIndex2632
+						if buffer[position] != rune('I') {
+							goto l2623
 						}
 						position++
 					}
-				l1563:
+				l2632:
 					{
-						position1565, tokenIndex1565 := position, tokenIndex
+						position2634, tokenIndex2634 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l1566
+							goto l2635
 						}
 						position++
-						goto l1565
-					l1566:
-						position, tokenIndex = position1565, tokenIndex1565
+						goto l2634
+					l2635:
+						position, tokenIndex = position2634, tokenIndex2634
 						if buffer[position] != rune('L') {
-							goto l1552
+							goto l2623
 						}
 						position++
 					}
-				l1565:
+				l2634:
 					{
-						position1567, tokenIndex1567 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1568
+						position2636, tokenIndex2636 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2637
 						}
 						position++
-						goto l1567
-					l1568:
-						position, tokenIndex = position1567, tokenIndex1567
-						if buffer[position] != rune('D') {
-							goto l1552
+						goto l2636
+					l2637:
+						position, tokenIndex = position2636, tokenIndex2636
+						if buffer[position] != rune('A') {
+							goto l2623
 						}
 						position++
 					}
-				l1567:
+				l2636:
 					{
-						position1569, tokenIndex1569 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1570
+						position2638, tokenIndex2638 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2639
 						}
 						position++
-						goto l1569
-					l1570:
-						position, tokenIndex = position1569, tokenIndex1569
-						if buffer[position] != rune('E') {
-							goto l1552
+						goto l2638
+					l2639:
+						position, tokenIndex = position2638, tokenIndex2638
+						if buffer[position] != rune('R') {
+							goto l2623
 						}
 						position++
 					}
-				l1569:
+				l2638:
+					if !_rules[rulesp]() {
+						goto l2623
+					}
 					{
-						position1571, tokenIndex1571 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1572
+						position2640, tokenIndex2640 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2641
 						}
 						position++
-						goto l1571
-					l1572:
-						position, tokenIndex = position1571, tokenIndex1571
-						if buffer[position] != rune('S') {
-							goto l1552
+						goto l2640
+					l2641:
+						position, tokenIndex = position2640, tokenIndex2640
+						if buffer[position] != rune('T') {
+							goto l2623
 						}
 						position++
 					}
-				l1571:
+				l2640:
 					{
-						position1573, tokenIndex1573 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1574
+						position2642, tokenIndex2642 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2643
 						}
 						position++
-						goto l1573
-					l1574:
-						position, tokenIndex = position1573, tokenIndex1573
-						if buffer[position] != rune('T') {
-							goto l1552
+						goto l2642
+					l2643:
+						position, tokenIndex = position2642, tokenIndex2642
+						if buffer[position] != rune('O') {
+							goto l2623
 						}
 						position++
 					}
-				l1573:
-					add(rulePegText, position1554)
+				l2642:
+					add(rulePegText, position2625)
 				}
-				if !_rules[ruleAction99]() {
-					goto l1552
+				if !_rules[ruleAction169]() {
+					goto l2623
 				}
-				add(ruleDropOldest, position1553)
+				add(ruleSimilarTo, position2624)
 			}
 			return true
-		l1552:
-			position, tokenIndex = position1552, tokenIndex1552
+		l2623:
+			position, tokenIndex = position2623, tokenIndex2623
 			return false
 		},
-		/* 130 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action100)> */
+		/* 212 NotSimilarTo <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('s' / 'S') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('l' / 'L') ('a' / 'A') ('r' / 'R')) sp (('t' / 'T') ('o' / 'O')))> Action170)> */
 		func() bool {
-			position1575, tokenIndex1575 := position, tokenIndex
+			position2644, tokenIndex2644 := position, tokenIndex
 			{
-				position1576 := position
+				position2645 := position
 				{
-					position1577 := position
+					position2646 := position
 					{
-						position1578, tokenIndex1578 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1579
+						position2647, tokenIndex2647 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2648
 						}
 						position++
-						goto l1578
-					l1579:
-						position, tokenIndex = position1578, tokenIndex1578
-						if buffer[position] != rune('D') {
-							goto l1575
+						goto l2647
+					l2648:
+						position, tokenIndex = position2647, tokenIndex2647
+						if buffer[position] != rune('N') {
+							goto l2644
 						}
 						position++
 					}
-				l1578:
+				l2647:
 					{
-						position1580, tokenIndex1580 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1581
+						position2649, tokenIndex2649 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2650
 						}
 						position++
-						goto l1580
-					l1581:
-						position, tokenIndex = position1580, tokenIndex1580
-						if buffer[position] != rune('R') {
-							goto l1575
+						goto l2649
+					l2650:
+						position, tokenIndex = position2649, tokenIndex2649
+						if buffer[position] != rune('O') {
+							goto l2644
+						}
+						position++
+					}
+				l2649:
+					{
+						position2651, tokenIndex2651 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2652
+						}
+						position++
+						goto l2651
+					l2652:
+						position, tokenIndex = position2651, tokenIndex2651
+						if buffer[position] != rune('T') {
+							goto l2644
+						}
+						position++
+					}
+				l2651:
+					if !_rules[rulesp]() {
+						goto l2644
+					}
+					{
+						position2653, tokenIndex2653 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2654
+						}
+						position++
+						goto l2653
+					l2654:
+						position, tokenIndex = position2653, tokenIndex2653
+						if buffer[position] != rune('S') {
+							goto l2644
 						}
 						position++
 					}
-				l1580:
+				l2653:
 					{
-						position1582, tokenIndex1582 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1583
+						position2655, tokenIndex2655 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2656
 						}
 						position++
-						goto l1582
-					l1583:
-						position, tokenIndex = position1582, tokenIndex1582
-						if buffer[position] != rune('O') {
-							goto l1575
+						goto l2655
+					l2656:
+						position, tokenIndex = position2655, tokenIndex2655
+						if buffer[position] != rune('I') {
+							goto l2644
 						}
 						position++
 					}
-				l1582:
+				l2655:
 					{
-						position1584, tokenIndex1584 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1585
+						position2657, tokenIndex2657 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2658
 						}
 						position++
-						goto l1584
-					l1585:
-						position, tokenIndex = position1584, tokenIndex1584
-						if buffer[position] != rune('P') {
-							goto l1575
+						goto l2657
+					l2658:
+						position, tokenIndex = position2657, tokenIndex2657
+						if buffer[position] != rune('M') {
+							goto l2644
 						}
 						position++
 					}
-				l1584:
-					if !_rules[rulesp]() {
-						goto l1575
-					}
+				l2657:
 					{
-						position1586, tokenIndex1586 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1587
+						position2659, tokenIndex2659 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2660
 						}
 						position++
-						goto l1586
-					l1587:
-						position, tokenIndex = position1586, tokenIndex1586
-						if buffer[position] != rune('N') {
-							goto l1575
+						goto l2659
+					l2660:
+						position, tokenIndex = position2659, tokenIndex2659
+						if buffer[position] != rune('I') {
+							goto l2644
 						}
 						position++
 					}
-				l1586:
+				l2659:
 					{
-						position1588, tokenIndex1588 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1589
+						position2661, tokenIndex2661 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2662
 						}
 						position++
-						goto l1588
-					l1589:
-						position, tokenIndex = position1588, tokenIndex1588
-						if buffer[position] != rune('E') {
-							goto l1575
+						goto l2661
+					l2662:
+						position, tokenIndex = position2661, tokenIndex2661
+						if buffer[position] != rune('L') {
+							goto l2644
 						}
 						position++
 					}
-				l1588:
+				l2661:
 					{
-						position1590, tokenIndex1590 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l1591
+						position2663, tokenIndex2663 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2664
 						}
 						position++
-						goto l1590
-					l1591:
-						position, tokenIndex = position1590, tokenIndex1590
-						if buffer[position] != rune('W') {
-							goto l1575
+						goto l2663
+					l2664:
+						position, tokenIndex = position2663, tokenIndex2663
+						if buffer[position] != rune('A') {
+							goto l2644
 						}
 						position++
 					}
-				l1590:
+				l2663:
 					{
-						position1592, tokenIndex1592 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1593
+						position2665, tokenIndex2665 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2666
 						}
 						position++
-						goto l1592
-					l1593:
-						position, tokenIndex = position1592, tokenIndex1592
-						if buffer[position] != rune('E') {
-							goto l1575
+						goto l2665
+					l2666:
+						position, tokenIndex = position2665, tokenIndex2665
+						if buffer[position] != rune('R') {
+							goto l2644
 						}
 						position++
 					}
-				l1592:
+				l2665:
+					if !_rules[rulesp]() {
+						goto l2644
+					}
 					{
-						position1594, tokenIndex1594 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1595
+						position2667, tokenIndex2667 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2668
 						}
 						position++
-						goto l1594
-					l1595:
-						position, tokenIndex = position1594, tokenIndex1594
-						if buffer[position] != rune('S') {
-							goto l1575
+						goto l2667
+					l2668:
+						position, tokenIndex = position2667, tokenIndex2667
+						if buffer[position] != rune('T') {
+							goto l2644
 						}
 						position++
 					}
-				l1594:
+				l2667:
 					{
-						position1596, tokenIndex1596 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1597
+						position2669, tokenIndex2669 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2670
 						}
 						position++
-						goto l1596
-					l1597:
-						position, tokenIndex = position1596, tokenIndex1596
-						if buffer[position] != rune('T') {
-							goto l1575
+						goto l2669
+					l2670:
+						position, tokenIndex = position2669, tokenIndex2669
+						if buffer[position] != rune('O') {
+							goto l2644
 						}
 						position++
 					}
-				l1596:
-					add(rulePegText, position1577)
+				l2669:
+					add(rulePegText, position2646)
 				}
-				if !_rules[ruleAction100]() {
-					goto l1575
+				if !_rules[ruleAction170]() {
+					goto l2644
 				}
-				add(ruleDropNewest, position1576)
+				add(ruleNotSimilarTo, position2645)
 			}
 			return true
-		l1575:
-			position, tokenIndex = position1575, tokenIndex1575
+		l2644:
+			position, tokenIndex = position2644, tokenIndex2644
 			return false
 		},
-		/* 131 StreamIdentifier <- <(<ident> Action101)> */
+		/* 213 RegexpMatch <- <(<'~'> Action171)> */
 		func() bool {
-			position1598, tokenIndex1598 := position, tokenIndex
+			position2671, tokenIndex2671 := position, tokenIndex
 			{
-				position1599 := position
+				position2672 := position
 				{
-					position1600 := position
-					if !_rules[ruleident]() {
-						goto l1598
+					position2673 := position
+					if buffer[position] != rune('~') {
+						goto l2671
 					}
-					add(rulePegText, position1600)
+					position++
+					add(rulePegText, position2673)
 				}
-				if !_rules[ruleAction101]() {
-					goto l1598
+				if !_rules[ruleAction171]() {
+					goto l2671
 				}
-				add(ruleStreamIdentifier, position1599)
+				add(ruleRegexpMatch, position2672)
 			}
 			return true
-		l1598:
-			position, tokenIndex = position1598, tokenIndex1598
+		l2671:
+			position, tokenIndex = position2671, tokenIndex2671
 			return false
 		},
-		/* 132 SourceSinkType <- <(<ident> Action102)> */
+		/* 214 NotRegexpMatch <- <(<('!' '~')> Action172)> */
 		func() bool {
-			position1601, tokenIndex1601 := position, tokenIndex
+			position2674, tokenIndex2674 := position, tokenIndex
 			{
-				position1602 := position
+				position2675 := position
 				{
-					position1603 := position
-					if !_rules[ruleident]() {
-						goto l1601
+					position2676 := position
+					if buffer[position] != rune('!') {
+						goto l2674
 					}
-					add(rulePegText, position1603)
-				}
-				if !_rules[ruleAction102]() {
-					goto l1601
-				}
-				add(ruleSourceSinkType, position1602)
-			}
-			return true
-		l1601:
-			position, tokenIndex = position1601, tokenIndex1601
-			return false
-		},
-		/* 133 SourceSinkParamKey <- <(<ident> Action103)> */
-		func() bool {
-			position1604, tokenIndex1604 := position, tokenIndex
-			{
-				position1605 := position
-				{
-					position1606 := position
-					if !_rules[ruleident]() {
-						goto l1604
+					position++
+					if buffer[position] != rune('~') {
+						goto l2674
 					}
-					add(rulePegText, position1606)
+					position++
+					add(rulePegText, position2676)
 				}
-				if !_rules[ruleAction103]() {
-					goto l1604
+				if !_rules[ruleAction172]() {
+					goto l2674
 				}
-				add(ruleSourceSinkParamKey, position1605)
+				add(ruleNotRegexpMatch, position2675)
 			}
 			return true
-		l1604:
-			position, tokenIndex = position1604, tokenIndex1604
+		l2674:
+			position, tokenIndex = position2674, tokenIndex2674
 			return false
 		},
-		/* 134 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action104)> */
+		/* 215 In <- <(<(('i' / 'I') ('n' / 'N'))> Action173)> */
 		func() bool {
-			position1607, tokenIndex1607 := position, tokenIndex
+			position2677, tokenIndex2677 := position, tokenIndex
 			{
-				position1608 := position
+				position2678 := position
 				{
-					position1609 := position
-					{
-						position1610, tokenIndex1610 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1611
-						}
-						position++
-						goto l1610
-					l1611:
-						position, tokenIndex = position1610, tokenIndex1610
-						if buffer[position] != rune('P') {
-							goto l1607
-						}
-						position++
-					}
-				l1610:
-					{
-						position1612, tokenIndex1612 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1613
-						}
-						position++
-						goto l1612
-					l1613:
-						position, tokenIndex = position1612, tokenIndex1612
-						if buffer[position] != rune('A') {
-							goto l1607
-						}
-						position++
-					}
-				l1612:
-					{
-						position1614, tokenIndex1614 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1615
-						}
-						position++
-						goto l1614
-					l1615:
-						position, tokenIndex = position1614, tokenIndex1614
-						if buffer[position] != rune('U') {
-							goto l1607
-						}
-						position++
-					}
-				l1614:
-					{
-						position1616, tokenIndex1616 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1617
-						}
-						position++
-						goto l1616
-					l1617:
-						position, tokenIndex = position1616, tokenIndex1616
-						if buffer[position] != rune('S') {
-							goto l1607
-						}
-						position++
-					}
-				l1616:
+					position2679 := position
 					{
-						position1618, tokenIndex1618 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1619
+						position2680, tokenIndex2680 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2681
 						}
 						position++
-						goto l1618
-					l1619:
-						position, tokenIndex = position1618, tokenIndex1618
-						if buffer[position] != rune('E') {
-							goto l1607
+						goto l2680
+					l2681:
+						position, tokenIndex = position2680, tokenIndex2680
+						if buffer[position] != rune('I') {
+							goto l2677
 						}
 						position++
 					}
-				l1618:
+				l2680:
 					{
-						position1620, tokenIndex1620 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1621
+						position2682, tokenIndex2682 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2683
 						}
 						position++
-						goto l1620
-					l1621:
-						position, tokenIndex = position1620, tokenIndex1620
-						if buffer[position] != rune('D') {
-							goto l1607
+						goto l2682
+					l2683:
+						position, tokenIndex = position2682, tokenIndex2682
+						if buffer[position] != rune('N') {
+							goto l2677
 						}
 						position++
 					}
-				l1620:
-					add(rulePegText, position1609)
+				l2682:
+					add(rulePegText, position2679)
 				}
-				if !_rules[ruleAction104]() {
-					goto l1607
+				if !_rules[ruleAction173]() {
+					goto l2677
 				}
-				add(rulePaused, position1608)
+				add(ruleIn, position2678)
 			}
 			return true
-		l1607:
-			position, tokenIndex = position1607, tokenIndex1607
+		l2677:
+			position, tokenIndex = position2677, tokenIndex2677
 			return false
 		},
-		/* 135 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action105)> */
+		/* 216 NotIn <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('n' / 'N')))> Action174)> */
 		func() bool {
-			position1622, tokenIndex1622 := position, tokenIndex
+			position2684, tokenIndex2684 := position, tokenIndex
 			{
-				position1623 := position
+				position2685 := position
 				{
-					position1624 := position
-					{
-						position1625, tokenIndex1625 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1626
-						}
-						position++
-						goto l1625
-					l1626:
-						position, tokenIndex = position1625, tokenIndex1625
-						if buffer[position] != rune('U') {
-							goto l1622
-						}
-						position++
-					}
-				l1625:
+					position2686 := position
 					{
-						position1627, tokenIndex1627 := position, tokenIndex
+						position2687, tokenIndex2687 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l1628
+							goto l2688
 						}
 						position++
-						goto l1627
-					l1628:
-						position, tokenIndex = position1627, tokenIndex1627
+						goto l2687
+					l2688:
+						position, tokenIndex = position2687, tokenIndex2687
 						if buffer[position] != rune('N') {
-							goto l1622
-						}
-						position++
-					}
-				l1627:
-					{
-						position1629, tokenIndex1629 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1630
-						}
-						position++
-						goto l1629
-					l1630:
-						position, tokenIndex = position1629, tokenIndex1629
-						if buffer[position] != rune('P') {
-							goto l1622
+							goto l2684
 						}
 						position++
 					}
-				l1629:
+				l2687:
 					{
-						position1631, tokenIndex1631 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1632
+						position2689, tokenIndex2689 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2690
 						}
 						position++
-						goto l1631
-					l1632:
-						position, tokenIndex = position1631, tokenIndex1631
-						if buffer[position] != rune('A') {
-							goto l1622
+						goto l2689
+					l2690:
+						position, tokenIndex = position2689, tokenIndex2689
+						if buffer[position] != rune('O') {
+							goto l2684
 						}
 						position++
 					}
-				l1631:
+				l2689:
 					{
-						position1633, tokenIndex1633 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1634
+						position2691, tokenIndex2691 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2692
 						}
 						position++
-						goto l1633
-					l1634:
-						position, tokenIndex = position1633, tokenIndex1633
-						if buffer[position] != rune('U') {
-							goto l1622
+						goto l2691
+					l2692:
+						position, tokenIndex = position2691, tokenIndex2691
+						if buffer[position] != rune('T') {
+							goto l2684
 						}
 						position++
 					}
-				l1633:
-					{
-						position1635, tokenIndex1635 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1636
-						}
-						position++
-						goto l1635
-					l1636:
-						position, tokenIndex = position1635, tokenIndex1635
-						if buffer[position] != rune('S') {
-							goto l1622
-						}
-						position++
+				l2691:
+					if !_rules[rulesp]() {
+						goto l2684
 					}
-				l1635:
 					{
-						position1637, tokenIndex1637 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1638
+						position2693, tokenIndex2693 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2694
 						}
 						position++
-						goto l1637
-					l1638:
-						position, tokenIndex = position1637, tokenIndex1637
-						if buffer[position] != rune('E') {
-							goto l1622
+						goto l2693
+					l2694:
+						position, tokenIndex = position2693, tokenIndex2693
+						if buffer[position] != rune('I') {
+							goto l2684
 						}
 						position++
 					}
-				l1637:
+				l2693:
 					{
-						position1639, tokenIndex1639 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1640
+						position2695, tokenIndex2695 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2696
 						}
 						position++
-						goto l1639
-					l1640:
-						position, tokenIndex = position1639, tokenIndex1639
-						if buffer[position] != rune('D') {
-							goto l1622
+						goto l2695
+					l2696:
+						position, tokenIndex = position2695, tokenIndex2695
+						if buffer[position] != rune('N') {
+							goto l2684
 						}
 						position++
 					}
-				l1639:
-					add(rulePegText, position1624)
+				l2695:
+					add(rulePegText, position2686)
 				}
-				if !_rules[ruleAction105]() {
-					goto l1622
+				if !_rules[ruleAction174]() {
+					goto l2684
 				}
-				add(ruleUnpaused, position1623)
+				add(ruleNotIn, position2685)
 			}
 			return true
-		l1622:
-			position, tokenIndex = position1622, tokenIndex1622
+		l2684:
+			position, tokenIndex = position2684, tokenIndex2684
 			return false
 		},
-		/* 136 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action106)> */
+		/* 217 Between <- <(<(('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N'))> Action175)> */
 		func() bool {
-			position1641, tokenIndex1641 := position, tokenIndex
+			position2697, tokenIndex2697 := position, tokenIndex
 			{
-				position1642 := position
+				position2698 := position
 				{
-					position1643 := position
+					position2699 := position
 					{
-						position1644, tokenIndex1644 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1645
+						position2700, tokenIndex2700 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2701
 						}
 						position++
-						goto l1644
-					l1645:
-						position, tokenIndex = position1644, tokenIndex1644
-						if buffer[position] != rune('A') {
-							goto l1641
+						goto l2700
+					l2701:
+						position, tokenIndex = position2700, tokenIndex2700
+						if buffer[position] != rune('B') {
+							goto l2697
 						}
 						position++
 					}
-				l1644:
+				l2700:
 					{
-						position1646, tokenIndex1646 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1647
+						position2702, tokenIndex2702 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2703
 						}
 						position++
-						goto l1646
-					l1647:
-						position, tokenIndex = position1646, tokenIndex1646
-						if buffer[position] != rune('S') {
-							goto l1641
+						goto l2702
+					l2703:
+						position, tokenIndex = position2702, tokenIndex2702
+						if buffer[position] != rune('E') {
+							goto l2697
 						}
 						position++
 					}
-				l1646:
+				l2702:
 					{
-						position1648, tokenIndex1648 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1649
+						position2704, tokenIndex2704 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2705
 						}
 						position++
-						goto l1648
-					l1649:
-						position, tokenIndex = position1648, tokenIndex1648
-						if buffer[position] != rune('C') {
-							goto l1641
+						goto l2704
+					l2705:
+						position, tokenIndex = position2704, tokenIndex2704
+						if buffer[position] != rune('T') {
+							goto l2697
 						}
 						position++
 					}
-				l1648:
-					add(rulePegText, position1643)
-				}
-				if !_rules[ruleAction106]() {
-					goto l1641
-				}
-				add(ruleAscending, position1642)
-			}
-			return true
-		l1641:
-			position, tokenIndex = position1641, tokenIndex1641
-			return false
-		},
-		/* 137 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action107)> */
-		func() bool {
-			position1650, tokenIndex1650 := position, tokenIndex
-			{
-				position1651 := position
-				{
-					position1652 := position
+				l2704:
 					{
-						position1653, tokenIndex1653 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1654
+						position2706, tokenIndex2706 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2707
 						}
 						position++
-						goto l1653
-					l1654:
-						position, tokenIndex = position1653, tokenIndex1653
-						if buffer[position] != rune('D') {
-							goto l1650
+						goto l2706
+					l2707:
+						position, tokenIndex = position2706, tokenIndex2706
+						if buffer[position] != rune('W') {
+							goto l2697
 						}
 						position++
 					}
-				l1653:
+				l2706:
 					{
-						position1655, tokenIndex1655 := position, tokenIndex
+						position2708, tokenIndex2708 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1656
+							goto l2709
 						}
 						position++
-						goto l1655
-					l1656:
-						position, tokenIndex = position1655, tokenIndex1655
+						goto l2708
+					l2709:
+						position, tokenIndex = position2708, tokenIndex2708
 						if buffer[position] != rune('E') {
-							goto l1650
+							goto l2697
 						}
 						position++
 					}
-				l1655:
+				l2708:
 					{
-						position1657, tokenIndex1657 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1658
+						position2710, tokenIndex2710 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2711
 						}
 						position++
-						goto l1657
-					l1658:
-						position, tokenIndex = position1657, tokenIndex1657
-						if buffer[position] != rune('S') {
-							goto l1650
+						goto l2710
+					l2711:
+						position, tokenIndex = position2710, tokenIndex2710
+						if buffer[position] != rune('E') {
+							goto l2697
 						}
 						position++
 					}
-				l1657:
+				l2710:
 					{
-						position1659, tokenIndex1659 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1660
-						}
-						position++
-						goto l1659
-					l1660:
-						position, tokenIndex = position1659, tokenIndex1659
-						if buffer[position] != rune('C') {
-							goto l1650
+						position2712, tokenIndex2712 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2713
+						}
+						position++
+						goto l2712
+					l2713:
+						position, tokenIndex = position2712, tokenIndex2712
+						if buffer[position] != rune('N') {
+							goto l2697
 						}
 						position++
 					}
-				l1659:
-					add(rulePegText, position1652)
-				}
-				if !_rules[ruleAction107]() {
-					goto l1650
-				}
-				add(ruleDescending, position1651)
-			}
-			return true
-		l1650:
-			position, tokenIndex = position1650, tokenIndex1650
-			return false
-		},
-		/* 138 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
-		func() bool {
-			position1661, tokenIndex1661 := position, tokenIndex
-			{
-				position1662 := position
-				{
-					position1663, tokenIndex1663 := position, tokenIndex
-					if !_rules[ruleBool]() {
-						goto l1664
-					}
-					goto l1663
-				l1664:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleInt]() {
-						goto l1665
-					}
-					goto l1663
-				l1665:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleFloat]() {
-						goto l1666
-					}
-					goto l1663
-				l1666:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleString]() {
-						goto l1667
-					}
-					goto l1663
-				l1667:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleBlob]() {
-						goto l1668
-					}
-					goto l1663
-				l1668:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleTimestamp]() {
-						goto l1669
-					}
-					goto l1663
-				l1669:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleArray]() {
-						goto l1670
-					}
-					goto l1663
-				l1670:
-					position, tokenIndex = position1663, tokenIndex1663
-					if !_rules[ruleMap]() {
-						goto l1661
-					}
+				l2712:
+					add(rulePegText, position2699)
+				}
+				if !_rules[ruleAction175]() {
+					goto l2697
 				}
-			l1663:
-				add(ruleType, position1662)
+				add(ruleBetween, position2698)
 			}
 			return true
-		l1661:
-			position, tokenIndex = position1661, tokenIndex1661
+		l2697:
+			position, tokenIndex = position2697, tokenIndex2697
 			return false
 		},
-		/* 139 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action108)> */
+		/* 218 NotBetween <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N')))> Action176)> */
 		func() bool {
-			position1671, tokenIndex1671 := position, tokenIndex
+			position2714, tokenIndex2714 := position, tokenIndex
 			{
-				position1672 := position
+				position2715 := position
 				{
-					position1673 := position
+					position2716 := position
 					{
-						position1674, tokenIndex1674 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1675
+						position2717, tokenIndex2717 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2718
 						}
 						position++
-						goto l1674
-					l1675:
-						position, tokenIndex = position1674, tokenIndex1674
-						if buffer[position] != rune('B') {
-							goto l1671
+						goto l2717
+					l2718:
+						position, tokenIndex = position2717, tokenIndex2717
+						if buffer[position] != rune('N') {
+							goto l2714
 						}
 						position++
 					}
-				l1674:
+				l2717:
 					{
-						position1676, tokenIndex1676 := position, tokenIndex
+						position2719, tokenIndex2719 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l1677
+							goto l2720
 						}
 						position++
-						goto l1676
-					l1677:
-						position, tokenIndex = position1676, tokenIndex1676
+						goto l2719
+					l2720:
+						position, tokenIndex = position2719, tokenIndex2719
 						if buffer[position] != rune('O') {
-							goto l1671
+							goto l2714
 						}
 						position++
 					}
-				l1676:
+				l2719:
 					{
-						position1678, tokenIndex1678 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1679
+						position2721, tokenIndex2721 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2722
 						}
 						position++
-						goto l1678
-					l1679:
-						position, tokenIndex = position1678, tokenIndex1678
-						if buffer[position] != rune('O') {
-							goto l1671
+						goto l2721
+					l2722:
+						position, tokenIndex = position2721, tokenIndex2721
+						if buffer[position] != rune('T') {
+							goto l2714
 						}
 						position++
 					}
-				l1678:
+				l2721:
+					if !_rules[rulesp]() {
+						goto l2714
+					}
 					{
-						position1680, tokenIndex1680 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1681
+						position2723, tokenIndex2723 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2724
 						}
 						position++
-						goto l1680
-					l1681:
-						position, tokenIndex = position1680, tokenIndex1680
-						if buffer[position] != rune('L') {
-							goto l1671
+						goto l2723
+					l2724:
+						position, tokenIndex = position2723, tokenIndex2723
+						if buffer[position] != rune('B') {
+							goto l2714
 						}
 						position++
 					}
-				l1680:
-					add(rulePegText, position1673)
-				}
-				if !_rules[ruleAction108]() {
-					goto l1671
-				}
-				add(ruleBool, position1672)
-			}
-			return true
-		l1671:
-			position, tokenIndex = position1671, tokenIndex1671
-			return false
-		},
-		/* 140 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action109)> */
-		func() bool {
-			position1682, tokenIndex1682 := position, tokenIndex
-			{
-				position1683 := position
-				{
-					position1684 := position
+				l2723:
 					{
-						position1685, tokenIndex1685 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1686
+						position2725, tokenIndex2725 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2726
 						}
 						position++
-						goto l1685
-					l1686:
-						position, tokenIndex = position1685, tokenIndex1685
-						if buffer[position] != rune('I') {
-							goto l1682
+						goto l2725
+					l2726:
+						position, tokenIndex = position2725, tokenIndex2725
+						if buffer[position] != rune('E') {
+							goto l2714
 						}
 						position++
 					}
-				l1685:
+				l2725:
 					{
-						position1687, tokenIndex1687 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1688
+						position2727, tokenIndex2727 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2728
 						}
 						position++
-						goto l1687
-					l1688:
-						position, tokenIndex = position1687, tokenIndex1687
-						if buffer[position] != rune('N') {
-							goto l1682
+						goto l2727
+					l2728:
+						position, tokenIndex = position2727, tokenIndex2727
+						if buffer[position] != rune('T') {
+							goto l2714
 						}
 						position++
 					}
-				l1687:
+				l2727:
 					{
-						position1689, tokenIndex1689 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1690
+						position2729, tokenIndex2729 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2730
 						}
 						position++
-						goto l1689
-					l1690:
-						position, tokenIndex = position1689, tokenIndex1689
-						if buffer[position] != rune('T') {
-							goto l1682
+						goto l2729
+					l2730:
+						position, tokenIndex = position2729, tokenIndex2729
+						if buffer[position] != rune('W') {
+							goto l2714
 						}
 						position++
 					}
-				l1689:
-					add(rulePegText, position1684)
-				}
-				if !_rules[ruleAction109]() {
-					goto l1682
-				}
-				add(ruleInt, position1683)
-			}
-			return true
-		l1682:
-			position, tokenIndex = position1682, tokenIndex1682
-			return false
-		},
-		/* 141 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action110)> */
-		func() bool {
-			position1691, tokenIndex1691 := position, tokenIndex
-			{
-				position1692 := position
-				{
-					position1693 := position
+				l2729:
 					{
-						position1694, tokenIndex1694 := position, tokenIndex
-						if buffer[position] != rune('f') {
-							goto l1695
+						position2731, tokenIndex2731 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2732
 						}
 						position++
-						goto l1694
-					l1695:
-						position, tokenIndex = position1694, tokenIndex1694
-						if buffer[position] != rune('F') {
-							goto l1691
+						goto l2731
+					l2732:
+						position, tokenIndex = position2731, tokenIndex2731
+						if buffer[position] != rune('E') {
+							goto l2714
 						}
 						position++
 					}
-				l1694:
+				l2731:
 					{
-						position1696, tokenIndex1696 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1697
+						position2733, tokenIndex2733 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2734
 						}
 						position++
-						goto l1696
-					l1697:
-						position, tokenIndex = position1696, tokenIndex1696
-						if buffer[position] != rune('L') {
-							goto l1691
+						goto l2733
+					l2734:
+						position, tokenIndex = position2733, tokenIndex2733
+						if buffer[position] != rune('E') {
+							goto l2714
 						}
 						position++
 					}
-				l1696:
+				l2733:
 					{
-						position1698, tokenIndex1698 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1699
+						position2735, tokenIndex2735 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2736
 						}
 						position++
-						goto l1698
-					l1699:
-						position, tokenIndex = position1698, tokenIndex1698
-						if buffer[position] != rune('O') {
-							goto l1691
+						goto l2735
+					l2736:
+						position, tokenIndex = position2735, tokenIndex2735
+						if buffer[position] != rune('N') {
+							goto l2714
 						}
 						position++
 					}
-				l1698:
+				l2735:
+					add(rulePegText, position2716)
+				}
+				if !_rules[ruleAction176]() {
+					goto l2714
+				}
+				add(ruleNotBetween, position2715)
+			}
+			return true
+		l2714:
+			position, tokenIndex = position2714, tokenIndex2714
+			return false
+		},
+		/* 219 Concat <- <(<('|' '|')> Action177)> */
+		func() bool {
+			position2737, tokenIndex2737 := position, tokenIndex
+			{
+				position2738 := position
+				{
+					position2739 := position
+					if buffer[position] != rune('|') {
+						goto l2737
+					}
+					position++
+					if buffer[position] != rune('|') {
+						goto l2737
+					}
+					position++
+					add(rulePegText, position2739)
+				}
+				if !_rules[ruleAction177]() {
+					goto l2737
+				}
+				add(ruleConcat, position2738)
+			}
+			return true
+		l2737:
+			position, tokenIndex = position2737, tokenIndex2737
+			return false
+		},
+		/* 220 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action178)> */
+		func() bool {
+			position2740, tokenIndex2740 := position, tokenIndex
+			{
+				position2741 := position
+				{
+					position2742 := position
 					{
-						position1700, tokenIndex1700 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1701
+						position2743, tokenIndex2743 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2744
 						}
 						position++
-						goto l1700
-					l1701:
-						position, tokenIndex = position1700, tokenIndex1700
-						if buffer[position] != rune('A') {
-							goto l1691
+						goto l2743
+					l2744:
+						position, tokenIndex = position2743, tokenIndex2743
+						if buffer[position] != rune('I') {
+							goto l2740
 						}
 						position++
 					}
-				l1700:
+				l2743:
 					{
-						position1702, tokenIndex1702 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1703
+						position2745, tokenIndex2745 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2746
 						}
 						position++
-						goto l1702
-					l1703:
-						position, tokenIndex = position1702, tokenIndex1702
-						if buffer[position] != rune('T') {
-							goto l1691
+						goto l2745
+					l2746:
+						position, tokenIndex = position2745, tokenIndex2745
+						if buffer[position] != rune('S') {
+							goto l2740
 						}
 						position++
 					}
-				l1702:
-					add(rulePegText, position1693)
+				l2745:
+					add(rulePegText, position2742)
 				}
-				if !_rules[ruleAction110]() {
-					goto l1691
+				if !_rules[ruleAction178]() {
+					goto l2740
 				}
-				add(ruleFloat, position1692)
+				add(ruleIs, position2741)
 			}
 			return true
-		l1691:
-			position, tokenIndex = position1691, tokenIndex1691
+		l2740:
+			position, tokenIndex = position2740, tokenIndex2740
 			return false
 		},
-		/* 142 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action111)> */
+		/* 221 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action179)> */
 		func() bool {
-			position1704, tokenIndex1704 := position, tokenIndex
+			position2747, tokenIndex2747 := position, tokenIndex
 			{
-				position1705 := position
+				position2748 := position
 				{
-					position1706 := position
+					position2749 := position
 					{
-						position1707, tokenIndex1707 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1708
+						position2750, tokenIndex2750 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2751
 						}
 						position++
-						goto l1707
-					l1708:
-						position, tokenIndex = position1707, tokenIndex1707
-						if buffer[position] != rune('S') {
-							goto l1704
+						goto l2750
+					l2751:
+						position, tokenIndex = position2750, tokenIndex2750
+						if buffer[position] != rune('I') {
+							goto l2747
 						}
 						position++
 					}
-				l1707:
+				l2750:
 					{
-						position1709, tokenIndex1709 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1710
+						position2752, tokenIndex2752 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2753
 						}
 						position++
-						goto l1709
-					l1710:
-						position, tokenIndex = position1709, tokenIndex1709
-						if buffer[position] != rune('T') {
-							goto l1704
+						goto l2752
+					l2753:
+						position, tokenIndex = position2752, tokenIndex2752
+						if buffer[position] != rune('S') {
+							goto l2747
 						}
 						position++
 					}
-				l1709:
+				l2752:
+					if !_rules[rulesp]() {
+						goto l2747
+					}
 					{
-						position1711, tokenIndex1711 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1712
+						position2754, tokenIndex2754 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2755
 						}
 						position++
-						goto l1711
-					l1712:
-						position, tokenIndex = position1711, tokenIndex1711
-						if buffer[position] != rune('R') {
-							goto l1704
+						goto l2754
+					l2755:
+						position, tokenIndex = position2754, tokenIndex2754
+						if buffer[position] != rune('N') {
+							goto l2747
 						}
 						position++
 					}
-				l1711:
+				l2754:
 					{
-						position1713, tokenIndex1713 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1714
+						position2756, tokenIndex2756 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2757
 						}
 						position++
-						goto l1713
-					l1714:
-						position, tokenIndex = position1713, tokenIndex1713
-						if buffer[position] != rune('I') {
-							goto l1704
+						goto l2756
+					l2757:
+						position, tokenIndex = position2756, tokenIndex2756
+						if buffer[position] != rune('O') {
+							goto l2747
 						}
 						position++
 					}
-				l1713:
+				l2756:
 					{
-						position1715, tokenIndex1715 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1716
+						position2758, tokenIndex2758 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2759
 						}
 						position++
-						goto l1715
-					l1716:
-						position, tokenIndex = position1715, tokenIndex1715
-						if buffer[position] != rune('N') {
-							goto l1704
+						goto l2758
+					l2759:
+						position, tokenIndex = position2758, tokenIndex2758
+						if buffer[position] != rune('T') {
+							goto l2747
 						}
 						position++
 					}
-				l1715:
+				l2758:
+					add(rulePegText, position2749)
+				}
+				if !_rules[ruleAction179]() {
+					goto l2747
+				}
+				add(ruleIsNot, position2748)
+			}
+			return true
+		l2747:
+			position, tokenIndex = position2747, tokenIndex2747
+			return false
+		},
+		/* 222 Plus <- <(<'+'> Action180)> */
+		func() bool {
+			position2760, tokenIndex2760 := position, tokenIndex
+			{
+				position2761 := position
+				{
+					position2762 := position
+					if buffer[position] != rune('+') {
+						goto l2760
+					}
+					position++
+					add(rulePegText, position2762)
+				}
+				if !_rules[ruleAction180]() {
+					goto l2760
+				}
+				add(rulePlus, position2761)
+			}
+			return true
+		l2760:
+			position, tokenIndex = position2760, tokenIndex2760
+			return false
+		},
+		/* 223 Minus <- <(<'-'> Action181)> */
+		func() bool {
+			position2763, tokenIndex2763 := position, tokenIndex
+			{
+				position2764 := position
+				{
+					position2765 := position
+					if buffer[position] != rune('-') {
+						goto l2763
+					}
+					position++
+					add(rulePegText, position2765)
+				}
+				if !_rules[ruleAction181]() {
+					goto l2763
+				}
+				add(ruleMinus, position2764)
+			}
+			return true
+		l2763:
+			position, tokenIndex = position2763, tokenIndex2763
+			return false
+		},
+		/* 224 Multiply <- <(<'*'> Action182)> */
+		func() bool {
+			position2766, tokenIndex2766 := position, tokenIndex
+			{
+				position2767 := position
+				{
+					position2768 := position
+					if buffer[position] != rune('*') {
+						goto l2766
+					}
+					position++
+					add(rulePegText, position2768)
+				}
+				if !_rules[ruleAction182]() {
+					goto l2766
+				}
+				add(ruleMultiply, position2767)
+			}
+			return true
+		l2766:
+			position, tokenIndex = position2766, tokenIndex2766
+			return false
+		},
+		/* 225 Divide <- <(<'/'> Action183)> */
+		func() bool {
+			position2769, tokenIndex2769 := position, tokenIndex
+			{
+				position2770 := position
+				{
+					position2771 := position
+					if buffer[position] != rune('/') {
+						goto l2769
+					}
+					position++
+					add(rulePegText, position2771)
+				}
+				if !_rules[ruleAction183]() {
+					goto l2769
+				}
+				add(ruleDivide, position2770)
+			}
+			return true
+		l2769:
+			position, tokenIndex = position2769, tokenIndex2769
+			return false
+		},
+		/* 226 Modulo <- <(<'%'> Action184)> */
+		func() bool {
+			position2772, tokenIndex2772 := position, tokenIndex
+			{
+				position2773 := position
+				{
+					position2774 := position
+					if buffer[position] != rune('%') {
+						goto l2772
+					}
+					position++
+					add(rulePegText, position2774)
+				}
+				if !_rules[ruleAction184]() {
+					goto l2772
+				}
+				add(ruleModulo, position2773)
+			}
+			return true
+		l2772:
+			position, tokenIndex = position2772, tokenIndex2772
+			return false
+		},
+		/* 227 UnaryMinus <- <(<'-'> Action185)> */
+		func() bool {
+			position2775, tokenIndex2775 := position, tokenIndex
+			{
+				position2776 := position
+				{
+					position2777 := position
+					if buffer[position] != rune('-') {
+						goto l2775
+					}
+					position++
+					add(rulePegText, position2777)
+				}
+				if !_rules[ruleAction185]() {
+					goto l2775
+				}
+				add(ruleUnaryMinus, position2776)
+			}
+			return true
+		l2775:
+			position, tokenIndex = position2775, tokenIndex2775
+			return false
+		},
+		/* 228 Identifier <- <(<ident> Action186)> */
+		func() bool {
+			position2778, tokenIndex2778 := position, tokenIndex
+			{
+				position2779 := position
+				{
+					position2780 := position
+					if !_rules[ruleident]() {
+						goto l2778
+					}
+					add(rulePegText, position2780)
+				}
+				if !_rules[ruleAction186]() {
+					goto l2778
+				}
+				add(ruleIdentifier, position2779)
+			}
+			return true
+		l2778:
+			position, tokenIndex = position2778, tokenIndex2778
+			return false
+		},
+		/* 229 TargetIdentifier <- <(<('*' / jsonSetPath)> Action187)> */
+		func() bool {
+			position2781, tokenIndex2781 := position, tokenIndex
+			{
+				position2782 := position
+				{
+					position2783 := position
 					{
-						position1717, tokenIndex1717 := position, tokenIndex
-						if buffer[position] != rune('g') {
-							goto l1718
+						position2784, tokenIndex2784 := position, tokenIndex
+						if buffer[position] != rune('*') {
+							goto l2785
 						}
 						position++
-						goto l1717
-					l1718:
-						position, tokenIndex = position1717, tokenIndex1717
-						if buffer[position] != rune('G') {
-							goto l1704
+						goto l2784
+					l2785:
+						position, tokenIndex = position2784, tokenIndex2784
+						if !_rules[rulejsonSetPath]() {
+							goto l2781
 						}
-						position++
 					}
-				l1717:
-					add(rulePegText, position1706)
+				l2784:
+					add(rulePegText, position2783)
 				}
-				if !_rules[ruleAction111]() {
-					goto l1704
+				if !_rules[ruleAction187]() {
+					goto l2781
 				}
-				add(ruleString, position1705)
+				add(ruleTargetIdentifier, position2782)
 			}
 			return true
-		l1704:
-			position, tokenIndex = position1704, tokenIndex1704
+		l2781:
+			position, tokenIndex = position2781, tokenIndex2781
 			return false
 		},
-		/* 143 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action112)> */
+		/* 230 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
 		func() bool {
-			position1719, tokenIndex1719 := position, tokenIndex
+			position2786, tokenIndex2786 := position, tokenIndex
 			{
-				position1720 := position
+				position2787 := position
 				{
-					position1721 := position
-					{
-						position1722, tokenIndex1722 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1723
-						}
-						position++
-						goto l1722
-					l1723:
-						position, tokenIndex = position1722, tokenIndex1722
-						if buffer[position] != rune('B') {
-							goto l1719
-						}
-						position++
+					position2788, tokenIndex2788 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2789
 					}
-				l1722:
-					{
-						position1724, tokenIndex1724 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1725
-						}
-						position++
-						goto l1724
-					l1725:
-						position, tokenIndex = position1724, tokenIndex1724
-						if buffer[position] != rune('L') {
-							goto l1719
-						}
-						position++
+					position++
+					goto l2788
+				l2789:
+					position, tokenIndex = position2788, tokenIndex2788
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2786
 					}
-				l1724:
+					position++
+				}
+			l2788:
+			l2790:
+				{
+					position2791, tokenIndex2791 := position, tokenIndex
 					{
-						position1726, tokenIndex1726 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1727
+						position2792, tokenIndex2792 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2793
 						}
 						position++
-						goto l1726
-					l1727:
-						position, tokenIndex = position1726, tokenIndex1726
-						if buffer[position] != rune('O') {
-							goto l1719
+						goto l2792
+					l2793:
+						position, tokenIndex = position2792, tokenIndex2792
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2794
 						}
 						position++
-					}
-				l1726:
-					{
-						position1728, tokenIndex1728 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1729
+						goto l2792
+					l2794:
+						position, tokenIndex = position2792, tokenIndex2792
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2795
 						}
 						position++
-						goto l1728
-					l1729:
-						position, tokenIndex = position1728, tokenIndex1728
-						if buffer[position] != rune('B') {
-							goto l1719
+						goto l2792
+					l2795:
+						position, tokenIndex = position2792, tokenIndex2792
+						if buffer[position] != rune('_') {
+							goto l2791
 						}
 						position++
 					}
-				l1728:
-					add(rulePegText, position1721)
+				l2792:
+					goto l2790
+				l2791:
+					position, tokenIndex = position2791, tokenIndex2791
 				}
-				if !_rules[ruleAction112]() {
-					goto l1719
+				add(ruleident, position2787)
+			}
+			return true
+		l2786:
+			position, tokenIndex = position2786, tokenIndex2786
+			return false
+		},
+		/* 231 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		func() bool {
+			position2796, tokenIndex2796 := position, tokenIndex
+			{
+				position2797 := position
+				if !_rules[rulejsonPathHead]() {
+					goto l2796
+				}
+			l2798:
+				{
+					position2799, tokenIndex2799 := position, tokenIndex
+					if !_rules[rulejsonGetPathNonHead]() {
+						goto l2799
+					}
+					goto l2798
+				l2799:
+					position, tokenIndex = position2799, tokenIndex2799
 				}
-				add(ruleBlob, position1720)
+				add(rulejsonGetPath, position2797)
 			}
 			return true
-		l1719:
-			position, tokenIndex = position1719, tokenIndex1719
+		l2796:
+			position, tokenIndex = position2796, tokenIndex2796
 			return false
 		},
-		/* 144 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action113)> */
+		/* 232 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
 		func() bool {
-			position1730, tokenIndex1730 := position, tokenIndex
+			position2800, tokenIndex2800 := position, tokenIndex
 			{
-				position1731 := position
+				position2801 := position
+				if !_rules[rulejsonPathHead]() {
+					goto l2800
+				}
+			l2802:
 				{
-					position1732 := position
-					{
-						position1733, tokenIndex1733 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1734
-						}
-						position++
-						goto l1733
-					l1734:
-						position, tokenIndex = position1733, tokenIndex1733
-						if buffer[position] != rune('T') {
-							goto l1730
-						}
-						position++
+					position2803, tokenIndex2803 := position, tokenIndex
+					if !_rules[rulejsonSetPathNonHead]() {
+						goto l2803
 					}
-				l1733:
-					{
-						position1735, tokenIndex1735 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1736
-						}
-						position++
-						goto l1735
-					l1736:
-						position, tokenIndex = position1735, tokenIndex1735
-						if buffer[position] != rune('I') {
-							goto l1730
-						}
-						position++
+					goto l2802
+				l2803:
+					position, tokenIndex = position2803, tokenIndex2803
+				}
+				add(rulejsonSetPath, position2801)
+			}
+			return true
+		l2800:
+			position, tokenIndex = position2800, tokenIndex2800
+			return false
+		},
+		/* 233 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		func() bool {
+			position2804, tokenIndex2804 := position, tokenIndex
+			{
+				position2805 := position
+				{
+					position2806, tokenIndex2806 := position, tokenIndex
+					if !_rules[rulejsonMapAccessString]() {
+						goto l2807
 					}
-				l1735:
-					{
-						position1737, tokenIndex1737 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1738
-						}
-						position++
-						goto l1737
-					l1738:
-						position, tokenIndex = position1737, tokenIndex1737
-						if buffer[position] != rune('M') {
-							goto l1730
-						}
-						position++
+					goto l2806
+				l2807:
+					position, tokenIndex = position2806, tokenIndex2806
+					if !_rules[rulejsonMapAccessBracket]() {
+						goto l2804
 					}
-				l1737:
-					{
-						position1739, tokenIndex1739 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1740
-						}
-						position++
-						goto l1739
-					l1740:
-						position, tokenIndex = position1739, tokenIndex1739
-						if buffer[position] != rune('E') {
-							goto l1730
-						}
-						position++
+				}
+			l2806:
+				add(rulejsonPathHead, position2805)
+			}
+			return true
+		l2804:
+			position, tokenIndex = position2804, tokenIndex2804
+			return false
+		},
+		/* 234 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		func() bool {
+			position2808, tokenIndex2808 := position, tokenIndex
+			{
+				position2809 := position
+				{
+					position2810, tokenIndex2810 := position, tokenIndex
+					if !_rules[rulejsonMapMultipleLevel]() {
+						goto l2811
 					}
-				l1739:
-					{
-						position1741, tokenIndex1741 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1742
-						}
-						position++
-						goto l1741
-					l1742:
-						position, tokenIndex = position1741, tokenIndex1741
-						if buffer[position] != rune('S') {
-							goto l1730
-						}
-						position++
+					goto l2810
+				l2811:
+					position, tokenIndex = position2810, tokenIndex2810
+					if !_rules[rulejsonMapSingleLevel]() {
+						goto l2812
 					}
-				l1741:
-					{
-						position1743, tokenIndex1743 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1744
-						}
-						position++
-						goto l1743
-					l1744:
-						position, tokenIndex = position1743, tokenIndex1743
-						if buffer[position] != rune('T') {
-							goto l1730
-						}
-						position++
+					goto l2810
+				l2812:
+					position, tokenIndex = position2810, tokenIndex2810
+					if !_rules[rulejsonArrayFullSlice]() {
+						goto l2813
 					}
-				l1743:
-					{
-						position1745, tokenIndex1745 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1746
-						}
-						position++
-						goto l1745
-					l1746:
-						position, tokenIndex = position1745, tokenIndex1745
-						if buffer[position] != rune('A') {
-							goto l1730
-						}
-						position++
+					goto l2810
+				l2813:
+					position, tokenIndex = position2810, tokenIndex2810
+					if !_rules[rulejsonArrayPartialSlice]() {
+						goto l2814
+					}
+					goto l2810
+				l2814:
+					position, tokenIndex = position2810, tokenIndex2810
+					if !_rules[rulejsonArraySlice]() {
+						goto l2815
+					}
+					goto l2810
+				l2815:
+					position, tokenIndex = position2810, tokenIndex2810
+					if !_rules[rulejsonArrayAccess]() {
+						goto l2808
+					}
+				}
+			l2810:
+				add(rulejsonGetPathNonHead, position2809)
+			}
+			return true
+		l2808:
+			position, tokenIndex = position2808, tokenIndex2808
+			return false
+		},
+		/* 235 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		func() bool {
+			position2816, tokenIndex2816 := position, tokenIndex
+			{
+				position2817 := position
+				{
+					position2818, tokenIndex2818 := position, tokenIndex
+					if !_rules[rulejsonMapSingleLevel]() {
+						goto l2819
+					}
+					goto l2818
+				l2819:
+					position, tokenIndex = position2818, tokenIndex2818
+					if !_rules[rulejsonNonNegativeArrayAccess]() {
+						goto l2816
+					}
+				}
+			l2818:
+				add(rulejsonSetPathNonHead, position2817)
+			}
+			return true
+		l2816:
+			position, tokenIndex = position2816, tokenIndex2816
+			return false
+		},
+		/* 236 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		func() bool {
+			position2820, tokenIndex2820 := position, tokenIndex
+			{
+				position2821 := position
+				{
+					position2822, tokenIndex2822 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l2823
+					}
+					position++
+					if !_rules[rulejsonMapAccessString]() {
+						goto l2823
+					}
+					goto l2822
+				l2823:
+					position, tokenIndex = position2822, tokenIndex2822
+					if !_rules[rulejsonMapAccessBracket]() {
+						goto l2820
+					}
+				}
+			l2822:
+				add(rulejsonMapSingleLevel, position2821)
+			}
+			return true
+		l2820:
+			position, tokenIndex = position2820, tokenIndex2820
+			return false
+		},
+		/* 237 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		func() bool {
+			position2824, tokenIndex2824 := position, tokenIndex
+			{
+				position2825 := position
+				if buffer[position] != rune('.') {
+					goto l2824
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l2824
+				}
+				position++
+				{
+					position2826, tokenIndex2826 := position, tokenIndex
+					if !_rules[rulejsonMapAccessString]() {
+						goto l2827
+					}
+					goto l2826
+				l2827:
+					position, tokenIndex = position2826, tokenIndex2826
+					if !_rules[rulejsonMapAccessBracket]() {
+						goto l2824
 					}
-				l1745:
+				}
+			l2826:
+				add(rulejsonMapMultipleLevel, position2825)
+			}
+			return true
+		l2824:
+			position, tokenIndex = position2824, tokenIndex2824
+			return false
+		},
+		/* 238 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		func() bool {
+			position2828, tokenIndex2828 := position, tokenIndex
+			{
+				position2829 := position
+				{
+					position2830 := position
 					{
-						position1747, tokenIndex1747 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1748
+						position2831, tokenIndex2831 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2832
 						}
 						position++
-						goto l1747
-					l1748:
-						position, tokenIndex = position1747, tokenIndex1747
-						if buffer[position] != rune('M') {
-							goto l1730
+						goto l2831
+					l2832:
+						position, tokenIndex = position2831, tokenIndex2831
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2828
 						}
 						position++
 					}
-				l1747:
+				l2831:
+				l2833:
 					{
-						position1749, tokenIndex1749 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1750
-						}
-						position++
-						goto l1749
-					l1750:
-						position, tokenIndex = position1749, tokenIndex1749
-						if buffer[position] != rune('P') {
-							goto l1730
+						position2834, tokenIndex2834 := position, tokenIndex
+						{
+							position2835, tokenIndex2835 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l2836
+							}
+							position++
+							goto l2835
+						l2836:
+							position, tokenIndex = position2835, tokenIndex2835
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l2837
+							}
+							position++
+							goto l2835
+						l2837:
+							position, tokenIndex = position2835, tokenIndex2835
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2838
+							}
+							position++
+							goto l2835
+						l2838:
+							position, tokenIndex = position2835, tokenIndex2835
+							if buffer[position] != rune('_') {
+								goto l2834
+							}
+							position++
 						}
-						position++
+					l2835:
+						goto l2833
+					l2834:
+						position, tokenIndex = position2834, tokenIndex2834
 					}
-				l1749:
-					add(rulePegText, position1732)
+					add(rulePegText, position2830)
 				}
-				if !_rules[ruleAction113]() {
-					goto l1730
+				add(rulejsonMapAccessString, position2829)
+			}
+			return true
+		l2828:
+			position, tokenIndex = position2828, tokenIndex2828
+			return false
+		},
+		/* 239 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		func() bool {
+			position2839, tokenIndex2839 := position, tokenIndex
+			{
+				position2840 := position
+				if buffer[position] != rune('[') {
+					goto l2839
+				}
+				position++
+				if !_rules[ruledoubleQuotedString]() {
+					goto l2839
 				}
-				add(ruleTimestamp, position1731)
+				if buffer[position] != rune(']') {
+					goto l2839
+				}
+				position++
+				add(rulejsonMapAccessBracket, position2840)
 			}
 			return true
-		l1730:
-			position, tokenIndex = position1730, tokenIndex1730
+		l2839:
+			position, tokenIndex = position2839, tokenIndex2839
 			return false
 		},
-		/* 145 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action114)> */
+		/* 240 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
 		func() bool {
-			position1751, tokenIndex1751 := position, tokenIndex
+			position2841, tokenIndex2841 := position, tokenIndex
 			{
-				position1752 := position
+				position2842 := position
+				if buffer[position] != rune('"') {
+					goto l2841
+				}
+				position++
 				{
-					position1753 := position
-					{
-						position1754, tokenIndex1754 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1755
-						}
-						position++
-						goto l1754
-					l1755:
-						position, tokenIndex = position1754, tokenIndex1754
-						if buffer[position] != rune('A') {
-							goto l1751
-						}
-						position++
-					}
-				l1754:
-					{
-						position1756, tokenIndex1756 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1757
-						}
-						position++
-						goto l1756
-					l1757:
-						position, tokenIndex = position1756, tokenIndex1756
-						if buffer[position] != rune('R') {
-							goto l1751
-						}
-						position++
-					}
-				l1756:
-					{
-						position1758, tokenIndex1758 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1759
-						}
-						position++
-						goto l1758
-					l1759:
-						position, tokenIndex = position1758, tokenIndex1758
-						if buffer[position] != rune('R') {
-							goto l1751
-						}
-						position++
-					}
-				l1758:
-					{
-						position1760, tokenIndex1760 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1761
-						}
-						position++
-						goto l1760
-					l1761:
-						position, tokenIndex = position1760, tokenIndex1760
-						if buffer[position] != rune('A') {
-							goto l1751
-						}
-						position++
-					}
-				l1760:
+					position2843 := position
+				l2844:
 					{
-						position1762, tokenIndex1762 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l1763
-						}
-						position++
-						goto l1762
-					l1763:
-						position, tokenIndex = position1762, tokenIndex1762
-						if buffer[position] != rune('Y') {
-							goto l1751
+						position2845, tokenIndex2845 := position, tokenIndex
+						{
+							position2846, tokenIndex2846 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l2847
+							}
+							position++
+							if buffer[position] != rune('"') {
+								goto l2847
+							}
+							position++
+							goto l2846
+						l2847:
+							position, tokenIndex = position2846, tokenIndex2846
+							{
+								position2848, tokenIndex2848 := position, tokenIndex
+								if buffer[position] != rune('"') {
+									goto l2848
+								}
+								position++
+								goto l2845
+							l2848:
+								position, tokenIndex = position2848, tokenIndex2848
+							}
+							if !matchDot() {
+								goto l2845
+							}
 						}
-						position++
+					l2846:
+						goto l2844
+					l2845:
+						position, tokenIndex = position2845, tokenIndex2845
 					}
-				l1762:
-					add(rulePegText, position1753)
+					add(rulePegText, position2843)
 				}
-				if !_rules[ruleAction114]() {
-					goto l1751
+				if buffer[position] != rune('"') {
+					goto l2841
 				}
-				add(ruleArray, position1752)
+				position++
+				add(ruledoubleQuotedString, position2842)
 			}
 			return true
-		l1751:
-			position, tokenIndex = position1751, tokenIndex1751
+		l2841:
+			position, tokenIndex = position2841, tokenIndex2841
 			return false
 		},
-		/* 146 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action115)> */
+		/* 241 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
 		func() bool {
-			position1764, tokenIndex1764 := position, tokenIndex
+			position2849, tokenIndex2849 := position, tokenIndex
 			{
-				position1765 := position
+				position2850 := position
+				if buffer[position] != rune('[') {
+					goto l2849
+				}
+				position++
 				{
-					position1766 := position
+					position2851 := position
 					{
-						position1767, tokenIndex1767 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1768
-						}
-						position++
-						goto l1767
-					l1768:
-						position, tokenIndex = position1767, tokenIndex1767
-						if buffer[position] != rune('M') {
-							goto l1764
+						position2852, tokenIndex2852 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l2852
 						}
 						position++
+						goto l2853
+					l2852:
+						position, tokenIndex = position2852, tokenIndex2852
 					}
-				l1767:
-					{
-						position1769, tokenIndex1769 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1770
-						}
-						position++
-						goto l1769
-					l1770:
-						position, tokenIndex = position1769, tokenIndex1769
-						if buffer[position] != rune('A') {
-							goto l1764
-						}
-						position++
+				l2853:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2849
 					}
-				l1769:
+					position++
+				l2854:
 					{
-						position1771, tokenIndex1771 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1772
-						}
-						position++
-						goto l1771
-					l1772:
-						position, tokenIndex = position1771, tokenIndex1771
-						if buffer[position] != rune('P') {
-							goto l1764
+						position2855, tokenIndex2855 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2855
 						}
 						position++
+						goto l2854
+					l2855:
+						position, tokenIndex = position2855, tokenIndex2855
 					}
-				l1771:
-					add(rulePegText, position1766)
+					add(rulePegText, position2851)
 				}
-				if !_rules[ruleAction115]() {
-					goto l1764
+				if buffer[position] != rune(']') {
+					goto l2849
 				}
-				add(ruleMap, position1765)
+				position++
+				add(rulejsonArrayAccess, position2850)
 			}
 			return true
-		l1764:
-			position, tokenIndex = position1764, tokenIndex1764
+		l2849:
+			position, tokenIndex = position2849, tokenIndex2849
 			return false
 		},
-		/* 147 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action116)> */
+		/* 242 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
 		func() bool {
-			position1773, tokenIndex1773 := position, tokenIndex
+			position2856, tokenIndex2856 := position, tokenIndex
 			{
-				position1774 := position
+				position2857 := position
+				if buffer[position] != rune('[') {
+					goto l2856
+				}
+				position++
 				{
-					position1775 := position
-					{
-						position1776, tokenIndex1776 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1777
-						}
-						position++
-						goto l1776
-					l1777:
-						position, tokenIndex = position1776, tokenIndex1776
-						if buffer[position] != rune('O') {
-							goto l1773
-						}
-						position++
+					position2858 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2856
 					}
-				l1776:
+					position++
+				l2859:
 					{
-						position1778, tokenIndex1778 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1779
-						}
-						position++
-						goto l1778
-					l1779:
-						position, tokenIndex = position1778, tokenIndex1778
-						if buffer[position] != rune('R') {
-							goto l1773
+						position2860, tokenIndex2860 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2860
 						}
 						position++
+						goto l2859
+					l2860:
+						position, tokenIndex = position2860, tokenIndex2860
 					}
-				l1778:
-					add(rulePegText, position1775)
+					add(rulePegText, position2858)
 				}
-				if !_rules[ruleAction116]() {
-					goto l1773
+				if buffer[position] != rune(']') {
+					goto l2856
 				}
-				add(ruleOr, position1774)
+				position++
+				add(rulejsonNonNegativeArrayAccess, position2857)
 			}
 			return true
-		l1773:
-			position, tokenIndex = position1773, tokenIndex1773
+		l2856:
+			position, tokenIndex = position2856, tokenIndex2856
 			return false
 		},
-		/* 148 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action117)> */
+		/* 243 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
 		func() bool {
-			position1780, tokenIndex1780 := position, tokenIndex
+			position2861, tokenIndex2861 := position, tokenIndex
 			{
-				position1781 := position
+				position2862 := position
+				if buffer[position] != rune('[') {
+					goto l2861
+				}
+				position++
 				{
-					position1782 := position
+					position2863 := position
 					{
-						position1783, tokenIndex1783 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1784
+						position2864, tokenIndex2864 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l2864
 						}
 						position++
-						goto l1783
-					l1784:
-						position, tokenIndex = position1783, tokenIndex1783
-						if buffer[position] != rune('A') {
-							goto l1780
+						goto l2865
+					l2864:
+						position, tokenIndex = position2864, tokenIndex2864
+					}
+				l2865:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2861
+					}
+					position++
+				l2866:
+					{
+						position2867, tokenIndex2867 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2867
 						}
 						position++
+						goto l2866
+					l2867:
+						position, tokenIndex = position2867, tokenIndex2867
 					}
-				l1783:
+					if buffer[position] != rune(':') {
+						goto l2861
+					}
+					position++
 					{
-						position1785, tokenIndex1785 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1786
+						position2868, tokenIndex2868 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l2868
 						}
 						position++
-						goto l1785
-					l1786:
-						position, tokenIndex = position1785, tokenIndex1785
-						if buffer[position] != rune('N') {
-							goto l1780
+						goto l2869
+					l2868:
+						position, tokenIndex = position2868, tokenIndex2868
+					}
+				l2869:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2861
+					}
+					position++
+				l2870:
+					{
+						position2871, tokenIndex2871 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2871
 						}
 						position++
+						goto l2870
+					l2871:
+						position, tokenIndex = position2871, tokenIndex2871
 					}
-				l1785:
 					{
-						position1787, tokenIndex1787 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1788
+						position2872, tokenIndex2872 := position, tokenIndex
+						if buffer[position] != rune(':') {
+							goto l2872
 						}
 						position++
-						goto l1787
-					l1788:
-						position, tokenIndex = position1787, tokenIndex1787
-						if buffer[position] != rune('D') {
-							goto l1780
+						{
+							position2874, tokenIndex2874 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l2874
+							}
+							position++
+							goto l2875
+						l2874:
+							position, tokenIndex = position2874, tokenIndex2874
+						}
+					l2875:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2872
 						}
 						position++
+					l2876:
+						{
+							position2877, tokenIndex2877 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2877
+							}
+							position++
+							goto l2876
+						l2877:
+							position, tokenIndex = position2877, tokenIndex2877
+						}
+						goto l2873
+					l2872:
+						position, tokenIndex = position2872, tokenIndex2872
 					}
-				l1787:
-					add(rulePegText, position1782)
+				l2873:
+					add(rulePegText, position2863)
 				}
-				if !_rules[ruleAction117]() {
-					goto l1780
+				if buffer[position] != rune(']') {
+					goto l2861
 				}
-				add(ruleAnd, position1781)
+				position++
+				add(rulejsonArraySlice, position2862)
 			}
 			return true
-		l1780:
-			position, tokenIndex = position1780, tokenIndex1780
+		l2861:
+			position, tokenIndex = position2861, tokenIndex2861
 			return false
 		},
-		/* 149 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action118)> */
+		/* 244 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
 		func() bool {
-			position1789, tokenIndex1789 := position, tokenIndex
+			position2878, tokenIndex2878 := position, tokenIndex
 			{
-				position1790 := position
+				position2879 := position
+				if buffer[position] != rune('[') {
+					goto l2878
+				}
+				position++
 				{
-					position1791 := position
+					position2880 := position
 					{
-						position1792, tokenIndex1792 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1793
+						position2881, tokenIndex2881 := position, tokenIndex
+						if buffer[position] != rune(':') {
+							goto l2882
 						}
 						position++
-						goto l1792
-					l1793:
-						position, tokenIndex = position1792, tokenIndex1792
-						if buffer[position] != rune('N') {
-							goto l1789
+						{
+							position2883, tokenIndex2883 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l2883
+							}
+							position++
+							goto l2884
+						l2883:
+							position, tokenIndex = position2883, tokenIndex2883
 						}
-						position++
-					}
-				l1792:
-					{
-						position1794, tokenIndex1794 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1795
+					l2884:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2882
 						}
 						position++
-						goto l1794
-					l1795:
-						position, tokenIndex = position1794, tokenIndex1794
-						if buffer[position] != rune('O') {
-							goto l1789
+					l2885:
+						{
+							position2886, tokenIndex2886 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2886
+							}
+							position++
+							goto l2885
+						l2886:
+							position, tokenIndex = position2886, tokenIndex2886
 						}
-						position++
-					}
-				l1794:
-					{
-						position1796, tokenIndex1796 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1797
+						goto l2881
+					l2882:
+						position, tokenIndex = position2881, tokenIndex2881
+						{
+							position2887, tokenIndex2887 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l2887
+							}
+							position++
+							goto l2888
+						l2887:
+							position, tokenIndex = position2887, tokenIndex2887
+						}
+					l2888:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2878
 						}
 						position++
-						goto l1796
-					l1797:
-						position, tokenIndex = position1796, tokenIndex1796
-						if buffer[position] != rune('T') {
-							goto l1789
+					l2889:
+						{
+							position2890, tokenIndex2890 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2890
+							}
+							position++
+							goto l2889
+						l2890:
+							position, tokenIndex = position2890, tokenIndex2890
+						}
+						if buffer[position] != rune(':') {
+							goto l2878
 						}
 						position++
 					}
-				l1796:
-					add(rulePegText, position1791)
+				l2881:
+					add(rulePegText, position2880)
 				}
-				if !_rules[ruleAction118]() {
-					goto l1789
+				if buffer[position] != rune(']') {
+					goto l2878
 				}
-				add(ruleNot, position1790)
+				position++
+				add(rulejsonArrayPartialSlice, position2879)
 			}
 			return true
-		l1789:
-			position, tokenIndex = position1789, tokenIndex1789
+		l2878:
+			position, tokenIndex = position2878, tokenIndex2878
 			return false
 		},
-		/* 150 Equal <- <(<'='> Action119)> */
+		/* 245 jsonArrayFullSlice <- <('[' ':' ']')> */
 		func() bool {
-			position1798, tokenIndex1798 := position, tokenIndex
+			position2891, tokenIndex2891 := position, tokenIndex
 			{
-				position1799 := position
-				{
-					position1800 := position
-					if buffer[position] != rune('=') {
-						goto l1798
-					}
-					position++
-					add(rulePegText, position1800)
+				position2892 := position
+				if buffer[position] != rune('[') {
+					goto l2891
 				}
-				if !_rules[ruleAction119]() {
-					goto l1798
+				position++
+				if buffer[position] != rune(':') {
+					goto l2891
+				}
+				position++
+				if buffer[position] != rune(']') {
+					goto l2891
 				}
-				add(ruleEqual, position1799)
+				position++
+				add(rulejsonArrayFullSlice, position2892)
 			}
 			return true
-		l1798:
-			position, tokenIndex = position1798, tokenIndex1798
+		l2891:
+			position, tokenIndex = position2891, tokenIndex2891
 			return false
 		},
-		/* 151 Less <- <(<'<'> Action120)> */
+		/* 246 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
 		func() bool {
-			position1801, tokenIndex1801 := position, tokenIndex
+			position2893, tokenIndex2893 := position, tokenIndex
 			{
-				position1802 := position
+				position2894 := position
 				{
-					position1803 := position
-					if buffer[position] != rune('<') {
-						goto l1801
+					position2895, tokenIndex2895 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l2896
 					}
 					position++
-					add(rulePegText, position1803)
-				}
-				if !_rules[ruleAction120]() {
-					goto l1801
-				}
-				add(ruleLess, position1802)
-			}
-			return true
-		l1801:
-			position, tokenIndex = position1801, tokenIndex1801
-			return false
-		},
-		/* 152 LessOrEqual <- <(<('<' '=')> Action121)> */
-		func() bool {
-			position1804, tokenIndex1804 := position, tokenIndex
-			{
-				position1805 := position
-				{
-					position1806 := position
-					if buffer[position] != rune('<') {
-						goto l1804
+					goto l2895
+				l2896:
+					position, tokenIndex = position2895, tokenIndex2895
+					if buffer[position] != rune('\t') {
+						goto l2897
 					}
 					position++
-					if buffer[position] != rune('=') {
-						goto l1804
+					goto l2895
+				l2897:
+					position, tokenIndex = position2895, tokenIndex2895
+					if buffer[position] != rune('\n') {
+						goto l2898
 					}
 					position++
-					add(rulePegText, position1806)
-				}
-				if !_rules[ruleAction121]() {
-					goto l1804
+					goto l2895
+				l2898:
+					position, tokenIndex = position2895, tokenIndex2895
+					if buffer[position] != rune('\r') {
+						goto l2899
+					}
+					position++
+					goto l2895
+				l2899:
+					position, tokenIndex = position2895, tokenIndex2895
+					if !_rules[rulecomment]() {
+						goto l2900
+					}
+					goto l2895
+				l2900:
+					position, tokenIndex = position2895, tokenIndex2895
+					if !_rules[rulefinalComment]() {
+						goto l2893
+					}
 				}
-				add(ruleLessOrEqual, position1805)
+			l2895:
+				add(rulespElem, position2894)
 			}
 			return true
-		l1804:
-			position, tokenIndex = position1804, tokenIndex1804
+		l2893:
+			position, tokenIndex = position2893, tokenIndex2893
 			return false
 		},
-		/* 153 Greater <- <(<'>'> Action122)> */
+		/* 247 sp <- <spElem+> */
 		func() bool {
-			position1807, tokenIndex1807 := position, tokenIndex
+			position2901, tokenIndex2901 := position, tokenIndex
 			{
-				position1808 := position
+				position2902 := position
+				if !_rules[rulespElem]() {
+					goto l2901
+				}
+			l2903:
 				{
-					position1809 := position
-					if buffer[position] != rune('>') {
-						goto l1807
+					position2904, tokenIndex2904 := position, tokenIndex
+					if !_rules[rulespElem]() {
+						goto l2904
 					}
-					position++
-					add(rulePegText, position1809)
-				}
-				if !_rules[ruleAction122]() {
-					goto l1807
+					goto l2903
+				l2904:
+					position, tokenIndex = position2904, tokenIndex2904
 				}
-				add(ruleGreater, position1808)
+				add(rulesp, position2902)
 			}
 			return true
-		l1807:
-			position, tokenIndex = position1807, tokenIndex1807
+		l2901:
+			position, tokenIndex = position2901, tokenIndex2901
 			return false
 		},
-		/* 154 GreaterOrEqual <- <(<('>' '=')> Action123)> */
+		/* 248 spOpt <- <spElem*> */
 		func() bool {
-			position1810, tokenIndex1810 := position, tokenIndex
 			{
-				position1811 := position
+				position2906 := position
+			l2907:
 				{
-					position1812 := position
-					if buffer[position] != rune('>') {
-						goto l1810
-					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l1810
+					position2908, tokenIndex2908 := position, tokenIndex
+					if !_rules[rulespElem]() {
+						goto l2908
 					}
-					position++
-					add(rulePegText, position1812)
-				}
-				if !_rules[ruleAction123]() {
-					goto l1810
+					goto l2907
+				l2908:
+					position, tokenIndex = position2908, tokenIndex2908
 				}
-				add(ruleGreaterOrEqual, position1811)
+				add(rulespOpt, position2906)
 			}
 			return true
-		l1810:
-			position, tokenIndex = position1810, tokenIndex1810
-			return false
 		},
-		/* 155 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action124)> */
+		/* 249 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
 		func() bool {
-			position1813, tokenIndex1813 := position, tokenIndex
+			position2909, tokenIndex2909 := position, tokenIndex
 			{
-				position1814 := position
+				position2910 := position
+				if buffer[position] != rune('-') {
+					goto l2909
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l2909
+				}
+				position++
+			l2911:
 				{
-					position1815 := position
+					position2912, tokenIndex2912 := position, tokenIndex
 					{
-						position1816, tokenIndex1816 := position, tokenIndex
-						if buffer[position] != rune('!') {
-							goto l1817
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l1817
-						}
-						position++
-						goto l1816
-					l1817:
-						position, tokenIndex = position1816, tokenIndex1816
-						if buffer[position] != rune('<') {
-							goto l1813
-						}
-						position++
-						if buffer[position] != rune('>') {
-							goto l1813
+						position2913, tokenIndex2913 := position, tokenIndex
+						{
+							position2914, tokenIndex2914 := position, tokenIndex
+							if buffer[position] != rune('\r') {
+								goto l2915
+							}
+							position++
+							goto l2914
+						l2915:
+							position, tokenIndex = position2914, tokenIndex2914
+							if buffer[position] != rune('\n') {
+								goto l2913
+							}
+							position++
 						}
-						position++
+					l2914:
+						goto l2912
+					l2913:
+						position, tokenIndex = position2913, tokenIndex2913
 					}
-				l1816:
-					add(rulePegText, position1815)
-				}
-				if !_rules[ruleAction124]() {
-					goto l1813
+					if !matchDot() {
+						goto l2912
+					}
+					goto l2911
+				l2912:
+					position, tokenIndex = position2912, tokenIndex2912
 				}
-				add(ruleNotEqual, position1814)
-			}
-			return true
-		l1813:
-			position, tokenIndex = position1813, tokenIndex1813
-			return false
-		},
-		/* 156 Concat <- <(<('|' '|')> Action125)> */
-		func() bool {
-			position1818, tokenIndex1818 := position, tokenIndex
-			{
-				position1819 := position
 				{
-					position1820 := position
-					if buffer[position] != rune('|') {
-						goto l1818
+					position2916, tokenIndex2916 := position, tokenIndex
+					if buffer[position] != rune('\r') {
+						goto l2917
 					}
 					position++
-					if buffer[position] != rune('|') {
-						goto l1818
+					goto l2916
+				l2917:
+					position, tokenIndex = position2916, tokenIndex2916
+					if buffer[position] != rune('\n') {
+						goto l2909
 					}
 					position++
-					add(rulePegText, position1820)
 				}
-				if !_rules[ruleAction125]() {
-					goto l1818
-				}
-				add(ruleConcat, position1819)
+			l2916:
+				add(rulecomment, position2910)
 			}
 			return true
-		l1818:
-			position, tokenIndex = position1818, tokenIndex1818
+		l2909:
+			position, tokenIndex = position2909, tokenIndex2909
 			return false
 		},
-		/* 157 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action126)> */
+		/* 250 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
 		func() bool {
-			position1821, tokenIndex1821 := position, tokenIndex
+			position2918, tokenIndex2918 := position, tokenIndex
 			{
-				position1822 := position
+				position2919 := position
+				if buffer[position] != rune('-') {
+					goto l2918
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l2918
+				}
+				position++
+			l2920:
 				{
-					position1823 := position
+					position2921, tokenIndex2921 := position, tokenIndex
 					{
-						position1824, tokenIndex1824 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1825
-						}
-						position++
-						goto l1824
-					l1825:
-						position, tokenIndex = position1824, tokenIndex1824
-						if buffer[position] != rune('I') {
-							goto l1821
+						position2922, tokenIndex2922 := position, tokenIndex
+						{
+							position2923, tokenIndex2923 := position, tokenIndex
+							if buffer[position] != rune('\r') {
+								goto l2924
+							}
+							position++
+							goto l2923
+						l2924:
+							position, tokenIndex = position2923, tokenIndex2923
+							if buffer[position] != rune('\n') {
+								goto l2922
+							}
+							position++
 						}
-						position++
+					l2923:
+						goto l2921
+					l2922:
+						position, tokenIndex = position2922, tokenIndex2922
 					}
-				l1824:
-					{
-						position1826, tokenIndex1826 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1827
-						}
-						position++
-						goto l1826
-					l1827:
-						position, tokenIndex = position1826, tokenIndex1826
-						if buffer[position] != rune('S') {
-							goto l1821
-						}
-						position++
+					if !matchDot() {
+						goto l2921
 					}
-				l1826:
-					add(rulePegText, position1823)
+					goto l2920
+				l2921:
+					position, tokenIndex = position2921, tokenIndex2921
 				}
-				if !_rules[ruleAction126]() {
-					goto l1821
+				{
+					position2925, tokenIndex2925 := position, tokenIndex
+					if !matchDot() {
+						goto l2925
+					}
+					goto l2918
+				l2925:
+					position, tokenIndex = position2925, tokenIndex2925
 				}
-				add(ruleIs, position1822)
+				add(rulefinalComment, position2919)
 			}
 			return true
-		l1821:
-			position, tokenIndex = position1821, tokenIndex1821
+		l2918:
+			position, tokenIndex = position2918, tokenIndex2918
 			return false
 		},
-		/* 158 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action127)> */
+		nil,
+		/* 253 Action0 <- <{
+		    p.IncludeTrailingWhitespace(begin, end)
+		}> */
 		func() bool {
-			position1828, tokenIndex1828 := position, tokenIndex
 			{
-				position1829 := position
-				{
-					position1830 := position
-					{
-						position1831, tokenIndex1831 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1832
-						}
-						position++
-						goto l1831
-					l1832:
-						position, tokenIndex = position1831, tokenIndex1831
-						if buffer[position] != rune('I') {
-							goto l1828
-						}
-						position++
-					}
-				l1831:
-					{
-						position1833, tokenIndex1833 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1834
-						}
-						position++
-						goto l1833
-					l1834:
-						position, tokenIndex = position1833, tokenIndex1833
-						if buffer[position] != rune('S') {
-							goto l1828
-						}
-						position++
-					}
-				l1833:
-					if !_rules[rulesp]() {
-						goto l1828
-					}
-					{
-						position1835, tokenIndex1835 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1836
-						}
-						position++
-						goto l1835
-					l1836:
-						position, tokenIndex = position1835, tokenIndex1835
-						if buffer[position] != rune('N') {
-							goto l1828
-						}
-						position++
-					}
-				l1835:
-					{
-						position1837, tokenIndex1837 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1838
-						}
-						position++
-						goto l1837
-					l1838:
-						position, tokenIndex = position1837, tokenIndex1837
-						if buffer[position] != rune('O') {
-							goto l1828
-						}
-						position++
-					}
-				l1837:
-					{
-						position1839, tokenIndex1839 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1840
-						}
-						position++
-						goto l1839
-					l1840:
-						position, tokenIndex = position1839, tokenIndex1839
-						if buffer[position] != rune('T') {
-							goto l1828
-						}
-						position++
-					}
-				l1839:
-					add(rulePegText, position1830)
-				}
-				if !_rules[ruleAction127]() {
-					goto l1828
-				}
-				add(ruleIsNot, position1829)
+				add(ruleAction0, position)
 			}
 			return true
-		l1828:
-			position, tokenIndex = position1828, tokenIndex1828
-			return false
 		},
-		/* 159 Plus <- <(<'+'> Action128)> */
+		/* 254 Action1 <- <{
+		    p.IncludeTrailingWhitespace(begin, end)
+		}> */
 		func() bool {
-			position1841, tokenIndex1841 := position, tokenIndex
 			{
-				position1842 := position
-				{
-					position1843 := position
-					if buffer[position] != rune('+') {
-						goto l1841
-					}
-					position++
-					add(rulePegText, position1843)
-				}
-				if !_rules[ruleAction128]() {
-					goto l1841
-				}
-				add(rulePlus, position1842)
+				add(ruleAction1, position)
 			}
 			return true
-		l1841:
-			position, tokenIndex = position1841, tokenIndex1841
-			return false
 		},
-		/* 160 Minus <- <(<'-'> Action129)> */
+		/* 255 Action2 <- <{
+		    p.AssembleSelect()
+		}> */
 		func() bool {
-			position1844, tokenIndex1844 := position, tokenIndex
 			{
-				position1845 := position
-				{
-					position1846 := position
-					if buffer[position] != rune('-') {
-						goto l1844
-					}
-					position++
-					add(rulePegText, position1846)
-				}
-				if !_rules[ruleAction129]() {
-					goto l1844
-				}
-				add(ruleMinus, position1845)
+				add(ruleAction2, position)
 			}
 			return true
-		l1844:
-			position, tokenIndex = position1844, tokenIndex1844
-			return false
 		},
-		/* 161 Multiply <- <(<'*'> Action130)> */
+		/* 256 Action3 <- <{
+		    p.AssembleSelectUnion(begin, end)
+		}> */
 		func() bool {
-			position1847, tokenIndex1847 := position, tokenIndex
 			{
-				position1848 := position
-				{
-					position1849 := position
-					if buffer[position] != rune('*') {
-						goto l1847
-					}
-					position++
-					add(rulePegText, position1849)
-				}
-				if !_rules[ruleAction130]() {
-					goto l1847
-				}
-				add(ruleMultiply, position1848)
+				add(ruleAction3, position)
 			}
 			return true
-		l1847:
-			position, tokenIndex = position1847, tokenIndex1847
-			return false
 		},
-		/* 162 Divide <- <(<'/'> Action131)> */
+		/* 257 Action4 <- <{
+		    p.AssembleCreateStreamAsSelect()
+		}> */
 		func() bool {
-			position1850, tokenIndex1850 := position, tokenIndex
 			{
-				position1851 := position
-				{
-					position1852 := position
-					if buffer[position] != rune('/') {
-						goto l1850
-					}
-					position++
-					add(rulePegText, position1852)
-				}
-				if !_rules[ruleAction131]() {
-					goto l1850
-				}
-				add(ruleDivide, position1851)
+				add(ruleAction4, position)
 			}
 			return true
-		l1850:
-			position, tokenIndex = position1850, tokenIndex1850
-			return false
 		},
-		/* 163 Modulo <- <(<'%'> Action132)> */
+		/* 258 Action5 <- <{
+		    p.AssembleCreateStreamAsSelectUnion()
+		}> */
 		func() bool {
-			position1853, tokenIndex1853 := position, tokenIndex
 			{
-				position1854 := position
-				{
-					position1855 := position
-					if buffer[position] != rune('%') {
-						goto l1853
-					}
-					position++
-					add(rulePegText, position1855)
-				}
-				if !_rules[ruleAction132]() {
-					goto l1853
-				}
-				add(ruleModulo, position1854)
+				add(ruleAction5, position)
 			}
 			return true
-		l1853:
-			position, tokenIndex = position1853, tokenIndex1853
-			return false
 		},
-		/* 164 UnaryMinus <- <(<'-'> Action133)> */
+		/* 259 Action6 <- <{
+		    p.AssembleCreateView()
+		}> */
 		func() bool {
-			position1856, tokenIndex1856 := position, tokenIndex
 			{
-				position1857 := position
-				{
-					position1858 := position
-					if buffer[position] != rune('-') {
-						goto l1856
-					}
-					position++
-					add(rulePegText, position1858)
-				}
-				if !_rules[ruleAction133]() {
-					goto l1856
-				}
-				add(ruleUnaryMinus, position1857)
+				add(ruleAction6, position)
 			}
 			return true
-		l1856:
-			position, tokenIndex = position1856, tokenIndex1856
-			return false
 		},
-		/* 165 Identifier <- <(<ident> Action134)> */
+		/* 260 Action7 <- <{
+		    p.AssembleCreateSource()
+		}> */
 		func() bool {
-			position1859, tokenIndex1859 := position, tokenIndex
 			{
-				position1860 := position
-				{
-					position1861 := position
-					if !_rules[ruleident]() {
-						goto l1859
-					}
-					add(rulePegText, position1861)
-				}
-				if !_rules[ruleAction134]() {
-					goto l1859
-				}
-				add(ruleIdentifier, position1860)
+				add(ruleAction7, position)
+			}
+			return true
+		},
+		/* 261 Action8 <- <{
+		    p.AssembleCreateSink()
+		}> */
+		func() bool {
+			{
+				add(ruleAction8, position)
+			}
+			return true
+		},
+		/* 262 Action9 <- <{
+		    p.AssembleCreateState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction9, position)
+			}
+			return true
+		},
+		/* 263 Action10 <- <{
+		    p.AssembleUpdateState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction10, position)
+			}
+			return true
+		},
+		/* 264 Action11 <- <{
+		    p.AssembleUpdateSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction11, position)
+			}
+			return true
+		},
+		/* 265 Action12 <- <{
+		    p.AssembleUpdateSink()
+		}> */
+		func() bool {
+			{
+				add(ruleAction12, position)
 			}
 			return true
-		l1859:
-			position, tokenIndex = position1859, tokenIndex1859
-			return false
 		},
-		/* 166 TargetIdentifier <- <(<('*' / jsonSetPath)> Action135)> */
+		/* 266 Action13 <- <{
+		    p.AssembleInsertIntoFrom()
+		}> */
 		func() bool {
-			position1862, tokenIndex1862 := position, tokenIndex
 			{
-				position1863 := position
-				{
-					position1864 := position
-					{
-						position1865, tokenIndex1865 := position, tokenIndex
-						if buffer[position] != rune('*') {
-							goto l1866
-						}
-						position++
-						goto l1865
-					l1866:
-						position, tokenIndex = position1865, tokenIndex1865
-						if !_rules[rulejsonSetPath]() {
-							goto l1862
-						}
-					}
-				l1865:
-					add(rulePegText, position1864)
-				}
-				if !_rules[ruleAction135]() {
-					goto l1862
-				}
-				add(ruleTargetIdentifier, position1863)
+				add(ruleAction13, position)
 			}
 			return true
-		l1862:
-			position, tokenIndex = position1862, tokenIndex1862
-			return false
 		},
-		/* 167 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		/* 267 Action14 <- <{
+		    p.AssemblePauseSource()
+		}> */
 		func() bool {
-			position1867, tokenIndex1867 := position, tokenIndex
 			{
-				position1868 := position
-				{
-					position1869, tokenIndex1869 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l1870
-					}
-					position++
-					goto l1869
-				l1870:
-					position, tokenIndex = position1869, tokenIndex1869
-					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l1867
-					}
-					position++
-				}
-			l1869:
-			l1871:
-				{
-					position1872, tokenIndex1872 := position, tokenIndex
-					{
-						position1873, tokenIndex1873 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1874
-						}
-						position++
-						goto l1873
-					l1874:
-						position, tokenIndex = position1873, tokenIndex1873
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1875
-						}
-						position++
-						goto l1873
-					l1875:
-						position, tokenIndex = position1873, tokenIndex1873
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1876
-						}
-						position++
-						goto l1873
-					l1876:
-						position, tokenIndex = position1873, tokenIndex1873
-						if buffer[position] != rune('_') {
-							goto l1872
-						}
-						position++
-					}
-				l1873:
-					goto l1871
-				l1872:
-					position, tokenIndex = position1872, tokenIndex1872
-				}
-				add(ruleident, position1868)
+				add(ruleAction14, position)
 			}
 			return true
-		l1867:
-			position, tokenIndex = position1867, tokenIndex1867
-			return false
 		},
-		/* 168 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		/* 268 Action15 <- <{
+		    p.AssembleResumeSource()
+		}> */
 		func() bool {
-			position1877, tokenIndex1877 := position, tokenIndex
 			{
-				position1878 := position
-				if !_rules[rulejsonPathHead]() {
-					goto l1877
-				}
-			l1879:
-				{
-					position1880, tokenIndex1880 := position, tokenIndex
-					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1880
-					}
-					goto l1879
-				l1880:
-					position, tokenIndex = position1880, tokenIndex1880
-				}
-				add(rulejsonGetPath, position1878)
+				add(ruleAction15, position)
 			}
 			return true
-		l1877:
-			position, tokenIndex = position1877, tokenIndex1877
-			return false
 		},
-		/* 169 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
+		/* 269 Action16 <- <{
+		    p.AssembleRewindSource()
+		}> */
 		func() bool {
-			position1881, tokenIndex1881 := position, tokenIndex
 			{
-				position1882 := position
-				if !_rules[rulejsonPathHead]() {
-					goto l1881
-				}
-			l1883:
-				{
-					position1884, tokenIndex1884 := position, tokenIndex
-					if !_rules[rulejsonSetPathNonHead]() {
-						goto l1884
-					}
-					goto l1883
-				l1884:
-					position, tokenIndex = position1884, tokenIndex1884
-				}
-				add(rulejsonSetPath, position1882)
+				add(ruleAction16, position)
 			}
 			return true
-		l1881:
-			position, tokenIndex = position1881, tokenIndex1881
-			return false
 		},
-		/* 170 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		/* 270 Action17 <- <{
+		    p.AssembleDropSource()
+		}> */
 		func() bool {
-			position1885, tokenIndex1885 := position, tokenIndex
 			{
-				position1886 := position
-				{
-					position1887, tokenIndex1887 := position, tokenIndex
-					if !_rules[rulejsonMapAccessString]() {
-						goto l1888
-					}
-					goto l1887
-				l1888:
-					position, tokenIndex = position1887, tokenIndex1887
-					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1885
-					}
-				}
-			l1887:
-				add(rulejsonPathHead, position1886)
+				add(ruleAction17, position)
 			}
 			return true
-		l1885:
-			position, tokenIndex = position1885, tokenIndex1885
-			return false
 		},
-		/* 171 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		/* 271 Action18 <- <{
+		    p.AssembleDropStream()
+		}> */
 		func() bool {
-			position1889, tokenIndex1889 := position, tokenIndex
 			{
-				position1890 := position
-				{
-					position1891, tokenIndex1891 := position, tokenIndex
-					if !_rules[rulejsonMapMultipleLevel]() {
-						goto l1892
-					}
-					goto l1891
-				l1892:
-					position, tokenIndex = position1891, tokenIndex1891
-					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1893
-					}
-					goto l1891
-				l1893:
-					position, tokenIndex = position1891, tokenIndex1891
-					if !_rules[rulejsonArrayFullSlice]() {
-						goto l1894
-					}
-					goto l1891
-				l1894:
-					position, tokenIndex = position1891, tokenIndex1891
-					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l1895
-					}
-					goto l1891
-				l1895:
-					position, tokenIndex = position1891, tokenIndex1891
-					if !_rules[rulejsonArraySlice]() {
-						goto l1896
-					}
-					goto l1891
-				l1896:
-					position, tokenIndex = position1891, tokenIndex1891
-					if !_rules[rulejsonArrayAccess]() {
-						goto l1889
-					}
-				}
-			l1891:
-				add(rulejsonGetPathNonHead, position1890)
+				add(ruleAction18, position)
 			}
 			return true
-		l1889:
-			position, tokenIndex = position1889, tokenIndex1889
-			return false
 		},
-		/* 172 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		/* 272 Action19 <- <{
+		    p.AssembleDropView()
+		}> */
 		func() bool {
-			position1897, tokenIndex1897 := position, tokenIndex
 			{
-				position1898 := position
-				{
-					position1899, tokenIndex1899 := position, tokenIndex
-					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1900
-					}
-					goto l1899
-				l1900:
-					position, tokenIndex = position1899, tokenIndex1899
-					if !_rules[rulejsonNonNegativeArrayAccess]() {
-						goto l1897
-					}
-				}
-			l1899:
-				add(rulejsonSetPathNonHead, position1898)
+				add(ruleAction19, position)
 			}
 			return true
-		l1897:
-			position, tokenIndex = position1897, tokenIndex1897
-			return false
 		},
-		/* 173 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		/* 273 Action20 <- <{
+		    p.AssembleDropSink()
+		}> */
 		func() bool {
-			position1901, tokenIndex1901 := position, tokenIndex
 			{
-				position1902 := position
-				{
-					position1903, tokenIndex1903 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l1904
-					}
-					position++
-					if !_rules[rulejsonMapAccessString]() {
-						goto l1904
-					}
-					goto l1903
-				l1904:
-					position, tokenIndex = position1903, tokenIndex1903
-					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1901
-					}
-				}
-			l1903:
-				add(rulejsonMapSingleLevel, position1902)
+				add(ruleAction20, position)
 			}
 			return true
-		l1901:
-			position, tokenIndex = position1901, tokenIndex1901
-			return false
 		},
-		/* 174 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		/* 274 Action21 <- <{
+		    p.AssembleDropState()
+		}> */
 		func() bool {
-			position1905, tokenIndex1905 := position, tokenIndex
 			{
-				position1906 := position
-				if buffer[position] != rune('.') {
-					goto l1905
-				}
-				position++
-				if buffer[position] != rune('.') {
-					goto l1905
-				}
-				position++
-				{
-					position1907, tokenIndex1907 := position, tokenIndex
-					if !_rules[rulejsonMapAccessString]() {
-						goto l1908
-					}
-					goto l1907
-				l1908:
-					position, tokenIndex = position1907, tokenIndex1907
-					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1905
-					}
-				}
-			l1907:
-				add(rulejsonMapMultipleLevel, position1906)
+				add(ruleAction21, position)
 			}
 			return true
-		l1905:
-			position, tokenIndex = position1905, tokenIndex1905
-			return false
 		},
-		/* 175 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		/* 275 Action22 <- <{
+		    p.AssembleCreateFunction(begin, end)
+		}> */
 		func() bool {
-			position1909, tokenIndex1909 := position, tokenIndex
 			{
-				position1910 := position
-				{
-					position1911 := position
-					{
-						position1912, tokenIndex1912 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1913
-						}
-						position++
-						goto l1912
-					l1913:
-						position, tokenIndex = position1912, tokenIndex1912
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1909
-						}
-						position++
-					}
-				l1912:
-				l1914:
-					{
-						position1915, tokenIndex1915 := position, tokenIndex
-						{
-							position1916, tokenIndex1916 := position, tokenIndex
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1917
-							}
-							position++
-							goto l1916
-						l1917:
-							position, tokenIndex = position1916, tokenIndex1916
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1918
-							}
-							position++
-							goto l1916
-						l1918:
-							position, tokenIndex = position1916, tokenIndex1916
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1919
-							}
-							position++
-							goto l1916
-						l1919:
-							position, tokenIndex = position1916, tokenIndex1916
-							if buffer[position] != rune('_') {
-								goto l1915
-							}
-							position++
-						}
-					l1916:
-						goto l1914
-					l1915:
-						position, tokenIndex = position1915, tokenIndex1915
-					}
-					add(rulePegText, position1911)
-				}
-				add(rulejsonMapAccessString, position1910)
+				add(ruleAction22, position)
 			}
 			return true
-		l1909:
-			position, tokenIndex = position1909, tokenIndex1909
-			return false
 		},
-		/* 176 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		/* 276 Action23 <- <{
+		    p.AssembleDropFunction()
+		}> */
 		func() bool {
-			position1920, tokenIndex1920 := position, tokenIndex
 			{
-				position1921 := position
-				if buffer[position] != rune('[') {
-					goto l1920
-				}
-				position++
-				if !_rules[ruledoubleQuotedString]() {
-					goto l1920
-				}
-				if buffer[position] != rune(']') {
-					goto l1920
-				}
-				position++
-				add(rulejsonMapAccessBracket, position1921)
+				add(ruleAction23, position)
 			}
 			return true
-		l1920:
-			position, tokenIndex = position1920, tokenIndex1920
-			return false
 		},
-		/* 177 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
+		/* 277 Action24 <- <{
+		    p.AssembleLoadState()
+		}> */
 		func() bool {
-			position1922, tokenIndex1922 := position, tokenIndex
 			{
-				position1923 := position
-				if buffer[position] != rune('"') {
-					goto l1922
-				}
-				position++
-				{
-					position1924 := position
-				l1925:
-					{
-						position1926, tokenIndex1926 := position, tokenIndex
-						{
-							position1927, tokenIndex1927 := position, tokenIndex
-							if buffer[position] != rune('"') {
-								goto l1928
-							}
-							position++
-							if buffer[position] != rune('"') {
-								goto l1928
-							}
-							position++
-							goto l1927
-						l1928:
-							position, tokenIndex = position1927, tokenIndex1927
-							{
-								position1929, tokenIndex1929 := position, tokenIndex
-								if buffer[position] != rune('"') {
-									goto l1929
-								}
-								position++
-								goto l1926
-							l1929:
-								position, tokenIndex = position1929, tokenIndex1929
-							}
-							if !matchDot() {
-								goto l1926
-							}
-						}
-					l1927:
-						goto l1925
-					l1926:
-						position, tokenIndex = position1926, tokenIndex1926
-					}
-					add(rulePegText, position1924)
-				}
-				if buffer[position] != rune('"') {
-					goto l1922
-				}
-				position++
-				add(ruledoubleQuotedString, position1923)
+				add(ruleAction24, position)
 			}
 			return true
-		l1922:
-			position, tokenIndex = position1922, tokenIndex1922
-			return false
 		},
-		/* 178 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
+		/* 278 Action25 <- <{
+		    p.AssembleLoadStateOrCreate()
+		}> */
 		func() bool {
-			position1930, tokenIndex1930 := position, tokenIndex
 			{
-				position1931 := position
-				if buffer[position] != rune('[') {
-					goto l1930
-				}
-				position++
-				{
-					position1932 := position
-					{
-						position1933, tokenIndex1933 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1933
-						}
-						position++
-						goto l1934
-					l1933:
-						position, tokenIndex = position1933, tokenIndex1933
-					}
-				l1934:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1930
-					}
-					position++
-				l1935:
-					{
-						position1936, tokenIndex1936 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1936
-						}
-						position++
-						goto l1935
-					l1936:
-						position, tokenIndex = position1936, tokenIndex1936
-					}
-					add(rulePegText, position1932)
-				}
-				if buffer[position] != rune(']') {
-					goto l1930
-				}
-				position++
-				add(rulejsonArrayAccess, position1931)
+				add(ruleAction25, position)
 			}
 			return true
-		l1930:
-			position, tokenIndex = position1930, tokenIndex1930
-			return false
 		},
-		/* 179 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
+		/* 279 Action26 <- <{
+		    p.AssembleSaveState()
+		}> */
 		func() bool {
-			position1937, tokenIndex1937 := position, tokenIndex
 			{
-				position1938 := position
-				if buffer[position] != rune('[') {
-					goto l1937
-				}
-				position++
-				{
-					position1939 := position
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1937
-					}
-					position++
-				l1940:
-					{
-						position1941, tokenIndex1941 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1941
-						}
-						position++
-						goto l1940
-					l1941:
-						position, tokenIndex = position1941, tokenIndex1941
-					}
-					add(rulePegText, position1939)
-				}
-				if buffer[position] != rune(']') {
-					goto l1937
-				}
-				position++
-				add(rulejsonNonNegativeArrayAccess, position1938)
+				add(ruleAction26, position)
 			}
 			return true
-		l1937:
-			position, tokenIndex = position1937, tokenIndex1937
-			return false
 		},
-		/* 180 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
+		/* 280 Action27 <- <{
+		    p.PushComponent(begin, end, BeginStmt{})
+		}> */
 		func() bool {
-			position1942, tokenIndex1942 := position, tokenIndex
 			{
-				position1943 := position
-				if buffer[position] != rune('[') {
-					goto l1942
-				}
-				position++
-				{
-					position1944 := position
-					{
-						position1945, tokenIndex1945 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1945
-						}
-						position++
-						goto l1946
-					l1945:
-						position, tokenIndex = position1945, tokenIndex1945
-					}
-				l1946:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1942
-					}
-					position++
-				l1947:
-					{
-						position1948, tokenIndex1948 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1948
-						}
-						position++
-						goto l1947
-					l1948:
-						position, tokenIndex = position1948, tokenIndex1948
-					}
-					if buffer[position] != rune(':') {
-						goto l1942
-					}
-					position++
-					{
-						position1949, tokenIndex1949 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1949
-						}
-						position++
-						goto l1950
-					l1949:
-						position, tokenIndex = position1949, tokenIndex1949
-					}
-				l1950:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1942
-					}
-					position++
-				l1951:
-					{
-						position1952, tokenIndex1952 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1952
-						}
-						position++
-						goto l1951
-					l1952:
-						position, tokenIndex = position1952, tokenIndex1952
-					}
-					{
-						position1953, tokenIndex1953 := position, tokenIndex
-						if buffer[position] != rune(':') {
-							goto l1953
-						}
-						position++
-						{
-							position1955, tokenIndex1955 := position, tokenIndex
-							if buffer[position] != rune('-') {
-								goto l1955
-							}
-							position++
-							goto l1956
-						l1955:
-							position, tokenIndex = position1955, tokenIndex1955
-						}
-					l1956:
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1953
-						}
-						position++
-					l1957:
-						{
-							position1958, tokenIndex1958 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1958
-							}
-							position++
-							goto l1957
-						l1958:
-							position, tokenIndex = position1958, tokenIndex1958
-						}
-						goto l1954
-					l1953:
-						position, tokenIndex = position1953, tokenIndex1953
-					}
-				l1954:
-					add(rulePegText, position1944)
-				}
-				if buffer[position] != rune(']') {
-					goto l1942
-				}
-				position++
-				add(rulejsonArraySlice, position1943)
+				add(ruleAction27, position)
 			}
 			return true
-		l1942:
-			position, tokenIndex = position1942, tokenIndex1942
-			return false
 		},
-		/* 181 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
+		/* 281 Action28 <- <{
+		    p.PushComponent(begin, end, CommitStmt{})
+		}> */
 		func() bool {
-			position1959, tokenIndex1959 := position, tokenIndex
 			{
-				position1960 := position
-				if buffer[position] != rune('[') {
-					goto l1959
-				}
-				position++
-				{
-					position1961 := position
-					{
-						position1962, tokenIndex1962 := position, tokenIndex
-						if buffer[position] != rune(':') {
-							goto l1963
-						}
-						position++
-						{
-							position1964, tokenIndex1964 := position, tokenIndex
-							if buffer[position] != rune('-') {
-								goto l1964
-							}
-							position++
-							goto l1965
-						l1964:
-							position, tokenIndex = position1964, tokenIndex1964
-						}
-					l1965:
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1963
-						}
-						position++
-					l1966:
-						{
-							position1967, tokenIndex1967 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1967
-							}
-							position++
-							goto l1966
-						l1967:
-							position, tokenIndex = position1967, tokenIndex1967
-						}
-						goto l1962
-					l1963:
-						position, tokenIndex = position1962, tokenIndex1962
-						{
-							position1968, tokenIndex1968 := position, tokenIndex
-							if buffer[position] != rune('-') {
-								goto l1968
-							}
-							position++
-							goto l1969
-						l1968:
-							position, tokenIndex = position1968, tokenIndex1968
-						}
-					l1969:
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1959
-						}
-						position++
-					l1970:
-						{
-							position1971, tokenIndex1971 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1971
-							}
-							position++
-							goto l1970
-						l1971:
-							position, tokenIndex = position1971, tokenIndex1971
-						}
-						if buffer[position] != rune(':') {
-							goto l1959
-						}
-						position++
-					}
-				l1962:
-					add(rulePegText, position1961)
-				}
-				if buffer[position] != rune(']') {
-					goto l1959
-				}
-				position++
-				add(rulejsonArrayPartialSlice, position1960)
+				add(ruleAction28, position)
 			}
 			return true
-		l1959:
-			position, tokenIndex = position1959, tokenIndex1959
-			return false
 		},
-		/* 182 jsonArrayFullSlice <- <('[' ':' ']')> */
+		/* 282 Action29 <- <{
+		    p.PushComponent(begin, end, RollbackStmt{})
+		}> */
 		func() bool {
-			position1972, tokenIndex1972 := position, tokenIndex
 			{
-				position1973 := position
-				if buffer[position] != rune('[') {
-					goto l1972
-				}
-				position++
-				if buffer[position] != rune(':') {
-					goto l1972
-				}
-				position++
-				if buffer[position] != rune(']') {
-					goto l1972
-				}
-				position++
-				add(rulejsonArrayFullSlice, position1973)
+				add(ruleAction29, position)
 			}
 			return true
-		l1972:
-			position, tokenIndex = position1972, tokenIndex1972
-			return false
 		},
-		/* 183 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
+		/* 283 Action30 <- <{
+		    p.AssembleEval(begin, end)
+		}> */
 		func() bool {
-			position1974, tokenIndex1974 := position, tokenIndex
 			{
-				position1975 := position
-				{
-					position1976, tokenIndex1976 := position, tokenIndex
-					if buffer[position] != rune(' ') {
-						goto l1977
-					}
-					position++
-					goto l1976
-				l1977:
-					position, tokenIndex = position1976, tokenIndex1976
-					if buffer[position] != rune('\t') {
-						goto l1978
-					}
-					position++
-					goto l1976
-				l1978:
-					position, tokenIndex = position1976, tokenIndex1976
-					if buffer[position] != rune('\n') {
-						goto l1979
-					}
-					position++
-					goto l1976
-				l1979:
-					position, tokenIndex = position1976, tokenIndex1976
-					if buffer[position] != rune('\r') {
-						goto l1980
-					}
-					position++
-					goto l1976
-				l1980:
-					position, tokenIndex = position1976, tokenIndex1976
-					if !_rules[rulecomment]() {
-						goto l1981
-					}
-					goto l1976
-				l1981:
-					position, tokenIndex = position1976, tokenIndex1976
-					if !_rules[rulefinalComment]() {
-						goto l1974
-					}
-				}
-			l1976:
-				add(rulespElem, position1975)
+				add(ruleAction30, position)
 			}
 			return true
-		l1974:
-			position, tokenIndex = position1974, tokenIndex1974
-			return false
 		},
-		/* 184 sp <- <spElem+> */
+		/* 284 Action31 <- <{
+		    p.AssembleExplain()
+		}> */
 		func() bool {
-			position1982, tokenIndex1982 := position, tokenIndex
 			{
-				position1983 := position
-				if !_rules[rulespElem]() {
-					goto l1982
-				}
-			l1984:
-				{
-					position1985, tokenIndex1985 := position, tokenIndex
-					if !_rules[rulespElem]() {
-						goto l1985
-					}
-					goto l1984
-				l1985:
-					position, tokenIndex = position1985, tokenIndex1985
-				}
-				add(rulesp, position1983)
+				add(ruleAction31, position)
 			}
 			return true
-		l1982:
-			position, tokenIndex = position1982, tokenIndex1982
-			return false
 		},
-		/* 185 spOpt <- <spElem*> */
+		/* 285 Action32 <- <{
+		    p.AssembleEmitter()
+		}> */
 		func() bool {
 			{
-				position1987 := position
-			l1988:
-				{
-					position1989, tokenIndex1989 := position, tokenIndex
-					if !_rules[rulespElem]() {
-						goto l1989
-					}
-					goto l1988
-				l1989:
-					position, tokenIndex = position1989, tokenIndex1989
-				}
-				add(rulespOpt, position1987)
+				add(ruleAction32, position)
 			}
 			return true
 		},
-		/* 186 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 286 Action33 <- <{
+		    p.AssembleEmitterOptions(begin, end)
+		}> */
 		func() bool {
-			position1990, tokenIndex1990 := position, tokenIndex
 			{
-				position1991 := position
-				if buffer[position] != rune('-') {
-					goto l1990
-				}
-				position++
-				if buffer[position] != rune('-') {
-					goto l1990
-				}
-				position++
-			l1992:
-				{
-					position1993, tokenIndex1993 := position, tokenIndex
-					{
-						position1994, tokenIndex1994 := position, tokenIndex
-						{
-							position1995, tokenIndex1995 := position, tokenIndex
-							if buffer[position] != rune('\r') {
-								goto l1996
-							}
-							position++
-							goto l1995
-						l1996:
-							position, tokenIndex = position1995, tokenIndex1995
-							if buffer[position] != rune('\n') {
-								goto l1994
-							}
-							position++
-						}
-					l1995:
-						goto l1993
-					l1994:
-						position, tokenIndex = position1994, tokenIndex1994
-					}
-					if !matchDot() {
-						goto l1993
-					}
-					goto l1992
-				l1993:
-					position, tokenIndex = position1993, tokenIndex1993
-				}
-				{
-					position1997, tokenIndex1997 := position, tokenIndex
-					if buffer[position] != rune('\r') {
-						goto l1998
-					}
-					position++
-					goto l1997
-				l1998:
-					position, tokenIndex = position1997, tokenIndex1997
-					if buffer[position] != rune('\n') {
-						goto l1990
-					}
-					position++
-				}
-			l1997:
-				add(rulecomment, position1991)
+				add(ruleAction33, position)
 			}
 			return true
-		l1990:
-			position, tokenIndex = position1990, tokenIndex1990
-			return false
 		},
-		/* 187 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
+		/* 287 Action34 <- <{
+		    p.AssembleEmitterLimit()
+		}> */
 		func() bool {
-			position1999, tokenIndex1999 := position, tokenIndex
 			{
-				position2000 := position
-				if buffer[position] != rune('-') {
-					goto l1999
-				}
-				position++
-				if buffer[position] != rune('-') {
-					goto l1999
-				}
-				position++
-			l2001:
-				{
-					position2002, tokenIndex2002 := position, tokenIndex
-					{
-						position2003, tokenIndex2003 := position, tokenIndex
-						{
-							position2004, tokenIndex2004 := position, tokenIndex
-							if buffer[position] != rune('\r') {
-								goto l2005
-							}
-							position++
-							goto l2004
-						l2005:
-							position, tokenIndex = position2004, tokenIndex2004
-							if buffer[position] != rune('\n') {
-								goto l2003
-							}
-							position++
-						}
-					l2004:
-						goto l2002
-					l2003:
-						position, tokenIndex = position2003, tokenIndex2003
-					}
-					if !matchDot() {
-						goto l2002
-					}
-					goto l2001
-				l2002:
-					position, tokenIndex = position2002, tokenIndex2002
-				}
-				{
-					position2006, tokenIndex2006 := position, tokenIndex
-					if !matchDot() {
-						goto l2006
-					}
-					goto l1999
-				l2006:
-					position, tokenIndex = position2006, tokenIndex2006
-				}
-				add(rulefinalComment, position2000)
+				add(ruleAction34, position)
 			}
 			return true
-		l1999:
-			position, tokenIndex = position1999, tokenIndex1999
-			return false
 		},
-		nil,
-		/* 190 Action0 <- <{
-		    p.IncludeTrailingWhitespace(begin, end)
+		/* 288 Action35 <- <{
+		    p.AssembleEmitterSampling(CountBasedSampling, 1)
 		}> */
 		func() bool {
 			{
-				add(ruleAction0, position)
+				add(ruleAction35, position)
 			}
 			return true
 		},
-		/* 191 Action1 <- <{
-		    p.IncludeTrailingWhitespace(begin, end)
+		/* 289 Action36 <- <{
+		    p.AssembleEmitterSampling(RandomizedSampling, 1)
 		}> */
 		func() bool {
 			{
-				add(ruleAction1, position)
+				add(ruleAction36, position)
 			}
 			return true
 		},
-		/* 192 Action2 <- <{
-		    p.AssembleSelect()
+		/* 290 Action37 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
 		}> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction37, position)
 			}
 			return true
 		},
-		/* 193 Action3 <- <{
-		    p.AssembleSelectUnion(begin, end)
+		/* 291 Action38 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 		}> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction38, position)
 			}
 			return true
 		},
-		/* 194 Action4 <- <{
-		    p.AssembleCreateStreamAsSelect()
+		/* 292 Action39 <- <{
+		    p.AssembleDistinct(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction39, position)
 			}
 			return true
 		},
-		/* 195 Action5 <- <{
-		    p.AssembleCreateStreamAsSelectUnion()
+		/* 293 Action40 <- <{
+		    p.AssembleProjections(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction40, position)
 			}
 			return true
 		},
-		/* 196 Action6 <- <{
-		    p.AssembleCreateSource()
+		/* 294 Action41 <- <{
+		    p.AssembleAlias()
 		}> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction41, position)
 			}
 			return true
 		},
-		/* 197 Action7 <- <{
-		    p.AssembleCreateSink()
+		/* 295 Action42 <- <{
+		    // This is *always* executed, even if there is no
+		    // FROM clause present in the statement.
+		    p.AssembleWindowedFrom(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction42, position)
 			}
 			return true
 		},
-		/* 198 Action8 <- <{
-		    p.AssembleCreateState()
+		/* 296 Action43 <- <{
+		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction43, position)
 			}
 			return true
 		},
-		/* 199 Action9 <- <{
-		    p.AssembleUpdateState()
+		/* 297 Action44 <- <{
+		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction44, position)
 			}
 			return true
 		},
-		/* 200 Action10 <- <{
-		    p.AssembleUpdateSource()
+		/* 298 Action45 <- <{
+		    p.AssembleJoin()
 		}> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction45, position)
 			}
 			return true
 		},
-		/* 201 Action11 <- <{
-		    p.AssembleUpdateSink()
+		/* 299 Action46 <- <{
+		    p.AssembleLookup()
 		}> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction46, position)
 			}
 			return true
 		},
-		/* 202 Action12 <- <{
-		    p.AssembleInsertIntoFrom()
+		/* 300 Action47 <- <{
+		    p.EnsureIdentifier(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction47, position)
 			}
 			return true
 		},
-		/* 203 Action13 <- <{
-		    p.AssemblePauseSource()
+		/* 301 Action48 <- <{
+		    p.PushComponent(begin, end, InnerJoin)
 		}> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction48, position)
 			}
 			return true
 		},
-		/* 204 Action14 <- <{
-		    p.AssembleResumeSource()
+		/* 302 Action49 <- <{
+		    p.PushComponent(begin, end, LeftOuterJoin)
 		}> */
 		func() bool {
 			{
-				add(ruleAction14, position)
+				add(ruleAction49, position)
 			}
 			return true
 		},
-		/* 205 Action15 <- <{
-		    p.AssembleRewindSource()
+		/* 303 Action50 <- <{
+		    // This is *always* executed, even if there is no
+		    // WHERE clause present in the statement.
+		    p.AssembleFilter(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction15, position)
+				add(ruleAction50, position)
 			}
 			return true
 		},
-		/* 206 Action16 <- <{
-		    p.AssembleDropSource()
+		/* 304 Action51 <- <{
+		    // This is *always* executed, even if there is no
+		    // GROUP BY clause present in the statement.
+		    p.AssembleGrouping(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction16, position)
+				add(ruleAction51, position)
 			}
 			return true
 		},
-		/* 207 Action17 <- <{
-		    p.AssembleDropStream()
+		/* 305 Action52 <- <{
+		    p.AssembleGroupingSets(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction17, position)
+				add(ruleAction52, position)
 			}
 			return true
 		},
-		/* 208 Action18 <- <{
-		    p.AssembleDropSink()
+		/* 306 Action53 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction18, position)
+				add(ruleAction53, position)
 			}
 			return true
 		},
-		/* 209 Action19 <- <{
-		    p.AssembleDropState()
+		/* 307 Action54 <- <{
+		    p.AssembleRollup(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction19, position)
+				add(ruleAction54, position)
 			}
 			return true
 		},
-		/* 210 Action20 <- <{
-		    p.AssembleLoadState()
+		/* 308 Action55 <- <{
+		    p.AssembleCube(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction20, position)
+				add(ruleAction55, position)
 			}
 			return true
 		},
-		/* 211 Action21 <- <{
-		    p.AssembleLoadStateOrCreate()
+		/* 309 Action56 <- <{
+		    // This is *always* executed, even if there is no
+		    // HAVING clause present in the statement.
+		    p.AssembleHaving(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction21, position)
+				add(ruleAction56, position)
 			}
 			return true
 		},
-		/* 212 Action22 <- <{
-		    p.AssembleSaveState()
+		/* 310 Action57 <- <{
+		    // This is *always* executed, even if there is no
+		    // ORDER BY clause present in the statement.
+		    p.AssembleOrdering(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction22, position)
+				add(ruleAction57, position)
 			}
 			return true
 		},
-		/* 213 Action23 <- <{
-		    p.AssembleEval(begin, end)
+		/* 311 Action58 <- <{
+		    // This is *always* executed, even if there is no
+		    // LIMIT clause present in the statement.
+		    p.AssembleLimit(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction23, position)
+				add(ruleAction58, position)
 			}
 			return true
 		},
-		/* 214 Action24 <- <{
-		    p.AssembleEmitter()
+		/* 312 Action59 <- <{
+		    p.EnsureAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction24, position)
+				add(ruleAction59, position)
 			}
 			return true
 		},
-		/* 215 Action25 <- <{
-		    p.AssembleEmitterOptions(begin, end)
+		/* 313 Action60 <- <{
+		    p.AssembleAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction25, position)
+				add(ruleAction60, position)
 			}
 			return true
 		},
-		/* 216 Action26 <- <{
-		    p.AssembleEmitterLimit()
+		/* 314 Action61 <- <{
+		    p.AssembleStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction26, position)
+				add(ruleAction61, position)
 			}
 			return true
 		},
-		/* 217 Action27 <- <{
-		    p.AssembleEmitterSampling(CountBasedSampling, 1)
+		/* 315 Action62 <- <{
+		    p.AssembleSessionWindowSpec()
 		}> */
 		func() bool {
 			{
-				add(ruleAction27, position)
+				add(ruleAction62, position)
 			}
 			return true
 		},
-		/* 218 Action28 <- <{
-		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		/* 316 Action63 <- <{
+		    p.AssembleSubquery(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction28, position)
+				add(ruleAction63, position)
 			}
 			return true
 		},
-		/* 219 Action29 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
+		/* 317 Action64 <- <{
+		    p.AssembleUDSFFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction29, position)
+				add(ruleAction64, position)
 			}
 			return true
 		},
-		/* 220 Action30 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		/* 318 Action65 <- <{
+		    p.EnsureSlideSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction30, position)
+				add(ruleAction65, position)
 			}
 			return true
 		},
-		/* 221 Action31 <- <{
-		    p.AssembleProjections(begin, end)
+		/* 319 Action66 <- <{
+		    p.EnsureWindowType(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction31, position)
+				add(ruleAction66, position)
 			}
 			return true
 		},
-		/* 222 Action32 <- <{
-		    p.AssembleAlias()
+		/* 320 Action67 <- <{
+		    p.EnsureLatenessSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction32, position)
+				add(ruleAction67, position)
 			}
 			return true
 		},
-		/* 223 Action33 <- <{
-		    // This is *always* executed, even if there is no
-		    // FROM clause present in the statement.
-		    p.AssembleWindowedFrom(begin, end)
+		/* 321 Action68 <- <{
+		    p.EnsureCapacitySpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction68, position)
 			}
 			return true
 		},
-		/* 224 Action34 <- <{
-		    p.AssembleInterval()
+		/* 322 Action69 <- <{
+		    p.EnsureSheddingSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction69, position)
 			}
 			return true
 		},
-		/* 225 Action35 <- <{
-		    p.AssembleInterval()
+		/* 323 Action70 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction70, position)
 			}
 			return true
 		},
-		/* 226 Action36 <- <{
-		    // This is *always* executed, even if there is no
-		    // WHERE clause present in the statement.
-		    p.AssembleFilter(begin, end)
+		/* 324 Action71 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction71, position)
 			}
 			return true
 		},
-		/* 227 Action37 <- <{
-		    // This is *always* executed, even if there is no
-		    // GROUP BY clause present in the statement.
-		    p.AssembleGrouping(begin, end)
+		/* 325 Action72 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction72, position)
 			}
 			return true
 		},
-		/* 228 Action38 <- <{
-		    // This is *always* executed, even if there is no
-		    // HAVING clause present in the statement.
-		    p.AssembleHaving(begin, end)
+		/* 326 Action73 <- <{
+		    p.EnsureIdentifier(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction73, position)
 			}
 			return true
 		},
-		/* 229 Action39 <- <{
-		    p.EnsureAliasedStreamWindow()
+		/* 327 Action74 <- <{
+		    p.AssembleSourceSinkParam()
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction74, position)
 			}
 			return true
 		},
-		/* 230 Action40 <- <{
-		    p.AssembleAliasedStreamWindow()
+		/* 328 Action75 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction75, position)
 			}
 			return true
 		},
-		/* 231 Action41 <- <{
-		    p.AssembleStreamWindow()
+		/* 329 Action76 <- <{
+		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction76, position)
 			}
 			return true
 		},
-		/* 232 Action42 <- <{
-		    p.AssembleUDSFFuncApp()
+		/* 330 Action77 <- <{
+		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction42, position)
+				add(ruleAction77, position)
 			}
 			return true
 		},
-		/* 233 Action43 <- <{
-		    p.EnsureCapacitySpec(begin, end)
+		/* 331 Action78 <- <{
+		    p.AssembleSchema(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction43, position)
+				add(ruleAction78, position)
 			}
 			return true
 		},
-		/* 234 Action44 <- <{
-		    p.EnsureSheddingSpec(begin, end)
+		/* 332 Action79 <- <{
+		    p.AssembleSchema(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction44, position)
+				add(ruleAction79, position)
 			}
 			return true
 		},
-		/* 235 Action45 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 333 Action80 <- <{
+		    p.AssembleSchemaField()
 		}> */
 		func() bool {
 			{
-				add(ruleAction45, position)
+				add(ruleAction80, position)
 			}
 			return true
 		},
-		/* 236 Action46 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 334 Action81 <- <{
+		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction46, position)
+				add(ruleAction81, position)
 			}
 			return true
 		},
-		/* 237 Action47 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 335 Action82 <- <{
+		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction47, position)
+				add(ruleAction82, position)
 			}
 			return true
 		},
-		/* 238 Action48 <- <{
-		    p.EnsureIdentifier(begin, end)
+		/* 336 Action83 <- <{
+		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction48, position)
+				add(ruleAction83, position)
 			}
 			return true
 		},
-		/* 239 Action49 <- <{
-		    p.AssembleSourceSinkParam()
+		/* 337 Action84 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction49, position)
+				add(ruleAction84, position)
 			}
 			return true
 		},
-		/* 240 Action50 <- <{
-		    p.AssembleExpressions(begin, end)
-		    p.AssembleArray()
+		/* 338 Action85 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction50, position)
+				add(ruleAction85, position)
+			}
+			return true
+		},
+		/* 339 Action86 <- <{
+		    p.AssembleUnaryPrefixOperation(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction86, position)
+			}
+			return true
+		},
+		/* 340 Action87 <- <{
+		    p.AssembleComparison(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction87, position)
 			}
 			return true
 		},
-		/* 241 Action51 <- <{
-		    p.AssembleMap(begin, end)
+		/* 341 Action88 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction51, position)
+				add(ruleAction88, position)
 			}
 			return true
 		},
-		/* 242 Action52 <- <{
-		    p.AssembleKeyValuePair()
+		/* 342 Action89 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction52, position)
+				add(ruleAction89, position)
 			}
 			return true
 		},
-		/* 243 Action53 <- <{
-		    p.EnsureKeywordPresent(begin, end)
+		/* 343 Action90 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction53, position)
+				add(ruleAction90, position)
 			}
 			return true
 		},
-		/* 244 Action54 <- <{
+		/* 344 Action91 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction54, position)
+				add(ruleAction91, position)
 			}
 			return true
 		},
-		/* 245 Action55 <- <{
+		/* 345 Action92 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction55, position)
+				add(ruleAction92, position)
 			}
 			return true
 		},
-		/* 246 Action56 <- <{
+		/* 346 Action93 <- <{
 		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction56, position)
+				add(ruleAction93, position)
 			}
 			return true
 		},
-		/* 247 Action57 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 347 Action94 <- <{
+		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction57, position)
+				add(ruleAction94, position)
 			}
 			return true
 		},
-		/* 248 Action58 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 348 Action95 <- <{
+		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction58, position)
+				add(ruleAction95, position)
 			}
 			return true
 		},
-		/* 249 Action59 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 349 Action96 <- <{
+		    p.AssembleFuncFilter()
 		}> */
 		func() bool {
 			{
-				add(ruleAction59, position)
+				add(ruleAction96, position)
 			}
 			return true
 		},
-		/* 250 Action60 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 350 Action97 <- <{
+		    // This is *always* executed, even if there is no
+		    // FILTER clause present in the function application.
+		    p.AssembleFilter(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction60, position)
+				add(ruleAction97, position)
 			}
 			return true
 		},
-		/* 251 Action61 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 351 Action98 <- <{
+		    p.AssembleFuncAppSelector()
 		}> */
 		func() bool {
 			{
-				add(ruleAction61, position)
+				add(ruleAction98, position)
 			}
 			return true
 		},
-		/* 252 Action62 <- <{
-		    p.AssembleUnaryPrefixOperation(begin, end)
+		/* 352 Action99 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewRaw(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction62, position)
+				add(ruleAction99, position)
 			}
 			return true
 		},
-		/* 253 Action63 <- <{
-		    p.AssembleTypeCast(begin, end)
+		/* 353 Action100 <- <{
+		    p.AssembleAnalyticFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction63, position)
+				add(ruleAction100, position)
 			}
 			return true
 		},
-		/* 254 Action64 <- <{
-		    p.AssembleTypeCast(begin, end)
+		/* 354 Action101 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction64, position)
+				add(ruleAction101, position)
 			}
 			return true
 		},
-		/* 255 Action65 <- <{
-		    p.AssembleFuncAppSelector()
+		/* 355 Action102 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction65, position)
+				add(ruleAction102, position)
 			}
 			return true
 		},
-		/* 256 Action66 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewRaw(substr))
+		/* 356 Action103 <- <{
+		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction66, position)
+				add(ruleAction103, position)
 			}
 			return true
 		},
-		/* 257 Action67 <- <{
+		/* 357 Action104 <- <{
+		    p.AssembleExpressions(begin, end)
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction67, position)
+				add(ruleAction104, position)
 			}
 			return true
 		},
-		/* 258 Action68 <- <{
+		/* 358 Action105 <- <{
 		    p.AssembleExpressions(begin, end)
-		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction68, position)
+				add(ruleAction105, position)
 			}
 			return true
 		},
-		/* 259 Action69 <- <{
-		    p.AssembleExpressions(begin, end)
+		/* 359 Action106 <- <{
+		    p.AssembleDistinctExpression(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction69, position)
+				add(ruleAction106, position)
 			}
 			return true
 		},
-		/* 260 Action70 <- <{
+		/* 360 Action107 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction70, position)
+				add(ruleAction107, position)
 			}
 			return true
 		},
-		/* 261 Action71 <- <{
+		/* 361 Action108 <- <{
 		    p.AssembleSortedExpression()
 		}> */
 		func() bool {
 			{
-				add(ruleAction71, position)
+				add(ruleAction108, position)
 			}
 			return true
 		},
-		/* 262 Action72 <- <{
+		/* 362 Action109 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction72, position)
+				add(ruleAction109, position)
 			}
 			return true
 		},
-		/* 263 Action73 <- <{
+		/* 363 Action110 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction73, position)
+				add(ruleAction110, position)
 			}
 			return true
 		},
-		/* 264 Action74 <- <{
+		/* 364 Action111 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction74, position)
+				add(ruleAction111, position)
 			}
 			return true
 		},
-		/* 265 Action75 <- <{
+		/* 365 Action112 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction75, position)
+				add(ruleAction112, position)
 			}
 			return true
 		},
-		/* 266 Action76 <- <{
+		/* 366 Action113 <- <{
 		    p.AssembleConditionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction76, position)
+				add(ruleAction113, position)
 			}
 			return true
 		},
-		/* 267 Action77 <- <{
+		/* 367 Action114 <- <{
 		    p.AssembleExpressionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction77, position)
+				add(ruleAction114, position)
 			}
 			return true
 		},
-		/* 268 Action78 <- <{
+		/* 368 Action115 <- <{
 		    p.AssembleWhenThenPair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction78, position)
+				add(ruleAction115, position)
 			}
 			return true
 		},
-		/* 269 Action79 <- <{
+		/* 369 Action116 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStream(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction79, position)
+				add(ruleAction116, position)
 			}
 			return true
 		},
-		/* 270 Action80 <- <{
+		/* 370 Action117 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 		}> */
 		func() bool {
 			{
-				add(ruleAction80, position)
+				add(ruleAction117, position)
 			}
 			return true
 		},
-		/* 271 Action81 <- <{
+		/* 371 Action118 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowValue(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction81, position)
+				add(ruleAction118, position)
 			}
 			return true
 		},
-		/* 272 Action82 <- <{
+		/* 372 Action119 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction82, position)
+				add(ruleAction119, position)
 			}
 			return true
 		},
-		/* 273 Action83 <- <{
+		/* 373 Action120 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction83, position)
+				add(ruleAction120, position)
 			}
 			return true
 		},
-		/* 274 Action84 <- <{
+		/* 374 Action121 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewFloatLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction84, position)
+				add(ruleAction121, position)
 			}
 			return true
 		},
-		/* 275 Action85 <- <{
+		/* 375 Action122 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, FuncName(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction85, position)
+				add(ruleAction122, position)
+			}
+			return true
+		},
+		/* 376 Action123 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.AssemblePlaceholder(begin, end, substr[1:])
+		}> */
+		func() bool {
+			{
+				add(ruleAction123, position)
 			}
 			return true
 		},
-		/* 276 Action86 <- <{
+		/* 377 Action124 <- <{
 		    p.PushComponent(begin, end, NewNullLiteral())
 		}> */
 		func() bool {
 			{
-				add(ruleAction86, position)
+				add(ruleAction124, position)
 			}
 			return true
 		},
-		/* 277 Action87 <- <{
+		/* 378 Action125 <- <{
 		    p.PushComponent(begin, end, NewMissing())
 		}> */
 		func() bool {
 			{
-				add(ruleAction87, position)
+				add(ruleAction125, position)
 			}
 			return true
 		},
-		/* 278 Action88 <- <{
+		/* 379 Action126 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(true))
 		}> */
 		func() bool {
 			{
-				add(ruleAction88, position)
+				add(ruleAction126, position)
 			}
 			return true
 		},
-		/* 279 Action89 <- <{
+		/* 380 Action127 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(false))
 		}> */
 		func() bool {
 			{
-				add(ruleAction89, position)
+				add(ruleAction127, position)
 			}
 			return true
 		},
-		/* 280 Action90 <- <{
+		/* 381 Action128 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewWildcard(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction90, position)
+				add(ruleAction128, position)
 			}
 			return true
 		},
-		/* 281 Action91 <- <{
+		/* 382 Action129 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStringLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction91, position)
+				add(ruleAction129, position)
 			}
 			return true
 		},
-		/* 282 Action92 <- <{
+		/* 383 Action130 <- <{
 		    p.PushComponent(begin, end, Istream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction92, position)
+				add(ruleAction130, position)
 			}
 			return true
 		},
-		/* 283 Action93 <- <{
+		/* 384 Action131 <- <{
 		    p.PushComponent(begin, end, Dstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction93, position)
+				add(ruleAction131, position)
 			}
 			return true
 		},
-		/* 284 Action94 <- <{
+		/* 385 Action132 <- <{
 		    p.PushComponent(begin, end, Rstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction94, position)
+				add(ruleAction132, position)
 			}
 			return true
 		},
-		/* 285 Action95 <- <{
+		/* 386 Action133 <- <{
 		    p.PushComponent(begin, end, Tuples)
 		}> */
 		func() bool {
 			{
-				add(ruleAction95, position)
+				add(ruleAction133, position)
+			}
+			return true
+		},
+		/* 387 Action134 <- <{
+		    p.PushComponent(begin, end, Minutes)
+		}> */
+		func() bool {
+			{
+				add(ruleAction134, position)
 			}
 			return true
 		},
-		/* 286 Action96 <- <{
+		/* 388 Action135 <- <{
 		    p.PushComponent(begin, end, Seconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction96, position)
+				add(ruleAction135, position)
 			}
 			return true
 		},
-		/* 287 Action97 <- <{
+		/* 389 Action136 <- <{
 		    p.PushComponent(begin, end, Milliseconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction97, position)
+				add(ruleAction136, position)
+			}
+			return true
+		},
+		/* 390 Action137 <- <{
+		    p.PushComponent(begin, end, TumblingWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction137, position)
 			}
 			return true
 		},
-		/* 288 Action98 <- <{
+		/* 391 Action138 <- <{
 		    p.PushComponent(begin, end, Wait)
 		}> */
 		func() bool {
 			{
-				add(ruleAction98, position)
+				add(ruleAction138, position)
 			}
 			return true
 		},
-		/* 289 Action99 <- <{
+		/* 392 Action139 <- <{
 		    p.PushComponent(begin, end, DropOldest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction99, position)
+				add(ruleAction139, position)
 			}
 			return true
 		},
-		/* 290 Action100 <- <{
+		/* 393 Action140 <- <{
 		    p.PushComponent(begin, end, DropNewest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction100, position)
+				add(ruleAction140, position)
 			}
 			return true
 		},
-		/* 291 Action101 <- <{
+		/* 394 Action141 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, StreamIdentifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction101, position)
+				add(ruleAction141, position)
 			}
 			return true
 		},
-		/* 292 Action102 <- <{
+		/* 395 Action142 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkType(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction102, position)
+				add(ruleAction142, position)
 			}
 			return true
 		},
-		/* 293 Action103 <- <{
+		/* 396 Action143 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkParamKey(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction103, position)
+				add(ruleAction143, position)
 			}
 			return true
 		},
-		/* 294 Action104 <- <{
+		/* 397 Action144 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction104, position)
+				add(ruleAction144, position)
 			}
 			return true
 		},
-		/* 295 Action105 <- <{
+		/* 398 Action145 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction105, position)
+				add(ruleAction145, position)
 			}
 			return true
 		},
-		/* 296 Action106 <- <{
+		/* 399 Action146 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction106, position)
+				add(ruleAction146, position)
 			}
 			return true
 		},
-		/* 297 Action107 <- <{
+		/* 400 Action147 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction107, position)
+				add(ruleAction147, position)
 			}
 			return true
 		},
-		/* 298 Action108 <- <{
+		/* 401 Action148 <- <{
 		    p.PushComponent(begin, end, Bool)
 		}> */
 		func() bool {
 			{
-				add(ruleAction108, position)
+				add(ruleAction148, position)
 			}
 			return true
 		},
-		/* 299 Action109 <- <{
+		/* 402 Action149 <- <{
 		    p.PushComponent(begin, end, Int)
 		}> */
 		func() bool {
 			{
-				add(ruleAction109, position)
+				add(ruleAction149, position)
 			}
 			return true
 		},
-		/* 300 Action110 <- <{
+		/* 403 Action150 <- <{
 		    p.PushComponent(begin, end, Float)
 		}> */
 		func() bool {
 			{
-				add(ruleAction110, position)
+				add(ruleAction150, position)
 			}
 			return true
 		},
-		/* 301 Action111 <- <{
+		/* 404 Action151 <- <{
 		    p.PushComponent(begin, end, String)
 		}> */
 		func() bool {
 			{
-				add(ruleAction111, position)
+				add(ruleAction151, position)
 			}
 			return true
 		},
-		/* 302 Action112 <- <{
+		/* 405 Action152 <- <{
 		    p.PushComponent(begin, end, Blob)
 		}> */
 		func() bool {
 			{
-				add(ruleAction112, position)
+				add(ruleAction152, position)
 			}
 			return true
 		},
-		/* 303 Action113 <- <{
+		/* 406 Action153 <- <{
 		    p.PushComponent(begin, end, Timestamp)
 		}> */
 		func() bool {
 			{
-				add(ruleAction113, position)
+				add(ruleAction153, position)
 			}
 			return true
 		},
-		/* 304 Action114 <- <{
+		/* 407 Action154 <- <{
 		    p.PushComponent(begin, end, Array)
 		}> */
 		func() bool {
 			{
-				add(ruleAction114, position)
+				add(ruleAction154, position)
 			}
 			return true
 		},
-		/* 305 Action115 <- <{
+		/* 408 Action155 <- <{
 		    p.PushComponent(begin, end, Map)
 		}> */
 		func() bool {
 			{
-				add(ruleAction115, position)
+				add(ruleAction155, position)
 			}
 			return true
 		},
-		/* 306 Action116 <- <{
+		/* 409 Action156 <- <{
 		    p.PushComponent(begin, end, Or)
 		}> */
 		func() bool {
 			{
-				add(ruleAction116, position)
+				add(ruleAction156, position)
 			}
 			return true
 		},
-		/* 307 Action117 <- <{
+		/* 410 Action157 <- <{
 		    p.PushComponent(begin, end, And)
 		}> */
 		func() bool {
 			{
-				add(ruleAction117, position)
+				add(ruleAction157, position)
 			}
 			return true
 		},
-		/* 308 Action118 <- <{
+		/* 411 Action158 <- <{
 		    p.PushComponent(begin, end, Not)
 		}> */
 		func() bool {
 			{
-				add(ruleAction118, position)
+				add(ruleAction158, position)
 			}
 			return true
 		},
-		/* 309 Action119 <- <{
+		/* 412 Action159 <- <{
 		    p.PushComponent(begin, end, Equal)
 		}> */
 		func() bool {
 			{
-				add(ruleAction119, position)
+				add(ruleAction159, position)
 			}
 			return true
 		},
-		/* 310 Action120 <- <{
+		/* 413 Action160 <- <{
 		    p.PushComponent(begin, end, Less)
 		}> */
 		func() bool {
 			{
-				add(ruleAction120, position)
+				add(ruleAction160, position)
 			}
 			return true
 		},
-		/* 311 Action121 <- <{
+		/* 414 Action161 <- <{
 		    p.PushComponent(begin, end, LessOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction121, position)
+				add(ruleAction161, position)
 			}
 			return true
 		},
-		/* 312 Action122 <- <{
+		/* 415 Action162 <- <{
 		    p.PushComponent(begin, end, Greater)
 		}> */
 		func() bool {
 			{
-				add(ruleAction122, position)
+				add(ruleAction162, position)
 			}
 			return true
 		},
-		/* 313 Action123 <- <{
+		/* 416 Action163 <- <{
 		    p.PushComponent(begin, end, GreaterOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction123, position)
+				add(ruleAction163, position)
 			}
 			return true
 		},
-		/* 314 Action124 <- <{
+		/* 417 Action164 <- <{
 		    p.PushComponent(begin, end, NotEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction124, position)
+				add(ruleAction164, position)
+			}
+			return true
+		},
+		/* 418 Action165 <- <{
+		    p.PushComponent(begin, end, Like)
+		}> */
+		func() bool {
+			{
+				add(ruleAction165, position)
+			}
+			return true
+		},
+		/* 419 Action166 <- <{
+		    p.PushComponent(begin, end, NotLike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction166, position)
+			}
+			return true
+		},
+		/* 420 Action167 <- <{
+		    p.PushComponent(begin, end, ILike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction167, position)
+			}
+			return true
+		},
+		/* 421 Action168 <- <{
+		    p.PushComponent(begin, end, NotILike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction168, position)
+			}
+			return true
+		},
+		/* 422 Action169 <- <{
+		    p.PushComponent(begin, end, SimilarTo)
+		}> */
+		func() bool {
+			{
+				add(ruleAction169, position)
+			}
+			return true
+		},
+		/* 423 Action170 <- <{
+		    p.PushComponent(begin, end, NotSimilarTo)
+		}> */
+		func() bool {
+			{
+				add(ruleAction170, position)
+			}
+			return true
+		},
+		/* 424 Action171 <- <{
+		    p.PushComponent(begin, end, RegexpMatch)
+		}> */
+		func() bool {
+			{
+				add(ruleAction171, position)
+			}
+			return true
+		},
+		/* 425 Action172 <- <{
+		    p.PushComponent(begin, end, NotRegexpMatch)
+		}> */
+		func() bool {
+			{
+				add(ruleAction172, position)
+			}
+			return true
+		},
+		/* 426 Action173 <- <{
+		    p.PushComponent(begin, end, In)
+		}> */
+		func() bool {
+			{
+				add(ruleAction173, position)
+			}
+			return true
+		},
+		/* 427 Action174 <- <{
+		    p.PushComponent(begin, end, NotIn)
+		}> */
+		func() bool {
+			{
+				add(ruleAction174, position)
+			}
+			return true
+		},
+		/* 428 Action175 <- <{
+		    p.PushComponent(begin, end, Between)
+		}> */
+		func() bool {
+			{
+				add(ruleAction175, position)
+			}
+			return true
+		},
+		/* 429 Action176 <- <{
+		    p.PushComponent(begin, end, NotBetween)
+		}> */
+		func() bool {
+			{
+				add(ruleAction176, position)
 			}
 			return true
 		},
-		/* 315 Action125 <- <{
+		/* 430 Action177 <- <{
 		    p.PushComponent(begin, end, Concat)
 		}> */
 		func() bool {
 			{
-				add(ruleAction125, position)
+				add(ruleAction177, position)
 			}
 			return true
 		},
-		/* 316 Action126 <- <{
+		/* 431 Action178 <- <{
 		    p.PushComponent(begin, end, Is)
 		}> */
 		func() bool {
 			{
-				add(ruleAction126, position)
+				add(ruleAction178, position)
 			}
 			return true
 		},
-		/* 317 Action127 <- <{
+		/* 432 Action179 <- <{
 		    p.PushComponent(begin, end, IsNot)
 		}> */
 		func() bool {
 			{
-				add(ruleAction127, position)
+				add(ruleAction179, position)
 			}
 			return true
 		},
-		/* 318 Action128 <- <{
+		/* 433 Action180 <- <{
 		    p.PushComponent(begin, end, Plus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction128, position)
+				add(ruleAction180, position)
 			}
 			return true
 		},
-		/* 319 Action129 <- <{
+		/* 434 Action181 <- <{
 		    p.PushComponent(begin, end, Minus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction129, position)
+				add(ruleAction181, position)
 			}
 			return true
 		},
-		/* 320 Action130 <- <{
+		/* 435 Action182 <- <{
 		    p.PushComponent(begin, end, Multiply)
 		}> */
 		func() bool {
 			{
-				add(ruleAction130, position)
+				add(ruleAction182, position)
 			}
 			return true
 		},
-		/* 321 Action131 <- <{
+		/* 436 Action183 <- <{
 		    p.PushComponent(begin, end, Divide)
 		}> */
 		func() bool {
 			{
-				add(ruleAction131, position)
+				add(ruleAction183, position)
 			}
 			return true
 		},
-		/* 322 Action132 <- <{
+		/* 437 Action184 <- <{
 		    p.PushComponent(begin, end, Modulo)
 		}> */
 		func() bool {
 			{
-				add(ruleAction132, position)
+				add(ruleAction184, position)
 			}
 			return true
 		},
-		/* 323 Action133 <- <{
+		/* 438 Action185 <- <{
 		    p.PushComponent(begin, end, UnaryMinus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction133, position)
+				add(ruleAction185, position)
 			}
 			return true
 		},
-		/* 324 Action134 <- <{
+		/* 439 Action186 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction134, position)
+				add(ruleAction186, position)
 			}
 			return true
 		},
-		/* 325 Action135 <- <{
+		/* 440 Action187 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction135, position)
+				add(ruleAction187, position)
 			}
 			return true
 		},
@@ -20,27 +20,54 @@ func TestExpressionParser(t *testing.T) {
 		"NULL": {[]Expression{NullLiteral{}}, "NULL"},
 		// Function Application
 		"f()": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{}}, nil}}, "f()"},
+			ExpressionsAST{[]Expression{}}, nil, nil}}, "f()"},
 		"now()": {[]Expression{FuncAppAST{FuncName("now"),
-			ExpressionsAST{[]Expression{}}, nil}}, "now()"},
+			ExpressionsAST{[]Expression{}}, nil, nil}}, "now()"},
 		"f(a)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil}}, "f(a)"},
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, nil}}, "f(a)"},
 		"f(*)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{Wildcard{}}}, nil}}, "f(*)"},
+			ExpressionsAST{[]Expression{Wildcard{}}}, nil, nil}}, "f(*)"},
 		"f(x:*)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{Wildcard{"x"}}}, nil}}, "f(x:*)"},
+			ExpressionsAST{[]Expression{Wildcard{"x"}}}, nil, nil}}, "f(x:*)"},
 		"f(x:* ORDER BY a)": {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{Wildcard{"x"}}},
-			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}}}, "f(x:* ORDER BY a)"},
+			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}, nil}}, "f(x:* ORDER BY a)"},
 		"f(a ORDER BY a DESC, b, c ASC)": {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
-			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}, {RowValue{"", "c"}, Yes}}}}, "f(a ORDER BY a DESC, b, c ASC)"},
+			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}, {RowValue{"", "c"}, Yes}}, nil}}, "f(a ORDER BY a DESC, b, c ASC)"},
 		"count(a ORDER BY count(b))": {[]Expression{FuncAppAST{FuncName("count"),
 			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
 			[]SortedExpressionAST{{FuncAppAST{FuncName("count"),
-				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil}, UnspecifiedKeyword}}}}, "count(a ORDER BY count(b))"},
+				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil, nil}, UnspecifiedKeyword}}, nil}}, "count(a ORDER BY count(b))"},
+		"count(DISTINCT a)": {[]Expression{FuncAppAST{FuncName("count"),
+			ExpressionsAST{[]Expression{DistinctExpressionAST{RowValue{"", "a"}}}}, nil, nil}}, "count(DISTINCT a)"},
+		"f(distinct a + 1, distinct_b)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{DistinctExpressionAST{BinaryOpAST{Plus, RowValue{"", "a"}, NumericLiteral{1}}},
+				RowValue{"", "distinct_b"}}}, nil, nil}}, "f(DISTINCT a + 1, distinct_b)"},
 		`f(2.1, "a")`: {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil}}, `f(2.1, "a")`},
+			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil, nil}}, `f(2.1, "a")`},
+		"count(a) FILTER (WHERE b > 1)": {[]Expression{FuncAppAST{FuncName("count"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil,
+			BinaryOpAST{Greater, RowValue{"", "b"}, NumericLiteral{1}}}}, "count(a) FILTER (WHERE b > 1)"},
+		"f(a ORDER BY b)filter(where c)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
+			[]SortedExpressionAST{{RowValue{"", "b"}, UnspecifiedKeyword}},
+			RowValue{"", "c"}}}, "f(a ORDER BY b) FILTER (WHERE c)"},
+		// Analytic Function Application
+		"row_number() OVER ()": {[]Expression{AnalyticFuncAppAST{FuncAppAST{FuncName("row_number"),
+			ExpressionsAST{[]Expression{}}, nil, nil}, OverAST{}}}, "row_number() OVER ()"},
+		"lag(a, 2) over(partition by x:b, c order by ts() desc)": {[]Expression{AnalyticFuncAppAST{
+			FuncAppAST{FuncName("lag"), ExpressionsAST{[]Expression{RowValue{"", "a"}, NumericLiteral{2}}}, nil, nil},
+			OverAST{[]Expression{RowValue{"x", "b"}, RowValue{"", "c"}},
+				[]SortedExpressionAST{{RowMeta{"", TimestampMeta}, No}}}}},
+			"lag(a, 2) OVER (PARTITION BY x:b, c ORDER BY ts() DESC)"},
+		"a - lag(a) OVER (ORDER BY b, c)": {[]Expression{BinaryOpAST{Minus, RowValue{"", "a"},
+			AnalyticFuncAppAST{FuncAppAST{FuncName("lag"), ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, nil},
+				OverAST{nil, []SortedExpressionAST{{RowValue{"", "b"}, UnspecifiedKeyword},
+					{RowValue{"", "c"}, UnspecifiedKeyword}}}}}},
+			"a - lag(a) OVER (ORDER BY b, c)"},
+		"f(a) OVER (PARTITION BY)": {nil, ""},
+		"f(a) OVER":                {nil, ""},
 		// Type Cast
 		"CAST(2.1 AS BOOL)":    {[]Expression{TypeCastAST{FloatLiteral{2.1}, Bool}}, "CAST(2.1 AS BOOL)"},
 		"CAST(2.1 AS INT)":     {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
@@ -148,6 +175,34 @@ func TestExpressionParser(t *testing.T) {
 		"a >= 2": {[]Expression{BinaryOpAST{GreaterOrEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a >= 2"},
 		"a != 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
 		"a <> 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
+		// Pattern matching
+		`a LIKE "x%"`:      {[]Expression{BinaryOpAST{Like, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a LIKE "x%"`},
+		`a not like "x%"`:  {[]Expression{BinaryOpAST{NotLike, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a NOT LIKE "x%"`},
+		`a ILIKE b`:        {[]Expression{BinaryOpAST{ILike, RowValue{"", "a"}, RowValue{"", "b"}}}, `a ILIKE b`},
+		`a NOT ILIKE b`:    {[]Expression{BinaryOpAST{NotILike, RowValue{"", "a"}, RowValue{"", "b"}}}, `a NOT ILIKE b`},
+		`a SIMILAR TO "x"`: {[]Expression{BinaryOpAST{SimilarTo, RowValue{"", "a"}, StringLiteral{"x"}}}, `a SIMILAR TO "x"`},
+		`a NOT SIMILAR TO "x"`: {[]Expression{BinaryOpAST{NotSimilarTo, RowValue{"", "a"},
+			StringLiteral{"x"}}}, `a NOT SIMILAR TO "x"`},
+		`a~"x"`:          {[]Expression{BinaryOpAST{RegexpMatch, RowValue{"", "a"}, StringLiteral{"x"}}}, `a ~ "x"`},
+		`a !~ "x"`:       {[]Expression{BinaryOpAST{NotRegexpMatch, RowValue{"", "a"}, StringLiteral{"x"}}}, `a !~ "x"`},
+		`a LIKEb`:        {nil, ""},
+		`like LIKE like`: {[]Expression{BinaryOpAST{Like, RowValue{"", "like"}, RowValue{"", "like"}}}, `like LIKE like`},
+		// IN and BETWEEN
+		"a IN (1, b)": {[]Expression{BinaryOpAST{In, RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}, RowValue{"", "b"}}}}}}, "a IN [1, b]"},
+		"a in(1)": {[]Expression{BinaryOpAST{In, RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}}}}}}, "a IN [1]"},
+		"a NOT IN [1, 2]": {[]Expression{BinaryOpAST{NotIn, RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}, NumericLiteral{2}}}}}}, "a NOT IN [1, 2]"},
+		"a IN b":  {[]Expression{BinaryOpAST{In, RowValue{"", "a"}, RowValue{"", "b"}}}, "a IN b"},
+		"a IN ()": {nil, ""},
+		"a INb":   {nil, ""},
+		"a BETWEEN 1 AND b": {[]Expression{BetweenAST{Between, RowValue{"", "a"},
+			NumericLiteral{1}, RowValue{"", "b"}}}, "a BETWEEN 1 AND b"},
+		"a not between b - 1 and b + 1": {[]Expression{BetweenAST{NotBetween, RowValue{"", "a"},
+			BinaryOpAST{Minus, RowValue{"", "b"}, NumericLiteral{1}},
+			BinaryOpAST{Plus, RowValue{"", "b"}, NumericLiteral{1}}}}, "a NOT BETWEEN b - 1 AND b + 1"},
+		"a BETWEEN 1": {nil, ""},
 		// Other operators
 		"a || 2": {[]Expression{BinaryOpAST{Concat, RowValue{"", "a"}, NumericLiteral{2}}}, "a || 2"},
 		// IS Expressions
@@ -199,6 +254,22 @@ func TestExpressionParser(t *testing.T) {
 		"2 || a = 4": {[]Expression{BinaryOpAST{Equal,
 			BinaryOpAST{Concat, NumericLiteral{2}, RowValue{"", "a"}},
 			NumericLiteral{4}}}, "2 || a = 4"},
+		`a || "%" LIKE b AND c`: {[]Expression{BinaryOpAST{And,
+			BinaryOpAST{Like, BinaryOpAST{Concat, RowValue{"", "a"}, StringLiteral{"%"}}, RowValue{"", "b"}},
+			RowValue{"", "c"}}}, `a || "%" LIKE b AND c`},
+		`NOT a ~ "x"`: {[]Expression{UnaryOpAST{Not,
+			BinaryOpAST{RegexpMatch, RowValue{"", "a"}, StringLiteral{"x"}}}}, `NOT (a ~ "x")`},
+		"a BETWEEN 1 AND 2 AND b IN (c) OR d": {[]Expression{BinaryOpAST{Or,
+			BinaryOpAST{And,
+				BetweenAST{Between, RowValue{"", "a"}, NumericLiteral{1}, NumericLiteral{2}},
+				BinaryOpAST{In, RowValue{"", "b"}, ArrayAST{ExpressionsAST{[]Expression{RowValue{"", "c"}}}}}},
+			RowValue{"", "d"}}}, "(a BETWEEN 1 AND 2 AND b IN [c]) OR d"},
+		"a BETWEEN (b OR c) AND d": {[]Expression{BetweenAST{Between, RowValue{"", "a"},
+			BinaryOpAST{Or, RowValue{"", "b"}, RowValue{"", "c"}}, RowValue{"", "d"}}},
+			"a BETWEEN (b OR c) AND d"},
+		"(a BETWEEN b AND c) = e": {[]Expression{BinaryOpAST{Equal,
+			BetweenAST{Between, RowValue{"", "a"}, RowValue{"", "b"}, RowValue{"", "c"}},
+			RowValue{"", "e"}}}, "(a BETWEEN b AND c) = e"},
 		"-2.1::INT": {[]Expression{UnaryOpAST{UnaryMinus,
 			TypeCastAST{FloatLiteral{2.1}, Int}}}, "-CAST(2.1 AS INT)"},
 		/// Left-Associativity
@@ -277,7 +348,7 @@ func TestExpressionParser(t *testing.T) {
 		"a, 3.1, false,-2": {[]Expression{RowValue{"", "a"}, FloatLiteral{3.1}, BoolLiteral{false}, UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "a, 3.1, FALSE, -2"},
 		`"日本語", 13`:        {[]Expression{StringLiteral{"日本語"}, NumericLiteral{13}}, `"日本語", 13`},
 		`concat(a, "Pi", 3.1), b`: {[]Expression{FuncAppAST{FuncName("concat"), ExpressionsAST{
-			[]Expression{RowValue{"", "a"}, StringLiteral{"Pi"}, FloatLiteral{3.1}}}, nil},
+			[]Expression{RowValue{"", "a"}, StringLiteral{"Pi"}, FloatLiteral{3.1}}}, nil, nil},
 			RowValue{"", "b"}}, `concat(a, "Pi", 3.1), b`},
 	}
 
@@ -319,3 +390,32 @@ func TestExpressionParser(t *testing.T) {
 	})
 
 }
+
+func TestParseExpression(t *testing.T) {
+	Convey("Given a BQL parser", t, func() {
+		p := New()
+
+		Convey("When parsing an expression", func() {
+			expr, err := p.ParseExpression("a + 1 > b:c")
+
+			Convey("Then it should be parsed correctly", func() {
+				So(err, ShouldBeNil)
+				So(expr, ShouldResemble, BinaryOpAST{Greater,
+					BinaryOpAST{Plus, RowValue{"", "a"}, NumericLiteral{1}},
+					RowValue{"b", "c"}})
+			})
+		})
+
+		for _, s := range []string{"", "a +", "a ON {}", "a; EVAL b", "a b"} {
+			s := s
+
+			Convey(fmt.Sprintf("When parsing an invalid expression '%s'", s), func() {
+				_, err := p.ParseExpression(s)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
@@ -84,6 +84,19 @@ func TestMultipleStmtParser(t *testing.T) {
 			SelectStmt{EmitterAST: EmitterAST{Istream, nil},
 				ProjectionsAST: ProjectionsAST{[]Expression{RowValue{"", "b"}}}},
 		},
+		// transactions
+		"BEGIN; PAUSE SOURCE a; RESUME SOURCE b; COMMIT;": []interface{}{
+			BeginStmt{},
+			PauseSourceStmt{"a"},
+			ResumeSourceStmt{"b"},
+			CommitStmt{},
+		},
+		"begin;\nPAUSE SOURCE a;\nrollback": []interface{}{
+			BeginStmt{},
+			PauseSourceStmt{"a"},
+			RollbackStmt{},
+		},
+		"BEGIN PAUSE SOURCE a": nil,
 	}
 
 	Convey("Given a BQL parser", t, func() {
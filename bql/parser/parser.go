@@ -8,16 +8,29 @@ import (
 	"unicode"
 
 	"github.com/mattn/go-runewidth"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
 
 type bqlParser struct {
-	b bqlPeg
+	b      bqlPeg
+	params data.Map
 }
 
 func New() *bqlParser {
 	return &bqlParser{}
 }
 
+// NewWithParams returns a parser which binds values in params to
+// placeholders such as $threshold in statements. Bound values are
+// embedded in statements as literals, so they're never interpreted as
+// a part of BQL. Parsing a statement fails when it has a placeholder
+// to which no value is bound.
+func NewWithParams(params data.Map) *bqlParser {
+	return &bqlParser{
+		params: params,
+	}
+}
+
 func (p *bqlParser) ParseStmt(s string) (result interface{}, rest string, err error) {
 	// catch any parser errors
 	defer func() {
@@ -27,6 +40,7 @@ func (p *bqlParser) ParseStmt(s string) (result interface{}, rest string, err er
 	}()
 	// parse the statement
 	b := p.b
+	b.params = p.params
 	b.Buffer = s
 	b.Init()
 	if err := b.Parse(); err != nil {
@@ -68,6 +82,20 @@ func (p *bqlParser) ParseStmts(s string) ([]interface{}, error) {
 	return results, nil
 }
 
+// ParseExpression parses a single BQL expression such as `a + 1 > b`.
+func (p *bqlParser) ParseExpression(s string) (Expression, error) {
+	// an expression is parsed as the body of an EVAL statement
+	stmt, rest, err := p.ParseStmt("EVAL " + s)
+	if err != nil {
+		return nil, err
+	}
+	eval, ok := stmt.(EvalStmt)
+	if !ok || eval.Input != nil || rest != "" {
+		return nil, fmt.Errorf("'%s' is not a single expression", s)
+	}
+	return eval.Expr, nil
+}
+
 type bqlPeg struct {
 	bqlPegBackend
 }
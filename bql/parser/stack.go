@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"sort"
 
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
@@ -11,6 +12,9 @@ import (
 type parseStack struct {
 	top  *stackElement
 	size int
+
+	// params has values bound to placeholders.
+	params data.Map
 }
 
 // stackElement is a stack-internal data structure that is used
@@ -68,30 +72,37 @@ func (ps *parseStack) Peek() (value *ParsedComponent) {
 // they are components of a SELECT statement, and replaces them by
 // a single SelectStmt element.
 //
-//  EmitterAST
+//  LimitAST
+//  OrderingAST
 //  HavingAST
 //  GroupingAST
 //  FilterAST
 //  WindowedFromAST
 //  ProjectionsAST
+//  DistinctAST
+//  EmitterAST
 //   =>
-//  SelectStmt{EmitterAST, ProjectionsAST, WindowedFromAST, FilterAST, GroupingAST, HavingAST}
+//  SelectStmt{EmitterAST, DistinctAST, ProjectionsAST, WindowedFromAST, FilterAST,
+//      GroupingAST, HavingAST, OrderingAST, LimitAST}
 func (ps *parseStack) AssembleSelect() {
 	// pop the components from the stack in reverse order
-	_having, _grouping, _filter, _from, _projections, _emitter := ps.pop6()
+	_limit, _ordering, _having, _grouping, _filter, _from, _projections, _distinct, _emitter := ps.pop9()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
+	limit := _limit.comp.(LimitAST)
+	ordering := _ordering.comp.(OrderingAST)
 	having := _having.comp.(HavingAST)
 	grouping := _grouping.comp.(GroupingAST)
 	filter := _filter.comp.(FilterAST)
 	from := _from.comp.(WindowedFromAST)
 	projections := _projections.comp.(ProjectionsAST)
+	distinct := _distinct.comp.(DistinctAST)
 	emitter := _emitter.comp.(EmitterAST)
 
 	// assemble the SelectStmt and push it back
-	s := SelectStmt{emitter, projections, from, filter, grouping, having}
-	se := ParsedComponent{_emitter.begin, _having.end, s}
+	s := SelectStmt{emitter, distinct, projections, from, filter, grouping, having, ordering, limit}
+	se := ParsedComponent{_emitter.begin, _limit.end, s}
 	ps.Push(&se)
 }
 
@@ -114,25 +125,46 @@ func (ps *parseStack) AssembleSelectUnion(begin int, end int) {
 	ps.PushComponent(begin, end, SelectUnionStmt{selects})
 }
 
+// AssembleCreateView takes the topmost elements from the stack,
+// assuming they are components of a CREATE VIEW statement, and
+// replaces them by a single CreateViewStmt element.
+//
+//  SelectStmt
+//  StreamIdentifier
+//   =>
+//  CreateViewStmt{StreamIdentifier, SelectStmt}
+func (ps *parseStack) AssembleCreateView() {
+	// now pop the components from the stack in reverse order
+	_select, _name := ps.pop2()
+
+	s := _select.comp.(SelectStmt)
+	name := _name.comp.(StreamIdentifier)
+
+	se := ParsedComponent{_name.begin, _select.end, CreateViewStmt{name, s}}
+	ps.Push(&se)
+}
+
 // AssembleCreateStreamAsSelect takes the topmost elements from the stack,
 // assuming they are components of a CREATE STREAM statement, and
 // replaces them by a single CreateStreamAsSelectStmt element.
 //
 //  SelectStmt
+//  SchemaAST
 //  StreamIdentifier
 //   =>
-//  CreateStreamAsSelectStmt{StreamIdentifier, SelectStmt}
+//  CreateStreamAsSelectStmt{StreamIdentifier, SelectStmt, SchemaAST}
 func (ps *parseStack) AssembleCreateStreamAsSelect() {
 	// now pop the components from the stack in reverse order
-	_select, _name := ps.pop2()
+	_select, _schema, _name := ps.pop3()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
 	s := _select.comp.(SelectStmt)
+	schema := _schema.comp.(SchemaAST)
 	name := _name.comp.(StreamIdentifier)
 
 	// assemble the SelectStmt and push it back
-	css := CreateStreamAsSelectStmt{name, s}
+	css := CreateStreamAsSelectStmt{name, s, schema}
 	se := ParsedComponent{_name.begin, _select.end, css}
 	ps.Push(&se)
 }
@@ -164,30 +196,76 @@ func (ps *parseStack) AssembleCreateStreamAsSelectUnion() {
 // assuming they are components of a CREATE SOURCE statement, and
 // replaces them by a single CreateSourceStmt element.
 //
-//  BinaryKeyword
+//  SchemaAST
 //  SourceSinkSpecsAST
 //  SourceSinkType
 //  StreamIdentifier
+//  BinaryKeyword
 //   =>
 //  CreateSourceStmt{BinaryKeyword, StreamIdentifier, SourceSinkType,
-//    SourceSinkSpecsAST}
+//    SourceSinkSpecsAST, SchemaAST}
 func (ps *parseStack) AssembleCreateSource() {
 	// pop the components from the stack in reverse order
-	_specs, _sourceType, _name, _paused := ps.pop4()
+	_schema, _specs, _sourceType, _name, _paused := ps.pop5()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
+	schema := _schema.comp.(SchemaAST)
 	specs := _specs.comp.(SourceSinkSpecsAST)
 	sourceType := _sourceType.comp.(SourceSinkType)
 	name := _name.comp.(StreamIdentifier)
 	paused := _paused.comp.(BinaryKeyword)
 
 	// assemble the CreateSourceStmt and push it back
-	s := CreateSourceStmt{paused, name, sourceType, specs}
-	se := ParsedComponent{_paused.begin, _specs.end, s}
+	s := CreateSourceStmt{paused, name, sourceType, specs, schema}
+	se := ParsedComponent{_paused.begin, _schema.end, s}
 	ps.Push(&se)
 }
 
+// AssembleSchema takes the elements from the stack that correspond
+// to the input[begin:end] string, assuming they are the fields of a
+// schema, and replaces them by a single SchemaAST element. If there
+// are no such elements, an empty SchemaAST is pushed. A field cannot
+// be declared more than once.
+//
+//  SchemaFieldAST
+//  SchemaFieldAST
+//   =>
+//  SchemaAST{[SchemaFieldAST, SchemaFieldAST]}
+func (ps *parseStack) AssembleSchema(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	var fields []SchemaFieldAST
+	names := map[Identifier]bool{}
+	for _, e := range elems {
+		f := e.(SchemaFieldAST)
+		if names[f.Name] {
+			panic(fmt.Sprintf("field '%s' is declared more than once in the schema", f.Name))
+		}
+		names[f.Name] = true
+		fields = append(fields, f)
+	}
+	ps.PushComponent(begin, end, SchemaAST{fields})
+}
+
+// AssembleSchemaField takes the topmost elements from the stack,
+// assuming they are components of a field of a schema, and replaces
+// them by a single SchemaFieldAST element.
+//
+//  BinaryKeyword
+//  Type
+//  Identifier
+//   =>
+//  SchemaFieldAST{Identifier, Type, BinaryKeyword}
+func (ps *parseStack) AssembleSchemaField() {
+	_notNull, _type, _name := ps.pop3()
+
+	notNull := _notNull.comp.(BinaryKeyword)
+	t := _type.comp.(Type)
+	name := _name.comp.(Identifier)
+
+	ps.PushComponent(_name.begin, _notNull.end, SchemaFieldAST{name, t, notNull})
+}
+
 // AssembleCreateSink takes the topmost elements from the stack,
 // assuming they are components of a CREATE SINK statement, and
 // replaces them by a single CreateSinkStmt element.
@@ -391,6 +469,23 @@ func (ps *parseStack) AssembleDropStream() {
 	ps.Push(&se)
 }
 
+// AssembleDropView takes the topmost elements from the stack,
+// assuming they are components of a DROP VIEW statement, and
+// replaces them by a single DropViewStmt element.
+//
+//  StreamIdentifier
+//   =>
+//  DropViewStmt{StreamIdentifier}
+func (ps *parseStack) AssembleDropView() {
+	// pop the components from the stack in reverse order
+	_name := ps.Pop()
+
+	name := _name.comp.(StreamIdentifier)
+
+	se := ParsedComponent{_name.begin, _name.end, DropViewStmt{name}}
+	ps.Push(&se)
+}
+
 // AssembleDropSink takes the topmost elements from the stack,
 // assuming they are components of a DROP SINK statement, and
 // replaces them by a single DropSinkStmt element.
@@ -530,6 +625,61 @@ func (ps *parseStack) AssembleEval(begin, end int) {
 	ps.Push(&se)
 }
 
+// AssembleExplain takes the topmost element from the stack, assuming
+// it is the statement of an EXPLAIN statement, and replaces it by a
+// single ExplainStmt element.
+//
+//  SelectStmt, SelectUnionStmt, CreateStreamAsSelectStmt, or
+//  CreateStreamAsSelectUnionStmt
+//   =>
+//  ExplainStmt{Statement}
+func (ps *parseStack) AssembleExplain() {
+	_stmt := ps.Pop()
+	se := ParsedComponent{_stmt.begin, _stmt.end, ExplainStmt{_stmt.comp}}
+	ps.Push(&se)
+}
+
+// AssembleCreateFunction takes the topmost elements from the stack,
+// assuming they are components of a CREATE FUNCTION statement, and
+// replaces them by a single CreateFunctionStmt element.
+//
+//  Expression
+//  Identifier
+//  ...
+//  Identifier
+//  FuncName
+//   =>
+//  CreateFunctionStmt{FuncName, []Identifier, Expression}
+func (ps *parseStack) AssembleCreateFunction(begin int, end int) {
+	_body := ps.Pop()
+	body := _body.comp.(Expression)
+
+	elems := ps.collectElements(begin, end)
+	name := elems[0].(FuncName)
+	params := make([]Identifier, len(elems)-1)
+	for i, elem := range elems[1:] {
+		params[i] = elem.(Identifier)
+	}
+
+	ps.PushComponent(begin, end, CreateFunctionStmt{name, params, body})
+}
+
+// AssembleDropFunction takes the topmost elements from the stack,
+// assuming they are components of a DROP FUNCTION statement, and
+// replaces them by a single DropFunctionStmt element.
+//
+//  FuncName
+//   =>
+//  DropFunctionStmt{FuncName}
+func (ps *parseStack) AssembleDropFunction() {
+	_name := ps.Pop()
+
+	name := _name.comp.(FuncName)
+
+	se := ParsedComponent{_name.begin, _name.end, DropFunctionStmt{name}}
+	ps.Push(&se)
+}
+
 /* Projections/Columns */
 
 // AssembleEmitter takes the topmost elements from the stack, assuming
@@ -606,6 +756,16 @@ func (ps *parseStack) AssembleEmitterSampling(samplingType EmitterSamplingType,
 	ps.PushComponent(_value.begin, _value.end, EmitterSampling{value * factor, samplingType})
 }
 
+// AssembleDistinct pushes a DistinctAST struct that tells whether
+// the DISTINCT keyword was found in the input[begin:end] string.
+//
+//  (nothing)
+//   =>
+//  DistinctAST{bool}
+func (ps *parseStack) AssembleDistinct(begin int, end int) {
+	ps.PushComponent(begin, end, DistinctAST{begin != end})
+}
+
 // AssembleProjections takes the elements from the stack that
 // correspond to the input[begin:end] string and wraps a
 // ProjectionsAST struct around them.
@@ -647,31 +807,81 @@ func (ps *parseStack) AssembleAlias() {
 
 // AssembleWindowedFrom takes the elements from the stack that
 // correspond to the input[begin:end] string, makes sure they are all
-// AliasedStreamWindowAST elements and wraps a WindowedFromAST struct
-// around them. If there are no such elements, adds an
-// empty WindowedFromAST struct to the stack.
+// AliasedStreamWindowAST, JoinAST or LookupAST elements and wraps a
+// WindowedFromAST struct around them. If there are no such elements,
+// adds an empty WindowedFromAST struct to the stack.
 //
 //  AliasedStreamWindowAST
 //  AliasedStreamWindowAST
+//  JoinAST
+//  LookupAST
 //   =>
-//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST]}
+//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST], [JoinAST], [LookupAST]}
 func (ps *parseStack) AssembleWindowedFrom(begin int, end int) {
 	if begin == end {
 		// push an empty FROM clause
 		ps.PushComponent(begin, end, WindowedFromAST{})
 	} else {
 		elems := ps.collectElements(begin, end)
-		rels := make([]AliasedStreamWindowAST, len(elems), len(elems))
-		for i, elem := range elems {
+		rels := make([]AliasedStreamWindowAST, 0, len(elems))
+		var joins []JoinAST
+		var lookups []LookupAST
+		for _, elem := range elems {
 			// (if this conversion fails, this is a fundamental parser bug)
-			e := elem.(AliasedStreamWindowAST)
-			rels[i] = e
+			switch e := elem.(type) {
+			case JoinAST:
+				joins = append(joins, e)
+			case LookupAST:
+				lookups = append(lookups, e)
+			default:
+				rels = append(rels, e.(AliasedStreamWindowAST))
+			}
 		}
 		// push the grouped list back
-		ps.PushComponent(begin, end, WindowedFromAST{rels})
+		ps.PushComponent(begin, end, WindowedFromAST{rels, joins, lookups})
 	}
 }
 
+// AssembleJoin takes the topmost elements from the stack, assuming
+// they are components of a JOIN clause, and replaces them by the
+// joined relation and a JoinAST element.
+//
+//  Any
+//  AliasedStreamWindowAST
+//  JoinType
+//   =>
+//  AliasedStreamWindowAST
+//  JoinAST{JoinType, Any}
+func (ps *parseStack) AssembleJoin() {
+	_on, _rel, _type := ps.pop3()
+
+	on := _on.comp.(Expression)
+	rel := _rel.comp.(AliasedStreamWindowAST)
+	joinType := _type.comp.(JoinType)
+
+	ps.PushComponent(_rel.begin, _rel.end, rel)
+	ps.PushComponent(_rel.end, _on.end, JoinAST{joinType, on})
+}
+
+// AssembleLookup takes the topmost elements from the stack, assuming
+// they are components of a LOOKUP clause, and replaces them by a
+// single LookupAST element.
+//
+//  Any
+//  Identifier
+//  StreamIdentifier
+//   =>
+//  LookupAST{StreamIdentifier, string, Any}
+func (ps *parseStack) AssembleLookup() {
+	_key, _alias, _state := ps.pop3()
+
+	key := _key.comp.(Expression)
+	alias := _alias.comp.(Identifier)
+	state := _state.comp.(StreamIdentifier)
+
+	ps.PushComponent(_state.begin, _key.end, LookupAST{state, string(alias), key})
+}
+
 // AssembleInterval takes the topmost elements from the stack, assuming
 // they are components of a RANGE clause, and replaces them by
 // a single IntervalAST element.
@@ -741,19 +951,168 @@ func (ps *parseStack) AssembleFilter(begin int, end int) {
 //  Any
 //   =>
 //  GroupingAST{[Any, Any, Any]}
+//
+// When some of the elements are GroupingSetsAST, the grouping sets
+// are the cross product of the elements, where an Expression is
+// regarded as a single set containing it, and the GroupList has the
+// distinct expressions of all elements:
+//
+//  Any
+//  GroupingSetsAST{[[Any, Any], [Any], []]}
+//   =>
+//  GroupingAST{[Any, Any, Any], [[0, 1, 2], [0, 1], [0]]}
 func (ps *parseStack) AssembleGrouping(begin int, end int) {
 	elems := ps.collectElements(begin, end)
+	hasSets := false
+	for _, e := range elems {
+		if _, ok := e.(GroupingSetsAST); ok {
+			hasSets = true
+			break
+		}
+	}
+	if !hasSets {
+		var exprs []Expression
+		if len(elems) > 0 {
+			exprs = make([]Expression, len(elems))
+		}
+		for i := range elems {
+			exprs[i] = elems[i].(Expression)
+		}
+		// push the grouped list back
+		ps.PushComponent(begin, end, GroupingAST{exprs, nil})
+		return
+	}
+
+	// collect distinct expressions in the order of their appearance
 	var exprs []Expression
-	if len(elems) > 0 {
-		exprs = make([]Expression, len(elems))
+	indexes := map[string]int{}
+	indexOf := func(e Expression) int {
+		key := e.String()
+		if i, ok := indexes[key]; ok {
+			return i
+		}
+		indexes[key] = len(exprs)
+		exprs = append(exprs, e)
+		return indexes[key]
 	}
+	// compute the cross product of all elements
+	sets := [][]int{{}}
+	for _, e := range elems {
+		var elemSets [][]int
+		switch obj := e.(type) {
+		case GroupingSetsAST:
+			for _, set := range obj.Sets {
+				idxs := make([]int, len(set))
+				for i, expr := range set {
+					idxs[i] = indexOf(expr)
+				}
+				elemSets = append(elemSets, idxs)
+			}
+		default:
+			elemSets = [][]int{{indexOf(obj.(Expression))}}
+		}
+		newSets := make([][]int, 0, len(sets)*len(elemSets))
+		for _, set := range sets {
+			for _, elemSet := range elemSets {
+				newSet := append([]int{}, set...)
+				for _, idx := range elemSet {
+					// an expression appears in a set only once
+					dup := false
+					for _, other := range newSet {
+						if idx == other {
+							dup = true
+							break
+						}
+					}
+					if !dup {
+						newSet = append(newSet, idx)
+					}
+				}
+				newSets = append(newSets, newSet)
+			}
+		}
+		sets = newSets
+	}
+	ps.PushComponent(begin, end, GroupingAST{exprs, sets})
+}
+
+// AssembleGroupingSets takes the elements from the stack that
+// correspond to the input[begin:end] string, assuming they are
+// the sets of GROUPING SETS, and replaces them by a single
+// GroupingSetsAST element.
+//
+//  ExpressionsAST
+//  ExpressionsAST
+//   =>
+//  GroupingSetsAST{[ExpressionsAST.Expressions, ExpressionsAST.Expressions]}
+func (ps *parseStack) AssembleGroupingSets(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	sets := make([][]Expression, len(elems))
+	for i, e := range elems {
+		sets[i] = e.(ExpressionsAST).Expressions
+	}
+	ps.PushComponent(begin, end, GroupingSetsAST{sets})
+}
+
+// AssembleRollup takes the elements from the stack that correspond
+// to the input[begin:end] string and replaces them by a single
+// GroupingSetsAST element having all prefixes of them as sets.
+//
+//  Any
+//  Any
+//   =>
+//  GroupingSetsAST{[[Any, Any], [Any], []]}
+func (ps *parseStack) AssembleRollup(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	exprs := make([]Expression, len(elems))
 	for i := range elems {
 		exprs[i] = elems[i].(Expression)
 	}
-	// push the grouped list back
-	ps.PushComponent(begin, end, GroupingAST{exprs})
+	sets := make([][]Expression, len(exprs)+1)
+	for i := range sets {
+		sets[i] = exprs[:len(exprs)-i]
+	}
+	ps.PushComponent(begin, end, GroupingSetsAST{sets})
 }
 
+// AssembleCube takes the elements from the stack that correspond
+// to the input[begin:end] string and replaces them by a single
+// GroupingSetsAST element having all subsets of them as sets.
+//
+//  Any
+//  Any
+//   =>
+//  GroupingSetsAST{[[Any, Any], [Any], [Any], []]}
+func (ps *parseStack) AssembleCube(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) > maxCubeExpressions {
+		panic(fmt.Sprintf("CUBE cannot have more than %d expressions", maxCubeExpressions))
+	}
+	exprs := make([]Expression, len(elems))
+	for i := range elems {
+		exprs[i] = elems[i].(Expression)
+	}
+	// the i-th expression is in a set when the (n-i-1)-th bit of
+	// the mask is set, so that the sets are in the same order as
+	// the ones of SQL
+	n := uint(len(exprs))
+	sets := make([][]Expression, 0, 1<<n)
+	for mask := (1 << n) - 1; mask >= 0; mask-- {
+		set := []Expression{}
+		for i := uint(0); i < n; i++ {
+			if mask&(1<<(n-i-1)) != 0 {
+				set = append(set, exprs[i])
+			}
+		}
+		sets = append(sets, set)
+	}
+	ps.PushComponent(begin, end, GroupingSetsAST{sets})
+}
+
+// maxCubeExpressions is the maximum number of expressions in a CUBE,
+// which has 2^n grouping sets for n expressions.
+const maxCubeExpressions = 10
+
 /* HAVING clause */
 
 // AssembleHaving takes the expression on top of the stack
@@ -779,6 +1138,57 @@ func (ps *parseStack) AssembleHaving(begin int, end int) {
 	}
 }
 
+/* ORDER BY clause */
+
+// AssembleOrdering takes the elements from the stack that
+// correspond to the input[begin:end] string, makes sure they
+// are all SortedExpressionAST structs and wraps an OrderingAST
+// struct around them. If there is no ORDER BY clause, an empty
+// OrderingAST struct is used.
+//
+//  SortedExpressionAST
+//  SortedExpressionAST
+//  ...
+//   =>
+//  OrderingAST{[SortedExpressionAST, SortedExpressionAST, ...]}
+func (ps *parseStack) AssembleOrdering(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	var exprs []SortedExpressionAST
+	if len(elems) > 0 {
+		exprs = make([]SortedExpressionAST, len(elems))
+	}
+	for i := range elems {
+		exprs[i] = elems[i].(SortedExpressionAST)
+	}
+	// push the sorted list back
+	ps.PushComponent(begin, end, OrderingAST{exprs})
+}
+
+/* LIMIT clause */
+
+// AssembleLimit takes the NumericLiteral on top of the stack
+// (if there is a LIMIT clause) and wraps a LimitAST struct
+// around it. If there is no LIMIT clause, an empty LimitAST
+// struct is used.
+//
+//  NumericLiteral
+//   =>
+//  LimitAST{true, NumericLiteral}
+func (ps *parseStack) AssembleLimit(begin int, end int) {
+	if begin == end {
+		// push an empty limit clause
+		ps.PushComponent(begin, end, LimitAST{})
+	} else {
+		// if the stack is empty at this point, this is
+		// a serious parser bug
+		l := ps.Pop()
+		if begin > l.begin || end < l.end {
+			panic("the item on top of the stack is not within given range")
+		}
+		ps.PushComponent(begin, end, LimitAST{true, l.comp.(NumericLiteral).Value})
+	}
+}
+
 // AssembleAliasedStreamWindow takes the topmost elements from the stack, assuming
 // they are components of an AS clause, and replaces them by
 // a single AliasedStreamWindowAST element.
@@ -811,6 +1221,11 @@ func (ps *parseStack) EnsureAliasedStreamWindow() {
 		aliasRel = e
 	} else {
 		e := elem.(StreamWindowAST)
+		if e.Type == SubqueryStream {
+			// a subquery doesn't have a name which can be used to
+			// refer to its columns
+			panic("a subquery in a FROM clause must have an alias")
+		}
 		aliasRel = AliasedStreamWindowAST{e, ""}
 	}
 	ps.PushComponent(_elem.begin, _elem.end, aliasRel)
@@ -820,21 +1235,54 @@ func (ps *parseStack) EnsureAliasedStreamWindow() {
 // they are components of an AS clause, and replaces them by
 // a single StreamWindowAST element.
 //
+//  SheddingOption
+//  NumericLiteral
+//  IntervalAST
+//  WindowType
+//  IntervalAST
 //  IntervalAST
 //  Stream
 //   =>
-//  StreamWindowAST{Stream, IntervalAST}
+//  StreamWindowAST{Stream, IntervalAST, IntervalAST, WindowType, IntervalAST,
+//    NumericLiteral.Value, SheddingOption}
 func (ps *parseStack) AssembleStreamWindow() {
 	// pop the components from the stack in reverse order
-	_shedding, _capacity, _range, _rel := ps.pop4()
+	_shedding, _capacity, _lateness, _winType, _slide, _range, _rel := ps.pop7()
 
 	rel := _rel.comp.(Stream)
 	rangeAst := _range.comp.(IntervalAST)
+	slide := _slide.comp.(IntervalAST)
+	winType := _winType.comp.(WindowType)
+	lateness := _lateness.comp.(IntervalAST)
 	capacity := _capacity.comp.(NumericLiteral)
 	shedding := _shedding.comp.(SheddingOption)
 
 	ps.PushComponent(_rel.begin, _shedding.end, StreamWindowAST{rel, rangeAst,
-		capacity.Value, shedding})
+		slide, winType, lateness, capacity.Value, shedding})
+}
+
+// AssembleSessionWindowSpec takes the IntervalAST on top of the stack,
+// which is the GAP of a session window, and adds the remaining window
+// components so that it can be processed by AssembleStreamWindow in
+// the same way as a RANGE specification.
+//
+//  IntervalAST
+//   =>
+//  IntervalAST
+//  IntervalAST (no SLIDE)
+//  SessionWindow
+//  IntervalAST (no ALLOWED LATENESS)
+func (ps *parseStack) AssembleSessionWindowSpec() {
+	_gap := ps.Peek()
+	if _gap == nil {
+		panic("there is no GAP specification on the stack")
+	}
+	// (if this fails, this is a fundamental parser bug => panic ok)
+	_ = _gap.comp.(IntervalAST)
+	end := _gap.end
+	ps.PushComponent(end, end, IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit})
+	ps.PushComponent(end, end, SessionWindow)
+	ps.PushComponent(end, end, IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit})
 }
 
 // AssembleUDSFFuncApp takes the topmost elements from the stack,
@@ -850,10 +1298,80 @@ func (ps *parseStack) AssembleUDSFFuncApp() {
 	fun := _fun.comp.(FuncAppAST)
 
 	se := ParsedComponent{_fun.begin, _fun.end,
-		Stream{UDSFStream, string(fun.Function), fun.Expressions}}
+		Stream{UDSFStream, string(fun.Function), fun.Expressions, nil}}
 	ps.Push(&se)
 }
 
+// AssembleSubquery takes the topmost element from the stack, assuming
+// it is a SELECT statement enclosed in parentheses in a FROM clause, and
+// replaces it by a single Stream element.
+//
+//  SelectStmt
+//   =>
+//  Stream{SubqueryStream, "", nil, SelectStmt}
+func (ps *parseStack) AssembleSubquery(begin int, end int) {
+	_select := ps.Pop()
+
+	s := _select.comp.(SelectStmt)
+
+	ps.PushComponent(begin, end, Stream{SubqueryStream, "", nil, &s})
+}
+
+// EnsureSlideSpec makes sure that the top element of the stack
+// is an IntervalAST element describing the SLIDE of a window. If
+// there is no SLIDE clause, an IntervalAST with an unspecified unit
+// is pushed.
+func (ps *parseStack) EnsureSlideSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(IntervalAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
+// EnsureWindowType makes sure that the top element of the stack
+// is a WindowType element.
+func (ps *parseStack) EnsureWindowType(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, UnspecifiedWindowType)
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(WindowType)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
+// EnsureLatenessSpec makes sure that the top element of the stack
+// is an IntervalAST element describing the allowed lateness of a
+// window. If there is no ALLOWED LATENESS clause, an IntervalAST with
+// an unspecified unit is pushed.
+func (ps *parseStack) EnsureLatenessSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(IntervalAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
 // EnsureCapacitySpec makes sure that the top element of the stack
 // is a NumericLiteral element.
 func (ps *parseStack) EnsureCapacitySpec(begin int, end int) {
@@ -941,6 +1459,8 @@ func (ps *parseStack) AssembleSourceSinkParam() {
 			value = data.Int(lit.Value)
 		case FloatLiteral:
 			value = data.Float(lit.Value)
+		case NullLiteral:
+			value = data.Null{}
 		case ArrayAST:
 			arr := make(data.Array, len(lit.Expressions))
 			for i, item := range lit.Expressions {
@@ -1028,13 +1548,19 @@ func (ps *parseStack) EnsureKeywordPresent(begin int, end int) {
 //  BinaryOpAST{Operator, BinaryOpAST{Operator, Any, Any}, Any}
 func (ps *parseStack) AssembleBinaryOperation(begin int, end int) {
 	elems := ps.collectElements(begin, end)
+	ps.PushComponent(begin, end, binaryOperation(elems))
+}
+
+// binaryOperation connects the given elements as described in the
+// documentation of AssembleBinaryOperation.
+func binaryOperation(elems []interface{}) interface{} {
 	if len(elems) == 1 {
-		// there is no "binary" operation, push back the single element
-		ps.PushComponent(begin, end, elems[0])
+		// there is no "binary" operation, return the single element
+		return elems[0]
 	} else if len(elems) == 3 {
 		op := elems[1].(Operator)
 		// connect left and right with the given operator
-		ps.PushComponent(begin, end, BinaryOpAST{op, elems[0].(Expression), elems[2].(Expression)})
+		return BinaryOpAST{op, elems[0].(Expression), elems[2].(Expression)}
 	} else if len(elems) > 3 {
 		op := elems[1].(Operator)
 		// left-associativity: process three leftmost items,
@@ -1043,10 +1569,31 @@ func (ps *parseStack) AssembleBinaryOperation(begin int, end int) {
 		for i := 4; i < len(elems); i += 2 {
 			leftmost = BinaryOpAST{elems[i-1].(Operator), leftmost, elems[i].(Expression)}
 		}
-		ps.PushComponent(begin, end, leftmost)
-	} else {
-		panic(fmt.Sprintf("cannot turn %+v into a binary operation", elems))
+		return leftmost
+	}
+	panic(fmt.Sprintf("cannot turn %+v into a binary operation", elems))
+}
+
+// AssembleComparison takes the elements from the stack that
+// correspond to the input[begin:end] string and replaces them by
+// a BetweenAST element if they form a BETWEEN predicate. Otherwise,
+// they are processed by AssembleBinaryOperation.
+//
+//  Any
+//  Operator
+//  Any
+//  Any
+//   =>
+//  BetweenAST{Operator, Any, Any, Any}
+func (ps *parseStack) AssembleComparison(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	if len(elems) == 4 {
+		op := elems[1].(Operator)
+		ps.PushComponent(begin, end, BetweenAST{op, elems[0].(Expression),
+			elems[2].(Expression), elems[3].(Expression)})
+		return
 	}
+	ps.PushComponent(begin, end, binaryOperation(elems))
 }
 
 // AssembleUnaryPrefixOperation takes the two elements from the stack that
@@ -1130,7 +1677,56 @@ func (ps *parseStack) AssembleFuncApp() {
 	}
 
 	// assemble the FuncAppAST and push it back
-	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs, orderExprs})
+	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs, orderExprs, nil})
+}
+
+// AssembleFuncFilter takes the topmost elements from the stack,
+// assuming they are a function application and its (possibly empty)
+// FILTER clause, and replaces them by a single FuncAppAST element.
+//
+//  FilterAST
+//  FuncAppAST
+//   =>
+//  FuncAppAST{Function, ExpressionsAST, Ordering, Filter}
+func (ps *parseStack) AssembleFuncFilter() {
+	_filter, _func := ps.pop2()
+
+	filter := _filter.comp.(FilterAST)
+	funcApp := _func.comp.(FuncAppAST)
+
+	funcApp.Filter = filter.Filter
+	ps.PushComponent(_func.begin, _filter.end, funcApp)
+}
+
+// AssembleAnalyticFuncApp takes the topmost elements from the stack,
+// assuming they are components of an analytic function application,
+// and replaces them by a single AnalyticFuncAppAST element.
+//
+//  ExpressionsAST
+//  ExpressionsAST
+//  FuncAppAST
+//   =>
+//  AnalyticFuncAppAST{FuncAppAST, OverAST{ExpressionsAST, ExpressionsAST}}
+func (ps *parseStack) AssembleAnalyticFuncApp() {
+	_ordering, _partition, _func := ps.pop3()
+
+	ordering := _ordering.comp.(ExpressionsAST)
+	partition := _partition.comp.(ExpressionsAST)
+	funcApp := _func.comp.(FuncAppAST)
+
+	var orderExprs []SortedExpressionAST
+	for _, e := range ordering.Expressions {
+		orderExprs = append(orderExprs, e.(SortedExpressionAST))
+	}
+	var partitionExprs []Expression
+	if len(partition.Expressions) > 0 {
+		partitionExprs = partition.Expressions
+	}
+
+	ps.PushComponent(_func.begin, _ordering.end, AnalyticFuncAppAST{
+		FuncAppAST: funcApp,
+		Over:       OverAST{partitionExprs, orderExprs},
+	})
 }
 
 func (ps *parseStack) AssembleFuncAppSelector() {
@@ -1162,6 +1758,21 @@ func (ps *parseStack) AssembleSortedExpression() {
 	ps.PushComponent(_expr.begin, _sortOrder.end, SortedExpressionAST{expr, sortOrder})
 }
 
+// AssembleDistinctExpression takes the expression on top of the
+// stack, assuming it is a parameter of a function with the DISTINCT
+// modifier, and wraps a DistinctExpressionAST struct around it.
+//
+//  Expression
+//   =>
+//  DistinctExpressionAST{Expression}
+func (ps *parseStack) AssembleDistinctExpression(begin int, end int) {
+	_expr := ps.Pop()
+
+	expr := _expr.comp.(Expression)
+
+	ps.PushComponent(begin, end, DistinctExpressionAST{expr})
+}
+
 // AssembleArray takes the topmost elements from the stack, assuming
 // they are components of an array expression, and replaces them
 // by a single ArrayAST element.
@@ -1321,6 +1932,73 @@ func (ps *parseStack) AssembleExpressions(begin int, end int) {
 	ps.PushComponent(begin, end, ExpressionsAST{exprs})
 }
 
+// AssemblePlaceholder pushes the literal bound to the placeholder
+// having the given name. It panics when no value is bound to the
+// placeholder.
+//
+//   =>
+//  Expression
+func (ps *parseStack) AssemblePlaceholder(begin int, end int, name string) {
+	v, ok := ps.params[name]
+	if !ok {
+		panic(fmt.Sprintf("no value is bound to the placeholder $%s", name))
+	}
+	lit, err := valueToLiteral(v)
+	if err != nil {
+		panic(fmt.Sprintf("cannot bind a value to the placeholder $%s: %v", name, err))
+	}
+	ps.PushComponent(begin, end, lit)
+}
+
+// valueToLiteral converts a data.Value to a literal expression
+// having the same value.
+func valueToLiteral(v data.Value) (Expression, error) {
+	switch v.Type() {
+	case data.TypeNull:
+		return NullLiteral{}, nil
+	case data.TypeBool:
+		b, _ := data.AsBool(v)
+		return BoolLiteral{b}, nil
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return NumericLiteral{i}, nil
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		return FloatLiteral{f}, nil
+	case data.TypeString:
+		s, _ := data.AsString(v)
+		return StringLiteral{s}, nil
+	case data.TypeArray:
+		a, _ := data.AsArray(v)
+		exprs := make([]Expression, len(a))
+		for i, e := range a {
+			lit, err := valueToLiteral(e)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i] = lit
+		}
+		return ArrayAST{ExpressionsAST{exprs}}, nil
+	case data.TypeMap:
+		m, _ := data.AsMap(v)
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		entries := make([]KeyValuePairAST, len(keys))
+		for i, k := range keys {
+			lit, err := valueToLiteral(m[k])
+			if err != nil {
+				return nil, err
+			}
+			entries[i] = KeyValuePairAST{k, lit}
+		}
+		return MapAST{entries}, nil
+	}
+	return nil, fmt.Errorf("%v cannot be used as a literal", v.Type())
+}
+
 // PushComponent pushes the given component to the top of the stack
 // wrapped in a ParsedComponent struct. It's the caller's responsibility
 // to make sure that the parameter is one of the AST classes, or there
@@ -1426,3 +2104,12 @@ func (ps *parseStack) pop8() (*ParsedComponent, *ParsedComponent,
 	}
 	return ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop()
 }
+
+func (ps *parseStack) pop9() (*ParsedComponent, *ParsedComponent,
+	*ParsedComponent, *ParsedComponent, *ParsedComponent, *ParsedComponent,
+	*ParsedComponent, *ParsedComponent, *ParsedComponent) {
+	if ps.Len() < 9 {
+		panic("not enough elements on stack to pop 9 of them")
+	}
+	return ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop()
+}
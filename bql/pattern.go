@@ -0,0 +1,414 @@
+package bql
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// patternUDSF detects sequences of tuples matching a pattern. A pattern is
+// a list of steps, and each step has a condition written in BQL. Tuples
+// which don't satisfy the condition of the next step are skipped, so steps
+// don't have to be matched by consecutive tuples.
+//
+// The UDSF is created by match_pattern(stream, spec) where spec is a map
+// having the following fields:
+//
+//	pattern: an array of names of steps in the order of the sequence
+//	define: a map from names of steps to conditions
+//	partition_by: an expression computing the key of a tuple (optional)
+//	within: the maximum number of seconds between the timestamps of
+//	        the first tuple and the last tuple of a match (optional)
+//	max_runs: the maximum number of candidates kept for each key
+//	          (optional, 100 by default)
+//
+// For example:
+//
+//	SELECT RSTREAM * FROM match_pattern("sensors", {
+//	    "pattern": ["hot", "open", "drop"],
+//	    "define": {
+//	        "hot": "temperature > 80",
+//	        "open": "door_opened",
+//	        "drop": "pressure < hot:pressure * 0.9"
+//	    },
+//	    "partition_by": "device_id",
+//	    "within": 10
+//	}) [RANGE 1 TUPLES];
+//
+// Columns without a relation prefix in a condition refer to the tuple
+// being tested. Tuples matched by preceding steps can be referred to by
+// the names of the steps as relations, e.g. hot:pressure. A condition
+// which cannot be evaluated, e.g. because of a missing field, isn't
+// satisfied.
+//
+// Tuples are processed separately for each key computed by partition_by.
+// A tuple whose key cannot be computed is dropped with an error. Every
+// tuple satisfying the condition of the first step starts a new candidate
+// of a match. When a candidate is completed, a tuple having the tuples
+// matched by the steps as its fields, e.g. {"hot": {...}, "open": {...},
+// "drop": {...}}, is emitted and all other candidates of the key are
+// discarded. The timestamp of the emitted tuple is the one of the last
+// matched tuple.
+//
+// Candidates are kept until they're completed or exceed within. Expired
+// candidates of all keys are removed periodically even if no tuple of the
+// key arrives. When a key has more than max_runs candidates, the oldest one
+// is discarded. Because candidates of keys never expire without within,
+// within should be specified to limit memory usage.
+type patternUDSF struct {
+	steps     []*patternStep
+	partition execution.Evaluator
+	within    time.Duration
+	maxRuns   int
+
+	m sync.Mutex
+
+	// partitions has candidates of matches for each partition key. Keys
+	// having the same hash value share the slice.
+	partitions map[data.HashValue][]*patternPartition
+
+	// lastSweep is the timestamp of the tuple at which expired candidates
+	// were removed from all partitions last time.
+	lastSweep time.Time
+}
+
+// patternPartition has candidates of matches of a partition key.
+type patternPartition struct {
+	key  data.Value
+	runs []*patternRun
+}
+
+type patternStep struct {
+	name string
+	cond execution.Evaluator
+}
+
+// patternRun is a candidate of a match. It has tuples matched by the
+// steps of the pattern so far.
+type patternRun struct {
+	matched []*core.Tuple
+}
+
+var (
+	_ udf.UDSF = &patternUDSF{}
+
+	patternStepNameRegexp = regexp.MustCompile("^[a-z][a-z0-9_]*$")
+)
+
+const defaultPatternMaxRuns = 100
+
+func createPatternUDSFCreator(tb *TopologyBuilder) udf.UDSFCreator {
+	return udf.MustConvertToUDSFCreator(func(decl udf.UDSFDeclarer, stream string, spec data.Map) (udf.UDSF, error) {
+		return newPatternUDSF(decl, tb.Reg, stream, spec)
+	})
+}
+
+func newPatternUDSF(decl udf.UDSFDeclarer, reg udf.FunctionRegistry, stream string, spec data.Map) (*patternUDSF, error) {
+	p := parser.New()
+	compile := func(expr parser.Expression) (execution.Evaluator, error) {
+		flatExpr, err := execution.ParserExprToFlatExpr(expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		if flatExpr.ContainsWildcard() {
+			return nil, errors.New("wildcards cannot be used in a pattern")
+		}
+		return execution.ExpressionToEvaluator(flatExpr, reg)
+	}
+
+	f := &patternUDSF{
+		maxRuns:    defaultPatternMaxRuns,
+		partitions: map[data.HashValue][]*patternPartition{},
+	}
+
+	v, ok := spec["pattern"]
+	if !ok {
+		return nil, errors.New("pattern field is missing")
+	}
+	pattern, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("pattern field must be an array: %v", err)
+	}
+	if len(pattern) == 0 {
+		return nil, errors.New("pattern must have at least one step")
+	}
+	v, ok = spec["define"]
+	if !ok {
+		return nil, errors.New("define field is missing")
+	}
+	define, err := data.AsMap(v)
+	if err != nil {
+		return nil, fmt.Errorf("define field must be a map: %v", err)
+	}
+
+	// names of steps which can be referred to by the condition
+	visible := map[string]bool{}
+	for _, v := range pattern {
+		name, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("a step in the pattern must be a string: %v", err)
+		}
+		if !patternStepNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf("invalid name for a step: %v", name)
+		}
+		if visible[name] {
+			return nil, fmt.Errorf("step '%v' appears more than once in the pattern", name)
+		}
+		visible[name] = true
+
+		c, ok := define[name]
+		if !ok {
+			return nil, fmt.Errorf("step '%v' isn't defined", name)
+		}
+		cond, err := data.AsString(c)
+		if err != nil {
+			return nil, fmt.Errorf("the condition of step '%v' must be a string: %v", name, err)
+		}
+		expr, err := p.ParseExpression(cond)
+		if err != nil {
+			return nil, fmt.Errorf("the condition of step '%v' is invalid: %v", name, err)
+		}
+		expr = expr.RenameReferencedRelation("", name)
+		for rel := range expr.ReferencedRelations() {
+			if !visible[rel] {
+				return nil, fmt.Errorf("step '%v' can only refer to itself and the preceding steps: %v",
+					name, rel)
+			}
+		}
+		eval, err := compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("the condition of step '%v' is invalid: %v", name, err)
+		}
+		f.steps = append(f.steps, &patternStep{
+			name: name,
+			cond: eval,
+		})
+	}
+	if len(define) != len(f.steps) {
+		for name := range define {
+			if !visible[name] {
+				return nil, fmt.Errorf("step '%v' isn't used in the pattern", name)
+			}
+		}
+	}
+
+	if v, ok := spec["partition_by"]; ok {
+		s, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("partition_by field must be a string: %v", err)
+		}
+		expr, err := p.ParseExpression(s)
+		if err != nil {
+			return nil, fmt.Errorf("partition_by field is invalid: %v", err)
+		}
+		rels := expr.ReferencedRelations()
+		if len(rels) > 1 || (len(rels) == 1 && !rels[""]) {
+			return nil, errors.New("stream prefixes cannot be used in partition_by field")
+		}
+		if f.partition, err = compile(expr); err != nil {
+			return nil, fmt.Errorf("partition_by field is invalid: %v", err)
+		}
+	}
+
+	if v, ok := spec["within"]; ok {
+		w, err := data.ToFloat(v)
+		if err != nil {
+			return nil, fmt.Errorf("within field must be a number: %v", err)
+		}
+		if w <= 0 {
+			return nil, errors.New("within field must be positive")
+		}
+		f.within = time.Duration(w * float64(time.Second))
+	}
+
+	if v, ok := spec["max_runs"]; ok {
+		n, err := data.AsInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("max_runs field must be an integer: %v", err)
+		}
+		if n <= 0 {
+			return nil, errors.New("max_runs field must be positive")
+		}
+		f.maxRuns = int(n)
+	}
+
+	if err := decl.Input(stream, nil); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *patternUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	var key data.Value = data.Null{}
+	if f.partition != nil {
+		v, err := f.partition.Eval(t.Data)
+		if err != nil {
+			return fmt.Errorf("cannot compute the partition key: %v", err)
+		}
+		key = v
+	}
+
+	f.m.Lock()
+	match := f.process(key, t)
+	f.m.Unlock()
+
+	if match == nil {
+		return nil
+	}
+	out := make(data.Map, len(f.steps))
+	for i, s := range f.steps {
+		out[s.name] = match.matched[i].Data
+	}
+	res := core.NewTuple(out)
+	res.Timestamp = t.Timestamp
+	return w.Write(ctx, res)
+}
+
+// process updates candidates of the partition with the tuple. It returns
+// a completed match if any.
+func (f *patternUDSF) process(key data.Value, t *core.Tuple) *patternRun {
+	f.sweep(t.Timestamp)
+
+	h := data.Hash(key)
+	var p *patternPartition
+	for _, c := range f.partitions[h] {
+		if data.Equal(c.key, key) {
+			p = c
+			break
+		}
+	}
+
+	var runs []*patternRun
+	if p != nil {
+		runs = p.runs
+	}
+	newRuns := runs[:0]
+	for _, r := range runs {
+		if f.expired(r, t.Timestamp) {
+			continue
+		}
+		if f.satisfies(r, t) {
+			r.matched = append(r.matched, t)
+			if len(r.matched) == len(f.steps) {
+				f.removePartition(h, p)
+				return r
+			}
+		}
+		newRuns = append(newRuns, r)
+	}
+
+	r := &patternRun{}
+	if f.satisfies(r, t) {
+		r.matched = append(r.matched, t)
+		if len(f.steps) == 1 {
+			if p != nil {
+				f.removePartition(h, p)
+			}
+			return r
+		}
+		newRuns = append(newRuns, r)
+	}
+	if len(newRuns) > f.maxRuns {
+		// discard the oldest candidates
+		newRuns = append(newRuns[:0], newRuns[len(newRuns)-f.maxRuns:]...)
+	}
+
+	switch {
+	case len(newRuns) == 0:
+		if p != nil {
+			f.removePartition(h, p)
+		}
+	case p == nil:
+		f.partitions[h] = append(f.partitions[h], &patternPartition{
+			key:  key,
+			runs: newRuns,
+		})
+	default:
+		p.runs = newRuns
+	}
+	return nil
+}
+
+// expired returns true when the candidate can no longer be completed by
+// a tuple having the timestamp.
+func (f *patternUDSF) expired(r *patternRun, now time.Time) bool {
+	return f.within > 0 && now.Sub(r.matched[0].Timestamp) > f.within
+}
+
+// sweep removes expired candidates from all partitions. It only scans
+// partitions once in within so that the cost is amortized over tuples.
+func (f *patternUDSF) sweep(now time.Time) {
+	if f.within <= 0 || now.Sub(f.lastSweep) < f.within {
+		return
+	}
+	f.lastSweep = now
+
+	for h, ps := range f.partitions {
+		newPs := ps[:0]
+		for _, p := range ps {
+			runs := p.runs[:0]
+			for _, r := range p.runs {
+				if !f.expired(r, now) {
+					runs = append(runs, r)
+				}
+			}
+			p.runs = runs
+			if len(runs) > 0 {
+				newPs = append(newPs, p)
+			}
+		}
+		if len(newPs) == 0 {
+			delete(f.partitions, h)
+		} else {
+			f.partitions[h] = newPs
+		}
+	}
+}
+
+func (f *patternUDSF) removePartition(h data.HashValue, p *patternPartition) {
+	ps := f.partitions[h]
+	for i, c := range ps {
+		if c == p {
+			ps = append(ps[:i], ps[i+1:]...)
+			break
+		}
+	}
+	if len(ps) == 0 {
+		delete(f.partitions, h)
+	} else {
+		f.partitions[h] = ps
+	}
+}
+
+// satisfies returns true when the tuple satisfies the condition of the
+// next step of the candidate.
+func (f *patternUDSF) satisfies(r *patternRun, t *core.Tuple) bool {
+	step := f.steps[len(r.matched)]
+	input := make(data.Map, len(r.matched)+1)
+	for i, m := range r.matched {
+		input[f.steps[i].name] = m.Data
+	}
+	input[step.name] = t.Data
+
+	v, err := step.cond.Eval(input)
+	if err != nil || v.Type() == data.TypeNull {
+		return false
+	}
+	b, err := data.AsBool(v)
+	return err == nil && b
+}
+
+func (f *patternUDSF) Terminate(ctx *core.Context) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.partitions = map[data.HashValue][]*patternPartition{}
+	return nil
+}
@@ -0,0 +1,259 @@
+package bql
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestPatternUDSF(t *testing.T) {
+	ctx := core.NewContext(nil)
+	reg := udf.CopyGlobalUDFRegistry(ctx)
+	base := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+
+	Convey("Given a pattern UDSF detecting a rise, an open door, and a pressure drop", t, func() {
+		decl := udf.NewUDSFDeclarer()
+		f, err := newPatternUDSF(decl, reg, "sensors", data.Map{
+			"pattern": data.Array{data.String("hot"), data.String("open"), data.String("drop")},
+			"define": data.Map{
+				"hot":  data.String("temp > 80"),
+				"open": data.String("door"),
+				"drop": data.String("pressure < hot:pressure"),
+			},
+			"partition_by": data.String("id"),
+			"within":       data.Int(10),
+		})
+		So(err, ShouldBeNil)
+
+		var out []*core.Tuple
+		w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+			out = append(out, t)
+			return nil
+		})
+		send := func(sec int, m data.Map) {
+			t := core.NewTuple(m)
+			t.Timestamp = base.Add(time.Duration(sec) * time.Second)
+			So(f.Process(ctx, t, w), ShouldBeNil)
+		}
+
+		Convey("Then it should read the input stream", func() {
+			So(decl.ListInputs(), ShouldContainKey, "sensors")
+		})
+
+		Convey("When tuples match the pattern with other tuples in between", func() {
+			send(0, data.Map{"id": data.Int(1), "temp": data.Int(85), "pressure": data.Int(100)})
+			send(1, data.Map{"id": data.Int(1), "temp": data.Int(70), "door": data.False})
+			send(2, data.Map{"id": data.Int(2), "door": data.True})
+			send(3, data.Map{"id": data.Int(1), "door": data.True})
+			send(4, data.Map{"id": data.Int(1), "pressure": data.Int(120)})
+			send(5, data.Map{"id": data.Int(1), "pressure": data.Int(90)})
+
+			Convey("Then one match should be emitted", func() {
+				So(len(out), ShouldEqual, 1)
+				m := out[0]
+				So(m.Timestamp, ShouldResemble, base.Add(5*time.Second))
+				So(m.Data["hot"], ShouldResemble, data.Map{
+					"id": data.Int(1), "temp": data.Int(85), "pressure": data.Int(100)})
+				So(m.Data["open"], ShouldResemble, data.Map{"id": data.Int(1), "door": data.True})
+				So(m.Data["drop"], ShouldResemble, data.Map{"id": data.Int(1), "pressure": data.Int(90)})
+			})
+
+			Convey("Then no candidate should be left", func() {
+				So(f.partitions, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When tuples of different keys are interleaved", func() {
+			send(0, data.Map{"id": data.Int(1), "temp": data.Int(90), "pressure": data.Int(100)})
+			send(1, data.Map{"id": data.Int(2), "temp": data.Int(90), "pressure": data.Int(50)})
+			send(2, data.Map{"id": data.Int(2), "door": data.True})
+			send(3, data.Map{"id": data.Int(1), "door": data.True})
+			send(4, data.Map{"id": data.Int(2), "pressure": data.Int(60)})
+			send(5, data.Map{"id": data.Int(1), "pressure": data.Int(60)})
+
+			Convey("Then only the key satisfying all conditions should match", func() {
+				So(len(out), ShouldEqual, 1)
+				So(out[0].Data["hot"].(data.Map)["id"], ShouldEqual, data.Int(1))
+			})
+		})
+
+		Convey("When the last step comes too late", func() {
+			send(0, data.Map{"id": data.Int(1), "temp": data.Int(85), "pressure": data.Int(100)})
+			send(3, data.Map{"id": data.Int(1), "door": data.True})
+			send(11, data.Map{"id": data.Int(1), "pressure": data.Int(90)})
+
+			Convey("Then nothing should be emitted", func() {
+				So(out, ShouldBeEmpty)
+				So(f.partitions, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When another candidate starts before the first one expires", func() {
+			send(0, data.Map{"id": data.Int(1), "temp": data.Int(85), "pressure": data.Int(100)})
+			send(6, data.Map{"id": data.Int(1), "temp": data.Int(95), "pressure": data.Int(80)})
+			send(8, data.Map{"id": data.Int(1), "door": data.True})
+			send(12, data.Map{"id": data.Int(1), "pressure": data.Int(70)})
+
+			Convey("Then the candidate within the time limit should match", func() {
+				So(len(out), ShouldEqual, 1)
+				So(out[0].Data["hot"].(data.Map)["temp"], ShouldEqual, data.Int(95))
+			})
+		})
+
+		Convey("When a candidate of a key expires without another tuple of the key", func() {
+			send(0, data.Map{"id": data.Int(1), "temp": data.Int(85), "pressure": data.Int(100)})
+			send(11, data.Map{"id": data.Int(2), "door": data.True})
+
+			Convey("Then it should be removed", func() {
+				So(f.partitions, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When keys have different types but are equal", func() {
+			send(0, data.Map{"id": data.Int(1), "temp": data.Int(85), "pressure": data.Int(100)})
+			send(1, data.Map{"id": data.Float(1), "door": data.True})
+			send(2, data.Map{"id": data.Int(1), "pressure": data.Int(90)})
+
+			Convey("Then they should be in the same partition", func() {
+				So(len(out), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a tuple doesn't have the partition key", func() {
+			t := core.NewTuple(data.Map{"temp": data.Int(85), "pressure": data.Int(100)})
+			err := f.Process(ctx, t, w)
+
+			Convey("Then it should be dropped with an error", func() {
+				So(err, ShouldNotBeNil)
+				So(f.partitions, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a pattern UDSF without partitioning and time limit", t, func() {
+		f, err := newPatternUDSF(udf.NewUDSFDeclarer(), reg, "s", data.Map{
+			"pattern": data.Array{data.String("a"), data.String("b")},
+			"define": data.Map{
+				"a": data.String("int % 2 = 1"),
+				"b": data.String("int = a:int + 1"),
+			},
+			"max_runs": data.Int(2),
+		})
+		So(err, ShouldBeNil)
+
+		var out []*core.Tuple
+		w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+			out = append(out, t)
+			return nil
+		})
+
+		Convey("When sending a sequence of integers", func() {
+			for i := 1; i <= 6; i++ {
+				So(f.Process(ctx, core.NewTuple(data.Map{"int": data.Int(i)}), w), ShouldBeNil)
+			}
+
+			Convey("Then each pair should match", func() {
+				So(len(out), ShouldEqual, 3)
+				for i, t := range out {
+					So(t.Data["a"], ShouldResemble, data.Map{"int": data.Int(2*i + 1)})
+					So(t.Data["b"], ShouldResemble, data.Map{"int": data.Int(2*i + 2)})
+				}
+			})
+		})
+
+		Convey("When more candidates than max_runs are started", func() {
+			for _, i := range []int{1, 3, 5, 2, 4} {
+				So(f.Process(ctx, core.NewTuple(data.Map{"int": data.Int(i)}), w), ShouldBeNil)
+			}
+
+			Convey("Then the oldest candidate should be discarded", func() {
+				So(len(out), ShouldEqual, 1)
+				So(out[0].Data["a"], ShouldResemble, data.Map{"int": data.Int(3)})
+			})
+		})
+	})
+
+	invalidSpecs := []data.Map{
+		{"define": data.Map{"a": data.String("x")}},
+		{"pattern": data.Array{data.String("a")}},
+		{"pattern": data.Array{}, "define": data.Map{}},
+		{"pattern": data.String("a"), "define": data.Map{"a": data.String("x")}},
+		{"pattern": data.Array{data.Int(1)}, "define": data.Map{"a": data.String("x")}},
+		{"pattern": data.Array{data.String("A-1")}, "define": data.Map{"A-1": data.String("x")}},
+		{"pattern": data.Array{data.String("a"), data.String("a")}, "define": data.Map{"a": data.String("x")}},
+		{"pattern": data.Array{data.String("a")}, "define": data.Map{"a": data.String("x"), "b": data.String("y")}},
+		{"pattern": data.Array{data.String("a")}, "define": data.Map{"a": data.String("x >")}},
+		{"pattern": data.Array{data.String("a")}, "define": data.Map{"a": data.String("b:x")}},
+		{"pattern": data.Array{data.String("a"), data.String("b")},
+			"define": data.Map{"a": data.String("b:x"), "b": data.String("x")}},
+		{"pattern": data.Array{data.String("a")}, "define": data.Map{"a": data.String("count(x)")}},
+		{"pattern": data.Array{data.String("a")}, "define": data.Map{"a": data.String("no_such_func(x)")}},
+		{"pattern": data.Array{data.String("a")}, "define": data.Map{"a": data.String("x")},
+			"partition_by": data.String("s:x")},
+		{"pattern": data.Array{data.String("a")}, "define": data.Map{"a": data.String("x")},
+			"within": data.Int(-1)},
+		{"pattern": data.Array{data.String("a")}, "define": data.Map{"a": data.String("x")},
+			"max_runs": data.Int(0)},
+	}
+	for i, spec := range invalidSpecs {
+		spec := spec
+
+		Convey(fmt.Sprintf("Given an invalid spec #%d", i), t, func() {
+			Convey("When creating a pattern UDSF", func() {
+				_, err := newPatternUDSF(udf.NewUDSFDeclarer(), reg, "s", spec)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
+	}
+}
+
+func TestMatchPatternUDSF(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a paused source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy WITH num=8`), ShouldBeNil)
+
+		Convey("When creating a stream with match_pattern", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM t AS SELECT RSTREAM a.int AS a, b.int AS b FROM match_pattern("s", {
+					"pattern": ["a", "b"],
+					"define": {"a": "int % 3 = 0", "b": "int > a:int + 1"}
+				}) [RANGE 1 TUPLES];
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM t;
+				RESUME SOURCE s;
+			`), ShouldBeNil)
+
+			Convey("Then matches should be emitted", func() {
+				sink, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(2)
+				So(si.get(0).Data, ShouldResemble, data.Map{"a": data.Int(3), "b": data.Int(5)})
+				So(si.get(1).Data, ShouldResemble, data.Map{"a": data.Int(6), "b": data.Int(8)})
+			})
+		})
+
+		Convey("When creating a stream with an invalid pattern", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT RSTREAM * FROM match_pattern("s", {
+					"pattern": ["a"], "define": {"a": "no_such_func(int)"}
+				}) [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
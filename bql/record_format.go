@@ -0,0 +1,133 @@
+package bql
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// RecordDecoder reads records from an underlying stream.
+type RecordDecoder interface {
+	// Decode returns the next record in the stream. It returns io.EOF when
+	// the stream doesn't have any more record. When a record is malformed,
+	// it returns *RecordError and the caller can continue to decode
+	// following records. Other errors aren't recoverable.
+	Decode() (data.Map, error)
+}
+
+// RecordEncoder writes records to an underlying stream.
+type RecordEncoder interface {
+	// Encode writes a record to the stream.
+	Encode(m data.Map) error
+}
+
+// RecordFormat creates decoders and encoders of a specific format such as
+// JSON Lines or CSV. It's used by sources and sinks reading or writing
+// records from or to byte streams.
+type RecordFormat interface {
+	// NewDecoder returns a RecordDecoder reading records from r.
+	NewDecoder(r io.Reader) RecordDecoder
+
+	// NewEncoder returns a RecordEncoder writing records to w.
+	NewEncoder(w io.Writer) RecordEncoder
+}
+
+// RecordError is returned from RecordDecoder.Decode when a record is
+// malformed.
+type RecordError struct {
+	// Index is the 0-origin position of the record in the stream. Line-based
+	// formats use the line number of the record.
+	Index int
+
+	// Body is the raw representation of the record if available.
+	Body string
+
+	// Err is the cause of the error.
+	Err error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("record %v is malformed: %v", e.Index, e.Err)
+}
+
+// RecordFormatCreator is an interface which creates a RecordFormat.
+type RecordFormatCreator interface {
+	// CreateRecordFormat creates a new RecordFormat using parameters given to
+	// a source or a sink. Parameters which aren't related to the format
+	// should be ignored.
+	CreateRecordFormat(params data.Map) (RecordFormat, error)
+}
+
+type recordFormatCreatorFunc func(data.Map) (RecordFormat, error)
+
+func (f recordFormatCreatorFunc) CreateRecordFormat(params data.Map) (RecordFormat, error) {
+	return f(params)
+}
+
+// RecordFormatCreatorFunc creates a RecordFormatCreator from a function.
+func RecordFormatCreatorFunc(f func(data.Map) (RecordFormat, error)) RecordFormatCreator {
+	return recordFormatCreatorFunc(f)
+}
+
+var (
+	globalRecordFormatCreatorsMutex sync.RWMutex
+	globalRecordFormatCreators      = map[string]RecordFormatCreator{}
+)
+
+// RegisterGlobalRecordFormatCreator adds a RecordFormatCreator which can be
+// referred from sources and sinks supporting "format" parameter. Call it from
+// init functions.
+func RegisterGlobalRecordFormatCreator(name string, c RecordFormatCreator) error {
+	// The name isn't validated by core.ValidateSymbol because it's given as
+	// a string parameter and can be a reserved word such as "raw".
+	if name == "" {
+		return errors.New("the name of a record format must not be empty")
+	}
+
+	globalRecordFormatCreatorsMutex.Lock()
+	defer globalRecordFormatCreatorsMutex.Unlock()
+
+	lowerName := strings.ToLower(name)
+	if _, ok := globalRecordFormatCreators[lowerName]; ok {
+		return fmt.Errorf("record format '%v' is already registered", name)
+	}
+	globalRecordFormatCreators[lowerName] = c
+	return nil
+}
+
+// MustRegisterGlobalRecordFormatCreator is like
+// RegisterGlobalRecordFormatCreator but panics if an error occurred.
+func MustRegisterGlobalRecordFormatCreator(name string, c RecordFormatCreator) {
+	if err := RegisterGlobalRecordFormatCreator(name, c); err != nil {
+		panic(fmt.Errorf("bql.MustRegisterGlobalRecordFormatCreator: cannot register '%v': %v", name, err))
+	}
+}
+
+// LookupGlobalRecordFormatCreator returns a RecordFormatCreator having the
+// name. It returns core.NotExistError if the format isn't registered.
+func LookupGlobalRecordFormatCreator(name string) (RecordFormatCreator, error) {
+	globalRecordFormatCreatorsMutex.RLock()
+	defer globalRecordFormatCreatorsMutex.RUnlock()
+	if c, ok := globalRecordFormatCreators[strings.ToLower(name)]; ok {
+		return c, nil
+	}
+	return nil, core.NotExistError(fmt.Errorf("record format '%v' is not registered", name))
+}
+
+// newRecordFormat creates a RecordFormat having the name with parameters
+// given to a source or a sink. "jsonl" is used when the name is empty.
+func newRecordFormat(name string, params data.Map) (RecordFormat, error) {
+	if name == "" {
+		name = "jsonl"
+	}
+	c, err := LookupGlobalRecordFormatCreator(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateRecordFormat(params)
+}
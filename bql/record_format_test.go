@@ -0,0 +1,303 @@
+package bql
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func decodeAllRecords(f RecordFormat, s string) ([]data.Map, []*RecordError, error) {
+	var (
+		ms   []data.Map
+		errs []*RecordError
+	)
+	dec := f.NewDecoder(strings.NewReader(s))
+	for {
+		m, err := dec.Decode()
+		if err == io.EOF {
+			return ms, errs, nil
+		} else if e, ok := err.(*RecordError); ok {
+			errs = append(errs, e)
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+		ms = append(ms, m)
+	}
+}
+
+func TestRecordFormatDecoders(t *testing.T) {
+	cases := []struct {
+		title    string
+		params   data.Map
+		input    string
+		expected []data.Map
+		invalid  []int
+	}{
+		{"jsonl", data.Map{"format": data.String("jsonl")},
+			"{\"a\":1}\n\n  {\"a\":\"b\"}\n{invalid\n{\"a\":2}",
+			[]data.Map{{"a": data.Int(1)}, {"a": data.String("b")}, {"a": data.Int(2)}},
+			[]int{3}},
+		{"csv with a header", data.Map{"format": data.String("csv")},
+			"a,b,c\n1,1.5,x\n-2,,\"y,z\"\ntrue,1,2,3\nFALSE,nan,\n",
+			[]data.Map{
+				{"a": data.Int(1), "b": data.Float(1.5), "c": data.String("x")},
+				{"a": data.Int(-2), "b": data.Null{}, "c": data.String("y,z")},
+				{"a": data.False, "b": data.String("nan"), "c": data.Null{}},
+			},
+			[]int{3}},
+		{"csv with columns", data.Map{"format": data.String("csv"), "header": data.False,
+			"columns": data.Array{data.String("x"), data.String("y")}, "infer_types": data.False},
+			"1,2\n3,4\n",
+			[]data.Map{{"x": data.String("1"), "y": data.String("2")}, {"x": data.String("3"), "y": data.String("4")}},
+			nil},
+		{"csv overriding a header", data.Map{"format": data.String("csv"),
+			"columns": data.Array{data.String("x"), data.String("y")}},
+			"a,b\n1,2\n",
+			[]data.Map{{"x": data.Int(1), "y": data.Int(2)}},
+			nil},
+		{"tsv", data.Map{"format": data.String("tsv")},
+			"a\tb\nx y\t1\n",
+			[]data.Map{{"a": data.String("x y"), "b": data.Int(1)}},
+			nil},
+		{"raw", data.Map{"format": data.String("raw")},
+			"a b\r\n\n{\"c\":1}",
+			[]data.Map{{"line": data.String("a b")}, {"line": data.String("")}, {"line": data.String(`{"c":1}`)}},
+			nil},
+		{"raw with a field", data.Map{"format": data.String("raw"), "field": data.String("text")},
+			"a\n",
+			[]data.Map{{"text": data.String("a")}},
+			nil},
+	}
+
+	for _, c := range cases {
+		c := c
+		Convey(fmt.Sprintf("Given a %v format", c.title), t, func() {
+			f, err := newRecordFormat(string(c.params["format"].(data.String)), c.params)
+			So(err, ShouldBeNil)
+
+			Convey("When decoding records", func() {
+				ms, errs, err := decodeAllRecords(f, c.input)
+				So(err, ShouldBeNil)
+
+				Convey("Then valid records should be decoded", func() {
+					So(ms, ShouldResemble, c.expected)
+				})
+
+				Convey("Then malformed records should be reported", func() {
+					So(len(errs), ShouldEqual, len(c.invalid))
+					for i, e := range errs {
+						So(e.Index, ShouldEqual, c.invalid[i])
+					}
+				})
+			})
+		})
+	}
+}
+
+func TestRecordFormatEncoders(t *testing.T) {
+	records := []data.Map{
+		{"a": data.Int(1), "b": data.String("x,y"), "c": data.Array{data.Int(1)}},
+		{"a": data.Float(1.5), "b": data.Null{}, "d": data.True},
+	}
+
+	cases := []struct {
+		title    string
+		params   data.Map
+		expected string
+	}{
+		{"jsonl", data.Map{}, "{\"a\":1,\"b\":\"x,y\",\"c\":[1]}\n{\"a\":1.5,\"b\":null,\"d\":true}\n"},
+		{"csv", data.Map{"format": data.String("csv")},
+			"a,b,c\n1,\"x,y\",[1]\n1.5,,\n"},
+		{"csv with columns", data.Map{"format": data.String("csv"), "header": data.False,
+			"columns": data.Array{data.String("a"), data.String("d")}},
+			"1,\n1.5,true\n"},
+		{"tsv", data.Map{"format": data.String("tsv")},
+			"a\tb\tc\n1\tx,y\t[1]\n1.5\t\t\n"},
+		{"raw", data.Map{"format": data.String("raw"), "field": data.String("a")},
+			"1\n1.5\n"},
+	}
+
+	for _, c := range cases {
+		c := c
+		Convey(fmt.Sprintf("Given a %v format", c.title), t, func() {
+			name, _ := c.params["format"].(data.String)
+			f, err := newRecordFormat(string(name), c.params)
+			So(err, ShouldBeNil)
+
+			Convey("When encoding records", func() {
+				buf := bytes.NewBuffer(nil)
+				enc := f.NewEncoder(buf)
+				for _, r := range records {
+					So(enc.Encode(r), ShouldBeNil)
+				}
+
+				Convey("Then they should be written in the format", func() {
+					So(buf.String(), ShouldEqual, c.expected)
+				})
+			})
+		})
+	}
+
+	Convey("Given a msgpack format", t, func() {
+		f, err := newRecordFormat("msgpack", data.Map{})
+		So(err, ShouldBeNil)
+
+		Convey("When encoding and decoding records", func() {
+			buf := bytes.NewBuffer(nil)
+			enc := f.NewEncoder(buf)
+			for _, r := range records {
+				So(enc.Encode(r), ShouldBeNil)
+			}
+			ms, errs, err := decodeAllRecords(f, buf.String())
+			So(err, ShouldBeNil)
+
+			Convey("Then the same records should be decoded", func() {
+				So(errs, ShouldBeEmpty)
+				So(ms, ShouldResemble, records)
+			})
+		})
+	})
+
+	Convey("Given a raw format", t, func() {
+		f, err := newRecordFormat("raw", data.Map{})
+		So(err, ShouldBeNil)
+
+		Convey("When encoding a record not having the field", func() {
+			err := f.NewEncoder(bytes.NewBuffer(nil)).Encode(data.Map{"a": data.Int(1)})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestRecordFormatParams(t *testing.T) {
+	invalid := []struct {
+		name   string
+		params data.Map
+	}{
+		{"no_such_format", data.Map{}},
+		{"csv", data.Map{"header": data.False}},
+		{"csv", data.Map{"delimiter": data.String(";;")}},
+		{"csv", data.Map{"delimiter": data.String("")}},
+		{"tsv", data.Map{"header": data.String("a")}},
+		{"raw", data.Map{"field": data.String("")}},
+	}
+	for _, c := range invalid {
+		c := c
+		Convey(fmt.Sprintf("When creating a %v format with %v", c.name, c.params), t, func() {
+			_, err := newRecordFormat(c.name, c.params)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	}
+
+	Convey("When creating a format without a name", t, func() {
+		f, err := newRecordFormat("", data.Map{})
+
+		Convey("Then jsonl should be used", func() {
+			So(err, ShouldBeNil)
+			So(f, ShouldHaveSameTypeAs, jsonlFormat{})
+		})
+	})
+
+	Convey("When registering a format which already exists", t, func() {
+		err := RegisterGlobalRecordFormatCreator("CSV", RecordFormatCreatorFunc(createCSVFormat))
+
+		Convey("Then it should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestFileSourceAndSinkWithFormat(t *testing.T) {
+	ctx := core.NewContext(nil)
+	Convey("Given a file sink writing CSV", t, func() {
+		tdir, err := ioutil.TempDir("", "test_sb_record_format")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(tdir)
+		})
+		params := data.Map{
+			"path":   data.String(filepath.Join(tdir, "out.csv")),
+			"format": data.String("csv"),
+		}
+		si, err := createFileSink(ctx, &IOParams{}, params)
+		So(err, ShouldBeNil)
+		for i := 0; i < 3; i++ {
+			So(si.Write(ctx, core.NewTuple(data.Map{"i": data.Int(i), "s": data.String(fmt.Sprint("v", i))})), ShouldBeNil)
+		}
+		So(si.Close(ctx), ShouldBeNil)
+
+		Convey("When reading the file by a file source with the same format", func() {
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Stop(ctx)
+			})
+			w := newRecordCollectingWriter()
+			So(s.GenerateStream(ctx, w), ShouldBeNil)
+
+			Convey("Then it should emit the written tuples", func() {
+				So(len(w.ms), ShouldEqual, 3)
+				for i, m := range w.ms {
+					So(m, ShouldResemble, data.Map{"i": data.Int(i), "s": data.String(fmt.Sprint("v", i))})
+				}
+			})
+		})
+	})
+
+	Convey("When creating a file source with an unknown format", t, func() {
+		_, err := createFileSource(ctx, &IOParams{}, data.Map{
+			"path":   data.String("a.txt"),
+			"format": data.String("no_such_format"),
+		})
+
+		Convey("Then it should fail", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+type recordCollectingWriter struct {
+	m  sync.Mutex
+	c  *sync.Cond
+	ms []data.Map
+}
+
+func newRecordCollectingWriter() *recordCollectingWriter {
+	w := &recordCollectingWriter{}
+	w.c = sync.NewCond(&w.m)
+	return w
+}
+
+func (w *recordCollectingWriter) Write(ctx *core.Context, t *core.Tuple) error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.ms = append(w.ms, t.Data)
+	w.c.Broadcast()
+	return nil
+}
+
+func (w *recordCollectingWriter) wait(n int) []data.Map {
+	w.m.Lock()
+	defer w.m.Unlock()
+	for len(w.ms) < n {
+		w.c.Wait()
+	}
+	return append([]data.Map(nil), w.ms...)
+}
@@ -0,0 +1,252 @@
+package bql
+
+import (
+	"fmt"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// streamSchema validates tuples emitted by a source or a stream with a
+// schema declared by CREATE SOURCE ... SCHEMA (...) or CREATE STREAM
+// ... (...) AS SELECT. Values of declared fields are converted to the
+// declared types in the same way as CAST. Fields which aren't declared
+// are kept as they are.
+type streamSchema struct {
+	fields []*schemaField
+}
+
+type schemaField struct {
+	name    string
+	typ     parser.Type
+	typeID  data.TypeID
+	notNull bool
+	conv    func(data.Value) (data.Value, error)
+}
+
+// newStreamSchema creates a streamSchema from the AST. It returns nil
+// when the schema doesn't have any field.
+func newStreamSchema(ast parser.SchemaAST) (*streamSchema, error) {
+	if len(ast.Fields) == 0 {
+		return nil, nil
+	}
+	s := &streamSchema{}
+	for _, f := range ast.Fields {
+		id, conv, err := schemaConverter(f.Type)
+		if err != nil {
+			return nil, err
+		}
+		s.fields = append(s.fields, &schemaField{
+			name:    string(f.Name),
+			typ:     f.Type,
+			typeID:  id,
+			notNull: f.NotNull == parser.Yes,
+			conv:    conv,
+		})
+	}
+	return s, nil
+}
+
+func schemaConverter(t parser.Type) (data.TypeID, func(data.Value) (data.Value, error), error) {
+	switch t {
+	case parser.Bool:
+		return data.TypeBool, func(v data.Value) (data.Value, error) {
+			x, err := data.ToBool(v)
+			return data.Bool(x), err
+		}, nil
+	case parser.Int:
+		return data.TypeInt, func(v data.Value) (data.Value, error) {
+			x, err := data.ToInt(v)
+			return data.Int(x), err
+		}, nil
+	case parser.Float:
+		return data.TypeFloat, func(v data.Value) (data.Value, error) {
+			x, err := data.ToFloat(v)
+			return data.Float(x), err
+		}, nil
+	case parser.String:
+		return data.TypeString, func(v data.Value) (data.Value, error) {
+			x, err := data.ToString(v)
+			return data.String(x), err
+		}, nil
+	case parser.Blob:
+		return data.TypeBlob, func(v data.Value) (data.Value, error) {
+			x, err := data.ToBlob(v)
+			return data.Blob(x), err
+		}, nil
+	case parser.Timestamp:
+		return data.TypeTimestamp, func(v data.Value) (data.Value, error) {
+			x, err := data.ToTimestamp(v)
+			return data.Timestamp(x), err
+		}, nil
+	case parser.Array:
+		// arrays and maps cannot be converted from other types
+		return data.TypeArray, func(v data.Value) (data.Value, error) {
+			return data.AsArray(v)
+		}, nil
+	case parser.Map:
+		return data.TypeMap, func(v data.Value) (data.Value, error) {
+			return data.AsMap(v)
+		}, nil
+	}
+	return 0, nil, fmt.Errorf("no converter for type %s known", t)
+}
+
+// validate checks if the tuple satisfies the schema. It returns a tuple
+// having converted values. The given tuple is returned as it is when no
+// conversion is necessary. Otherwise, a new tuple is returned so that
+// the data of the given tuple isn't modified.
+func (s *streamSchema) validate(t *core.Tuple) (*core.Tuple, error) {
+	var converted data.Map
+	for _, f := range s.fields {
+		v, ok := t.Data[f.name]
+		if !ok || v.Type() == data.TypeNull {
+			if !f.notNull {
+				continue
+			}
+			if !ok {
+				return nil, fmt.Errorf("field '%s' is missing", f.name)
+			}
+			return nil, fmt.Errorf("field '%s' must not be NULL", f.name)
+		}
+		if v.Type() == f.typeID {
+			continue
+		}
+		c, err := f.conv(v)
+		if err != nil {
+			return nil, fmt.Errorf("field '%s' cannot be converted to %s: %v", f.name, f.typ, err)
+		}
+		if converted == nil {
+			converted = data.Map{}
+		}
+		converted[f.name] = c
+	}
+	if converted == nil {
+		return t, nil
+	}
+
+	res := t.ShallowCopy()
+	res.Data = make(data.Map, len(t.Data))
+	for k, v := range t.Data {
+		res.Data[k] = v
+	}
+	for k, v := range converted {
+		res.Data[k] = v
+	}
+	return res, nil
+}
+
+// meta returns the description of the schema. It's set to the meta
+// information of the node having the schema.
+func (s *streamSchema) meta() data.Map {
+	fields := make(data.Array, len(s.fields))
+	for i, f := range s.fields {
+		fields[i] = data.Map{
+			"name":     data.String(f.name),
+			"type":     data.String(f.typ.String()),
+			"not_null": data.Bool(f.notNull),
+		}
+	}
+	return data.Map{
+		"schema": fields,
+	}
+}
+
+// schemaWriter validates tuples with the schema before writing them to
+// the underlying writer. Tuples violating the schema are reported as
+// dropped tuples of the node.
+type schemaWriter struct {
+	schema   *streamSchema
+	w        core.Writer
+	nodeType core.NodeType
+	nodeName string
+}
+
+func (s *schemaWriter) Write(ctx *core.Context, t *core.Tuple) error {
+	res, err := s.schema.validate(t)
+	if err != nil {
+		ctx.DroppedTuple(t, s.nodeType, s.nodeName, core.ETOutput, err)
+		return nil
+	}
+	return s.w.Write(ctx, res)
+}
+
+// schemaSource wraps a Source to validate tuples it generates with the
+// schema. Use newSchemaSource to create it because the returned source
+// must implement the same optional interfaces as the original one.
+type schemaSource struct {
+	source core.Source
+	schema *streamSchema
+	name   string
+}
+
+var (
+	_ core.Statuser         = &schemaSource{}
+	_ core.Resumable        = &schemaResumableSource{}
+	_ core.RewindableSource = &schemaRewindableSource{}
+)
+
+func newSchemaSource(s core.Source, schema *streamSchema, name string) core.Source {
+	src := &schemaSource{
+		source: s,
+		schema: schema,
+		name:   name,
+	}
+	if rs, ok := s.(core.RewindableSource); ok {
+		return &schemaRewindableSource{
+			schemaResumableSource: &schemaResumableSource{src, rs},
+			rewindable:            rs,
+		}
+	}
+	if r, ok := s.(core.Resumable); ok {
+		return &schemaResumableSource{src, r}
+	}
+	return src
+}
+
+func (s *schemaSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	return s.source.GenerateStream(ctx, &schemaWriter{
+		schema:   s.schema,
+		w:        w,
+		nodeType: core.NTSource,
+		nodeName: s.name,
+	})
+}
+
+func (s *schemaSource) unwrapSource() core.Source {
+	return s.source
+}
+
+func (s *schemaSource) Stop(ctx *core.Context) error {
+	return s.source.Stop(ctx)
+}
+
+func (s *schemaSource) Status() data.Map {
+	if st, ok := s.source.(core.Statuser); ok {
+		return st.Status()
+	}
+	return data.Map{}
+}
+
+type schemaResumableSource struct {
+	*schemaSource
+	resumable core.Resumable
+}
+
+func (s *schemaResumableSource) Pause(ctx *core.Context) error {
+	return s.resumable.Pause(ctx)
+}
+
+func (s *schemaResumableSource) Resume(ctx *core.Context) error {
+	return s.resumable.Resume(ctx)
+}
+
+type schemaRewindableSource struct {
+	*schemaResumableSource
+	rewindable core.RewindableSource
+}
+
+func (s *schemaRewindableSource) Rewind(ctx *core.Context) error {
+	return s.rewindable.Rewind(ctx)
+}
@@ -0,0 +1,194 @@
+package bql
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestStreamSchema(t *testing.T) {
+	Convey("Given a schema", t, func() {
+		s, err := newStreamSchema(parser.SchemaAST{Fields: []parser.SchemaFieldAST{
+			{"id", parser.Int, parser.Yes},
+			{"temp", parser.Float, parser.UnspecifiedKeyword},
+			{"ts", parser.Timestamp, parser.UnspecifiedKeyword},
+			{"tags", parser.Array, parser.UnspecifiedKeyword},
+		}})
+		So(err, ShouldBeNil)
+
+		ts := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+		valid := []struct {
+			input    data.Map
+			expected data.Map
+		}{
+			{data.Map{"id": data.Int(1), "temp": data.Float(1.5)},
+				data.Map{"id": data.Int(1), "temp": data.Float(1.5)}},
+			{data.Map{"id": data.String("2"), "temp": data.Int(3), "other": data.String("a")},
+				data.Map{"id": data.Int(2), "temp": data.Float(3), "other": data.String("a")}},
+			{data.Map{"id": data.Float(3.7), "temp": data.Null{}},
+				data.Map{"id": data.Int(3), "temp": data.Null{}}},
+			{data.Map{"id": data.Int(4), "ts": data.String("2015-05-01T14:27:00Z")},
+				data.Map{"id": data.Int(4), "ts": data.Timestamp(ts)}},
+			{data.Map{"id": data.Int(5), "tags": data.Array{data.String("a")}},
+				data.Map{"id": data.Int(5), "tags": data.Array{data.String("a")}}},
+		}
+		for _, tc := range valid {
+			tc := tc
+
+			Convey(fmt.Sprintf("When validating %v", tc.input), func() {
+				input := tc.input.Copy()
+				res, err := s.validate(core.NewTuple(input))
+
+				Convey("Then it should be converted", func() {
+					So(err, ShouldBeNil)
+					So(res.Data, ShouldResemble, tc.expected)
+				})
+
+				Convey("Then the original data should not be modified", func() {
+					So(input, ShouldResemble, tc.input)
+				})
+			})
+		}
+
+		invalid := []data.Map{
+			{"temp": data.Float(1)},
+			{"id": data.Null{}},
+			{"id": data.String("a")},
+			{"id": data.Int(1), "temp": data.Map{}},
+			{"id": data.Int(1), "tags": data.String("a")},
+		}
+		for _, input := range invalid {
+			input := input
+
+			Convey(fmt.Sprintf("When validating %v", input), func() {
+				_, err := s.validate(core.NewTuple(input))
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+
+		Convey("When getting meta information", func() {
+			m := s.meta()
+
+			Convey("Then it should describe the fields", func() {
+				So(m["schema"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("id"), "type": data.String("INT"), "not_null": data.True},
+					data.Map{"name": data.String("temp"), "type": data.String("FLOAT"), "not_null": data.False},
+					data.Map{"name": data.String("ts"), "type": data.String("TIMESTAMP"), "not_null": data.False},
+					data.Map{"name": data.String("tags"), "type": data.String("ARRAY"), "not_null": data.False},
+				})
+			})
+		})
+	})
+
+	Convey("Given an empty schema", t, func() {
+		s, err := newStreamSchema(parser.SchemaAST{})
+
+		Convey("Then no schema should be created", func() {
+			So(err, ShouldBeNil)
+			So(s, ShouldBeNil)
+		})
+	})
+}
+
+func TestSchemaInTopology(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder collecting dropped tuples", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `
+			CREATE SOURCE dropped TYPE dropped_tuples;
+			CREATE SINK dk TYPE collector;
+			INSERT INTO dk FROM dropped;
+		`), ShouldBeNil)
+
+		Convey("When creating a source with a schema", func() {
+			So(addBQLToTopology(tb, `
+				CREATE PAUSED SOURCE s TYPE dummy WITH num=4 SCHEMA (int string NOT NULL);
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM s;
+			`), ShouldBeNil)
+			src, err := dt.Source("s")
+			So(err, ShouldBeNil)
+
+			Convey("Then the source should still be rewindable", func() {
+				_, ok := src.Source().(core.RewindableSource)
+				So(ok, ShouldBeTrue)
+			})
+
+			Convey("Then the meta information should have the schema", func() {
+				So(src.Meta(), ShouldResemble, data.Map{"schema": data.Array{
+					data.Map{"name": data.String("int"), "type": data.String("STRING"), "not_null": data.True},
+				}})
+			})
+
+			Convey("And resuming the source", func() {
+				So(src.Resume(), ShouldBeNil)
+
+				Convey("Then converted tuples should be emitted", func() {
+					sink, err := dt.Sink("k")
+					So(err, ShouldBeNil)
+					si := sink.Sink().(*tupleCollectorSink)
+					si.Wait(4)
+					for i := 0; i < 4; i++ {
+						So(si.get(i).Data, ShouldResemble, data.Map{"int": data.String(fmt.Sprint(i + 1))})
+					}
+				})
+			})
+		})
+
+		Convey("When creating a stream with a schema", func() {
+			So(addBQLToTopology(tb, `
+				CREATE PAUSED SOURCE s TYPE dummy WITH num=4;
+				CREATE STREAM t (x int NOT NULL, y float) AS SELECT ISTREAM
+					CASE WHEN int % 2 = 0 THEN int END AS x, int AS y FROM s [RANGE 1 TUPLES];
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM t;
+				RESUME SOURCE s;
+			`), ShouldBeNil)
+
+			Convey("Then valid tuples should be converted", func() {
+				sink, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(2)
+				So(si.get(0).Data, ShouldResemble, data.Map{"x": data.Int(2), "y": data.Float(2)})
+				So(si.get(1).Data, ShouldResemble, data.Map{"x": data.Int(4), "y": data.Float(4)})
+			})
+
+			Convey("Then violating tuples should be reported as dropped tuples", func() {
+				sink, err := dt.Sink("dk")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(2)
+				for i := 0; i < 2; i++ {
+					d := si.get(i).Data
+					So(d["node_type"], ShouldEqual, data.String("box"))
+					So(d["node_name"], ShouldEqual, data.String("t"))
+					So(d["event_type"], ShouldEqual, data.String("output"))
+					So(d["data"], ShouldResemble, data.Map{"x": data.Null{}, "y": data.Int(2*i + 1)})
+					So(d["error"], ShouldEqual, data.String("field 'x' must not be NULL"))
+				}
+			})
+
+			Convey("Then the meta information should have the schema", func() {
+				b, err := dt.Box("t")
+				So(err, ShouldBeNil)
+				So(b.Meta(), ShouldResemble, data.Map{"schema": data.Array{
+					data.Map{"name": data.String("x"), "type": data.String("INT"), "not_null": data.True},
+					data.Map{"name": data.String("y"), "type": data.String("FLOAT"), "not_null": data.False},
+				}})
+			})
+		})
+	})
+}
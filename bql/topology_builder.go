@@ -23,19 +23,26 @@ type TopologyBuilder struct {
 	SourceCreators SourceCreatorRegistry
 	SinkCreators   SinkCreatorRegistry
 	UDSStorage     udf.UDSStorage
-}
 
-// TODO: Provide AtomicTopologyBuilder which support building multiple nodes
-// in an atomic manner (kind of transactionally)
+	// viewMutex protects views.
+	viewMutex sync.RWMutex
+
+	// views has SELECT statements defined by CREATE VIEW. Keys are lower
+	// case names of views. References to other views in the statements
+	// are already expanded to subqueries.
+	views map[string]parser.SelectStmt
+}
 
 // NewTopologyBuilder creates a new TopologyBuilder which dynamically creates
 // nodes from BQL statements. The target Topology can be shared by
 // multiple TopologyBuilders.
 //
-// TopologyBuilder doesn't support atomic topology building. For example,
-// when a user wants to add three statement and the second statement fails,
-// only the node created from the first statement is registered to the topology
-// and it starts to generate tuples. Others won't be registered.
+// Statements added by AddStmt are applied one by one. For example, when a user
+// wants to add three statement and the second statement fails, only the node
+// created from the first statement is registered to the topology and it starts
+// to generate tuples. Others won't be registered. To add multiple statements
+// atomically, use AddStmts or enclose the statements with BEGIN and COMMIT in
+// a Session.
 func NewTopologyBuilder(t core.Topology) (*TopologyBuilder, error) {
 	udsfs, err := udf.CopyGlobalUDSFCreatorRegistry()
 	if err != nil {
@@ -73,6 +80,12 @@ func NewTopologyBuilder(t core.Topology) (*TopologyBuilder, error) {
 		SourceCreators: srcs,
 		SinkCreators:   sinks,
 		UDSStorage:     udf.NewInMemoryUDSStorage(),
+		views:          map[string]parser.SelectStmt{},
+	}
+	// match_pattern builtin UDSF can only be registered here because it
+	// compiles conditions with functions registered to the builder.
+	if err := udsfs.Register("match_pattern", createPatternUDSFCreator(tb)); err != nil {
+		return nil, err
 	}
 	return tb, nil
 }
@@ -95,12 +108,29 @@ func (tb *TopologyBuilder) Topology() core.Topology {
 
 // AddStmt add a node created from a statement to the topology. It returns
 // a created node. It returns a nil node when the statement is CREATE STATE.
+// CREATE FUNCTION and DROP FUNCTION register and unregister functions to Reg
+// and return a nil node. CREATE VIEW and DROP VIEW also return a nil node.
+//
+// BEGIN, COMMIT, and ROLLBACK can only be added to a Session because a
+// transaction is scoped to a session.
 func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
+	switch stmt.(type) {
+	case parser.BeginStmt, parser.CommitStmt, parser.RollbackStmt:
+		return nil, fmt.Errorf("%v can only be used in a session", stmt)
+	}
+	return tb.addStmt(stmt)
+}
+
+func (tb *TopologyBuilder) addStmt(stmt interface{}) (core.Node, error) {
 	// TODO: Enable StopOnDisconnect properly
 
 	// check the type of statement
 	switch stmt := stmt.(type) {
 	case parser.CreateSourceStmt:
+		if err := tb.checkViewName(string(stmt.Name)); err != nil {
+			return nil, err
+		}
+
 		// load params into map for faster access
 		paramsMap := tb.mkParamsMap(stmt.Params)
 
@@ -110,6 +140,11 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			return nil, err
 		}
 
+		schema, err := newStreamSchema(stmt.Schema)
+		if err != nil {
+			return nil, err
+		}
+
 		// if so, try to create such a source
 		source, err := creator.CreateSource(tb.topology.Context(), &IOParams{
 			TypeName: string(stmt.Type),
@@ -118,61 +153,20 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		if err != nil {
 			return nil, err
 		}
-		return tb.topology.AddSource(string(stmt.Name), source, &core.SourceConfig{
+		config := &core.SourceConfig{
 			PausedOnStartup: stmt.Paused == parser.Yes,
-		})
+		}
+		if schema != nil {
+			source = newSchemaSource(source, schema, string(stmt.Name))
+			config.Meta = schema.meta()
+		}
+		return tb.topology.AddSource(string(stmt.Name), source, config)
 
 	case parser.CreateStreamAsSelectStmt:
-		return tb.createStreamAsSelectStmt(&stmt)
+		return tb.createStreamAsSelectStmt(&stmt, nil)
 
 	case parser.CreateStreamAsSelectUnionStmt:
-		// idea: create an intermediate box for each SELECT substatement,
-		// then connect them with a simple forwarder box
-		names := make([]string, 0, len(stmt.Selects))
-		nodes := make([]core.BoxNode, 0, len(stmt.Selects))
-		removeTmpNodes := func() {
-			for _, name := range names {
-				tb.topology.Remove(name)
-			}
-		}
-		for _, selStmt := range stmt.Selects {
-			// create a stream with a generated name and recurse
-			tmpName := fmt.Sprintf("sensorbee_tmp_%v", topologyBuilderNextTemporaryID())
-			tmpStmt := parser.CreateStreamAsSelectStmt{
-				parser.StreamIdentifier(tmpName),
-				selStmt,
-			}
-			box, err := tb.AddStmt(tmpStmt)
-			if err != nil {
-				removeTmpNodes()
-				return nil, err
-			}
-			names = append(names, tmpName)
-			nodes = append(nodes, box.(core.BoxNode))
-		}
-		// simple forwarder box
-		forwardBox := core.BoxFunc(func(ctx *core.Context, t *core.Tuple, w core.Writer) error {
-			return w.Write(ctx, t)
-		})
-		node, err := tb.topology.AddBox(string(stmt.Name), forwardBox, nil)
-		if err != nil {
-			removeTmpNodes()
-			return nil, err
-		}
-		// connect inputs
-		for _, name := range names {
-			if err := node.Input(name, nil); err != nil {
-				removeTmpNodes()
-				return nil, err
-			}
-		}
-		for _, node := range nodes {
-			node.StopOnDisconnect(core.Inbound | core.Outbound)
-			node.RemoveOnStop()
-		}
-		node.StopOnDisconnect(core.Inbound)
-		node.RemoveOnStop()
-		return node, nil
+		return tb.createStreamAsSelectUnionStmt(&stmt, nil)
 
 	case parser.CreateSinkStmt:
 		// load params into map for faster access
@@ -240,7 +234,7 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			c.Type = stmt.Type
 			c.Name = stmt.Name
 			c.Params = stmt.CreateSpecs.Params
-			return tb.AddStmt(c)
+			return tb.addStmt(c)
 		}
 		return nil, err
 
@@ -250,11 +244,15 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			return nil, err
 		}
 
-		u, ok := src.Source().(core.Updater)
+		// The source can be wrapped, e.g. to validate its schema.
+		u, ok := findSource(src.Source(), func(s core.Source) bool {
+			_, ok := s.(core.Updater)
+			return ok
+		})
 		if !ok {
 			return nil, fmt.Errorf("%s cannot be updated", string(stmt.Name))
 		}
-		return nil, u.Update(tb.topology.Context(), tb.mkParamsMap(stmt.Params))
+		return nil, u.(core.Updater).Update(tb.topology.Context(), tb.mkParamsMap(stmt.Params))
 
 	case parser.UpdateSinkStmt:
 		sink, err := tb.topology.Sink(string(stmt.Name))
@@ -302,6 +300,26 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		_, err = ctx.SharedStates.Remove(string(stmt.State))
 		return nil, err
 
+	case parser.CreateFunctionStmt:
+		params := make([]string, len(stmt.Params))
+		for i, p := range stmt.Params {
+			params[i] = string(p)
+		}
+		f, err := execution.NewExpressionUDF(params, stmt.Body, tb.Reg)
+		if err != nil {
+			return nil, err
+		}
+		return nil, tb.Reg.Register(string(stmt.Name), f)
+
+	case parser.DropFunctionStmt:
+		return nil, dropExpressionUDF(tb.Reg, string(stmt.Function))
+
+	case parser.CreateViewStmt:
+		return nil, tb.createView(&stmt)
+
+	case parser.DropViewStmt:
+		return nil, tb.dropView(string(stmt.View))
+
 	case parser.InsertIntoFromStmt:
 		// get the sink to add an input to
 		sink, err := tb.topology.Sink(string(stmt.Sink))
@@ -408,21 +426,120 @@ func (s *udsfSource) Stop(ctx *core.Context) error {
 	return s.f.Terminate(ctx)
 }
 
-func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsSelectStmt) (core.Node, error) {
-	// insert a bqlBox that executes the SELECT statement
+// createStreamAsSelectUnionStmt creates a box for each SELECT statement and
+// a box forwarding tuples from them. tx is nil when the statement isn't
+// added in a transaction.
+func (tb *TopologyBuilder) createStreamAsSelectUnionStmt(stmt *parser.CreateStreamAsSelectUnionStmt, tx *transaction) (core.Node, error) {
+	if err := tb.checkViewName(string(stmt.Name)); err != nil {
+		return nil, err
+	}
+
+	// idea: create an intermediate box for each SELECT substatement,
+	// then connect them with a simple forwarder box
+	names := make([]string, 0, len(stmt.Selects))
+	nodes := make([]core.BoxNode, 0, len(stmt.Selects))
+	removeTmpNodes := func() {
+		for _, name := range names {
+			tb.topology.Remove(name)
+		}
+	}
+	for _, selStmt := range stmt.Selects {
+		// create a stream with a generated name and recurse
+		tmpName := fmt.Sprintf("sensorbee_tmp_%v", topologyBuilderNextTemporaryID())
+		tmpStmt := parser.CreateStreamAsSelectStmt{
+			parser.StreamIdentifier(tmpName),
+			selStmt,
+			parser.SchemaAST{},
+		}
+		box, err := tb.createStreamAsSelectStmt(&tmpStmt, tx)
+		if err != nil {
+			removeTmpNodes()
+			return nil, err
+		}
+		names = append(names, tmpName)
+		nodes = append(nodes, box.(core.BoxNode))
+	}
+	// simple forwarder box
+	forwardBox := core.BoxFunc(func(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+		return w.Write(ctx, t)
+	})
+	node, err := tb.topology.AddBox(string(stmt.Name), tx.box(forwardBox), nil)
+	if err != nil {
+		removeTmpNodes()
+		return nil, err
+	}
+	// connect inputs
+	for _, name := range names {
+		if err := node.Input(name, nil); err != nil {
+			removeTmpNodes()
+			return nil, err
+		}
+	}
+	for _, node := range nodes {
+		node.StopOnDisconnect(core.Inbound | core.Outbound)
+		node.RemoveOnStop()
+	}
+	node.StopOnDisconnect(core.Inbound)
+	node.RemoveOnStop()
+	return node, nil
+}
+
+func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsSelectStmt, tx *transaction) (core.Node, error) {
 	outName := string(stmt.Name)
-	box := NewBQLBox(&stmt.Select, tb.Reg)
-	// add all the referenced relations as named inputs
-	dbox, err := tb.topology.AddBox(outName, box, nil)
+	if err := tb.checkViewName(outName); err != nil {
+		return nil, err
+	}
+	sel := tb.expandViews(stmt.Select)
+	schema, err := newStreamSchema(stmt.Schema)
 	if err != nil {
 		return nil, err
 	}
 
 	// TODO: this check doesn't prevent a user from creating selfloops using UDSFs
-	for _, rel := range stmt.Select.Relations {
-		if strings.ToLower(rel.Name) == strings.ToLower(outName) {
-			return nil, fmt.Errorf("a stream '%v' contains a selfloop", outName)
+	if selectReadsFrom(&sel, outName) {
+		return nil, fmt.Errorf("a stream '%v' contains a selfloop", outName)
+	}
+	return tb.createSelectBox(outName, &sel, schema, tx)
+}
+
+// createSelectBox adds a bqlBox executing the SELECT statement to the
+// topology. Each subquery in the FROM clause is executed by a temporary
+// bqlBox, which is connected to the bqlBox as an actual stream. Views
+// must already be expanded in the statement. Tuples emitted by the
+// bqlBox are validated with schema if it isn't nil. When tx isn't nil, the
+// boxes don't process tuples and sources of UDSFs aren't resumed until the
+// transaction is committed.
+func (tb *TopologyBuilder) createSelectBox(outName string, sel *parser.SelectStmt, schema *streamSchema, tx *transaction) (core.BoxNode, error) {
+	subqueries := map[string]*parser.SelectStmt{}
+	if len(sel.Relations) > 0 {
+		rels := make([]parser.AliasedStreamWindowAST, len(sel.Relations))
+		for i, rel := range sel.Relations {
+			if rel.Type == parser.SubqueryStream {
+				tmpName := fmt.Sprintf("sensorbee_tmp_subquery_%v", topologyBuilderNextTemporaryID())
+				subqueries[tmpName] = rel.Subquery
+				rel.Stream = parser.NewStream(tmpName)
+			}
+			rels[i] = rel
 		}
+		s := *sel
+		s.Relations = rels
+		sel = &s
+	}
+
+	// insert a bqlBox that executes the SELECT statement
+	box := NewBQLBox(sel, tb.Reg)
+	var config *core.BoxConfig
+	if schema != nil {
+		box.schema = schema
+		box.name = outName
+		config = &core.BoxConfig{
+			Meta: schema.meta(),
+		}
+	}
+	// add all the referenced relations as named inputs
+	dbox, err := tb.topology.AddBox(outName, tx.box(box), config)
+	if err != nil {
+		return nil, err
 	}
 
 	// provide a function to the BQL box to remove itself from the topology
@@ -443,9 +560,15 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 
 	connected := map[string]bool{}
 	var pausedSources []core.SourceNode
-	for _, rel := range stmt.Select.Relations {
+	for _, rel := range sel.Relations {
 		switch rel.Type {
 		case parser.ActualStream:
+			if sub, ok := subqueries[rel.Name]; ok {
+				if _, err := tb.createSelectBox(rel.Name, sub, nil, tx); err != nil {
+					return nil, err
+				}
+				temporaryNodes = append(temporaryNodes, rel.Name)
+			}
 			if connected[rel.Name] {
 				// this is a self-join (FROM x [RANGE ...] AS a, x [RANGE ...] AS b)
 				// and we already have connected x to this box before
@@ -487,7 +610,7 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 			connected[rel.Name] = true
 
 		case parser.UDSFStream:
-			sn, name, err := tb.setUpUDSFStream(dbox, &rel)
+			sn, name, err := tb.setUpUDSFStream(dbox, &rel, tx)
 			if err != nil {
 				return nil, err
 			}
@@ -501,10 +624,27 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 				rel.Type)
 		}
 	}
-	dbox.(core.BoxNode).StopOnDisconnect(core.Inbound)
+	dbox.StopOnDisconnect(core.Inbound)
+
+	// Boxes of subqueries are stopped and removed together with this box.
+	// StopOnDisconnect is called only after the Outbound connection has
+	// been made.
+	for name := range subqueries {
+		bn, err := tb.topology.Box(name)
+		if err != nil {
+			return nil, err
+		}
+		bn.StopOnDisconnect(core.Inbound | core.Outbound)
+		bn.RemoveOnStop()
+	}
 
 	// Resume all UDSFs running in the source mode as fairly as possible.
+	// In a transaction, they're resumed on commit.
 	for _, sn := range pausedSources {
+		if tx != nil {
+			tx.resume = append(tx.resume, sn.Name())
+			continue
+		}
 		if err := sn.Resume(); err != nil {
 			return nil, err
 		}
@@ -513,11 +653,29 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 	return dbox, nil
 }
 
+// selectReadsFrom returns true when the statement or one of its subqueries
+// reads tuples from the stream having the given name.
+func selectReadsFrom(sel *parser.SelectStmt, name string) bool {
+	for _, rel := range sel.Relations {
+		switch rel.Type {
+		case parser.ActualStream:
+			if strings.ToLower(rel.Name) == strings.ToLower(name) {
+				return true
+			}
+		case parser.SubqueryStream:
+			if selectReadsFrom(rel.Subquery, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // setUpUDSFStream creates a Source or a Box from a UDSF. When it creates a
 // Source, it will return the corresponding core.SourceNode of it. Otherwise,
 // it returns nil for core.SourceNode. It also returns the temporary name of
 // the UDSF node.
-func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *parser.AliasedStreamWindowAST) (core.SourceNode, string, error) {
+func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *parser.AliasedStreamWindowAST, tx *transaction) (core.SourceNode, string, error) {
 	// Compute the values of the UDSF parameters (if there was
 	// an unusable parameter, as in `udsf(7, col)` this will fail).
 	// Note: it doesn't feel exactly right to do this kind of
@@ -612,7 +770,7 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 		return sn, temporaryName, nil
 	}
 
-	bn, err := tb.topology.AddBox(temporaryName, newUDSFBox(udsf), &core.BoxConfig{
+	bn, err := tb.topology.AddBox(temporaryName, tx.box(newUDSFBox(udsf)), &core.BoxConfig{
 	// TODO: add information of the statement
 	})
 	if err != nil {
@@ -633,6 +791,33 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 	return nil, temporaryName, nil
 }
 
+// dropExpressionUDF removes a function created by CREATE FUNCTION from the
+// registry. The registry has to implement udf.FunctionLister and
+// udf.FunctionUnregisterer.
+func dropExpressionUDF(reg udf.FunctionManager, name string) error {
+	lister, ok := reg.(udf.FunctionLister)
+	if !ok {
+		return errors.New("the function registry cannot list functions")
+	}
+	unreg, ok := reg.(udf.FunctionUnregisterer)
+	if !ok {
+		return errors.New("the function registry cannot unregister functions")
+	}
+
+	funcs, err := lister.List()
+	if err != nil {
+		return err
+	}
+	f, ok := funcs[strings.ToLower(name)]
+	if !ok {
+		return core.NotExistError(fmt.Errorf("function '%s' is unknown", name))
+	}
+	if _, ok := f.(*execution.ExpressionUDF); !ok {
+		return fmt.Errorf("function '%s' isn't created by CREATE FUNCTION", name)
+	}
+	return unreg.Unregister(name)
+}
+
 func (tb *TopologyBuilder) mkParamsMap(params []parser.SourceSinkParamAST) data.Map {
 	paramsMap := make(data.Map, len(params))
 	for _, kv := range params {
@@ -731,14 +916,18 @@ func (tb *TopologyBuilder) AddSelectUnionStmt(stmts *parser.SelectUnionStmt) (co
 				parser.StreamIdentifier(tmpName),
 				parser.SelectStmt{
 					stmt.EmitterAST,
+					stmt.DistinctAST,
 					stmt.ProjectionsAST,
 					stmt.WindowedFromAST,
 					stmt.FilterAST,
 					stmt.GroupingAST,
 					stmt.HavingAST,
+					stmt.OrderingAST,
+					stmt.LimitAST,
 				},
+				parser.SchemaAST{},
 			}
-			box, err := tb.AddStmt(tmpStmt)
+			box, err := tb.addStmt(tmpStmt)
 			if err != nil {
 				return nil, err
 			}
@@ -822,6 +1011,66 @@ func (tb *TopologyBuilder) RunEvalStmt(stmt *parser.EvalStmt) (data.Value, error
 	return execution.EvaluateOnInput(expr, inputRow, tb.Reg)
 }
 
+// RunExplainStmt describes how the statement contained in the given
+// ExplainStmt would be executed without executing it. See
+// execution.LogicalPlan.Explain for the details of the result.
+func (tb *TopologyBuilder) RunExplainStmt(stmt *parser.ExplainStmt) (data.Map, error) {
+	explainSelects := func(selects []parser.SelectStmt) (data.Array, error) {
+		arr := make(data.Array, len(selects))
+		for i, s := range selects {
+			m, err := tb.explainSelectStmt(&s)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = m
+		}
+		return arr, nil
+	}
+
+	switch s := stmt.Stmt.(type) {
+	case parser.SelectStmt:
+		return tb.explainSelectStmt(&s)
+
+	case parser.SelectUnionStmt:
+		selects, err := explainSelects(s.Selects)
+		if err != nil {
+			return nil, err
+		}
+		return data.Map{"selects": selects}, nil
+
+	case parser.CreateStreamAsSelectStmt:
+		m, err := tb.explainSelectStmt(&s.Select)
+		if err != nil {
+			return nil, err
+		}
+		m["stream"] = data.String(s.Name)
+		return m, nil
+
+	case parser.CreateStreamAsSelectUnionStmt:
+		selects, err := explainSelects(s.Selects)
+		if err != nil {
+			return nil, err
+		}
+		return data.Map{
+			"stream":  data.String(s.Name),
+			"selects": selects,
+		}, nil
+	}
+	return nil, fmt.Errorf("statement of type %T cannot be explained", stmt.Stmt)
+}
+
+func (tb *TopologyBuilder) explainSelectStmt(stmt *parser.SelectStmt) (data.Map, error) {
+	analyzedPlan, err := execution.Analyze(tb.expandViews(*stmt), tb.Reg)
+	if err != nil {
+		return nil, err
+	}
+	optimizedPlan, err := analyzedPlan.LogicalOptimize()
+	if err != nil {
+		return nil, err
+	}
+	return optimizedPlan.Explain(tb.Reg)
+}
+
 func (tb *TopologyBuilder) saveState(name, tag string) error {
 	st, err := tb.topology.Context().SharedStates.Get(name)
 	if err != nil {
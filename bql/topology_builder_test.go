@@ -1,11 +1,13 @@
 package bql
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 )
@@ -258,6 +260,105 @@ func TestCreateStreamAsSelectStmt(t *testing.T) {
 	})
 }
 
+func TestCreateStreamAsSelectStmtWithSubquery(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a paused source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy WITH num=4`), ShouldBeNil)
+
+		Convey("When creating a stream from a subquery", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM t AS SELECT ISTREAM x:d * 10 AS v FROM
+					(SELECT ISTREAM int * 2 AS d FROM s [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS x;
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM t;
+				RESUME SOURCE s;
+			`), ShouldBeNil)
+
+			Convey("Then the subquery should be run by a temporary box", func() {
+				So(len(dt.Nodes()), ShouldEqual, 4)
+				found := false
+				for name := range dt.Nodes() {
+					if strings.HasPrefix(name, "sensorbee_tmp_subquery_") {
+						found = true
+					}
+				}
+				So(found, ShouldBeTrue)
+			})
+
+			Convey("Then the stream should receive results of the subquery", func() {
+				sink, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(4)
+				So(si.len(), ShouldEqual, 4)
+				for i := 0; i < 4; i++ {
+					So(si.get(i).Data["v"], ShouldEqual, data.Int((i+1)*20))
+				}
+			})
+		})
+
+		Convey("When creating a stream from nested subqueries joined with a stream", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM t AS SELECT ISTREAM s:int AS a, x:d AS b FROM
+					s [RANGE 1 TUPLES],
+					(SELECT ISTREAM y:int + 1 AS d FROM
+						(SELECT ISTREAM int FROM s [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS y
+					) [RANGE 1 TUPLES] AS x
+					WHERE s:int + 1 = x:d;
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM t;
+				RESUME SOURCE s;
+			`), ShouldBeNil)
+
+			Convey("Then a temporary box should be created for each subquery", func() {
+				So(len(dt.Nodes()), ShouldEqual, 5)
+			})
+
+			Convey("Then the stream should receive joined tuples", func() {
+				sink, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(1)
+				tu := si.get(0)
+				So(tu.Data["b"], ShouldEqual, tu.Data["a"].(data.Int)+1)
+			})
+		})
+
+		Convey("When a subquery reads from a non-existing stream", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM * FROM
+				(SELECT ISTREAM * FROM bar [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS x`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "was not found")
+			})
+
+			Convey("Then all created nodes should be removed", func() {
+				So(len(dt.Nodes()), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a subquery reads from the stream being created", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM * FROM
+				(SELECT ISTREAM * FROM T [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS x`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "selfloop")
+			})
+
+			Convey("Then no node should be created", func() {
+				So(len(dt.Nodes()), ShouldEqual, 1)
+			})
+		})
+	})
+}
+
 func TestCreateStreamAsSelectUnionStmt(t *testing.T) {
 	Convey("Given a BQL TopologyBuilder with source and stream", t, func() {
 		dt := newTestTopology()
@@ -757,6 +858,30 @@ func TestUpdateSourceStmt(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("Given an Updater source with a schema", func() {
+			So(addBQLToTopology(tb, `CREATE PAUSED SOURCE hoge TYPE dummy_updatable WITH num=5 SCHEMA (int int);`), ShouldBeNil)
+
+			Convey("When updating the updatable source", func() {
+				err := addBQLToTopology(tb, `UPDATE SOURCE hoge SET num=5;`)
+
+				Convey("There should be no error", func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+
+		Convey("Given a non-Updater source with a schema", func() {
+			So(addBQLToTopology(tb, `CREATE PAUSED SOURCE hoge TYPE dummy WITH num=4 SCHEMA (int int);`), ShouldBeNil)
+
+			Convey("When updating the source", func() {
+				err := addBQLToTopology(tb, `UPDATE SOURCE hoge SET num=5;`)
+
+				Convey("There should be an error", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		})
 	})
 }
 
@@ -1034,6 +1159,168 @@ func TestEvalStmt(t *testing.T) {
 	})
 }
 
+func TestExplainStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		explain := func(s string) (data.Map, error) {
+			istmt, _, err := parser.New().ParseStmt(s)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.ExplainStmt)
+			return tb.RunExplainStmt(&stmt)
+		}
+
+		Convey("When issuing an EXPLAIN stmt for a SELECT stmt", func() {
+			m, err := explain(`EXPLAIN SELECT RSTREAM a FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then the chosen plan should be returned", func() {
+				So(err, ShouldBeNil)
+				name, err := m.Get(data.MustCompilePath("plan.name"))
+				So(err, ShouldBeNil)
+				So(name, ShouldEqual, data.String("filterPlan"))
+			})
+
+			Convey("Then no node should be created", func() {
+				So(dt.Nodes(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When issuing an EXPLAIN stmt for a CREATE STREAM stmt", func() {
+			m, err := explain(`EXPLAIN CREATE STREAM t AS SELECT ISTREAM count(a) FROM s [RANGE 2 TUPLES]`)
+
+			Convey("Then the chosen plan and the stream name should be returned", func() {
+				So(err, ShouldBeNil)
+				So(m["stream"], ShouldEqual, data.String("t"))
+				name, err := m.Get(data.MustCompilePath("plan.name"))
+				So(err, ShouldBeNil)
+				So(name, ShouldEqual, data.String("groupbyExecutionPlan"))
+			})
+
+			Convey("Then no node should be created", func() {
+				So(dt.Nodes(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When issuing an EXPLAIN stmt for a SELECT UNION stmt", func() {
+			m, err := explain(`EXPLAIN SELECT RSTREAM a FROM s [RANGE 1 TUPLES]
+				UNION ALL SELECT ISTREAM a FROM t [RANGE 2 TUPLES]`)
+
+			Convey("Then each SELECT stmt should be explained", func() {
+				So(err, ShouldBeNil)
+				So(m["selects"], ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When issuing an EXPLAIN stmt for a SELECT stmt with a subquery", func() {
+			m, err := explain(`EXPLAIN SELECT RSTREAM x:a FROM
+				(SELECT ISTREAM count(a) AS a FROM s [RANGE 2 TUPLES]) [RANGE 1 TUPLES] AS x`)
+
+			Convey("Then the subquery should be explained", func() {
+				So(err, ShouldBeNil)
+				typ, err := m.Get(data.MustCompilePath("relations[0].type"))
+				So(err, ShouldBeNil)
+				So(typ, ShouldEqual, data.String("SubqueryStream"))
+				name, err := m.Get(data.MustCompilePath("relations[0].subquery.plan.name"))
+				So(err, ShouldBeNil)
+				So(name, ShouldEqual, data.String("groupbyExecutionPlan"))
+			})
+		})
+
+		Convey("When issuing an EXPLAIN stmt for an invalid SELECT stmt", func() {
+			_, err := explain(`EXPLAIN SELECT RSTREAM a, count(b) FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestCreateFunctionStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		Convey("When creating a function", func() {
+			So(addBQLToTopology(tb, `CREATE FUNCTION c_to_f(x) AS x * 9 / 5 + 32`), ShouldBeNil)
+
+			Convey("Then it should be registered", func() {
+				f, err := tb.Reg.Lookup("c_to_f", 1)
+				So(err, ShouldBeNil)
+				v, err := f.Call(dt.Context(), data.Int(100))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(212))
+			})
+
+			Convey("Then it should be listed", func() {
+				funcs, err := tb.Reg.(udf.FunctionLister).List()
+				So(err, ShouldBeNil)
+				So(funcs, ShouldContainKey, "c_to_f")
+			})
+
+			Convey("Then it can be used in EVAL", func() {
+				bp := parser.New()
+				istmt, _, err := bp.ParseStmt(`EVAL c_to_f(-40.0)`)
+				So(err, ShouldBeNil)
+				stmt := istmt.(parser.EvalStmt)
+				val, err := tb.RunEvalStmt(&stmt)
+				So(err, ShouldBeNil)
+				So(val, ShouldEqual, data.Float(-40))
+			})
+
+			Convey("Then it can be used in another function", func() {
+				So(addBQLToTopology(tb, `CREATE FUNCTION c_to_f_str(x) AS c_to_f(x)::string || "F"`), ShouldBeNil)
+				f, err := tb.Reg.Lookup("c_to_f_str", 1)
+				So(err, ShouldBeNil)
+				v, err := f.Call(dt.Context(), data.Int(0))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.String("32F"))
+			})
+
+			Convey("Then it can be used in a stream", func() {
+				So(addBQLToTopology(tb, `
+					CREATE PAUSED SOURCE s TYPE dummy WITH num=4;
+					CREATE STREAM t AS SELECT ISTREAM c_to_f(int) AS f FROM s [RANGE 1 TUPLES];
+					CREATE SINK k TYPE collector;
+					INSERT INTO k FROM t;
+					RESUME SOURCE s;
+				`), ShouldBeNil)
+				sink, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(4)
+				So(si.get(3).Data["f"], ShouldEqual, data.Int(39))
+			})
+
+			Convey("Then creating it again should fail", func() {
+				So(addBQLToTopology(tb, `CREATE FUNCTION c_to_f(y) AS y`), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating a function referring to an undefined parameter", func() {
+			err := addBQLToTopology(tb, `CREATE FUNCTION f(x) AS x + y`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the function shouldn't be registered", func() {
+				_, err := tb.Reg.Lookup("f", 1)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
 func TestDropSourceStmt(t *testing.T) {
 	Convey("Given a BQL TopologyBuilder", t, func() {
 		dt := newTestTopology()
@@ -1142,6 +1429,57 @@ func TestDropStateStmt(t *testing.T) {
 	})
 }
 
+func TestDropFunctionStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		Convey("When there is no function", func() {
+			Convey("Then dropping should fail", func() {
+				So(addBQLToTopology(tb, `DROP FUNCTION hoge;`), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When adding a function", func() {
+			So(addBQLToTopology(tb, `CREATE FUNCTION hoge(x) AS x + 1;`), ShouldBeNil)
+
+			Convey("Then dropping it should succeed", func() {
+				So(addBQLToTopology(tb, `DROP FUNCTION HOGE;`), ShouldBeNil)
+				_, err := tb.Reg.Lookup("hoge", 1)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When dropping a function not created by CREATE FUNCTION", func() {
+			err := addBQLToTopology(tb, `DROP FUNCTION str;`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the function should still be registered", func() {
+				_, err := tb.Reg.Lookup("str", 1)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When the function registry cannot unregister functions", func() {
+			So(addBQLToTopology(tb, `CREATE FUNCTION hoge(x) AS x + 1;`), ShouldBeNil)
+			tb.Reg = struct{ udf.FunctionManager }{tb.Reg}
+			err := addBQLToTopology(tb, `DROP FUNCTION hoge;`)
+
+			Convey("Then dropping should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "cannot list functions")
+			})
+		})
+	})
+}
+
 func waitForExpectedCondition(f func() bool) {
 	for !f() {
 		time.Sleep(time.Nanosecond)
@@ -0,0 +1,366 @@
+package bql
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+)
+
+// AddStmts adds nodes created from statements to the topology in an atomic
+// manner. It returns created nodes in the same order as the statements.
+// Elements of the returned slice have the same values as AddStmt returns.
+//
+// All sources are created in the paused state and boxes created by CREATE
+// STREAM statements don't process any tuple until all statements succeed.
+// Connections to sinks specified by INSERT INTO statements are made after
+// all nodes are created. Sources are resumed only when all statements
+// succeeded, unless they're created with PAUSED. RESUME SOURCE statements
+// are also applied at that time. When any statement fails, all nodes and
+// states created by the statements are removed, connections made by INSERT
+// INTO and sources resumed by RESUME SOURCE are restored, and an error is
+// returned.
+//
+// Only statements which create nodes or states, INSERT INTO, and RESUME
+// SOURCE can be added by AddStmts.
+func (tb *TopologyBuilder) AddStmts(stmts []interface{}) ([]core.Node, error) {
+	for _, stmt := range stmts {
+		if err := validateTransactionalStmt(stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	tx := &transaction{
+		tb:   tb,
+		gate: newTxGate(),
+	}
+	nodes := make([]core.Node, len(stmts))
+	for i, stmt := range stmts {
+		n, err := tx.add(stmt)
+		if err != nil {
+			tx.rollback()
+			return nil, fmt.Errorf("cannot process '%v' in the transaction: %v", stmt, err)
+		}
+		nodes[i] = n
+	}
+	if err := tx.commit(); err != nil {
+		tx.rollback()
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// Session processes statements sent by a client such as a request to the
+// server or a BQL file. A transaction started by BEGIN is only visible to
+// the session, so statements added to other sessions or directly to the
+// TopologyBuilder aren't affected by it.
+//
+// A Session isn't safe for concurrent use.
+type Session struct {
+	tb *TopologyBuilder
+
+	// txStmts holds statements added after BEGIN. It's nil when there's no
+	// transaction in progress.
+	txStmts []interface{}
+}
+
+// NewSession creates a new Session adding statements to the topology.
+func (tb *TopologyBuilder) NewSession() *Session {
+	return &Session{
+		tb: tb,
+	}
+}
+
+// AddStmt adds a statement in the same way as TopologyBuilder.AddStmt does.
+//
+// BEGIN starts a transaction. Statements added after BEGIN are only validated
+// and kept in the session, and AddStmt returns a nil node for them. They're
+// added to the topology by AddStmts when COMMIT is added, or discarded when
+// ROLLBACK is added.
+func (s *Session) AddStmt(stmt interface{}) (core.Node, error) {
+	switch stmt.(type) {
+	case parser.BeginStmt:
+		if s.txStmts != nil {
+			return nil, errors.New("a transaction is already in progress")
+		}
+		s.txStmts = []interface{}{}
+		return nil, nil
+
+	case parser.CommitStmt, parser.RollbackStmt:
+		stmts := s.txStmts
+		s.txStmts = nil
+		if stmts == nil {
+			return nil, fmt.Errorf("%v without BEGIN", stmt)
+		}
+		if _, ok := stmt.(parser.RollbackStmt); ok {
+			return nil, nil
+		}
+		_, err := s.tb.AddStmts(stmts)
+		return nil, err
+	}
+
+	if s.txStmts == nil {
+		return s.tb.AddStmt(stmt)
+	}
+	if err := validateTransactionalStmt(stmt); err != nil {
+		return nil, err
+	}
+	s.txStmts = append(s.txStmts, stmt)
+	return nil, nil
+}
+
+// InTransaction returns true when a transaction was started by BEGIN and it
+// hasn't been committed or rolled back yet.
+func (s *Session) InTransaction() bool {
+	return s.txStmts != nil
+}
+
+// Close closes the session. When a transaction started by BEGIN is still in
+// progress, statements in it are discarded and an error is returned.
+func (s *Session) Close() error {
+	if s.txStmts == nil {
+		return nil
+	}
+	s.txStmts = nil
+	return errors.New("the transaction started by BEGIN isn't committed")
+}
+
+func validateTransactionalStmt(stmt interface{}) error {
+	switch stmt.(type) {
+	case parser.CreateSourceStmt, parser.CreateStreamAsSelectStmt,
+		parser.CreateStreamAsSelectUnionStmt, parser.CreateSinkStmt,
+		parser.CreateStateStmt, parser.InsertIntoFromStmt, parser.ResumeSourceStmt:
+		return nil
+	case parser.BeginStmt:
+		return errors.New("transactions cannot be nested")
+	}
+	return fmt.Errorf("statement of type %T cannot be used in a transaction", stmt)
+}
+
+// transaction has information to apply or roll back statements added by
+// AddStmts.
+type transaction struct {
+	tb *TopologyBuilder
+
+	// gate blocks boxes created in the transaction until it's committed.
+	gate *txGate
+
+	// undo has functions removing created nodes and states, and restoring
+	// existing nodes changed on commit. They're called in the reverse order
+	// on rollback.
+	undo []func() error
+
+	// inserts has INSERT INTO statements which are applied on commit.
+	inserts []parser.InsertIntoFromStmt
+
+	// resume has names of sources which are resumed on commit.
+	resume []string
+}
+
+// box returns a Box which doesn't process tuples until the transaction is
+// committed. It returns b as is when tx is nil so that statements added
+// without a transaction can share the code creating nodes.
+func (tx *transaction) box(b core.Box) core.Box {
+	if tx == nil {
+		return b
+	}
+	return &gatedBox{
+		box:  b,
+		gate: tx.gate,
+	}
+}
+
+func (tx *transaction) add(stmt interface{}) (core.Node, error) {
+	tb := tx.tb
+	removeNode := func(name parser.StreamIdentifier) {
+		tx.undo = append(tx.undo, func() error {
+			return tb.topology.Remove(string(name))
+		})
+	}
+
+	switch stmt := stmt.(type) {
+	case parser.CreateSourceStmt:
+		paused := stmt.Paused == parser.Yes
+		stmt.Paused = parser.Yes
+		n, err := tb.addStmt(stmt)
+		if err != nil {
+			return nil, err
+		}
+		removeNode(stmt.Name)
+		if !paused {
+			tx.resume = append(tx.resume, string(stmt.Name))
+		}
+		return n, nil
+
+	case parser.CreateStreamAsSelectStmt:
+		n, err := tb.createStreamAsSelectStmt(&stmt, tx)
+		if err != nil {
+			return nil, err
+		}
+		removeNode(stmt.Name)
+		return n, nil
+
+	case parser.CreateStreamAsSelectUnionStmt:
+		n, err := tb.createStreamAsSelectUnionStmt(&stmt, tx)
+		if err != nil {
+			return nil, err
+		}
+		removeNode(stmt.Name)
+		return n, nil
+
+	case parser.CreateSinkStmt:
+		n, err := tb.addStmt(stmt)
+		if err != nil {
+			return nil, err
+		}
+		removeNode(stmt.Name)
+		return n, nil
+
+	case parser.CreateStateStmt:
+		n, err := tb.addStmt(stmt)
+		if err != nil {
+			return nil, err
+		}
+		tx.undo = append(tx.undo, func() error {
+			_, err := tb.topology.Context().SharedStates.Remove(string(stmt.Name))
+			return err
+		})
+		return n, nil
+
+	case parser.InsertIntoFromStmt:
+		sink, err := tb.topology.Sink(string(stmt.Sink))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := sink.(core.InputRemover); !ok {
+			return nil, fmt.Errorf("the connection to sink '%v' cannot be undone", stmt.Sink)
+		}
+		if _, err := tb.topology.Node(string(stmt.Input)); err != nil {
+			return nil, err
+		}
+		tx.inserts = append(tx.inserts, stmt)
+		return sink, nil
+
+	case parser.ResumeSourceStmt:
+		src, err := tb.topology.Source(string(stmt.Source))
+		if err != nil {
+			return nil, err
+		}
+		tx.resume = append(tx.resume, string(stmt.Source))
+		return src, nil
+	}
+	return nil, validateTransactionalStmt(stmt)
+}
+
+// commit connects sinks to their inputs, lets boxes process tuples, and
+// resumes sources. Changes made to existing nodes are recorded in undo so
+// that rollback can restore them when commit fails.
+func (tx *transaction) commit() error {
+	for _, stmt := range tx.inserts {
+		sink, err := tx.tb.topology.Sink(string(stmt.Sink))
+		if err != nil {
+			return err
+		}
+		if err := sink.Input(string(stmt.Input), nil); err != nil {
+			return fmt.Errorf("cannot process '%v' in the transaction: %v", stmt, err)
+		}
+		input := string(stmt.Input)
+		tx.undo = append(tx.undo, func() error {
+			return sink.(core.InputRemover).RemoveInput(input)
+		})
+	}
+	tx.gate.release(false)
+
+	for _, name := range tx.resume {
+		src, err := tx.tb.topology.Source(name)
+		if err != nil {
+			return err
+		}
+		paused := src.State().Get() == core.TSPaused
+		if err := src.Resume(); err != nil {
+			return fmt.Errorf("cannot resume source '%v': %v", name, err)
+		}
+		if paused {
+			tx.undo = append(tx.undo, src.Pause)
+		}
+	}
+	return nil
+}
+
+// rollback removes all nodes and states created in the transaction and
+// restores existing nodes changed on commit.
+func (tx *transaction) rollback() {
+	// Boxes blocked by the gate have to be released before they're removed.
+	tx.gate.release(true)
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		if err := tx.undo[i](); err != nil {
+			tx.tb.topology.Context().ErrLog(err).Error("Cannot roll back a transaction")
+		}
+	}
+	tx.undo = nil
+}
+
+// txGate blocks boxes created in a transaction until it's committed or
+// rolled back.
+type txGate struct {
+	once      sync.Once
+	ch        chan struct{}
+	discarded bool
+}
+
+func newTxGate() *txGate {
+	return &txGate{
+		ch: make(chan struct{}),
+	}
+}
+
+// release unblocks all boxes waiting for the gate. When discard is true,
+// they drop tuples instead of processing them. Only the first call has an
+// effect.
+func (g *txGate) release(discard bool) {
+	g.once.Do(func() {
+		g.discarded = discard
+		close(g.ch)
+	})
+}
+
+// wait blocks until the gate is released. It returns false when tuples
+// should be dropped.
+func (g *txGate) wait() bool {
+	<-g.ch
+	return !g.discarded
+}
+
+// gatedBox is a Box created in a transaction. It doesn't process tuples
+// until the transaction is committed.
+type gatedBox struct {
+	box  core.Box
+	gate *txGate
+}
+
+var (
+	_ core.StatefulBox = &gatedBox{}
+)
+
+func (b *gatedBox) Init(ctx *core.Context) error {
+	if s, ok := b.box.(core.StatefulBox); ok {
+		return s.Init(ctx)
+	}
+	return nil
+}
+
+func (b *gatedBox) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	if !b.gate.wait() {
+		return errors.New("the transaction creating the box was rolled back")
+	}
+	return b.box.Process(ctx, t, w)
+}
+
+func (b *gatedBox) Terminate(ctx *core.Context) error {
+	if s, ok := b.box.(core.StatefulBox); ok {
+		return s.Terminate(ctx)
+	}
+	return nil
+}
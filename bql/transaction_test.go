@@ -0,0 +1,358 @@
+package bql
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestSession(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		addToSession := func(s *Session, bql string) error {
+			stmts, err := parser.New().ParseStmts(bql)
+			So(err, ShouldBeNil)
+			for _, stmt := range stmts {
+				if _, err := s.AddStmt(stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		stmts := `
+			BEGIN;
+			CREATE SOURCE s TYPE dummy WITH num=4;
+			CREATE STATE st TYPE dummy_uds WITH num=5;
+			CREATE STREAM t AS SELECT ISTREAM int FROM s [RANGE 1 TUPLES];
+			CREATE SINK k TYPE collector;
+			INSERT INTO k FROM t;
+		`
+
+		Convey("When adding statements after BEGIN to a session", func() {
+			s := tb.NewSession()
+			So(addToSession(s, stmts), ShouldBeNil)
+
+			Convey("Then the session should be in a transaction", func() {
+				So(s.InTransaction(), ShouldBeTrue)
+			})
+
+			Convey("Then no node should be created", func() {
+				_, err := dt.Node("s")
+				So(err, ShouldNotBeNil)
+				_, err = dt.Node("t")
+				So(err, ShouldNotBeNil)
+				_, err = dt.Context().SharedStates.Get("st")
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then other sessions shouldn't be in the transaction", func() {
+				s2 := tb.NewSession()
+				So(s2.InTransaction(), ShouldBeFalse)
+				So(addToSession(s2, "CREATE SOURCE s2 TYPE dummy;"), ShouldBeNil)
+				_, err := dt.Node("s2")
+				So(err, ShouldBeNil)
+				So(s2.Close(), ShouldBeNil)
+			})
+
+			Convey("Then statements added to the builder directly shouldn't be in the transaction", func() {
+				So(addBQLToTopology(tb, "CREATE SOURCE s2 TYPE dummy;"), ShouldBeNil)
+				_, err := dt.Node("s2")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("And when committing the transaction", func() {
+				So(addToSession(s, "COMMIT"), ShouldBeNil)
+
+				Convey("Then the session shouldn't be in a transaction", func() {
+					So(s.InTransaction(), ShouldBeFalse)
+					So(s.Close(), ShouldBeNil)
+				})
+
+				Convey("Then all tuples should arrive at the sink", func() {
+					sink, err := dt.Sink("k")
+					So(err, ShouldBeNil)
+					si := sink.Sink().(*tupleCollectorSink)
+					si.Wait(4)
+					So(si.len(), ShouldEqual, 4)
+				})
+
+				Convey("Then the state should be created", func() {
+					_, err := dt.Context().SharedStates.Get("st")
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey("And when rolling back the transaction", func() {
+				So(addToSession(s, "ROLLBACK"), ShouldBeNil)
+
+				Convey("Then the session shouldn't be in a transaction", func() {
+					So(s.InTransaction(), ShouldBeFalse)
+				})
+
+				Convey("Then no node should be created", func() {
+					So(dt.Nodes(), ShouldBeEmpty)
+				})
+			})
+
+			Convey("And when closing the session without COMMIT", func() {
+				err := s.Close()
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, "isn't committed")
+				})
+
+				Convey("Then the session shouldn't be in a transaction", func() {
+					So(s.InTransaction(), ShouldBeFalse)
+				})
+
+				Convey("Then no node should be created", func() {
+					So(dt.Nodes(), ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey("When a statement in the transaction fails on COMMIT", func() {
+			s := tb.NewSession()
+			err := addToSession(s, stmts+`
+				CREATE STREAM u AS SELECT ISTREAM int FROM no_such_stream [RANGE 1 TUPLES];
+				COMMIT;
+			`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "CREATE STREAM u")
+			})
+
+			Convey("Then the session shouldn't be in a transaction", func() {
+				So(s.InTransaction(), ShouldBeFalse)
+			})
+
+			Convey("Then all created nodes and states should be removed", func() {
+				So(dt.Nodes(), ShouldBeEmpty)
+				_, err = dt.Context().SharedStates.Get("st")
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When adding a statement which cannot be used in a transaction", func() {
+			s := tb.NewSession()
+			So(addToSession(s, "BEGIN"), ShouldBeNil)
+			err := addToSession(s, "DROP SOURCE s")
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "cannot be used in a transaction")
+			})
+
+			Convey("Then the transaction should still be in progress", func() {
+				So(s.InTransaction(), ShouldBeTrue)
+			})
+		})
+
+		Convey("When nesting BEGIN", func() {
+			s := tb.NewSession()
+			So(addToSession(s, "BEGIN"), ShouldBeNil)
+			err := addToSession(s, "BEGIN")
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When committing without BEGIN", func() {
+			err := addToSession(tb.NewSession(), "COMMIT")
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "COMMIT without BEGIN")
+			})
+		})
+
+		Convey("When adding BEGIN to the builder directly", func() {
+			_, err := tb.AddStmt(parser.BeginStmt{})
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "can only be used in a session")
+			})
+		})
+
+		Convey("When a BQL leaves a transaction open", func() {
+			err := addBQLToTopology(tb, stmts)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then no node should be created", func() {
+				So(dt.Nodes(), ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestAddStmts(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		parse := func(bql string) []interface{} {
+			stmts, err := parser.New().ParseStmts(bql)
+			So(err, ShouldBeNil)
+			return stmts
+		}
+
+		Convey("When adding statements creating paused and unpaused sources", func() {
+			nodes, err := tb.AddStmts(parse(`
+				CREATE PAUSED SOURCE s1 TYPE dummy;
+				CREATE SOURCE s2 TYPE dummy;
+				CREATE PAUSED SOURCE s3 TYPE dummy;
+				RESUME SOURCE s3;
+			`))
+			So(err, ShouldBeNil)
+
+			Convey("Then a node should be returned for each statement", func() {
+				So(len(nodes), ShouldEqual, 4)
+				So(nodes[0].Name(), ShouldEqual, "s1")
+				So(nodes[3].Name(), ShouldEqual, "s3")
+			})
+
+			Convey("Then only the paused source should stay paused", func() {
+				for name, state := range map[string]core.TopologyState{
+					"s1": core.TSPaused,
+					"s2": core.TSRunning,
+					"s3": core.TSRunning,
+				} {
+					src, err := dt.Source(name)
+					So(err, ShouldBeNil)
+					So(src.State().Get(), ShouldEqual, state)
+				}
+			})
+		})
+
+		Convey("When adding statements with a failing INSERT INTO", func() {
+			_, err := tb.AddStmts(parse(`
+				CREATE SOURCE s TYPE dummy;
+				CREATE SINK k TYPE collector;
+				INSERT INTO k FROM no_such_stream;
+			`))
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "INSERT INTO k FROM no_such_stream")
+			})
+
+			Convey("Then all created nodes should be removed", func() {
+				So(dt.Nodes(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When adding statements containing an unsupported statement", func() {
+			_, err := tb.AddStmts(parse(`
+				CREATE SOURCE s TYPE dummy;
+				PAUSE SOURCE s;
+			`))
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then no node should be created", func() {
+				So(dt.Nodes(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a transaction changing existing nodes fails on commit", func() {
+			So(addBQLToTopology(tb, `
+				CREATE PAUSED SOURCE p TYPE dummy;
+				CREATE PAUSED SOURCE q TYPE dummy;
+				CREATE SINK k TYPE collector;
+			`), ShouldBeNil)
+			q, err := dt.Source("q")
+			So(err, ShouldBeNil)
+			So(q.Stop(), ShouldBeNil)
+
+			_, err = tb.AddStmts(parse(`
+				INSERT INTO k FROM p;
+				RESUME SOURCE p;
+				RESUME SOURCE q;
+			`))
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the resumed source should be paused again", func() {
+				p, err := dt.Source("p")
+				So(err, ShouldBeNil)
+				So(p.State().Get(), ShouldEqual, core.TSPaused)
+			})
+
+			Convey("Then the sink should be disconnected from the source", func() {
+				k, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				So(k.Input("p", nil), ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestGatedBox(t *testing.T) {
+	Convey("Given a box created in a transaction", t, func() {
+		ctx := core.NewContext(nil)
+		tx := &transaction{gate: newTxGate()}
+		processed := make(chan *core.Tuple, 1)
+		b := tx.box(core.BoxFunc(func(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+			processed <- t
+			return nil
+		}))
+		tuple := core.NewTuple(data.Map{"int": data.Int(1)})
+		done := make(chan error, 1)
+		go func() {
+			done <- b.Process(ctx, tuple, nil)
+		}()
+
+		Convey("When the transaction isn't committed yet", func() {
+			Convey("Then the box shouldn't process the tuple", func() {
+				select {
+				case <-processed:
+					t.Fatal("the tuple was processed before the commit")
+				case <-time.After(10 * time.Millisecond):
+				}
+				tx.gate.release(true)
+				So(<-done, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When the transaction is committed", func() {
+			tx.gate.release(false)
+
+			Convey("Then the box should process the tuple", func() {
+				So(<-done, ShouldBeNil)
+				So(<-processed, ShouldEqual, tuple)
+			})
+		})
+
+		Convey("When the transaction is rolled back", func() {
+			tx.gate.release(true)
+
+			Convey("Then the box should drop the tuple", func() {
+				So(<-done, ShouldNotBeNil)
+				So(processed, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -0,0 +1,82 @@
+package udf
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// AnalyticFunction is a UDF which computes a value for every input row
+// using the other rows of the same partition, e.g., the value of the
+// previous row. It must be called with an OVER clause that specifies how
+// the rows of the current window are partitioned and ordered:
+//
+//	SELECT RSTREAM id, value - lag(value) OVER (PARTITION BY id ORDER BY ts())
+//	    AS delta FROM readings [RANGE 10 TUPLES]
+//
+// Unlike an aggregate function, an analytic function returns one value per
+// row instead of one value per group. The Call method of the UDF interface
+// is not used by the planner, and IsAggregationParameter must return false
+// for all parameters.
+type AnalyticFunction interface {
+	UDF
+
+	// CallAnalytic computes the results for all rows of a partition. The
+	// returned array must have exactly one element per row, in the same
+	// order as the rows of the partition.
+	CallAnalytic(ctx *core.Context, p *AnalyticPartition) (data.Array, error)
+}
+
+// AnalyticPartition holds the rows of a partition that an analytic function
+// is called on. The rows are sorted as specified in the ORDER BY part of the
+// OVER clause. Rows that are equal with regard to the ORDER BY expressions
+// (or all rows if there is no ORDER BY) keep the order in which they arrived.
+type AnalyticPartition struct {
+	// Args holds the evaluated arguments for every row, i.e., Args[i][k]
+	// is the k-th argument of the i-th row.
+	Args []data.Array
+
+	// Peers holds, for every row, the index of the first row that has the
+	// same values for all ORDER BY expressions. Rows with the same Peers
+	// value are peers as in the rank function of SQL.
+	Peers []int
+}
+
+// Len returns the number of rows in the partition.
+func (p *AnalyticPartition) Len() int {
+	return len(p.Args)
+}
+
+// AnalyticFunc creates an AnalyticFunction that accepts `arity` many
+// parameters. A negative arity means that any number of parameters is
+// accepted.
+func AnalyticFunc(f func(*core.Context, *AnalyticPartition) (data.Array, error), arity int) AnalyticFunction {
+	return &analyticFunction{
+		f:     f,
+		arity: arity,
+	}
+}
+
+type analyticFunction struct {
+	f     func(*core.Context, *AnalyticPartition) (data.Array, error)
+	arity int
+}
+
+func (f *analyticFunction) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	return nil, fmt.Errorf("an analytic function must be called with an OVER clause")
+}
+
+func (f *analyticFunction) Accept(arity int) bool {
+	if f.arity < 0 {
+		return true
+	}
+	return arity == f.arity
+}
+
+func (f *analyticFunction) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *analyticFunction) CallAnalytic(ctx *core.Context, p *AnalyticPartition) (data.Array, error) {
+	return f.f(ctx, p)
+}
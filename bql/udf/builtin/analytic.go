@@ -0,0 +1,141 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// shiftFunc is a template for analytic functions that return the
+// value of a row before or after the current row in the partition.
+type shiftFunc struct {
+	// direction is -1 for preceding rows and 1 for following rows
+	direction int
+}
+
+func (f *shiftFunc) Accept(arity int) bool {
+	return 1 <= arity && arity <= 3
+}
+
+func (f *shiftFunc) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *shiftFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	return nil, fmt.Errorf("function must be called with an OVER clause")
+}
+
+func (f *shiftFunc) CallAnalytic(ctx *core.Context, p *udf.AnalyticPartition) (data.Array, error) {
+	res := make(data.Array, p.Len())
+	for i, args := range p.Args {
+		offset := int64(1)
+		if len(args) > 1 {
+			o, err := data.AsInt(args[1])
+			if err != nil {
+				return nil, fmt.Errorf("offset must be an integer, not %T", args[1])
+			}
+			if o < 0 {
+				return nil, fmt.Errorf("offset must not be negative, not %d", o)
+			}
+			offset = o
+		}
+		var def data.Value = data.Null{}
+		if len(args) > 2 {
+			def = args[2]
+		}
+		j := int64(i) + int64(f.direction)*offset
+		if j < 0 || j >= int64(p.Len()) {
+			res[i] = def
+		} else {
+			res[i] = p.Args[j][0]
+		}
+	}
+	return res, nil
+}
+
+// lagFunc returns the value of the row that precedes the current row
+// by the given offset (default: 1) in the partition, or the given
+// default value (default: NULL) if there is no such row.
+//
+// It can be used in BQL as `lag`.
+//
+//  Input: Any [, Int [, Any]]
+//  Return Type: same as input
+var lagFunc udf.AnalyticFunction = &shiftFunc{direction: -1}
+
+// leadFunc returns the value of the row that follows the current row
+// by the given offset (default: 1) in the partition, or the given
+// default value (default: NULL) if there is no such row.
+//
+// It can be used in BQL as `lead`.
+//
+//  Input: Any [, Int [, Any]]
+//  Return Type: same as input
+var leadFunc udf.AnalyticFunction = &shiftFunc{direction: 1}
+
+// firstValueFunc returns the value of the first row of the partition.
+//
+// It can be used in BQL as `first_value`.
+//
+//  Input: Any
+//  Return Type: same as input
+var firstValueFunc = udf.AnalyticFunc(func(ctx *core.Context, p *udf.AnalyticPartition) (data.Array, error) {
+	res := make(data.Array, p.Len())
+	for i := range res {
+		res[i] = p.Args[0][0]
+	}
+	return res, nil
+}, 1)
+
+// lastValueFunc returns the value of the last peer of the current row,
+// i.e., the last row of the partition that has the same values for the
+// ORDER BY expressions as the current row. This follows the default
+// window frame of SQL, so the result is the value of the last row of
+// the partition if there is no ORDER BY.
+//
+// It can be used in BQL as `last_value`.
+//
+//  Input: Any
+//  Return Type: same as input
+var lastValueFunc = udf.AnalyticFunc(func(ctx *core.Context, p *udf.AnalyticPartition) (data.Array, error) {
+	res := make(data.Array, p.Len())
+	for i := len(res) - 1; i >= 0; i-- {
+		if i == len(res)-1 || p.Peers[i] != p.Peers[i+1] {
+			res[i] = p.Args[i][0]
+		} else {
+			res[i] = res[i+1]
+		}
+	}
+	return res, nil
+}, 1)
+
+// rowNumberFunc returns the number of the current row in the partition,
+// starting from 1.
+//
+// It can be used in BQL as `row_number`.
+//
+//  Input: None
+//  Return Type: Int
+var rowNumberFunc = udf.AnalyticFunc(func(ctx *core.Context, p *udf.AnalyticPartition) (data.Array, error) {
+	res := make(data.Array, p.Len())
+	for i := range res {
+		res[i] = data.Int(i + 1)
+	}
+	return res, nil
+}, 0)
+
+// rankFunc returns the rank of the current row in the partition with
+// gaps, i.e., the row number of its first peer.
+//
+// It can be used in BQL as `rank`.
+//
+//  Input: None
+//  Return Type: Int
+var rankFunc = udf.AnalyticFunc(func(ctx *core.Context, p *udf.AnalyticPartition) (data.Array, error) {
+	res := make(data.Array, p.Len())
+	for i := range res {
+		res[i] = data.Int(p.Peers[i] + 1)
+	}
+	return res, nil
+}, 0)
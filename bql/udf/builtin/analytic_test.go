@@ -0,0 +1,100 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestAnalyticFuncs(t *testing.T) {
+	// the partition is sorted as 1, 2, 2, 3
+	peers := []int{0, 1, 1, 3}
+	args := func(rows ...data.Array) *udf.AnalyticPartition {
+		return &udf.AnalyticPartition{Args: rows, Peers: peers}
+	}
+	s := func(v string) data.Value {
+		return data.String(v)
+	}
+
+	testCases := []struct {
+		name     string
+		f        udf.AnalyticFunction
+		arity    int
+		p        *udf.AnalyticPartition
+		expected data.Array
+	}{
+		{"lag", lagFunc, 1,
+			args(data.Array{s("a")}, data.Array{s("b")}, data.Array{s("c")}, data.Array{s("d")}),
+			data.Array{data.Null{}, s("a"), s("b"), s("c")}},
+		{"lag", lagFunc, 3,
+			args(data.Array{s("a"), data.Int(2), s("x")}, data.Array{s("b"), data.Int(2), s("x")},
+				data.Array{s("c"), data.Int(2), s("x")}, data.Array{s("d"), data.Int(0), s("x")}),
+			data.Array{s("x"), s("x"), s("a"), s("d")}},
+		{"lag", lagFunc, 2,
+			args(data.Array{s("a"), data.Int(-1)}, data.Array{s("b"), data.Int(1)},
+				data.Array{s("c"), data.Int(1)}, data.Array{s("d"), data.Int(1)}),
+			nil},
+		{"lag", lagFunc, 2,
+			args(data.Array{s("a"), s("1")}, data.Array{s("b"), data.Int(1)},
+				data.Array{s("c"), data.Int(1)}, data.Array{s("d"), data.Int(1)}),
+			nil},
+		{"lead", leadFunc, 1,
+			args(data.Array{s("a")}, data.Array{s("b")}, data.Array{s("c")}, data.Array{s("d")}),
+			data.Array{s("b"), s("c"), s("d"), data.Null{}}},
+		{"lead", leadFunc, 3,
+			args(data.Array{s("a"), data.Int(3), data.Int(0)}, data.Array{s("b"), data.Int(3), data.Int(0)},
+				data.Array{s("c"), data.Int(3), data.Int(0)}, data.Array{s("d"), data.Int(3), data.Int(0)}),
+			data.Array{s("d"), data.Int(0), data.Int(0), data.Int(0)}},
+		{"first_value", firstValueFunc, 1,
+			args(data.Array{s("a")}, data.Array{s("b")}, data.Array{s("c")}, data.Array{s("d")}),
+			data.Array{s("a"), s("a"), s("a"), s("a")}},
+		{"last_value", lastValueFunc, 1,
+			args(data.Array{s("a")}, data.Array{s("b")}, data.Array{s("c")}, data.Array{s("d")}),
+			data.Array{s("a"), s("c"), s("c"), s("d")}},
+		{"row_number", rowNumberFunc, 0,
+			args(data.Array{}, data.Array{}, data.Array{}, data.Array{}),
+			data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(4)}},
+		{"rank", rankFunc, 0,
+			args(data.Array{}, data.Array{}, data.Array{}, data.Array{}),
+			data.Array{data.Int(1), data.Int(2), data.Int(2), data.Int(4)}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		Convey(fmt.Sprintf("Given the %s function with %d arguments", tc.name, tc.arity), t, func() {
+			Convey("Then it should accept the number of arguments", func() {
+				So(tc.f.Accept(tc.arity), ShouldBeTrue)
+				So(tc.f.Accept(4), ShouldBeFalse)
+			})
+
+			Convey(fmt.Sprintf("When evaluating it on %v", tc.p.Args), func() {
+				res, err := tc.f.CallAnalytic(nil, tc.p)
+
+				if tc.expected == nil {
+					Convey("Then evaluation should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey(fmt.Sprintf("Then the result should be %v", tc.expected), func() {
+						So(err, ShouldBeNil)
+						So(res, ShouldResemble, tc.expected)
+					})
+				}
+			})
+
+			Convey("Then it should fail when called without an OVER clause", func() {
+				_, err := tc.f.Call(nil, make([]data.Value, tc.arity)...)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(tc.name, tc.arity)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, tc.f)
+			})
+		})
+	}
+}
@@ -78,6 +78,13 @@ func init() {
 	udf.RegisterGlobalUDF("min", minFunc)
 	udf.RegisterGlobalUDF("string_agg", stringAggFunc)
 	udf.RegisterGlobalUDF("sum", sumFunc)
+	// analytic functions
+	udf.RegisterGlobalUDF("first_value", firstValueFunc)
+	udf.RegisterGlobalUDF("lag", lagFunc)
+	udf.RegisterGlobalUDF("last_value", lastValueFunc)
+	udf.RegisterGlobalUDF("lead", leadFunc)
+	udf.RegisterGlobalUDF("rank", rankFunc)
+	udf.RegisterGlobalUDF("row_number", rowNumberFunc)
 	// conversion functions
 	udf.RegisterGlobalUDF("blob_to_raw_string", udf.MustConvertGeneric(blobToRawString))
 	// other functions
@@ -136,12 +136,34 @@ type FunctionManager interface {
 	Register(name string, f UDF) error
 }
 
+// FunctionLister is implemented by a FunctionRegistry which can list its
+// functions.
+type FunctionLister interface {
+	// List returns all functions the registry has. The caller can safely
+	// modify the map returned from this method.
+	List() (map[string]UDF, error)
+}
+
+// FunctionUnregisterer is implemented by a FunctionManager which can remove
+// registered functions.
+type FunctionUnregisterer interface {
+	// Unregister removes a function from the registry. It returns
+	// core.NotExistError when the registry doesn't have a function having
+	// the name.
+	Unregister(name string) error
+}
+
 type defaultFunctionRegistry struct {
 	ctx   *core.Context
 	m     sync.RWMutex
 	funcs map[string]UDF
 }
 
+var (
+	_ FunctionLister       = &defaultFunctionRegistry{}
+	_ FunctionUnregisterer = &defaultFunctionRegistry{}
+)
+
 // NewDefaultFunctionRegistry returns a new instance of the default
 // FunctionRegistry implementation.
 func NewDefaultFunctionRegistry(ctx *core.Context) FunctionManager {
@@ -192,6 +214,28 @@ func (fr *defaultFunctionRegistry) Register(name string, f UDF) error {
 	return nil
 }
 
+func (fr *defaultFunctionRegistry) List() (map[string]UDF, error) {
+	fr.m.RLock()
+	defer fr.m.RUnlock()
+
+	m := make(map[string]UDF, len(fr.funcs))
+	for n, f := range fr.funcs {
+		m[n] = f
+	}
+	return m, nil
+}
+
+func (fr *defaultFunctionRegistry) Unregister(name string) error {
+	fr.m.Lock()
+	defer fr.m.Unlock()
+	lowerName := strings.ToLower(name)
+	if _, exists := fr.funcs[lowerName]; !exists {
+		return core.NotExistError(fmt.Errorf("function '%s' is unknown", name))
+	}
+	delete(fr.funcs, lowerName)
+	return nil
+}
+
 var (
 	// globalUDFRegistry has UDFs visible to all topologies. Do NOT use this
 	// instance in running topologies because it doesn't have an actual
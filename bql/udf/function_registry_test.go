@@ -119,5 +119,44 @@ func TestDefaultFunctionRegistry(t *testing.T) {
 				So(err, ShouldNotBeNil)
 			})
 		})
+
+		Convey("When listing functions", func() {
+			fr.Register("test1", UnaryFunc(func(*core.Context, data.Value) (data.Value, error) {
+				return data.Bool(true), nil
+			}))
+			fr.Register("Test2", BinaryFunc(func(*core.Context, data.Value, data.Value) (data.Value, error) {
+				return data.Bool(true), nil
+			}))
+			m, err := fr.(FunctionLister).List()
+			So(err, ShouldBeNil)
+
+			Convey("Then all registered functions should be returned", func() {
+				So(m, ShouldContainKey, "str")
+				So(m, ShouldContainKey, "test1")
+				So(m, ShouldContainKey, "test2")
+			})
+
+			Convey("And modifying the returned map shouldn't affect the registry", func() {
+				delete(m, "test1")
+				_, err := fr.Lookup("test1", 1)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When unregistering a function", func() {
+			fr.Register("test1", UnaryFunc(func(*core.Context, data.Value) (data.Value, error) {
+				return data.Bool(true), nil
+			}))
+			So(fr.(FunctionUnregisterer).Unregister("TEST1"), ShouldBeNil)
+
+			Convey("Then it cannot be looked up", func() {
+				_, err := fr.Lookup("test1", 1)
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then unregistering it again should fail", func() {
+				So(core.IsNotExist(fr.(FunctionUnregisterer).Unregister("test1")), ShouldBeTrue)
+			})
+		})
 	})
 }
@@ -0,0 +1,44 @@
+package udf
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// KeyedLookupState is a User Defined State which holds reference data
+// that can be looked up by a key. Such a state can be used in the LOOKUP
+// clause of a SELECT statement to enrich every input tuple:
+//
+//	SELECT RSTREAM r:value, d:location FROM readings [RANGE 1 TUPLES] AS r
+//	    LOOKUP devices AS d ON r:id
+//
+// The planner calls Lookup directly for every tuple of the relation the key
+// refers to, so the reference data don't have to be fed into a stream and
+// kept in a window.
+type KeyedLookupState interface {
+	core.SharedState
+
+	// Lookup returns the data stored for the given key. When there's no data
+	// for the key, Lookup must return an error for which core.IsNotExist
+	// returns true. The looked up value is NULL in that case.
+	//
+	// The returned map must not be modified by the caller or the state
+	// afterwards. Lookup can be called concurrently with other methods.
+	Lookup(ctx *core.Context, key data.Value) (data.Map, error)
+}
+
+// GetKeyedLookupState returns a KeyedLookupState having the name from the
+// context's SharedStateRegistry. It returns an error if the state doesn't
+// exist or doesn't implement KeyedLookupState.
+func GetKeyedLookupState(ctx *core.Context, name string) (KeyedLookupState, error) {
+	state, err := ctx.SharedStates.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := state.(KeyedLookupState)
+	if !ok {
+		return nil, fmt.Errorf("'%v' state cannot be used for lookups", name)
+	}
+	return s, nil
+}
@@ -0,0 +1,67 @@
+package udf
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+type testLookupState struct {
+	testSharedState
+	m data.Map
+}
+
+func (s *testLookupState) Lookup(ctx *core.Context, key data.Value) (data.Map, error) {
+	k, err := data.AsString(key)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := s.m[k]
+	if !ok {
+		return nil, core.NotExistError(fmt.Errorf("key '%v' was not found", k))
+	}
+	return data.AsMap(v)
+}
+
+func TestGetKeyedLookupState(t *testing.T) {
+	Convey("Given a context with shared states", t, func() {
+		ctx := core.NewContext(nil)
+		So(ctx.SharedStates.Add("lookup", "test_lookup", &testLookupState{
+			m: data.Map{"a": data.Map{"x": data.Int(1)}},
+		}), ShouldBeNil)
+		So(ctx.SharedStates.Add("plain", "test", &testSharedState{}), ShouldBeNil)
+
+		Convey("When getting a state implementing KeyedLookupState", func() {
+			s, err := GetKeyedLookupState(ctx, "lookup")
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+
+				Convey("And it should be usable for lookups", func() {
+					v, err := s.Lookup(ctx, data.String("a"))
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, data.Map{"x": data.Int(1)})
+				})
+			})
+		})
+
+		Convey("When getting a state not implementing KeyedLookupState", func() {
+			_, err := GetKeyedLookupState(ctx, "plain")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "cannot be used for lookups")
+			})
+		})
+
+		Convey("When getting a nonexistent state", func() {
+			_, err := GetKeyedLookupState(ctx, "hoge")
+
+			Convey("Then it should fail", func() {
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package bql
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+)
+
+// createView registers the SELECT statement of a CREATE VIEW statement.
+// Views referred to in the statement are expanded when the view is created,
+// so dropping them later doesn't affect the view.
+func (tb *TopologyBuilder) createView(stmt *parser.CreateViewStmt) error {
+	name := string(stmt.Name)
+	if _, err := tb.topology.Node(name); err == nil {
+		return fmt.Errorf("the name is already used by a node: %v", name)
+	}
+
+	sel := tb.expandViews(stmt.Select)
+	if selectReadsFrom(&sel, name) {
+		return fmt.Errorf("a view '%v' refers to itself", name)
+	}
+	if err := tb.validateSelectStmt(&sel); err != nil {
+		return err
+	}
+
+	tb.viewMutex.Lock()
+	defer tb.viewMutex.Unlock()
+	key := strings.ToLower(name)
+	if _, ok := tb.views[key]; ok {
+		return fmt.Errorf("the name is already used by a view: %v", name)
+	}
+	tb.views[key] = sel
+	return nil
+}
+
+// dropView removes a view. Streams which were created from statements
+// referring to the view keep running.
+func (tb *TopologyBuilder) dropView(name string) error {
+	tb.viewMutex.Lock()
+	defer tb.viewMutex.Unlock()
+	key := strings.ToLower(name)
+	if _, ok := tb.views[key]; !ok {
+		return core.NotExistError(fmt.Errorf("view '%s' is unknown", name))
+	}
+	delete(tb.views, key)
+	return nil
+}
+
+// checkViewName returns an error when a view having the given name exists.
+func (tb *TopologyBuilder) checkViewName(name string) error {
+	tb.viewMutex.RLock()
+	defer tb.viewMutex.RUnlock()
+	if _, ok := tb.views[strings.ToLower(name)]; ok {
+		return fmt.Errorf("the name is already used by a view: %v", name)
+	}
+	return nil
+}
+
+// expandViews replaces references to views in the FROM clause of the
+// statement and its subqueries with subqueries having the statements of
+// the views. The names of the views are used as the aliases of the
+// subqueries unless other aliases are given.
+func (tb *TopologyBuilder) expandViews(stmt parser.SelectStmt) parser.SelectStmt {
+	tb.viewMutex.RLock()
+	defer tb.viewMutex.RUnlock()
+	return expandViews(stmt, tb.views)
+}
+
+func expandViews(stmt parser.SelectStmt, views map[string]parser.SelectStmt) parser.SelectStmt {
+	if len(stmt.Relations) == 0 {
+		return stmt
+	}
+	rels := make([]parser.AliasedStreamWindowAST, len(stmt.Relations))
+	for i, rel := range stmt.Relations {
+		switch rel.Type {
+		case parser.ActualStream:
+			// Statements of views don't have to be expanded again
+			// because they don't refer to other views.
+			if v, ok := views[strings.ToLower(rel.Name)]; ok {
+				if rel.Alias == "" {
+					rel.Alias = rel.Name
+				}
+				rel.Stream = parser.Stream{
+					Type:     parser.SubqueryStream,
+					Name:     rel.Name,
+					Subquery: &v,
+				}
+			}
+
+		case parser.SubqueryStream:
+			sub := expandViews(*rel.Subquery, views)
+			rel.Subquery = &sub
+		}
+		rels[i] = rel
+	}
+	stmt.Relations = rels
+	return stmt
+}
+
+// validateSelectStmt checks if execution plans can be created from the
+// statement and its subqueries without adding them to the topology.
+func (tb *TopologyBuilder) validateSelectStmt(stmt *parser.SelectStmt) error {
+	return validateSelectStmt(stmt, tb.Reg)
+}
+
+func validateSelectStmt(stmt *parser.SelectStmt, reg udf.FunctionRegistry) error {
+	analyzedPlan, err := execution.Analyze(*stmt, reg)
+	if err != nil {
+		return err
+	}
+	if _, err := analyzedPlan.LogicalOptimize(); err != nil {
+		return err
+	}
+	for _, rel := range stmt.Relations {
+		if rel.Type == parser.SubqueryStream {
+			if err := validateSelectStmt(rel.Subquery, reg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package bql
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestCreateViewStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a paused source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy WITH num=4`), ShouldBeNil)
+
+		Convey("When creating a view", func() {
+			So(addBQLToTopology(tb, `CREATE VIEW v AS
+				SELECT ISTREAM int * 2 AS d FROM s [RANGE 1 TUPLES] WHERE int % 2 = 0`), ShouldBeNil)
+
+			Convey("Then no node should be created", func() {
+				So(len(dt.Nodes()), ShouldEqual, 1)
+			})
+
+			Convey("Then it can be used in a stream", func() {
+				So(addBQLToTopology(tb, `
+					CREATE STREAM t AS SELECT ISTREAM v:d FROM v [RANGE 1 TUPLES];
+					CREATE SINK k TYPE collector;
+					INSERT INTO k FROM t;
+					RESUME SOURCE s;
+				`), ShouldBeNil)
+				sink, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(2)
+				So(si.len(), ShouldEqual, 2)
+				So(si.get(0).Data["d"], ShouldEqual, data.Int(4))
+				So(si.get(1).Data["d"], ShouldEqual, data.Int(8))
+			})
+
+			Convey("Then it can be used with an alias", func() {
+				So(addBQLToTopology(tb, `CREATE STREAM t AS
+					SELECT ISTREAM a:d FROM v [RANGE 1 TUPLES] AS a, v [RANGE 2 TUPLES] AS b`), ShouldBeNil)
+				So(len(dt.Nodes()), ShouldEqual, 4)
+			})
+
+			Convey("Then it can be used in another view", func() {
+				So(addBQLToTopology(tb, `
+					CREATE VIEW w AS SELECT ISTREAM d + 1 AS e FROM v [RANGE 1 TUPLES];
+					DROP VIEW v;
+					CREATE STREAM t AS SELECT ISTREAM e FROM w [RANGE 1 TUPLES];
+					CREATE SINK k TYPE collector;
+					INSERT INTO k FROM t;
+					RESUME SOURCE s;
+				`), ShouldBeNil)
+				sink, err := dt.Sink("k")
+				So(err, ShouldBeNil)
+				si := sink.Sink().(*tupleCollectorSink)
+				si.Wait(2)
+				So(si.get(0).Data["e"], ShouldEqual, data.Int(5))
+				So(si.get(1).Data["e"], ShouldEqual, data.Int(9))
+			})
+
+			Convey("Then it can be used in a subquery", func() {
+				So(addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM * FROM
+					(SELECT ISTREAM d FROM v [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS x`), ShouldBeNil)
+				So(len(dt.Nodes()), ShouldEqual, 4)
+			})
+
+			Convey("Then it can be explained", func() {
+				istmt, _, err := parser.New().ParseStmt(`EXPLAIN SELECT ISTREAM d FROM v [RANGE 1 TUPLES]`)
+				So(err, ShouldBeNil)
+				stmt := istmt.(parser.ExplainStmt)
+				m, err := tb.RunExplainStmt(&stmt)
+				So(err, ShouldBeNil)
+				alias, err := m.Get(data.MustCompilePath("relations[0].alias"))
+				So(err, ShouldBeNil)
+				So(alias, ShouldEqual, data.String("v"))
+				name, err := m.Get(data.MustCompilePath("relations[0].subquery.relations[0].name"))
+				So(err, ShouldBeNil)
+				So(name, ShouldEqual, data.String("s"))
+			})
+
+			Convey("Then creating it again should fail", func() {
+				err := addBQLToTopology(tb, `CREATE VIEW v AS SELECT ISTREAM int FROM s [RANGE 1 TUPLES]`)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "already used by a view")
+			})
+
+			Convey("Then creating a stream having the same name should fail", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM v AS SELECT ISTREAM int FROM s [RANGE 1 TUPLES]`)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "already used by a view")
+			})
+
+			Convey("Then creating a source having the same name should fail", func() {
+				err := addBQLToTopology(tb, `CREATE SOURCE v TYPE dummy`)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "already used by a view")
+			})
+		})
+
+		Convey("When creating a view having the same name as a node", func() {
+			err := addBQLToTopology(tb, `CREATE VIEW S AS SELECT ISTREAM int FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "already used by a node")
+			})
+		})
+
+		Convey("When creating a view referring to itself", func() {
+			err := addBQLToTopology(tb, `CREATE VIEW v AS SELECT ISTREAM int FROM v [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "refers to itself")
+			})
+		})
+
+		Convey("When creating an invalid view", func() {
+			err := addBQLToTopology(tb, `CREATE VIEW v AS SELECT ISTREAM int, count(int) FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the view shouldn't be created", func() {
+				So(addBQLToTopology(tb, `CREATE STREAM v AS SELECT ISTREAM int FROM s [RANGE 1 TUPLES]`), ShouldBeNil)
+			})
+		})
+
+		Convey("When creating a view reading from a stream which doesn't exist yet", func() {
+			err := addBQLToTopology(tb, `CREATE VIEW v AS SELECT ISTREAM int FROM t [RANGE 1 TUPLES]`)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then it can be used after the stream is created", func() {
+				So(addBQLToTopology(tb, `
+					CREATE STREAM t AS SELECT ISTREAM int FROM s [RANGE 1 TUPLES];
+					CREATE STREAM u AS SELECT ISTREAM int FROM v [RANGE 1 TUPLES];
+				`), ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestDropViewStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a view", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `
+			CREATE PAUSED SOURCE s TYPE dummy WITH num=4;
+			CREATE VIEW v AS SELECT ISTREAM int FROM s [RANGE 1 TUPLES];
+		`), ShouldBeNil)
+
+		Convey("When dropping the view", func() {
+			So(addBQLToTopology(tb, `DROP VIEW v`), ShouldBeNil)
+
+			Convey("Then it cannot be used anymore", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM v [RANGE 1 TUPLES]`)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "was not found")
+			})
+
+			Convey("Then dropping it again should fail", func() {
+				err := addBQLToTopology(tb, `DROP VIEW v`)
+				So(err, ShouldNotBeNil)
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When dropping the view used by a stream", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM t AS SELECT ISTREAM int FROM v [RANGE 1 TUPLES];
+				DROP VIEW v;
+			`), ShouldBeNil)
+
+			Convey("Then the stream should keep running", func() {
+				_, err := dt.Box("t")
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/server/response"
+	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
+	"net/http"
+	"testing"
+)
+
+func TestFunctions(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+	r := newTestRequester(s)
+
+	Convey("Given an API server with a topology", t, func() {
+		res, _, err := do(r, Post, "/topologies", map[string]interface{}{
+			"name": "test_topology",
+		})
+		So(err, ShouldBeNil)
+		So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+		Reset(func() {
+			do(r, Delete, "/topologies/test_topology", nil)
+		})
+
+		type indexRes struct {
+			Topology  string               `json:"topology"`
+			Count     int                  `json:"count"`
+			Functions []*response.Function `json:"functions"`
+		}
+
+		Convey("When listing functions", func() {
+			res, _, err := do(r, Get, "/topologies/test_topology/functions", nil)
+			So(err, ShouldBeNil)
+			So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+			Convey("Then the list shouldn't contain built-in functions", func() {
+				s := indexRes{}
+				So(res.ReadJSON(&s), ShouldBeNil)
+				So(s.Topology, ShouldEqual, "test_topology")
+				So(s.Count, ShouldEqual, 0)
+				So(s.Functions, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When listing functions of a nonexistent topology", func() {
+			res, _, err := do(r, Get, "/topologies/test_topology2/functions", nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When creating a function", func() {
+			res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `CREATE FUNCTION c_to_f(x) AS x * 9 / 5 + 32;`,
+			})
+			So(err, ShouldBeNil)
+			So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+			Convey("Then the list should contain the function", func() {
+				res, _, err := do(r, Get, "/topologies/test_topology/functions", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				s := indexRes{}
+				So(res.ReadJSON(&s), ShouldBeNil)
+				So(s.Count, ShouldEqual, 1)
+
+				f := s.Functions[0]
+				So(f.Name, ShouldEqual, "c_to_f")
+				So(f.Params, ShouldResemble, []string{"x"})
+				So(f.Body, ShouldEqual, "(x * 9) / 5 + 32")
+			})
+
+			Convey("And dropping it", func() {
+				res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+					"queries": `DROP FUNCTION c_to_f;`,
+				})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+				Convey("Then the list should be empty", func() {
+					res, _, err := do(r, Get, "/topologies/test_topology/functions", nil)
+					So(err, ShouldBeNil)
+					s := indexRes{}
+					So(res.ReadJSON(&s), ShouldBeNil)
+					So(s.Count, ShouldEqual, 0)
+				})
+			})
+		})
+	})
+}
@@ -120,5 +120,73 @@ func TestSources(t *testing.T) {
 				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
 			})
 		})
+
+		Convey("When adding an http source", func() {
+			res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `CREATE SOURCE test_source TYPE http WITH queue_size=2;`,
+			})
+			So(err, ShouldBeNil)
+			So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+			path := "/topologies/test_topology/sources/test_source/tuples"
+
+			Convey("Then posting tuples should succeed", func() {
+				res, js, err := do(r, Post, path, []map[string]interface{}{{"a": 1}, {"a": 2}})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				So(jsonNumberToInt64(js["count"]), ShouldEqual, 2)
+			})
+
+			Convey("Then posting an invalid body should fail", func() {
+				res, _, err := do(r, Post, path, []interface{}{})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+			})
+
+			Convey("Then posting more tuples than the queue size should fail", func() {
+				res, _, err := do(r, Post, path, []map[string]interface{}{{"a": 1}, {"a": 2}, {"a": 3}})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusRequestEntityTooLarge)
+			})
+
+			Convey("Then posting tuples to the paused source should fail", func() {
+				res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+					"queries": `PAUSE SOURCE test_source;`,
+				})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+				res, _, err = do(r, Post, path, map[string]interface{}{"a": 1})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusTooManyRequests)
+			})
+
+			Convey("Then posting tuples to the dropped source should fail", func() {
+				res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+					"queries": `DROP SOURCE test_source;`,
+				})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+				res, _, err = do(r, Post, path, map[string]interface{}{"a": 1})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When posting tuples to a source which doesn't receive tuples", func() {
+			res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `CREATE PAUSED SOURCE test_source TYPE dummy;`,
+			})
+			So(err, ShouldBeNil)
+			So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+			res, _, err = do(r, Post, "/topologies/test_topology/sources/test_source/tuples",
+				map[string]interface{}{"a": 1})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+			})
+		})
 	})
 }
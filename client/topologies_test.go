@@ -374,6 +374,69 @@ func TestTopologiesQueriesEvalStmt(t *testing.T) {
 				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
 			})
 		})
+
+		Convey("When issueing an EVAL statement with params", func() {
+			res, js, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `EVAL "日本" || $suffix`,
+				"params": map[string]interface{}{
+					"suffix": "語",
+				},
+			})
+
+			Convey("Then the placeholder should be replaced by the value", func() {
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				So(js["result"], ShouldEqual, "日本語")
+			})
+		})
+
+		Convey("When issueing an EVAL statement with an unbound placeholder", func() {
+			res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `EVAL "日本" || $suffix`,
+			})
+
+			Convey("Then the statement should not execute", func() {
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+			})
+		})
+
+		Convey("When issueing an EVAL statement with invalid params", func() {
+			res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `EVAL "日本" || $suffix`,
+				"params":  "語",
+			})
+
+			Convey("Then the statement should not execute", func() {
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+			})
+		})
+
+		Convey("When issueing an EXPLAIN statement", func() {
+			res, js, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `EXPLAIN SELECT RSTREAM a FROM s [RANGE 1 TUPLES]`,
+			})
+
+			Convey("Then the plan should be returned", func() {
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				result, ok := js["result"].(map[string]interface{})
+				So(ok, ShouldBeTrue)
+				So(result["plan"], ShouldResemble, map[string]interface{}{"name": "filterPlan"})
+			})
+		})
+
+		Convey("When issueing an EXPLAIN statement with another statement", func() {
+			res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `EXPLAIN SELECT RSTREAM a FROM s [RANGE 1 TUPLES]; EVAL 1`,
+			})
+
+			Convey("Then the statement should not execute", func() {
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+			})
+		})
 	})
 }
 
@@ -219,5 +219,5 @@ func main() {
 )
 
 var (
-	defaultCommands = []string{"run", "shell", "topology", "runfile"}
+	defaultCommands = []string{"run", "shell", "topology", "runfile", "lint"}
 )
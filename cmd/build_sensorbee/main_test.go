@@ -64,6 +64,7 @@ commands:
 						"shell":    commandDetail{},
 						"topology": commandDetail{},
 						"runfile":  commandDetail{},
+						"lint":     commandDetail{},
 					},
 					Version: version.Version,
 				}
@@ -22,8 +22,11 @@ type Statement struct {
 //	* UPDATE SOURCE/SINK/STATE
 //	* DROP SOURCE/STREAM/SINK/STATE
 //	* SAVE/LOAD STATE (they are automatically saved)
+//	* CREATE/DROP VIEW (use CREATE STREAM instead)
 func NewStatement(s interface{}) (*Statement, error) {
 	switch s.(type) {
+	case parser.CreateViewStmt, parser.DropViewStmt:
+		return nil, fmt.Errorf("views aren't supported by sensorbee exp command, use CREATE STREAM instead: %v", s)
 	case parser.SelectStmt, parser.SelectUnionStmt,
 		parser.UpdateSourceStmt, parser.UpdateSinkStmt, parser.UpdateStateStmt,
 		parser.DropSourceStmt, parser.DropStreamStmt, parser.DropSinkStmt, parser.DropStateStmt,
@@ -69,6 +72,13 @@ func inputFromSelect(stmt *parser.SelectStmt) ([]string, error) {
 		case parser.ActualStream:
 			names = append(names, rel.Name)
 
+		case parser.SubqueryStream:
+			ns, err := inputFromSelect(rel.Subquery)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, ns...)
+
 		case parser.UDSFStream:
 			ctx := core.NewContext(nil)
 			udfReg := udf.CopyGlobalUDFRegistry(ctx)
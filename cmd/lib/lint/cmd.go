@@ -0,0 +1,154 @@
+/*
+Package lint implements sensorbee lint command. This command statically
+checks BQL files without running them so that mistakes such as references to
+unknown streams or functions can be found before running the files by
+runfile command or in a SensorBee server. Sources, sinks, UDFs, UDSFs, and UDS
+types which can be used in the files are the ones registered to the sensorbee
+command by plugins.
+*/
+package lint
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/cmd/lib/param"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// SetUp sets up a command for checking BQL files.
+func SetUp() cli.Command {
+	cmd := cli.Command{
+		Name:  "lint",
+		Usage: "check BQL files without running them",
+		Description: "lint command checks BQL files and reports errors such as references to unknown\n" +
+			"   streams or functions and warnings such as streams which are never used.\n" +
+			"   It exits with a non-zero status when an error is found.",
+		Action: Run,
+	}
+
+	cmd.Flags = []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "param, p",
+			Usage: "bind a value to a placeholder in the BQL file in the form of name=value (the value is parsed as YAML)",
+		},
+		cli.BoolFlag{
+			Name:  "warnings-as-errors, w",
+			Usage: "exit with a non-zero status when a warning is found",
+		},
+	}
+	return cmd
+}
+
+// Run runs "lint" command.
+func Run(c *cli.Context) error {
+	if len(c.Args()) == 0 {
+		cli.ShowSubcommandHelp(c)
+		os.Exit(1)
+	}
+
+	params, err := param.ParseOptions(c.StringSlice("param"))
+	if err != nil {
+		return cli.NewExitError(fmt.Sprintf("Cannot parse 'param' option: %v", err), 1)
+	}
+
+	failed := false
+	for _, path := range c.Args() {
+		errs, warns, err := lintFile(c.App.Writer, path, params)
+		if err != nil {
+			fmt.Fprintf(c.App.Writer, "%v: %v\n", path, err)
+			failed = true
+			continue
+		}
+		if errs > 0 || (warns > 0 && c.Bool("warnings-as-errors")) {
+			failed = true
+		}
+	}
+	if failed {
+		return cli.NewExitError("", 1)
+	}
+	return nil
+}
+
+// lintFile checks a BQL file and writes issues found in it to w. It returns
+// the number of errors and warnings. It returns an error when the file cannot
+// be read or parsed.
+func lintFile(w io.Writer, path string, params data.Map) (int, int, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	stmts, lines, err := parseStmts(string(b), params)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tp, err := core.NewDefaultTopology(core.NewContext(nil), "lint")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tp.Stop()
+	tb, err := bql.NewTopologyBuilder(tp)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	errs, warns := 0, 0
+	for _, i := range tb.Lint(stmts) {
+		if i.Severity == bql.LintError {
+			errs++
+		} else {
+			warns++
+		}
+		fmt.Fprintf(w, "%v:%v: %v: %v\n", path, lines[i.Index], i.Severity, i.Message)
+	}
+	return errs, warns, nil
+}
+
+// parseStmts parses all statements in the BQL. It also returns the line
+// number at which each statement starts.
+func parseStmts(s string, params data.Map) ([]interface{}, []int, error) {
+	var (
+		stmts []interface{}
+		lines []int
+	)
+	bp := parser.NewWithParams(params)
+	rest := skipSpacesAndComments(s)
+	for rest != "" {
+		line := strings.Count(s[:len(s)-len(rest)], "\n") + 1
+		stmt, r, err := bp.ParseStmt(rest)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot parse the statement at line %v: %v", line, err)
+		}
+		stmts = append(stmts, stmt)
+		lines = append(lines, line)
+
+		// r is a suffix of s, so the length of it tells where the
+		// next statement starts.
+		rest = skipSpacesAndComments(r)
+	}
+	return stmts, lines, nil
+}
+
+// skipSpacesAndComments removes whitespaces and "--" comments at the
+// beginning of s.
+func skipSpacesAndComments(s string) string {
+	for {
+		s = strings.TrimLeft(s, " \t\r\n")
+		if !strings.HasPrefix(s, "--") {
+			return s
+		}
+		i := strings.IndexAny(s, "\r\n")
+		if i < 0 {
+			return ""
+		}
+		s = s[i:]
+	}
+}
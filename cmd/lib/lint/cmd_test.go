@@ -0,0 +1,118 @@
+package lint
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestLintFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sensorbee_lint_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, bql string) string {
+		path := filepath.Join(dir, name)
+		So(ioutil.WriteFile(path, []byte(bql), 0644), ShouldBeNil)
+		return path
+	}
+
+	Convey("Given a BQL file having issues", t, func() {
+		path := writeFile("issues.bql", `-- comment
+CREATE SOURCE s TYPE no_such_type;
+
+CREATE STREAM t AS SELECT ISTREAM no_such_func(x)
+    FROM s [RANGE 1 TUPLES];
+CREATE SINK k TYPE stdout; INSERT INTO k FROM u;
+`)
+
+		Convey("When linting it", func() {
+			buf := bytes.NewBuffer(nil)
+			errs, warns, err := lintFile(buf, path, data.Map{})
+			So(err, ShouldBeNil)
+
+			Convey("Then issues should be reported with line numbers", func() {
+				So(errs, ShouldEqual, 3)
+				So(warns, ShouldEqual, 1)
+				So(buf.String(), ShouldEqual, path+":2: error: source type 'no_such_type' is not registered\n"+
+					path+":4: error: function 'no_such_func' is unknown\n"+
+					path+":4: warning: stream 't' isn't read by any stream or sink\n"+
+					path+":6: error: stream 'u' is unknown\n")
+			})
+		})
+	})
+
+	Convey("Given a valid BQL file with placeholders", t, func() {
+		path := writeFile("valid.bql", `CREATE PAUSED SOURCE s TYPE node_statuses WITH interval=$interval;
+CREATE SINK k TYPE stdout;
+INSERT INTO k FROM s;`)
+
+		Convey("When linting it with parameters", func() {
+			buf := bytes.NewBuffer(nil)
+			errs, warns, err := lintFile(buf, path, data.Map{"interval": data.Int(1)})
+
+			Convey("Then no issue should be reported", func() {
+				So(err, ShouldBeNil)
+				So(errs, ShouldEqual, 0)
+				So(warns, ShouldEqual, 0)
+				So(buf.String(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When linting it without parameters", func() {
+			_, _, err := lintFile(bytes.NewBuffer(nil), path, data.Map{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "cannot parse the statement at line 1")
+			})
+		})
+	})
+
+	Convey("Given a BQL file having a syntax error", t, func() {
+		path := writeFile("syntax.bql", `CREATE SOURCE s TYPE dummy;
+CREATE SOURCE s2 TYPO dummy;`)
+
+		Convey("When linting it", func() {
+			_, _, err := lintFile(bytes.NewBuffer(nil), path, data.Map{})
+
+			Convey("Then it should fail with the line of the statement", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "cannot parse the statement at line 2")
+			})
+		})
+	})
+
+	Convey("Given a nonexistent file", t, func() {
+		Convey("When linting it", func() {
+			_, _, err := lintFile(bytes.NewBuffer(nil), filepath.Join(dir, "no_such_file.bql"), data.Map{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestParseStmts(t *testing.T) {
+	Convey("Given BQL having comments before statements", t, func() {
+		bql := "-- header\n  -- more\n\nCREATE SINK k TYPE stdout; -- trailing\n-- comment\nINSERT INTO k FROM s;\n-- end"
+
+		Convey("When parsing it", func() {
+			stmts, lines, err := parseStmts(bql, data.Map{})
+			So(err, ShouldBeNil)
+
+			Convey("Then line numbers should point to the statements", func() {
+				So(stmts, ShouldHaveLength, 2)
+				So(lines, ShouldResemble, []int{4, 6})
+			})
+		})
+	})
+}
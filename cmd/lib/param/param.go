@@ -0,0 +1,40 @@
+/*
+Package param parses parameters given to BQL files by param options of
+commands such as runfile and lint.
+*/
+package param
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"gopkg.in/yaml.v2"
+)
+
+// ParseOptions parses values of param options. Each option has the form of
+// name=value and value is parsed as YAML so that it can have a type other
+// than string, e.g. --param threshold=10 or --param 'ids=[1, 2]'.
+func ParseOptions(opts []string) (data.Map, error) {
+	params := data.Map{}
+	for _, opt := range opts {
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("param must have the form of name=value: %v", opt)
+		}
+		if _, ok := params[kv[0]]; ok {
+			return nil, fmt.Errorf("param '%v' is specified more than once", kv[0])
+		}
+
+		var v interface{}
+		if err := yaml.Unmarshal([]byte(kv[1]), &v); err != nil {
+			return nil, fmt.Errorf("cannot parse the value of param '%v': %v", kv[0], err)
+		}
+		value, err := data.NewValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("param '%v' has an invalid value: %v", kv[0], err)
+		}
+		params[kv[0]] = value
+	}
+	return params, nil
+}
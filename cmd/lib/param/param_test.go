@@ -0,0 +1,48 @@
+package param
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestParseOptions(t *testing.T) {
+	Convey("Given param options", t, func() {
+		Convey("When parsing options having values of various types", func() {
+			params, err := ParseOptions([]string{"a=10", "b=hoge", "c=[1, 2]", "d=x=y", "e="})
+
+			Convey("Then values should be parsed as YAML", func() {
+				So(err, ShouldBeNil)
+				So(params, ShouldResemble, data.Map{
+					"a": data.Int(10),
+					"b": data.String("hoge"),
+					"c": data.Array{data.Int(1), data.Int(2)},
+					"d": data.String("x=y"),
+					"e": data.Null{},
+				})
+			})
+		})
+
+		Convey("When parsing no option", func() {
+			params, err := ParseOptions(nil)
+
+			Convey("Then an empty map should be returned", func() {
+				So(err, ShouldBeNil)
+				So(params, ShouldBeEmpty)
+			})
+		})
+
+		for _, opts := range [][]string{{"a"}, {"=1"}, {"a=1", "a=2"}, {"a=[1"}} {
+			opts := opts
+
+			Convey("When parsing invalid options "+opts[len(opts)-1], func() {
+				_, err := ParseOptions(opts)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
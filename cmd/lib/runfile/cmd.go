@@ -18,6 +18,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/cmd/lib/param"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"gopkg.in/sensorbee/sensorbee.v0/server/config"
@@ -52,6 +53,10 @@ func SetUp() cli.Command {
 			Value: "",
 			Usage: "name of the topology",
 		},
+		cli.StringSliceFlag{
+			Name:  "param, p",
+			Usage: "bind a value to a placeholder in the BQL file in the form of name=value (the value is parsed as YAML)",
+		},
 	}
 	return cmd
 }
@@ -127,7 +132,13 @@ func Run(c *cli.Context) error {
 			return emptyError
 		}
 
-		if err := setUpBQLStmt(tb, bqlFile); err != nil {
+		params, err := param.ParseOptions(c.StringSlice("param"))
+		if err != nil {
+			logger.WithField("err", err).Error("Cannot parse 'param' option")
+			return emptyError
+		}
+
+		if err := setUpBQLStmt(tb, bqlFile, params); err != nil {
 			logger.WithFields(logrus.Fields{
 				"err":      err,
 				"bql_file": bqlFile,
@@ -245,7 +256,7 @@ func setUpTopology(name string, logger *logrus.Logger, conf *config.Config, us u
 	return tb, nil
 }
 
-func setUpBQLStmt(tb *bql.TopologyBuilder, bqlFile string) error {
+func setUpBQLStmt(tb *bql.TopologyBuilder, bqlFile string, params data.Map) error {
 	queries, err := func() (string, error) {
 		f, err := os.Open(bqlFile)
 		if err != nil {
@@ -262,27 +273,30 @@ func setUpBQLStmt(tb *bql.TopologyBuilder, bqlFile string) error {
 		return err
 	}
 
-	bp := parser.New()
+	bp := parser.NewWithParams(params)
 	// TODO: provide better parse error reporting using ParseStmt instead of ParseStmts
 	stmts, err := bp.ParseStmts(string(queries))
 	if err != nil {
 		return err
 	}
 
+	session := tb.NewSession()
 	for _, stmt := range stmts {
 		// TODO: if stmt is CREATE SOURCE, create it with PAUSED
-		if n, err := tb.AddStmt(stmt); err != nil {
+		if n, err := session.AddStmt(stmt); err != nil {
+			session.Close()
 			tb.Topology().Context().ErrLog(err).WithField("stmt", stmt).Error(
 				"Cannot add a statement to the topology")
 			return err // FIXME: logger output "err" two twice
 		} else if n != nil && n.Type() == core.NTSource {
 			sn, _ := n.(core.SourceNode)
 			if _, ok := sn.Source().(core.RewindableSource); ok {
+				session.Close()
 				return fmt.Errorf(`rewindable source "%v" isn't supported`, n.Name())
 			}
 		}
 	}
-	return nil
+	return session.Close()
 }
 
 func hasStates(tb *bql.TopologyBuilder, saveUDSList string) error {
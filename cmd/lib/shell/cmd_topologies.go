@@ -62,6 +62,11 @@ func (ct *changeTopologyCmd) Eval(requester *client.Requester) {
 
 type bqlCmd struct {
 	buffer string
+
+	// tx has statements entered after BEGIN. Because a transaction cannot
+	// span multiple requests, they're sent together when COMMIT or ROLLBACK
+	// is entered.
+	tx string
 }
 
 // Init BQL state.
@@ -72,7 +77,7 @@ func (b *bqlCmd) Init() error {
 // Name returns BQL start words.
 func (b *bqlCmd) Name() []string {
 	return []string{"select", "create", "update", "insert", "pause", "resume",
-		"rewind", "drop", "save", "load", "eval"}
+		"rewind", "drop", "save", "load", "eval", "begin", "commit", "rollback", "explain"}
 }
 
 func (b *bqlCmd) Input(input string) (cmdInputStatusType, error) {
@@ -94,7 +99,35 @@ func (b *bqlCmd) Eval(requester *client.Requester) {
 	queries := b.buffer
 	b.buffer = ""
 
-	sendBQLQueries(requester, queries)
+	if queries = b.bufferTransaction(queries); queries != "" {
+		sendBQLQueries(requester, queries)
+	}
+}
+
+// bufferTransaction buffers statements from BEGIN to COMMIT or ROLLBACK. It
+// returns statements to be sent, which are all statements of the transaction
+// when it's finished by the given statement. It returns an empty string while
+// the transaction is in progress.
+func (b *bqlCmd) bufferTransaction(stmt string) string {
+	keyword := ""
+	if fs := strings.Fields(stmt); len(fs) > 0 {
+		keyword = strings.ToLower(strings.TrimRight(fs[0], ";"))
+	}
+	if b.tx == "" {
+		if keyword != "begin" {
+			return stmt
+		}
+		b.tx = stmt
+		return ""
+	}
+
+	b.tx += "\n" + stmt
+	if keyword != "commit" && keyword != "rollback" {
+		return ""
+	}
+	queries := b.tx
+	b.tx = ""
+	return queries
 }
 
 func sendBQLQueries(requester *client.Requester, queries string) {
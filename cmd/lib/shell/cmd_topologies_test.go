@@ -92,3 +92,37 @@ func TestBQLCommandWithVariousStatements(t *testing.T) {
 		})
 	})
 }
+
+func TestBQLCommandWithTransaction(t *testing.T) {
+	Convey("Given a BQL command struct", t, func() {
+		cmd := bqlCmd{}
+
+		Convey("When a statement is given outside of a transaction", func() {
+			q := cmd.bufferTransaction("pause source s;")
+
+			Convey("Then it should be sent immediately", func() {
+				So(q, ShouldEqual, "pause source s;")
+			})
+		})
+
+		Convey("When statements are given after BEGIN", func() {
+			So(cmd.bufferTransaction("BEGIN;"), ShouldEqual, "")
+			So(cmd.bufferTransaction("create sink k type stdout;"), ShouldEqual, "")
+			So(cmd.bufferTransaction("insert into k\nfrom s;"), ShouldEqual, "")
+
+			Convey("Then all statements should be sent with COMMIT", func() {
+				So(cmd.bufferTransaction("commit;"), ShouldEqual,
+					"BEGIN;\ncreate sink k type stdout;\ninsert into k\nfrom s;\ncommit;")
+
+				Convey("And following statements should be sent immediately", func() {
+					So(cmd.bufferTransaction("pause source s;"), ShouldEqual, "pause source s;")
+				})
+			})
+
+			Convey("Then all statements should be sent with ROLLBACK", func() {
+				So(cmd.bufferTransaction("ROLLBACK ;"), ShouldEqual,
+					"BEGIN;\ncreate sink k type stdout;\ninsert into k\nfrom s;\nROLLBACK ;")
+			})
+		})
+	})
+}
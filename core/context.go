@@ -85,6 +85,14 @@ func (c *Context) log(depth int) *logrus.Entry {
 	})
 }
 
+// DroppedTuple reports a tuple dropped by a node itself, e.g. a tuple a Box
+// discards because it's invalid. The tuple is logged and sent to dropped
+// tuple collectors in the same way as tuples dropped by the topology. The
+// tuple is copied before it's reported, so the caller can keep using it.
+func (c *Context) DroppedTuple(t *Tuple, nodeType NodeType, nodeName string, et EventType, err error) {
+	c.droppedTuple(t.Copy(), nodeType, nodeName, et, err)
+}
+
 // droppedTuple records tuples dropped by errors.
 func (c *Context) droppedTuple(t *Tuple, nodeType NodeType, nodeName string, et EventType, err error) {
 	if t.Flags.IsSet(TFDropped) {
@@ -57,6 +57,16 @@ func (ds *defaultSinkNode) Input(refname string, config *SinkInputConfig) error
 	return nil
 }
 
+func (ds *defaultSinkNode) RemoveInput(refname string) error {
+	s, err := ds.topology.dataSource(refname)
+	if err != nil {
+		return err
+	}
+	s.destinations().remove(ds.name)
+	ds.srcs.remove(s.Name())
+	return nil
+}
+
 func (ds *defaultSinkNode) run() (runErr error) {
 	if err := ds.checkAndPrepareForRunning("sink"); err != nil {
 		return err
@@ -946,6 +946,49 @@ func TestLinearDefaultTopology(t *testing.T) {
 			})
 		})
 
+		Convey("When removing the input of the sink after receiving some tuples", func() {
+			Reset(func() {
+				t.Stop()
+			})
+			so.EmitTuples(2)
+			si.Wait(2)
+			So(sin.(InputRemover).RemoveInput("BOX2"), ShouldBeNil)
+			so.EmitTuples(2)
+
+			Convey("Then the sink should still be running", func() {
+				So(sin.State().Get(), ShouldEqual, TSRunning)
+			})
+
+			Convey("Then sink shouldn't receive tuples generated after the input got removed", func() {
+				So(si.len(), ShouldEqual, 2)
+			})
+
+		})
+
+		Convey("When removing the input of the sink and connecting it again", func() {
+			Reset(func() {
+				t.Stop()
+			})
+			so.EmitTuples(2)
+			si.Wait(2)
+			So(sin.(InputRemover).RemoveInput("BOX2"), ShouldBeNil)
+			So(sin.Input("BOX2", nil), ShouldBeNil)
+			so.EmitTuples(2)
+
+			Convey("Then the sink should receive tuples through the new connection", func() {
+				si.Wait(4)
+				So(si.len(), ShouldEqual, 4)
+			})
+		})
+
+		Convey("When removing the input of the sink from a node which doesn't exist", func() {
+			err := sin.(InputRemover).RemoveInput("no_such_node")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
 		Convey("When generating some tuples and pause the source", func() { // 2.a.
 			Reset(func() {
 				t.Stop()
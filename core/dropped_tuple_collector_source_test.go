@@ -103,6 +103,35 @@ func TestDroppedTupleCollectorSource(t *testing.T) {
 			})
 		})
 
+		Convey("When a Box reports tuples it keeps using", func() {
+			bn, err := t.AddBox("box", BoxFunc(func(ctx *Context, t *Tuple, w Writer) error {
+				ctx.DroppedTuple(t, NTBox, "box", ETInput, errors.New("box report"))
+				return w.Write(ctx, t)
+			}), nil)
+			So(err, ShouldBeNil)
+			So(bn.Input("source", nil), ShouldBeNil)
+			si2 := NewTupleCollectorSink()
+			sin2, err := t.AddSink("sink2", si2, nil)
+			So(err, ShouldBeNil)
+			So(sin2.Input("box", nil), ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+
+			Convey("Then they should be reported", func() {
+				si.Wait(8)
+				So(si.len(), ShouldEqual, 8)
+				si.forEachTuple(func(t *Tuple) {
+					locationChecker(t, bn)
+				})
+			})
+
+			Convey("Then the tuples written by the Box shouldn't be modified", func() {
+				si2.Wait(8)
+				So(si2.len(), ShouldEqual, 8)
+				So(si2.get(0).Data, ShouldResemble, freshTuples()[0].Data)
+				So(si2.get(0).Flags.IsSet(TFDropped), ShouldBeFalse)
+			})
+		})
+
 		Convey("When tuples are dropped from a Sink", func() {
 			sin2, err := t.AddSink("fail_sink", &writeFailSink{}, nil)
 			So(err, ShouldBeNil)
@@ -344,6 +344,14 @@ type SinkNode interface {
 	StopOnDisconnect()
 }
 
+// InputRemover is implemented by nodes whose input connections can be
+// removed. SinkNodes created by the default topology implement it.
+type InputRemover interface {
+	// RemoveInput removes the input connection from the node having the given
+	// name. It doesn't return an error when the node isn't connected to it.
+	RemoveInput(refname string) error
+}
+
 // SinkInputConfig has parameters to customize input behavior of a Sink on
 // each input pipe.
 type SinkInputConfig struct {
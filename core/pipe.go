@@ -170,8 +170,9 @@ func (s *pipeSender) close() {
 	for _, d := range s.registeredDsts {
 		// There can be a circular recursive call like pipeSender.close ->
 		// dataDestinations.remove -> pipeSender.close. So, this should be
-		// called via goroutine.
-		go d.dst.remove(d.registeredName)
+		// called via goroutine. Only this sender is removed so that a new
+		// connection added with the same name in the meantime survives.
+		go d.dst.removeSender(d.registeredName, s)
 	}
 	s.registeredDsts = nil
 }
@@ -759,6 +760,13 @@ func (d *dataDestinations) add(name string, s *pipeSender) error {
 }
 
 func (d *dataDestinations) remove(name string) {
+	d.removeSender(name, nil)
+}
+
+// removeSender removes the destination having the given name only when its
+// sender is s. When s is nil, it removes the destination regardless of its
+// sender.
+func (d *dataDestinations) removeSender(name string, s *pipeSender) {
 	d.rwm.Lock()
 	defer d.rwm.Unlock()
 	if d.dsts == nil {
@@ -766,7 +774,7 @@ func (d *dataDestinations) remove(name string) {
 	}
 
 	dst, ok := d.dsts[name]
-	if !ok {
+	if !ok || (s != nil && dst != s) {
 		return
 	}
 	delete(d.dsts, name)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/ugorji/go/codec"
+	"io"
 	"math"
 	"reflect"
 	"time"
@@ -111,6 +112,29 @@ func UnmarshalMsgpack(b []byte) (Map, error) {
 	return NewMap(m)
 }
 
+// MsgpackDecoder reads Maps from a stream of msgpack-encoded maps such as
+// ones written by concatenating results of MarshalMsgpack.
+type MsgpackDecoder struct {
+	dec *codec.Decoder
+}
+
+// NewMsgpackDecoder returns a MsgpackDecoder reading maps from r.
+func NewMsgpackDecoder(r io.Reader) *MsgpackDecoder {
+	return &MsgpackDecoder{
+		dec: codec.NewDecoder(r, msgpackHandle),
+	}
+}
+
+// Decode reads the next map from the stream. It returns io.EOF when the
+// stream doesn't have any more map.
+func (d *MsgpackDecoder) Decode() (Map, error) {
+	var m map[string]interface{}
+	if err := d.dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	return NewMap(m)
+}
+
 // NewMap returns a Map object from map[string]interface{}.
 // Returns an error when value type is not supported in SensorBee.
 //
@@ -1,10 +1,12 @@
 package data
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/ugorji/go/codec"
+	"io"
 	"math"
 	"testing"
 	"time"
@@ -210,6 +212,37 @@ func TestMarshalMsgpack(t *testing.T) {
 	})
 }
 
+func TestMsgpackDecoder(t *testing.T) {
+	Convey("Given a stream of msgpack-encoded maps", t, func() {
+		var b []byte
+		for i := 0; i < 3; i++ {
+			m, err := MarshalMsgpack(Map{"int": Int(i), "str": String(fmt.Sprint(i))})
+			So(err, ShouldBeNil)
+			b = append(b, m...)
+		}
+		dec := NewMsgpackDecoder(bytes.NewReader(b))
+
+		Convey("When decoding maps", func() {
+			Convey("Then each map should be returned in order", func() {
+				for i := 0; i < 3; i++ {
+					m, err := dec.Decode()
+					So(err, ShouldBeNil)
+					So(m, ShouldResemble, Map{"int": Int(i), "str": String(fmt.Sprint(i))})
+				}
+			})
+
+			Convey("Then it should return io.EOF at the end of the stream", func() {
+				for i := 0; i < 3; i++ {
+					_, err := dec.Decode()
+					So(err, ShouldBeNil)
+				}
+				_, err := dec.Decode()
+				So(err, ShouldEqual, io.EOF)
+			})
+		})
+	})
+}
+
 func TestValue(t *testing.T) {
 	var testData = Map{
 		"bool":   Bool(true),
@@ -13,6 +13,10 @@ type Topology struct {
 
 	// BQLFile is a file path to the BQL file executed on start up.
 	BQLFile string `json:"bql_file" yaml:"bql_file"`
+
+	// Params has values bound to placeholders such as $threshold in the
+	// BQL file.
+	Params data.Map `json:"params,omitempty" yaml:"params,omitempty"`
 }
 
 // Topologies is a set of configuration of topologies.
@@ -32,6 +36,9 @@ var (
 						"bql_file": {
 							"type": "string",
 							"minLength": 1
+						},
+						"params": {
+							"type": "object"
 						}
 					},
 					"additionalProperties": false
@@ -75,6 +82,7 @@ func newTopologies(m data.Map) Topologies {
 		t := &Topology{
 			Name:    name,
 			BQLFile: mustAsString(getWithDefault(mustAsMap(conf), "bql_file", data.String(""))),
+			Params:  mustAsMap(getWithDefault(mustAsMap(conf), "params", data.Map{})),
 		}
 		ts[name] = t
 	}
@@ -86,9 +94,13 @@ func (ts *Topologies) ToMap() data.Map {
 	m := data.Map{}
 	for k, v := range *ts {
 		v := v
-		m[k] = data.Map{
+		t := data.Map{
 			"bql_file": data.String(v.BQLFile),
 		}
+		if len(v.Params) > 0 {
+			t["params"] = v.Params.Copy()
+		}
+		m[k] = t
 	}
 	return m
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"testing"
 )
 
@@ -32,6 +33,37 @@ func TestTopologies(t *testing.T) {
 			})
 		})
 
+		Convey("When the config has params", func() {
+			ts, err := NewTopologies(toMap(`{"test":{"bql_file":"a.bql","params":{"threshold":10,"path":"/tmp"}}}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have the params", func() {
+				So(ts["test"].Params, ShouldResemble, data.Map{
+					"threshold": data.Int(10),
+					"path":      data.String("/tmp"),
+				})
+			})
+
+			Convey("Then ToMap should return the params", func() {
+				m := ts.ToMap()
+				So(m["test"], ShouldResemble, data.Map{
+					"bql_file": data.String("a.bql"),
+					"params": data.Map{
+						"threshold": data.Int(10),
+						"path":      data.String("/tmp"),
+					},
+				})
+			})
+		})
+
+		Convey("When the config has params which isn't an object", func() {
+			_, err := NewTopologies(toMap(`{"test":{"params":[1,2]}}`))
+
+			Convey("Then it should be invalid", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
 		Convey("When the config has an undefined field", func() {
 			_, err := NewTopologies(toMap(`{"test":{"bql_path":"/path/to/hoge.bql"}}`))
 
@@ -220,14 +220,16 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 	}
 
 	// TODO: improve error handling
-	bp := parser.New()
+	bp := parser.NewWithParams(conf.Topologies[name].Params)
 	stmts, err := bp.ParseStmts(string(queries))
 	if err != nil {
 		return nil, err
 	}
 
+	session := tb.NewSession()
 	for _, stmt := range stmts {
-		if _, err := tb.AddStmt(stmt); err != nil {
+		if _, err := session.AddStmt(stmt); err != nil {
+			session.Close()
 			logger.WithFields(logrus.Fields{
 				"err":      err,
 				"topology": name,
@@ -236,6 +238,13 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 			return nil, err
 		}
 	}
+	if err := session.Close(); err != nil {
+		logger.WithFields(logrus.Fields{
+			"err":      err,
+			"topology": name,
+		}).Error("Cannot add statements to the topology")
+		return nil, err
+	}
 
 	shouldStop = false
 	return tb, nil
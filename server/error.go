@@ -25,4 +25,16 @@ const (
 	// nonWebSocketRequestErrorCode is returned when a requested action only
 	// supports WebSocket and a request is a regular HTTP request.
 	nonWebSocketRequestErrorCode = "E0008"
+
+	// nonTupleReceiverSourceErrorCode is returned when tuples are posted to
+	// a source which doesn't receive tuples.
+	nonTupleReceiverSourceErrorCode = "E0009"
+
+	// sourceBusyErrorCode is returned when tuples are posted to a source
+	// which is paused or whose queue is full. The client can retry later.
+	sourceBusyErrorCode = "E0010"
+
+	// requestTooLargeErrorCode is returned when a request has more data than
+	// the server can handle at once. The client has to split the request.
+	requestTooLargeErrorCode = "E0011"
 )
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"errors"
+	"github.com/gocraft/web"
+	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/server/response"
+	"sort"
+)
+
+type functions struct {
+	*topologies
+}
+
+func setUpFunctionsRouter(prefix string, router *web.Router) {
+	root := router.Subrouter(functions{}, "/:topologyName/functions")
+	root.Middleware((*functions).fetchFunctionTopology)
+	root.Get("/", (*functions).Index)
+}
+
+func (fc *functions) fetchFunctionTopology(rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
+	if fc.fetchTopology() == nil {
+		return
+	}
+	next(rw, req)
+}
+
+// Index returns functions created by CREATE FUNCTION statements.
+func (fc *functions) Index(rw web.ResponseWriter, req *web.Request) {
+	lister, ok := fc.topology.Reg.(udf.FunctionLister)
+	if !ok {
+		err := errors.New("the function registry of the topology cannot list functions")
+		fc.ErrLog(err).Error("Cannot list functions")
+		fc.RenderError(jasco.NewInternalServerError(err))
+		return
+	}
+	funcs, err := lister.List()
+	if err != nil {
+		fc.ErrLog(err).Error("Cannot list functions")
+		fc.RenderError(jasco.NewInternalServerError(err))
+		return
+	}
+
+	names := make([]string, 0, len(funcs))
+	for name, f := range funcs {
+		if _, ok := f.(*execution.ExpressionUDF); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	res := make([]*response.Function, 0, len(names))
+	for _, name := range names {
+		res = append(res, response.NewFunction(name, funcs[name].(*execution.ExpressionUDF)))
+	}
+	fc.Render(map[string]interface{}{
+		"topology":  fc.topologyName,
+		"count":     len(res),
+		"functions": res,
+	})
+}
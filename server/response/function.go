@@ -0,0 +1,22 @@
+package response
+
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+)
+
+// Function is a part of the response which is returned by functions' action.
+type Function struct {
+	Name   string   `json:"name"`
+	Params []string `json:"params"`
+	Body   string   `json:"body"`
+}
+
+// NewFunction returns the result of the function created by a CREATE
+// FUNCTION statement.
+func NewFunction(name string, f *execution.ExpressionUDF) *Function {
+	return &Function{
+		Name:   name,
+		Params: f.Params(),
+		Body:   f.Body().String(),
+	}
+}
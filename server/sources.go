@@ -1,10 +1,15 @@
 package server
 
 import (
+	"encoding/json"
+	"errors"
 	"github.com/gocraft/web"
 	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"gopkg.in/sensorbee/sensorbee.v0/server/response"
+	"io"
 	"net/http"
 )
 
@@ -18,6 +23,7 @@ func setUpSourcesRouter(prefix string, router *web.Router) {
 	root.Middleware((*sources).fetchSource)
 	root.Get("/", (*sources).Index)
 	root.Get("/:sourceName", (*sources).Show)
+	root.Post("/:sourceName/tuples", (*sources).Tuples)
 }
 
 func (sc *sources) fetchSource(rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
@@ -63,5 +69,127 @@ func (sc *sources) Show(rw web.ResponseWriter, req *web.Request) {
 	})
 }
 
+// maxTuplesRequestBodySize is the maximum size of the body of a request
+// adding tuples to a source.
+const maxTuplesRequestBodySize = 32 * 1024 * 1024
+
+// Tuples adds tuples in the request body to a source receiving tuples such as
+// "http" source. The body can be a JSON object, a JSON array of objects, or
+// JSON Lines.
+func (sc *sources) Tuples(rw web.ResponseWriter, req *web.Request) {
+	r, ok := bql.AsTupleReceiver(sc.src.Source())
+	if !ok {
+		err := errors.New("the source doesn't receive tuples")
+		sc.ErrLog(err).Error("Cannot add tuples to the source")
+		sc.RenderError(jasco.NewError(nonTupleReceiverSourceErrorCode, "The source doesn't accept tuples",
+			http.StatusBadRequest, err))
+		return
+	}
+
+	// The body isn't parsed when the source cannot receive tuples anyway.
+	if err := r.CanReceive(); err != nil {
+		sc.renderReceiveError(err)
+		return
+	}
+
+	body := &countingReader{r: http.MaxBytesReader(rw, req.Body, maxTuplesRequestBodySize)}
+	ts, err := parseTuples(body)
+	if err != nil {
+		if body.n >= maxTuplesRequestBodySize {
+			sc.ErrLog(err).Warning("The request body is too large")
+			sc.RenderError(jasco.NewError(requestTooLargeErrorCode, "The request body is too large",
+				http.StatusRequestEntityTooLarge, err))
+			return
+		}
+		sc.ErrLog(err).Error("Cannot parse the request body")
+		e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+			http.StatusBadRequest, err)
+		e.Meta["body"] = []string{err.Error()}
+		sc.RenderError(e)
+		return
+	}
+
+	if err := r.Receive(ts); err != nil {
+		sc.renderReceiveError(err)
+		return
+	}
+	sc.Render(map[string]interface{}{
+		"topology": sc.topologyName,
+		"source":   sc.src.Name(),
+		"count":    len(ts),
+	})
+}
+
+// renderReceiveError renders an error returned from bql.TupleReceiver.
+func (sc *sources) renderReceiveError(err error) {
+	switch err {
+	case bql.ErrSourceBusy:
+		// This is a normal back pressure rather than an error of the server.
+		sc.ErrLog(err).Warning("Cannot add tuples to the source")
+		sc.RenderError(jasco.NewError(sourceBusyErrorCode, "The source is paused or its queue is full",
+			http.StatusTooManyRequests, err))
+	case bql.ErrTooManyTuples:
+		sc.ErrLog(err).Warning("Cannot add tuples to the source")
+		sc.RenderError(jasco.NewError(requestTooLargeErrorCode, "The request has more tuples than the queue of the source",
+			http.StatusRequestEntityTooLarge, err))
+	case core.ErrSourceStopped:
+		sc.ErrLog(err).Error("Cannot add tuples to the source")
+		sc.RenderError(jasco.NewError(requestResourceNotFoundErrorCode, "The source has been stopped",
+			http.StatusNotFound, err))
+	default:
+		sc.ErrLog(err).Error("Cannot add tuples to the source")
+		sc.RenderError(jasco.NewInternalServerError(err))
+	}
+}
+
+// countingReader counts the number of bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// parseTuples reads a sequence of JSON values from r. Each value must be an
+// object or an array of objects, and each object becomes a tuple.
+func parseTuples(r io.Reader) ([]*core.Tuple, error) {
+	var ts []*core.Tuple
+	dec := json.NewDecoder(r)
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		objs := []json.RawMessage{raw}
+		if raw[0] == '[' {
+			objs = nil
+			if err := json.Unmarshal(raw, &objs); err != nil {
+				return nil, err
+			}
+		}
+		for _, o := range objs {
+			if o[0] != '{' {
+				return nil, errors.New("the body must only contain objects or arrays of objects")
+			}
+			m := data.Map{}
+			if err := json.Unmarshal(o, &m); err != nil {
+				return nil, err
+			}
+			ts = append(ts, core.NewTuple(m))
+		}
+	}
+	if len(ts) == 0 {
+		return nil, errors.New("the body doesn't have any tuple")
+	}
+	return ts, nil
+}
+
 // TODO: Support Update(e.g. pause/resume) and Destroy if necessary. They can be
 // done by queries.
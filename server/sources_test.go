@@ -0,0 +1,46 @@
+package server
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"strings"
+	"testing"
+)
+
+func TestParseTuples(t *testing.T) {
+	valid := []struct {
+		body     string
+		expected []data.Map
+	}{
+		{`{"a":1}`, []data.Map{{"a": data.Int(1)}}},
+		{`[{"a":1}, {"b":"c"}]`, []data.Map{{"a": data.Int(1)}, {"b": data.String("c")}}},
+		{"{\"a\":1}\n{\"a\":2}\n", []data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}}},
+		{"{\"a\":1}\n[{\"a\":2}, {\"a\":3}]", []data.Map{{"a": data.Int(1)}, {"a": data.Int(2)}, {"a": data.Int(3)}}},
+	}
+	for _, c := range valid {
+		c := c
+		Convey("When parsing "+c.body, t, func() {
+			ts, err := parseTuples(strings.NewReader(c.body))
+
+			Convey("Then each object should be a tuple", func() {
+				So(err, ShouldBeNil)
+				So(len(ts), ShouldEqual, len(c.expected))
+				for i, t := range ts {
+					So(t.Data, ShouldResemble, c.expected[i])
+				}
+			})
+		})
+	}
+
+	invalid := []string{``, `[]`, `1`, `[{"a":1}, null]`, `[[{"a":1}]]`, `{"a":`, `{"a":1} "b"`}
+	for _, body := range invalid {
+		body := body
+		Convey("When parsing "+body, t, func() {
+			_, err := parseTuples(strings.NewReader(body))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	}
+}
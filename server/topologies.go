@@ -41,6 +41,7 @@ func setUpTopologiesRouter(prefix string, router *web.Router) {
 	setUpSourcesRouter(prefix, root)
 	setUpStreamsRouter(prefix, root)
 	setUpSinksRouter(prefix, root)
+	setUpFunctionsRouter(prefix, root)
 }
 
 func (tc *topologies) extractName(rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
@@ -273,14 +274,19 @@ func (tc *topologies) Queries(rw web.ResponseWriter, req *web.Request) {
 		} else if stmt, ok := stmts[0].(parser.EvalStmt); ok {
 			tc.handleEvalStmt(rw, stmt, stmtStr)
 			return
+		} else if stmt, ok := stmts[0].(parser.ExplainStmt); ok {
+			tc.handleExplainStmt(rw, stmt, stmtStr)
+			return
 		}
 	}
 
-	// TODO: handle this atomically
+	// A transaction started by BEGIN must be committed in the same request.
+	session := tb.NewSession()
 	for _, stmt := range stmts {
 		// TODO: change the return value of AddStmt to support the new response format.
-		_, err := tb.AddStmt(stmt)
+		_, err := session.AddStmt(stmt)
 		if err != nil {
+			session.Close()
 			tc.ErrLog(err).Error("Cannot process a statement")
 			e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
 			e.Meta["error"] = err.Error()
@@ -289,6 +295,13 @@ func (tc *topologies) Queries(rw web.ResponseWriter, req *web.Request) {
 			return
 		}
 	}
+	if err := session.Close(); err != nil {
+		tc.ErrLog(err).Error("Cannot process statements")
+		e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process statements", http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		tc.RenderError(e)
+		return
+	}
 
 	// TODO: support the new format
 	tc.Render(map[string]interface{}{
@@ -317,7 +330,21 @@ func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error)
 		queries = f
 	}
 
-	bp := parser.New()
+	// params is optional and has values bound to placeholders in queries
+	var params data.Map
+	if v, ok := form["params"]; ok {
+		m, err := data.AsMap(v)
+		if err != nil {
+			errMsg := "'params' must be an object"
+			tc.ErrLog(err).Error(errMsg)
+			e := jasco.NewError(formValidationErrorCode, "'params' field must be an object",
+				http.StatusBadRequest, err)
+			return nil, e
+		}
+		params = m
+	}
+
+	bp := parser.NewWithParams(params)
 	stmts := []interface{}{}
 	dataReturningStmtIndex := -1
 	for queries != "" {
@@ -336,6 +363,8 @@ func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error)
 			dataReturningStmtIndex = len(stmts)
 		} else if _, ok := stmt.(parser.EvalStmt); ok {
 			dataReturningStmtIndex = len(stmts)
+		} else if _, ok := stmt.(parser.ExplainStmt); ok {
+			dataReturningStmtIndex = len(stmts)
 		}
 
 		stmts = append(stmts, stmt)
@@ -344,10 +373,10 @@ func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error)
 
 	if dataReturningStmtIndex >= 0 {
 		if len(stmts) != 1 {
-			errMsg := "A SELECT or EVAL statement cannot be issued with other statements"
+			errMsg := "A SELECT, EVAL, or EXPLAIN statement cannot be issued with other statements"
 			tc.Log().Error(errMsg)
 			e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, nil)
-			e.Meta["error"] = "a SELECT or EVAL statement cannot be issued with other statements"
+			e.Meta["error"] = "a SELECT, EVAL, or EXPLAIN statement cannot be issued with other statements"
 			e.Meta["statement"] = fmt.Sprint(stmts[dataReturningStmtIndex])
 			return nil, e
 		}
@@ -524,6 +553,27 @@ func (tc *topologies) handleEvalStmt(rw web.ResponseWriter, stmt parser.EvalStmt
 	})
 }
 
+func (tc *topologies) handleExplainStmt(rw web.ResponseWriter, stmt parser.ExplainStmt, stmtStr string) {
+	tb := tc.fetchTopology()
+	if tb == nil { // just in case
+		return
+	}
+
+	result, err := tb.RunExplainStmt(&stmt)
+	if err != nil {
+		tc.ErrLog(err).Error("Cannot process a statement")
+		e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		e.Meta["statement"] = stmtStr
+		tc.RenderError(e)
+		return
+	}
+
+	tc.Render(map[string]interface{}{
+		"result": result,
+	})
+}
+
 // WebSocketQueries handles requests using WebSocket. A single WebSocket
 // connection can concurrently issue multiple requests including requests
 // containing a SELECT statement.
@@ -711,14 +761,20 @@ func (tc *topologies) processWebSocketMessage(conn *websocket.Conn, tb *bql.Topo
 			} else if stmt, ok := stmts[0].(parser.EvalStmt); ok {
 				w.handleEvalStmtWebSocket(conn, stmt, stmtStr)
 				return
+			} else if stmt, ok := stmts[0].(parser.ExplainStmt); ok {
+				w.handleExplainStmtWebSocket(conn, stmt, stmtStr)
+				return
 			}
 		}
 
-		// TODO: handle this atomically
+		// A transaction started by BEGIN must be committed in the same
+		// request.
+		session := tb.NewSession()
 		for _, stmt := range stmts {
 			// TODO: change the return value of AddStmt to support the new response format.
-			_, err = tb.AddStmt(stmt)
+			_, err = session.AddStmt(stmt)
 			if err != nil {
+				session.Close()
 				w.ErrLog(err).Error("Cannot process a statement")
 				e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
 				e.Meta["error"] = err.Error()
@@ -727,6 +783,13 @@ func (tc *topologies) processWebSocketMessage(conn *websocket.Conn, tb *bql.Topo
 				return
 			}
 		}
+		if err := session.Close(); err != nil {
+			w.ErrLog(err).Error("Cannot process statements")
+			e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process statements", http.StatusBadRequest, err)
+			e.Meta["error"] = err.Error()
+			w.sendErr(e)
+			return
+		}
 
 		// TODO: define a proper response format
 		if err := w.send("result", map[string]interface{}{}); err != nil {
@@ -876,3 +939,27 @@ func (w *webSocketTopologyQueryHandler) handleEvalStmtWebSocket(conn *websocket.
 		return
 	}
 }
+
+func (w *webSocketTopologyQueryHandler) handleExplainStmtWebSocket(conn *websocket.Conn, stmt parser.ExplainStmt, stmtStr string) {
+	tb := w.tc.fetchTopology()
+	if tb == nil { // just in case
+		return
+	}
+
+	result, err := tb.RunExplainStmt(&stmt)
+	if err != nil {
+		w.ErrLog(err).Error("Cannot process a statement")
+		e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		e.Meta["statement"] = stmtStr
+		w.sendErr(e)
+		return
+	}
+
+	if err := w.send("result", map[string]interface{}{
+		"result": result,
+	}); err != nil {
+		w.ErrLog(err).Error("Cannot send data to the WebSocket client")
+		return
+	}
+}